@@ -10,15 +10,25 @@ import (
 type (
 	// Config -.
 	Config struct {
-		App     App
-		HTTP    HTTP
-		GRPC    GRPC
-		Log     Log
-		PG      PG
-		Metrics Metrics
-		Swagger Swagger
-		JWT     JWT
-		TLS     TLS
+		App      App
+		HTTP     HTTP
+		GRPC     GRPC
+		Log      Log
+		PG       PG
+		Metrics  Metrics
+		Swagger  Swagger
+		JWT      JWT
+		TLS      TLS
+		Session  Session
+		Account  Account
+		Mail     Mail
+		MFA      MFA
+		Lockout  Lockout
+		OAuth    OAuth
+		APIKey   APIKey
+		Audit    Audit
+		Profile  Profile
+		UserData UserData
 	}
 
 	// App -.
@@ -76,11 +86,13 @@ type (
 
 	// JWT -.
 	JWT struct {
-		Secret     string `env:"JWT_SECRET,required"`
-		AccessTTL  int    `env:"JWT_ACCESS_TTL_MINUTES,required"`
-		RefreshTTL int    `env:"JWT_REFRESH_TTL_DAYS,required"`
-		Issuer     string `env:"JWT_ISSUER" envDefault:"auth-service"`
-		Algorithm  string `env:"JWT_ALGORITHM" envDefault:"HS256"`
+		AccessTokenTTL      time.Duration `env:"JWT_ACCESS_TOKEN_TTL" envDefault:"15m"`
+		RefreshTokenTTL     time.Duration `env:"JWT_REFRESH_TOKEN_TTL" envDefault:"168h"`
+		Issuer              string        `env:"JWT_ISSUER" envDefault:"auth-service"`
+		Algorithm           string        `env:"JWT_ALGORITHM" envDefault:"RS256"`
+		KeyRotationInterval time.Duration `env:"JWT_KEY_ROTATION_INTERVAL" envDefault:"720h"`
+		KeyPrepublish       time.Duration `env:"JWT_KEY_PREPUBLISH" envDefault:"1h"`
+		KeySyncInterval     time.Duration `env:"JWT_KEY_SYNC_INTERVAL" envDefault:"5m"`
 	}
 
 	// TLS -.
@@ -88,6 +100,88 @@ type (
 		CertFile string `env:"TLS_CERT_FILE"`
 		KeyFile  string `env:"TLS_KEY_FILE"`
 	}
+
+	// Session -.
+	Session struct {
+		DenylistPurgeInterval time.Duration `env:"SESSION_DENYLIST_PURGE_INTERVAL" envDefault:"1h"`
+	}
+
+	// Account -.
+	Account struct {
+		VerificationTTL    time.Duration `env:"ACCOUNT_VERIFICATION_TTL"     envDefault:"48h"`
+		ResetTTL           time.Duration `env:"ACCOUNT_RESET_TTL"            envDefault:"1h"`
+		UnverifiedPolicy   string        `env:"ACCOUNT_UNVERIFIED_POLICY"    envDefault:"restrict"`
+		TokenPurgeInterval time.Duration `env:"ACCOUNT_TOKEN_PURGE_INTERVAL" envDefault:"1h"`
+		AppURL             string        `env:"ACCOUNT_APP_URL"              envDefault:"http://localhost:3000"`
+	}
+
+	// Mail -.
+	Mail struct {
+		Driver       string        `env:"MAIL_DRIVER"        envDefault:"log"`
+		From         string        `env:"MAIL_FROM"          envDefault:"FinancialAdviser <no-reply@financialadviser.local>"`
+		FilePath     string        `env:"MAIL_FILE_PATH"     envDefault:"/tmp/auth-service/mail.log"`
+		SMTPHost     string        `env:"MAIL_SMTP_HOST"`
+		SMTPPort     string        `env:"MAIL_SMTP_PORT"     envDefault:"587"`
+		SMTPUsername string        `env:"MAIL_SMTP_USERNAME"`
+		SMTPPassword string        `env:"MAIL_SMTP_PASSWORD"`
+		SMTPTimeout  time.Duration `env:"MAIL_SMTP_TIMEOUT"  envDefault:"10s"`
+	}
+	// MFA -.
+	MFA struct {
+		Issuer                 string        `env:"MFA_ISSUER"                   envDefault:"FinancialAdviser"`
+		ChallengeTTL           time.Duration `env:"MFA_CHALLENGE_TTL"            envDefault:"5m"`
+		ChallengeAttempts      int           `env:"MFA_CHALLENGE_ATTEMPTS"       envDefault:"5"`
+		ChallengePurgeInterval time.Duration `env:"MFA_CHALLENGE_PURGE_INTERVAL" envDefault:"1h"`
+	}
+	// Lockout -.
+	Lockout struct {
+		AccountThreshold int           `env:"LOCKOUT_ACCOUNT_THRESHOLD" envDefault:"5"`
+		IPThreshold      int           `env:"LOCKOUT_IP_THRESHOLD"      envDefault:"20"`
+		BaseDelay        time.Duration `env:"LOCKOUT_BASE_DELAY"        envDefault:"30s"`
+		MaxDelay         time.Duration `env:"LOCKOUT_MAX_DELAY"         envDefault:"1h"`
+		Window           time.Duration `env:"LOCKOUT_WINDOW"            envDefault:"24h"`
+		PurgeInterval    time.Duration `env:"LOCKOUT_PURGE_INTERVAL"    envDefault:"1h"`
+	}
+	// OAuth -.
+	OAuth struct {
+		RedirectBaseURL    string        `env:"OAUTH_REDIRECT_BASE_URL"    envDefault:"http://localhost:8080/api/auth/oauth"`
+		StateTTL           time.Duration `env:"OAUTH_STATE_TTL"            envDefault:"10m"`
+		StatePurgeInterval time.Duration `env:"OAUTH_STATE_PURGE_INTERVAL" envDefault:"1h"`
+		GoogleIssuer       string        `env:"OAUTH_GOOGLE_ISSUER"        envDefault:"https://accounts.google.com"`
+		GoogleClientID     string        `env:"OAUTH_GOOGLE_CLIENT_ID"`
+		GoogleClientSecret string        `env:"OAUTH_GOOGLE_CLIENT_SECRET"`
+		GitHubClientID     string        `env:"OAUTH_GITHUB_CLIENT_ID"`
+		GitHubClientSecret string        `env:"OAUTH_GITHUB_CLIENT_SECRET"`
+	}
+
+	// APIKey -.
+	APIKey struct {
+		DefaultTTL time.Duration `env:"API_KEY_DEFAULT_TTL"  envDefault:"2160h"`
+		MaxTTL     time.Duration `env:"API_KEY_MAX_TTL"      envDefault:"8760h"`
+		MaxPerUser int           `env:"API_KEY_MAX_PER_USER" envDefault:"10"`
+	}
+
+	// Audit -.
+	Audit struct {
+		Retention     time.Duration `env:"AUDIT_RETENTION"      envDefault:"8760h"`
+		PurgeInterval time.Duration `env:"AUDIT_PURGE_INTERVAL" envDefault:"24h"`
+	}
+
+	// Profile -.
+	Profile struct {
+		WatchlistLimit int `env:"PROFILE_WATCHLIST_LIMIT" envDefault:"50"`
+	}
+
+	// UserData -.
+	UserData struct {
+		BrainAddr        string        `env:"USER_DATA_BRAIN_ADDR"`
+		SubAddr          string        `env:"USER_DATA_SUB_ADDR"`
+		Timeout          time.Duration `env:"USER_DATA_TIMEOUT"           envDefault:"30s"`
+		DeletionInterval time.Duration `env:"USER_DATA_DELETION_INTERVAL" envDefault:"1m"`
+		RetryBaseDelay   time.Duration `env:"USER_DATA_RETRY_BASE_DELAY"  envDefault:"1m"`
+		RetryMaxDelay    time.Duration `env:"USER_DATA_RETRY_MAX_DELAY"   envDefault:"6h"`
+		MaxAttempts      int           `env:"USER_DATA_MAX_ATTEMPTS"      envDefault:"20"`
+	}
 )
 
 // NewConfig returns app config
@@ -1,16 +1,24 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/config"
 	grpcController "github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/controller/grpc"
+	httpController "github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/controller/http"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo/audit"
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo/persistent"
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase/auth"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase/keys"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase/profile"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
 	grpcServer "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/grpcserver"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/httpserver"
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/logger"
 	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
@@ -37,25 +45,211 @@ func Run(cfg *config.Config) {
 
 	// repository and use case
 	userRepository := persistent.NewUserPostgres(pg)
+	sessionRepository := persistent.NewSessionPostgres(pg)
+	denylistRepository := persistent.NewDenylistPostgres(pg)
+	signingKeyRepository := persistent.NewSigningKeyPostgres(pg)
+	userTokenRepository := persistent.NewUserTokenPostgres(pg)
+	mfaRepository := persistent.NewMFAPostgres(pg)
+	throttleRepository := persistent.NewThrottlePostgres(pg)
+	identityRepository := persistent.NewIdentityPostgres(pg)
+	apiKeyRepository := persistent.NewAPIKeyPostgres(pg)
+	auditRepository := persistent.NewAuditPostgres(pg)
+	profileRepository := persistent.NewProfilePostgres(pg)
+	deletionRepository := persistent.NewDeletionPostgres(pg)
+
+	keyRing := keys.New(
+		signingKeyRepository,
+		cfg.JWT.Algorithm,
+		cfg.JWT.KeyRotationInterval,
+		cfg.JWT.KeyPrepublish,
+		cfg.JWT.AccessTokenTTL,
+	)
+	if err = keyRing.Sync(context.Background()); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - keyRing.Sync: %w", err))
+	}
+
+	unverifiedPolicy, err := auth.ParseUnverifiedPolicy(cfg.Account.UnverifiedPolicy)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - auth.ParseUnverifiedPolicy: %w", err))
+	}
+
+	mail, err := newMailer(cfg.Mail, l)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - newMailer: %w", err))
+	}
+
+	userDataServices, closeUserData, err := newUserDataServices(cfg.UserData)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - newUserDataServices: %w", err))
+	}
+	defer closeUserData()
+
 	authUseCase := auth.New(
 		userRepository,
-		cfg.JWT.Secret,
-		cfg.JWT.AccessTTL,
+		sessionRepository,
+		denylistRepository,
+		userTokenRepository,
+		mfaRepository,
+		throttleRepository,
+		audit.NewFallback(auditRepository, l),
+		identityRepository,
+		apiKeyRepository,
+		profileRepository,
+		deletionRepository,
+		keyRing,
+		mail,
+		newIdentityProviders(cfg.OAuth),
+		userDataServices,
+		auth.Config{
+			Issuer:           cfg.JWT.Issuer,
+			AccessTTL:        cfg.JWT.AccessTokenTTL,
+			RefreshTTL:       cfg.JWT.RefreshTokenTTL,
+			VerificationTTL:  cfg.Account.VerificationTTL,
+			ResetTTL:         cfg.Account.ResetTTL,
+			UnverifiedPolicy: unverifiedPolicy,
+			AppURL:           cfg.Account.AppURL,
+
+			MFAIssuer:         cfg.MFA.Issuer,
+			ChallengeTTL:      cfg.MFA.ChallengeTTL,
+			ChallengeAttempts: cfg.MFA.ChallengeAttempts,
+
+			Lockout: auth.LockoutPolicy{
+				AccountThreshold: cfg.Lockout.AccountThreshold,
+				IPThreshold:      cfg.Lockout.IPThreshold,
+				BaseDelay:        cfg.Lockout.BaseDelay,
+				MaxDelay:         cfg.Lockout.MaxDelay,
+				Window:           cfg.Lockout.Window,
+			},
+
+			OAuthStateTTL: cfg.OAuth.StateTTL,
+
+			APIKeyTTL:      cfg.APIKey.DefaultTTL,
+			APIKeyMaxTTL:   cfg.APIKey.MaxTTL,
+			APIKeysPerUser: cfg.APIKey.MaxPerUser,
+
+			Deletion: auth.DeletionPolicy{
+				BaseDelay:   cfg.UserData.RetryBaseDelay,
+				MaxDelay:    cfg.UserData.RetryMaxDelay,
+				MaxAttempts: cfg.UserData.MaxAttempts,
+			},
+
+			Logger: l,
+		},
+	)
+
+	profileUseCase := profile.New(
+		profileRepository,
+		userRepository,
+		profile.Config{WatchlistLimit: cfg.Profile.WatchlistLimit},
 	)
 
-	// GRPC Server
+	// background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	go runPeriodically(jobsCtx, cfg.Session.DenylistPurgeInterval, func(ctx context.Context) {
+		n, err := denylistRepository.Purge(ctx)
+		if err != nil {
+			l.Error("app - Run - denylistRepository.Purge: %v", err)
+			return
+		}
+		l.Debug("app - Run - purged %d expired denylist entries", n)
+	})
+
+	go runPeriodically(jobsCtx, cfg.Account.TokenPurgeInterval, func(ctx context.Context) {
+		n, err := userTokenRepository.Purge(ctx)
+		if err != nil {
+			l.Error("app - Run - userTokenRepository.Purge: %v", err)
+			return
+		}
+		l.Debug("app - Run - purged %d expired user tokens", n)
+	})
+
+	go runPeriodically(jobsCtx, cfg.MFA.ChallengePurgeInterval, func(ctx context.Context) {
+		n, err := mfaRepository.PurgeChallenges(ctx)
+		if err != nil {
+			l.Error("app - Run - mfaRepository.PurgeChallenges: %v", err)
+			return
+		}
+		l.Debug("app - Run - purged %d expired mfa challenges", n)
+	})
+
+	go runPeriodically(jobsCtx, cfg.Lockout.PurgeInterval, func(ctx context.Context) {
+		n, err := throttleRepository.Purge(ctx, cfg.Lockout.Window)
+		if err != nil {
+			l.Error("app - Run - throttleRepository.Purge: %v", err)
+			return
+		}
+		l.Debug("app - Run - purged %d stale sign-in counters", n)
+	})
+
+	go runPeriodically(jobsCtx, cfg.OAuth.StatePurgeInterval, func(ctx context.Context) {
+		n, err := identityRepository.PurgeStates(ctx)
+		if err != nil {
+			l.Error("app - Run - identityRepository.PurgeStates: %v", err)
+			return
+		}
+		l.Debug("app - Run - purged %d expired oauth states", n)
+	})
+
+	go runPeriodically(jobsCtx, cfg.Audit.PurgeInterval, func(ctx context.Context) {
+		n, err := auditRepository.Purge(ctx, time.Now().Add(-cfg.Audit.Retention))
+		if err != nil {
+			l.Error("app - Run - auditRepository.Purge: %v", err)
+			return
+		}
+		l.Debug("app - Run - purged %d audit events past retention", n)
+	})
+
+	go runPeriodically(jobsCtx, cfg.UserData.DeletionInterval, func(ctx context.Context) {
+		n, err := authUseCase.ProcessDeletions(ctx)
+		if err != nil {
+			l.Error("app - Run - authUseCase.ProcessDeletions: %v", err)
+			return
+		}
+		l.Debug("app - Run - completed %d account deletions", n)
+	})
+
+	go runPeriodically(jobsCtx, cfg.JWT.KeySyncInterval, func(ctx context.Context) {
+		if err := keyRing.Sync(ctx); err != nil {
+			l.Error("app - Run - keyRing.Sync: %v", err)
+		}
+	})
+
+	// HTTP Server
+	hs := httpserver.New(
+		httpserver.Port(cfg.HTTP.Port),
+		httpserver.ReadTimeout(cfg.HTTP.ReadTimeout),
+		httpserver.WriteTimeout(cfg.HTTP.WriteTimeout),
+		httpserver.ShutdownTimeout(cfg.HTTP.ShutdownTimeout),
+	)
+	httpController.NewRouter(hs.GetEngine(), keyRing)
+	hs.Start()
+	l.Info("HTTP server listening on %s", cfg.HTTP.Port)
+
+	// GRPC Server, AdminService calls and GetUserProfile are authorized by the
+	// caller's access token. The end user of every call is taken from the
+	// metadata the gateway forwards
+	tokenVerifier := grpcController.NewTokenVerifier(authUseCase)
 	gs := grpcServer.New(
 		grpcServer.Port("0.0.0.0:"+cfg.GRPC.Port),
 		grpcServer.MaxStreams(cfg.GRPC.MaxConcurrentStreams),
 		grpcServer.TLS(cfg.TLS.CertFile, cfg.TLS.KeyFile),
+		grpcServer.UnaryInterceptors(
+			grpcController.ClientUnaryInterceptor(),
+			authz.UnaryServerInterceptor(tokenVerifier, grpcController.AdminPolicy),
+			authz.UnaryServerInterceptor(tokenVerifier, grpcController.ProfilePolicy),
+		),
 	)
 
 	// register services
 	gs.Serve(func(s *grpc.Server) {
 		// register all services with the same server instance
 		authv1.RegisterAuthServiceServer(s, grpcController.NewAuthService(authUseCase))
+		authv1.RegisterAdminServiceServer(s, grpcController.NewAdminService(authUseCase))
+		authv1.RegisterProfileServiceServer(s, grpcController.NewProfileService(authUseCase, profileUseCase))
 	})
-	l.Info("gRPC server listening on " + cfg.GRPC.Port)
+	l.Info("gRPC server listening on %s", cfg.GRPC.Port)
 
 	// waiting signal
 	interrupt := make(chan os.Signal, 1)
@@ -65,9 +259,15 @@ func Run(cfg *config.Config) {
 		l.Info("app - Run - signal: %s", s.String())
 	case err = <-gs.Notify():
 		l.Error("app - Run - grpcserver.Notify: %v", err)
+	case err = <-hs.Notify():
+		l.Error("app - Run - httpserver.Notify: %v", err)
 	}
 
 	// graceful shutdown
 	l.Info("app - Run - shutting down gRPC server")
 	gs.GracefulStop(cfg.GRPC.ShutdownTimeout)
+	if err = hs.Shutdown(); err != nil {
+		l.Error("app - Run - httpserver.Shutdown: %v", err)
+	}
+	stopJobs()
 }
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo/mailer"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/logger"
+)
+
+// newMailer creates the mailer selected by the configured driver
+func newMailer(cfg config.Mail, l logger.Interface) (repo.Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		return mailer.NewSMTP(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From, cfg.SMTPTimeout)
+	case "file":
+		return mailer.NewFile(cfg.FilePath, cfg.From)
+	case "log":
+		l.Warn("app - newMailer - mails are written to the log, do not use in production")
+		return mailer.NewLog(l), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", cfg.Driver)
+	}
+}
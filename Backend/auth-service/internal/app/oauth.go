@@ -0,0 +1,39 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo/oauth"
+)
+
+// newIdentityProviders creates the identity providers that have a client configured
+func newIdentityProviders(cfg config.OAuth) []repo.IdentityProvider {
+	var providers []repo.IdentityProvider
+
+	if cfg.GoogleClientID != "" {
+		providers = append(providers, oauth.NewOIDC(
+			entity.ProviderGoogle,
+			cfg.GoogleIssuer,
+			cfg.GoogleClientID,
+			cfg.GoogleClientSecret,
+			callbackURL(cfg, entity.ProviderGoogle),
+		))
+	}
+	if cfg.GitHubClientID != "" {
+		providers = append(providers, oauth.NewGitHub(
+			cfg.GitHubClientID,
+			cfg.GitHubClientSecret,
+			callbackURL(cfg, entity.ProviderGitHub),
+		))
+	}
+
+	return providers
+}
+
+// callbackURL returns the redirect URL registered with the provider
+func callbackURL(cfg config.OAuth, provider string) string {
+	return strings.TrimSuffix(cfg.RedirectBaseURL, "/") + "/" + provider + "/callback"
+}
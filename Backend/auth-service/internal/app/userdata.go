@@ -0,0 +1,43 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo/userdata"
+)
+
+// newUserDataServices connects to the services that keep user data and have an address configured.
+// The returned func closes the connections
+func newUserDataServices(cfg config.UserData) ([]repo.UserDataService, func(), error) {
+	addrs := []struct{ name, addr string }{
+		{"brain", cfg.BrainAddr},
+		{"subscriptions", cfg.SubAddr},
+	}
+
+	var (
+		services []repo.UserDataService
+		clients  []*userdata.Service
+	)
+	closeAll := func() {
+		for _, c := range clients {
+			_ = c.Close()
+		}
+	}
+
+	for _, a := range addrs {
+		if a.addr == "" {
+			continue
+		}
+		c, err := userdata.New(a.name, a.addr, cfg.Timeout)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("userdata.New(%s): %w", a.name, err)
+		}
+		clients = append(clients, c)
+		services = append(services, c)
+	}
+
+	return services, closeAll, nil
+}
@@ -0,0 +1,439 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/mapper"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+)
+
+const (
+	_defaultUsersLimit = 50
+	_maxUsersLimit     = 200
+
+	_defaultAuditLimit = 50
+	_maxAuditLimit     = 200
+)
+
+// AdminPolicy is the authorization policy of AdminService
+var AdminPolicy = authz.Policy{
+	authv1.AdminService_ListUsers_FullMethodName:       authz.RequirePermissions(authz.PermUsersRead),
+	authv1.AdminService_GetUser_FullMethodName:         authz.RequirePermissions(authz.PermUsersRead),
+	authv1.AdminService_SetUserRole_FullMethodName:     authz.RequirePermissions(authz.PermUsersWrite),
+	authv1.AdminService_DisableUser_FullMethodName:     authz.RequirePermissions(authz.PermUsersWrite),
+	authv1.AdminService_EnableUser_FullMethodName:      authz.RequirePermissions(authz.PermUsersWrite),
+	authv1.AdminService_ImpersonateUser_FullMethodName: authz.RequirePermissions(authz.PermUsersImpersonate),
+
+	authv1.AdminService_GetMFAPolicy_FullMethodName:          authz.RequirePermissions(authz.PermUsersRead),
+	authv1.AdminService_SetRoleMFARequirement_FullMethodName: authz.RequirePermissions(authz.PermUsersWrite),
+	authv1.AdminService_ResetUserMFA_FullMethodName:          authz.RequirePermissions(authz.PermUsersWrite),
+	authv1.AdminService_UnlockUser_FullMethodName:            authz.RequirePermissions(authz.PermUsersWrite),
+
+	authv1.AdminService_ListAuditEvents_FullMethodName: authz.RequirePermissions(authz.PermAuditRead),
+
+	authv1.AdminService_RetryAccountDeletion_FullMethodName: authz.RequirePermissions(authz.PermUsersWrite),
+}
+
+// AdminService implements the gRPC admin service
+type AdminService struct {
+	authv1.UnimplementedAdminServiceServer
+	adminUseCase usecase.AdminUseCase
+}
+
+// NewAdminService creates a new instance of AdminService
+func NewAdminService(adminUseCase usecase.AdminUseCase) *AdminService {
+	return &AdminService{
+		adminUseCase: adminUseCase,
+	}
+}
+
+// ListUsers implements the ListUsers RPC method
+func (s *AdminService) ListUsers(ctx context.Context, req *authv1.ListUsersRequest) (*authv1.ListUsersResponse, error) {
+	filter := entity.UserFilter{
+		Query:    req.GetQuery(),
+		Disabled: req.Disabled,
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	}
+	if req.GetRole() != "" {
+		role, err := entity.ParseRole(req.GetRole())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid role")
+		}
+		filter.Role = role
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = _defaultUsersLimit
+	}
+	if filter.Limit > _maxUsersLimit {
+		filter.Limit = _maxUsersLimit
+	}
+	if filter.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+
+	users, total, err := s.adminUseCase.ListUsers(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &authv1.ListUsersResponse{
+		Users: make([]*authv1.User, len(users)),
+		Total: int32(total),
+	}
+	for i, user := range users {
+		resp.Users[i] = mapper.UserToProto(user)
+	}
+
+	return resp, nil
+}
+
+// GetUser implements the GetUser RPC method
+func (s *AdminService) GetUser(ctx context.Context, req *authv1.GetUserRequest) (*authv1.GetUserResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	user, err := s.adminUseCase.GetUser(ctx, userID)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.GetUserResponse{User: mapper.UserToProto(user)}, nil
+}
+
+// SetUserRole implements the SetUserRole RPC method
+func (s *AdminService) SetUserRole(ctx context.Context, req *authv1.SetUserRoleRequest) (*authv1.SetUserRoleResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	role, err := entity.ParseRole(req.GetRole())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.adminUseCase.SetUserRole(ctx, actorID, userID, role)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.SetUserRoleResponse{User: mapper.UserToProto(user)}, nil
+}
+
+// DisableUser implements the DisableUser RPC method
+func (s *AdminService) DisableUser(ctx context.Context, req *authv1.DisableUserRequest) (*authv1.DisableUserResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.adminUseCase.DisableUser(ctx, actorID, userID)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.DisableUserResponse{User: mapper.UserToProto(user)}, nil
+}
+
+// EnableUser implements the EnableUser RPC method
+func (s *AdminService) EnableUser(ctx context.Context, req *authv1.EnableUserRequest) (*authv1.EnableUserResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.adminUseCase.EnableUser(ctx, actorID, userID)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.EnableUserResponse{User: mapper.UserToProto(user)}, nil
+}
+
+// ImpersonateUser implements the ImpersonateUser RPC method
+func (s *AdminService) ImpersonateUser(ctx context.Context, req *authv1.ImpersonateUserRequest) (*authv1.ImpersonateUserResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.adminUseCase.Impersonate(ctx, actorID, userID)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.ImpersonateUserResponse{
+		Token:     tokens.AccessToken,
+		ExpiresAt: tokens.AccessExpiresAt.Unix(),
+	}, nil
+}
+
+// GetMFAPolicy implements the GetMFAPolicy RPC method
+func (s *AdminService) GetMFAPolicy(ctx context.Context, _ *authv1.GetMFAPolicyRequest) (*authv1.GetMFAPolicyResponse, error) {
+	roles, err := s.adminUseCase.MFAPolicy(ctx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.GetMFAPolicyResponse{RequiredRoles: rolesToStrings(roles)}, nil
+}
+
+// SetRoleMFARequirement implements the SetRoleMFARequirement RPC method
+func (s *AdminService) SetRoleMFARequirement(
+	ctx context.Context,
+	req *authv1.SetRoleMFARequirementRequest,
+) (*authv1.SetRoleMFARequirementResponse, error) {
+	role, err := entity.ParseRole(req.GetRole())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.adminUseCase.SetMFARequirement(ctx, actorID, role, req.GetRequired()); err != nil {
+		return nil, adminError(err)
+	}
+
+	roles, err := s.adminUseCase.MFAPolicy(ctx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.SetRoleMFARequirementResponse{RequiredRoles: rolesToStrings(roles)}, nil
+}
+
+// ResetUserMFA implements the ResetUserMFA RPC method
+func (s *AdminService) ResetUserMFA(ctx context.Context, req *authv1.ResetUserMFARequest) (*authv1.ResetUserMFAResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.adminUseCase.ResetMFA(ctx, actorID, userID); err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.ResetUserMFAResponse{}, nil
+}
+
+// UnlockUser implements the UnlockUser RPC method
+func (s *AdminService) UnlockUser(ctx context.Context, req *authv1.UnlockUserRequest) (*authv1.UnlockUserResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.adminUseCase.UnlockUser(ctx, actorID, userID); err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.UnlockUserResponse{}, nil
+}
+
+// ListAuditEvents implements the ListAuditEvents RPC method
+func (s *AdminService) ListAuditEvents(
+	ctx context.Context,
+	req *authv1.ListAuditEventsRequest,
+) (*authv1.ListAuditEventsResponse, error) {
+	filter := entity.AuditFilter{
+		Type:    entity.AuditEventType(req.GetType()),
+		Outcome: entity.AuditOutcome(req.GetOutcome()),
+		IP:      req.GetIp(),
+		Limit:   int(req.GetLimit()),
+	}
+
+	var err error
+	if req.GetActorId() != "" {
+		if filter.ActorID, err = uuid.Parse(req.GetActorId()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid actor id")
+		}
+	}
+	if req.GetTargetId() != "" {
+		if filter.TargetID, err = uuid.Parse(req.GetTargetId()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid target id")
+		}
+	}
+	if filter.Outcome != "" && filter.Outcome != entity.AuditSuccess && filter.Outcome != entity.AuditFailure {
+		return nil, status.Error(codes.InvalidArgument, "invalid outcome")
+	}
+	if req.GetSince() > 0 {
+		filter.Since = time.Unix(req.GetSince(), 0).UTC()
+	}
+	if req.GetUntil() > 0 {
+		filter.Until = time.Unix(req.GetUntil(), 0).UTC()
+	}
+	if req.GetCursor() != "" {
+		if filter.After, err = entity.ParseAuditCursor(req.GetCursor()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = _defaultAuditLimit
+	}
+	if filter.Limit > _maxAuditLimit {
+		filter.Limit = _maxAuditLimit
+	}
+
+	events, next, err := s.adminUseCase.ListAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &authv1.ListAuditEventsResponse{
+		Events: make([]*authv1.AuditEvent, len(events)),
+	}
+	for i, e := range events {
+		resp.Events[i] = mapper.AuditEventToProto(e)
+	}
+	if next != nil {
+		resp.NextCursor = next.String()
+	}
+
+	return resp, nil
+}
+
+// RetryAccountDeletion implements the RetryAccountDeletion RPC method
+func (s *AdminService) RetryAccountDeletion(
+	ctx context.Context,
+	req *authv1.RetryAccountDeletionRequest,
+) (*authv1.RetryAccountDeletionResponse, error) {
+	id, err := uuid.Parse(req.GetDeletionId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid deletion id")
+	}
+
+	actorID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion, err := s.adminUseCase.RetryAccountDeletion(ctx, actorID, id)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &authv1.RetryAccountDeletionResponse{Deletion: mapper.AccountDeletionToProto(deletion)}, nil
+}
+
+// rolesToStrings returns the names of the roles
+func rolesToStrings(roles []entity.Role) []string {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+
+	return names
+}
+
+// callerID returns the id of the staff member the call was authorized for
+func callerID(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "access token is required")
+	}
+
+	id, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return id, nil
+}
+
+// adminError maps errors of the admin use case to statuses
+func adminError(err error) error {
+	switch err {
+	case usecase.ErrUserNotFound:
+		return status.Error(codes.NotFound, "user not found")
+	case usecase.ErrSelfModification:
+		return status.Error(codes.FailedPrecondition, "cannot modify own account")
+	case usecase.ErrNotImpersonable:
+		return status.Error(codes.PermissionDenied, "user cannot be impersonated")
+	case usecase.ErrUserDisabled:
+		return status.Error(codes.FailedPrecondition, "user disabled")
+	case usecase.ErrDeletionNotFound:
+		return status.Error(codes.NotFound, "account deletion not found")
+	case usecase.ErrDeletionCompleted:
+		return status.Error(codes.FailedPrecondition, "account deletion already completed")
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// TokenVerifier verifies access tokens for authz interceptors with the auth
+// use case itself, unlike JWKS verification it honours revocation
+type TokenVerifier struct {
+	authUseCase usecase.AuthUseCase
+}
+
+// NewTokenVerifier creates a new instance of TokenVerifier
+func NewTokenVerifier(authUseCase usecase.AuthUseCase) *TokenVerifier {
+	return &TokenVerifier{authUseCase: authUseCase}
+}
+
+// Verify implements authz.Verifier
+func (v *TokenVerifier) Verify(ctx context.Context, token string) (*authz.Claims, error) {
+	claims, err := v.authUseCase.Authenticate(ctx, token)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidToken) {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	c := &authz.Claims{
+		UserID:      claims.UserID.String(),
+		SessionID:   claims.SessionID.String(),
+		Role:        string(claims.Role),
+		Permissions: claims.Permissions,
+	}
+	if claims.ActorID != uuid.Nil {
+		c.ActorID = claims.ActorID.String()
+	}
+
+	return c, nil
+}
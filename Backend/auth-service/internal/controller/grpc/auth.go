@@ -2,11 +2,15 @@ package grpc
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/mapper"
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
 	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
 )
@@ -30,26 +34,39 @@ func (s *AuthService) SignIn(ctx context.Context, req *authv1.SignInRequest) (*a
 		return nil, status.Error(codes.InvalidArgument, "email and password are required")
 	}
 
-	token, err := s.authUseCase.SignIn(ctx, req.GetEmail(), req.GetPassword())
+	tokens, challenge, err := s.authUseCase.SignIn(ctx, req.GetEmail(), req.GetPassword())
 	if err != nil {
 		switch err {
-		case usecase.ErrUserNotFound:
-			return nil, status.Error(codes.NotFound, "user not found")
-		case usecase.ErrInvalidPassword:
-			return nil, status.Error(codes.InvalidArgument, "invalid password")
+		case usecase.ErrInvalidCredentials:
+			return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+		case usecase.ErrTooManyAttempts:
+			return nil, status.Error(codes.ResourceExhausted, "too many sign-in attempts, try again later")
+		case usecase.ErrUserDisabled:
+			return nil, status.Error(codes.PermissionDenied, "user disabled")
+		case usecase.ErrEmailNotVerified:
+			return nil, status.Error(codes.FailedPrecondition, "email not verified")
 		default:
 			return nil, status.Error(codes.Internal, "internal server error")
 		}
 	}
 
-	user, err := s.authUseCase.ValidateToken(ctx, token)
-	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Errorf("s.authUseCase.ValidateToken(): %w", err).Error())
+	if challenge != nil {
+		return &authv1.SignInResponse{
+			UserId:       challenge.UserID.String(),
+			MfaRequired:  true,
+			MfaToken:     challenge.Token,
+			MfaExpiresAt: challenge.ExpiresAt.Unix(),
+		}, nil
 	}
 
 	return &authv1.SignInResponse{
-		Token:  token,
-		UserId: user.ID.String(),
+		Token:            tokens.AccessToken,
+		UserId:           tokens.UserID.String(),
+		RefreshToken:     tokens.RefreshToken,
+		ExpiresAt:        tokens.AccessExpiresAt.Unix(),
+		RefreshExpiresAt: tokens.RefreshExpiresAt.Unix(),
+
+		MfaEnrollmentRequired: tokens.MFAEnrollmentRequired,
 	}, nil
 }
 
@@ -59,7 +76,7 @@ func (s *AuthService) SignUp(ctx context.Context, req *authv1.SignUpRequest) (*a
 		return nil, status.Error(codes.InvalidArgument, "email, password, and username are required")
 	}
 
-	token, err := s.authUseCase.SignUp(ctx, req.GetEmail(), req.GetPassword(), req.GetUsername())
+	tokens, err := s.authUseCase.SignUp(ctx, req.GetEmail(), req.GetPassword(), req.GetUsername())
 	if err != nil {
 		switch err {
 		case usecase.ErrUserAlreadyExists:
@@ -75,15 +92,15 @@ func (s *AuthService) SignUp(ctx context.Context, req *authv1.SignUpRequest) (*a
 		}
 	}
 
-	user, err := s.authUseCase.ValidateToken(ctx, token)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to validate token")
+	resp := &authv1.SignUpResponse{UserId: tokens.UserID.String()}
+	if tokens.AccessToken != "" { // no tokens until the email is verified while unverified users are blocked
+		resp.Token = tokens.AccessToken
+		resp.RefreshToken = tokens.RefreshToken
+		resp.ExpiresAt = tokens.AccessExpiresAt.Unix()
+		resp.RefreshExpiresAt = tokens.RefreshExpiresAt.Unix()
 	}
 
-	return &authv1.SignUpResponse{
-		Token:  token,
-		UserId: user.ID.String(),
-	}, nil
+	return resp, nil
 }
 
 // ValidateToken implements the ValidateToken RPC method
@@ -92,23 +109,638 @@ func (s *AuthService) ValidateToken(ctx context.Context, req *authv1.ValidateTok
 		return nil, status.Error(codes.InvalidArgument, "token is required")
 	}
 
-	user, err := s.authUseCase.ValidateToken(ctx, req.GetToken())
+	user, claims, err := s.authUseCase.ValidateToken(ctx, req.GetToken())
 	if err != nil {
 		switch err {
 		case usecase.ErrInvalidToken:
 			return &authv1.ValidateTokenResponse{IsValid: false}, status.Error(codes.InvalidArgument, "invalid token")
 		case usecase.ErrUserNotFound:
 			return &authv1.ValidateTokenResponse{IsValid: false}, status.Error(codes.NotFound, "user not found")
+		case usecase.ErrUserDisabled:
+			return &authv1.ValidateTokenResponse{IsValid: false}, status.Error(codes.PermissionDenied, "user disabled")
 		default:
 			return &authv1.ValidateTokenResponse{IsValid: false}, status.Error(codes.Internal, "internal server error")
 		}
 	}
 
 	return &authv1.ValidateTokenResponse{
-		IsValid:  true,
-		UserId:   user.ID.String(),
-		Email:    user.Email,
-		Username: user.Username,
-		IsAdmin:  user.IsAdmin,
+		IsValid:       true,
+		UserId:        user.ID.String(),
+		Email:         user.Email,
+		Username:      user.Username,
+		IsAdmin:       claims.Role == entity.RoleAdmin,
+		Role:          string(claims.Role),
+		Permissions:   claims.Permissions,
+		EmailVerified: user.IsVerified(),
+	}, nil
+}
+
+// Refresh implements the Refresh RPC method
+func (s *AuthService) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*authv1.RefreshResponse, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+
+	tokens, err := s.authUseCase.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidToken, usecase.ErrUserNotFound:
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired refresh token")
+		case usecase.ErrRefreshTokenReused:
+			return nil, status.Error(codes.Unauthenticated, "refresh token reused, session revoked")
+		case usecase.ErrUserDisabled:
+			return nil, status.Error(codes.PermissionDenied, "user disabled")
+		case usecase.ErrEmailNotVerified:
+			return nil, status.Error(codes.FailedPrecondition, "email not verified")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.RefreshResponse{
+		Token:            tokens.AccessToken,
+		UserId:           tokens.UserID.String(),
+		RefreshToken:     tokens.RefreshToken,
+		ExpiresAt:        tokens.AccessExpiresAt.Unix(),
+		RefreshExpiresAt: tokens.RefreshExpiresAt.Unix(),
+	}, nil
+}
+
+// SignOut implements the SignOut RPC method
+func (s *AuthService) SignOut(ctx context.Context, req *authv1.SignOutRequest) (*authv1.SignOutResponse, error) {
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authUseCase.SignOut(ctx, claims); err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &authv1.SignOutResponse{}, nil
+}
+
+// ListSessions implements the ListSessions RPC method
+func (s *AuthService) ListSessions(ctx context.Context, req *authv1.ListSessionsRequest) (*authv1.ListSessionsResponse, error) {
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.authUseCase.ListSessions(ctx, claims.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &authv1.ListSessionsResponse{
+		Sessions: make([]*authv1.Session, len(sessions)),
+	}
+	for i, session := range sessions {
+		resp.Sessions[i] = mapper.SessionToProto(session, claims.SessionID)
+	}
+
+	return resp, nil
+}
+
+// RevokeSession implements the RevokeSession RPC method
+func (s *AuthService) RevokeSession(ctx context.Context, req *authv1.RevokeSessionRequest) (*authv1.RevokeSessionResponse, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session id")
+	}
+
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authUseCase.RevokeSession(ctx, claims.UserID, sessionID); err != nil {
+		switch err {
+		case usecase.ErrSessionNotFound:
+			return nil, status.Error(codes.NotFound, "session not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.RevokeSessionResponse{}, nil
+}
+
+// VerifyEmail implements the VerifyEmail RPC method
+func (s *AuthService) VerifyEmail(ctx context.Context, req *authv1.VerifyEmailRequest) (*authv1.VerifyEmailResponse, error) {
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if err := s.authUseCase.VerifyEmail(ctx, req.GetToken()); err != nil {
+		switch err {
+		case usecase.ErrInvalidToken:
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired token")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.VerifyEmailResponse{}, nil
+}
+
+// ResendVerificationEmail implements the ResendVerificationEmail RPC method
+func (s *AuthService) ResendVerificationEmail(
+	ctx context.Context,
+	req *authv1.ResendVerificationEmailRequest,
+) (*authv1.ResendVerificationEmailResponse, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	if err := s.authUseCase.ResendVerification(ctx, req.GetEmail()); err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &authv1.ResendVerificationEmailResponse{}, nil
+}
+
+// RequestPasswordReset implements the RequestPasswordReset RPC method
+func (s *AuthService) RequestPasswordReset(
+	ctx context.Context,
+	req *authv1.RequestPasswordResetRequest,
+) (*authv1.RequestPasswordResetResponse, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	if err := s.authUseCase.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &authv1.RequestPasswordResetResponse{}, nil
+}
+
+// ResetPassword implements the ResetPassword RPC method
+func (s *AuthService) ResetPassword(ctx context.Context, req *authv1.ResetPasswordRequest) (*authv1.ResetPasswordResponse, error) {
+	if req.GetToken() == "" || req.GetNewPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and new password are required")
+	}
+
+	if err := s.authUseCase.ResetPassword(ctx, req.GetToken(), req.GetNewPassword()); err != nil {
+		switch err {
+		case usecase.ErrWeakPassword:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case usecase.ErrInvalidToken:
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired token")
+		case usecase.ErrUserDisabled:
+			return nil, status.Error(codes.PermissionDenied, "user disabled")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.ResetPasswordResponse{}, nil
+}
+
+// ChangePassword implements the ChangePassword RPC method
+func (s *AuthService) ChangePassword(ctx context.Context, req *authv1.ChangePasswordRequest) (*authv1.ChangePasswordResponse, error) {
+	if req.GetCurrentPassword() == "" || req.GetNewPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "current and new password are required")
+	}
+
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authUseCase.ChangePassword(ctx, claims, req.GetCurrentPassword(), req.GetNewPassword())
+	if err != nil {
+		switch err {
+		case usecase.ErrWeakPassword:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case usecase.ErrInvalidPassword:
+			return nil, status.Error(codes.InvalidArgument, "current password is incorrect")
+		case usecase.ErrImpersonated:
+			return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+		case usecase.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.ChangePasswordResponse{}, nil
+}
+
+// VerifyMFA implements the VerifyMFA RPC method
+func (s *AuthService) VerifyMFA(ctx context.Context, req *authv1.VerifyMFARequest) (*authv1.VerifyMFAResponse, error) {
+	if req.GetMfaToken() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa token and code are required")
+	}
+
+	tokens, err := s.authUseCase.VerifyMFA(ctx, req.GetMfaToken(), req.GetCode())
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidToken:
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired mfa token")
+		case usecase.ErrInvalidMFACode:
+			return nil, status.Error(codes.Unauthenticated, "invalid mfa code")
+		case usecase.ErrUserDisabled:
+			return nil, status.Error(codes.PermissionDenied, "user disabled")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.VerifyMFAResponse{
+		Token:            tokens.AccessToken,
+		UserId:           tokens.UserID.String(),
+		RefreshToken:     tokens.RefreshToken,
+		ExpiresAt:        tokens.AccessExpiresAt.Unix(),
+		RefreshExpiresAt: tokens.RefreshExpiresAt.Unix(),
+	}, nil
+}
+
+// EnrollMFA implements the EnrollMFA RPC method
+func (s *AuthService) EnrollMFA(ctx context.Context, req *authv1.EnrollMFARequest) (*authv1.EnrollMFAResponse, error) {
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.authUseCase.EnrollMFA(ctx, claims)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+
+	return &authv1.EnrollMFAResponse{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	}, nil
+}
+
+// ConfirmMFA implements the ConfirmMFA RPC method
+func (s *AuthService) ConfirmMFA(ctx context.Context, req *authv1.ConfirmMFARequest) (*authv1.ConfirmMFAResponse, error) {
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := s.authUseCase.ConfirmMFA(ctx, claims, req.GetCode())
+	if err != nil {
+		return nil, mfaError(err)
+	}
+
+	return &authv1.ConfirmMFAResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// DisableMFA implements the DisableMFA RPC method
+func (s *AuthService) DisableMFA(ctx context.Context, req *authv1.DisableMFARequest) (*authv1.DisableMFAResponse, error) {
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authUseCase.DisableMFA(ctx, claims, req.GetCode()); err != nil {
+		return nil, mfaError(err)
+	}
+
+	return &authv1.DisableMFAResponse{}, nil
+}
+
+// RegenerateRecoveryCodes implements the RegenerateRecoveryCodes RPC method
+func (s *AuthService) RegenerateRecoveryCodes(
+	ctx context.Context,
+	req *authv1.RegenerateRecoveryCodesRequest,
+) (*authv1.RegenerateRecoveryCodesResponse, error) {
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := s.authUseCase.RegenerateRecoveryCodes(ctx, claims, req.GetCode())
+	if err != nil {
+		return nil, mfaError(err)
+	}
+
+	return &authv1.RegenerateRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// mfaError maps errors of the MFA management methods to gRPC status errors
+func mfaError(err error) error {
+	switch err {
+	case usecase.ErrInvalidMFACode:
+		return status.Error(codes.InvalidArgument, "invalid mfa code")
+	case usecase.ErrMFAAlreadyEnabled:
+		return status.Error(codes.AlreadyExists, "mfa already enabled")
+	case usecase.ErrMFANotEnabled:
+		return status.Error(codes.FailedPrecondition, "mfa not enabled")
+	case usecase.ErrMFAEnforced:
+		return status.Error(codes.FailedPrecondition, "mfa is required for the role")
+	case usecase.ErrImpersonated:
+		return status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	case usecase.ErrUserNotFound:
+		return status.Error(codes.NotFound, "user not found")
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// StartOAuth implements the StartOAuth RPC method
+func (s *AuthService) StartOAuth(ctx context.Context, req *authv1.StartOAuthRequest) (*authv1.StartOAuthResponse, error) {
+	if req.GetProvider() == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	redirect, err := s.authUseCase.StartOAuth(ctx, req.GetProvider())
+	if err != nil {
+		switch err {
+		case usecase.ErrUnknownProvider:
+			return nil, status.Error(codes.NotFound, "unknown identity provider")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.StartOAuthResponse{
+		AuthorizationUrl: redirect.URL,
+		State:            redirect.State,
+		ExpiresAt:        redirect.ExpiresAt.Unix(),
+	}, nil
+}
+
+// CompleteOAuth implements the CompleteOAuth RPC method
+func (s *AuthService) CompleteOAuth(ctx context.Context, req *authv1.CompleteOAuthRequest) (*authv1.CompleteOAuthResponse, error) {
+	if req.GetProvider() == "" || req.GetCode() == "" || req.GetState() == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider, code and state are required")
+	}
+
+	tokens, challenge, err := s.authUseCase.CompleteOAuth(ctx, req.GetProvider(), req.GetCode(), req.GetState())
+	if err != nil {
+		if errors.Is(err, usecase.ErrOAuthFailed) {
+			return nil, status.Error(codes.Unauthenticated, "identity provider sign-in failed")
+		}
+
+		switch err {
+		case usecase.ErrUnknownProvider:
+			return nil, status.Error(codes.NotFound, "unknown identity provider")
+		case usecase.ErrInvalidToken:
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired state")
+		case usecase.ErrExternalEmailNotVerified:
+			return nil, status.Error(codes.FailedPrecondition, "identity provider email not verified")
+		case usecase.ErrAccountNotLinkable:
+			return nil, status.Error(codes.FailedPrecondition, "verify the email of the existing account before linking")
+		case usecase.ErrUserDisabled:
+			return nil, status.Error(codes.PermissionDenied, "user disabled")
+		case usecase.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	if challenge != nil {
+		return &authv1.CompleteOAuthResponse{
+			UserId:       challenge.UserID.String(),
+			MfaRequired:  true,
+			MfaToken:     challenge.Token,
+			MfaExpiresAt: challenge.ExpiresAt.Unix(),
+		}, nil
+	}
+
+	return &authv1.CompleteOAuthResponse{
+		Token:            tokens.AccessToken,
+		UserId:           tokens.UserID.String(),
+		RefreshToken:     tokens.RefreshToken,
+		ExpiresAt:        tokens.AccessExpiresAt.Unix(),
+		RefreshExpiresAt: tokens.RefreshExpiresAt.Unix(),
+
+		MfaEnrollmentRequired: tokens.MFAEnrollmentRequired,
+	}, nil
+}
+
+// CreateAPIKey implements the CreateAPIKey RPC method
+func (s *AuthService) CreateAPIKey(ctx context.Context, req *authv1.CreateAPIKeyRequest) (*authv1.CreateAPIKeyResponse, error) {
+	if req.GetTtlSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl must not be negative")
+	}
+
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := time.Duration(req.GetTtlSeconds()) * time.Second
+	created, err := s.authUseCase.CreateAPIKey(ctx, claims, req.GetName(), req.GetScopes(), ttl)
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidAPIKeyName, usecase.ErrInvalidAPIKeyTTL, usecase.ErrInvalidScope:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case usecase.ErrAPIKeyLimit:
+			return nil, status.Error(codes.ResourceExhausted, "too many api keys")
+		case usecase.ErrImpersonated:
+			return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.CreateAPIKeyResponse{
+		ApiKey: mapper.APIKeyToProto(created.APIKey),
+		Key:    created.Key,
+	}, nil
+}
+
+// ListAPIKeys implements the ListAPIKeys RPC method
+func (s *AuthService) ListAPIKeys(ctx context.Context, req *authv1.ListAPIKeysRequest) (*authv1.ListAPIKeysResponse, error) {
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.authUseCase.ListAPIKeys(ctx, claims.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &authv1.ListAPIKeysResponse{
+		ApiKeys: make([]*authv1.APIKey, len(keys)),
+	}
+	for i, key := range keys {
+		resp.ApiKeys[i] = mapper.APIKeyToProto(key)
+	}
+
+	return resp, nil
+}
+
+// RevokeAPIKey implements the RevokeAPIKey RPC method
+func (s *AuthService) RevokeAPIKey(ctx context.Context, req *authv1.RevokeAPIKeyRequest) (*authv1.RevokeAPIKeyResponse, error) {
+	keyID, err := uuid.Parse(req.GetKeyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid api key id")
+	}
+
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authUseCase.RevokeAPIKey(ctx, claims, keyID); err != nil {
+		switch err {
+		case usecase.ErrAPIKeyNotFound:
+			return nil, status.Error(codes.NotFound, "api key not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.RevokeAPIKeyResponse{}, nil
+}
+
+// ValidateAPIKey implements the ValidateAPIKey RPC method
+func (s *AuthService) ValidateAPIKey(ctx context.Context, req *authv1.ValidateAPIKeyRequest) (*authv1.ValidateAPIKeyResponse, error) {
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	claims, err := s.authUseCase.AuthenticateAPIKey(ctx, req.GetKey())
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidToken:
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		case usecase.ErrUserDisabled:
+			return nil, status.Error(codes.PermissionDenied, "user disabled")
+		case usecase.ErrEmailNotVerified:
+			return nil, status.Error(codes.FailedPrecondition, "email not verified")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	// a key grants its scopes only, not the role of its user, so neither
+	// is_admin nor the role is reported
+	user := claims.User
+	return &authv1.ValidateAPIKeyResponse{
+		IsValid:       true,
+		UserId:        user.ID.String(),
+		Email:         user.Email,
+		Username:      user.Username,
+		Permissions:   claims.Permissions,
+		EmailVerified: user.IsVerified(),
+		KeyId:         claims.KeyID.String(),
+	}, nil
+}
+
+// ExportMyData implements the ExportMyData RPC method
+func (s *AuthService) ExportMyData(ctx context.Context, req *authv1.ExportMyDataRequest) (*authv1.ExportMyDataResponse, error) {
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := s.authUseCase.ExportUserData(ctx, claims)
+	if err != nil {
+		if errors.Is(err, usecase.ErrServiceUnavailable) {
+			return nil, status.Error(codes.Unavailable, "data of a service is unavailable, try again later")
+		}
+
+		switch err {
+		case usecase.ErrImpersonated:
+			return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+		case usecase.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.ExportMyDataResponse{
+		Archive:   export.Archive,
+		FileName:  export.FileName,
+		CreatedAt: export.CreatedAt.Format(time.RFC3339),
 	}, nil
 }
+
+// DeleteMyAccount implements the DeleteMyAccount RPC method
+func (s *AuthService) DeleteMyAccount(ctx context.Context, req *authv1.DeleteMyAccountRequest) (*authv1.DeleteMyAccountResponse, error) {
+	if req.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+
+	claims, err := s.authenticate(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	deletion, err := s.authUseCase.DeleteAccount(ctx, claims, req.GetPassword())
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidPassword:
+			return nil, status.Error(codes.InvalidArgument, "password is incorrect")
+		case usecase.ErrImpersonated:
+			return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+		case usecase.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.DeleteMyAccountResponse{Deletion: mapper.AccountDeletionToProto(deletion)}, nil
+}
+
+// GetAccountDeletion implements the GetAccountDeletion RPC method
+func (s *AuthService) GetAccountDeletion(
+	ctx context.Context,
+	req *authv1.GetAccountDeletionRequest,
+) (*authv1.GetAccountDeletionResponse, error) {
+	id, err := uuid.Parse(req.GetDeletionId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid deletion id")
+	}
+
+	deletion, err := s.authUseCase.AccountDeletion(ctx, id)
+	if err != nil {
+		switch err {
+		case usecase.ErrDeletionNotFound:
+			return nil, status.Error(codes.NotFound, "account deletion not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.GetAccountDeletionResponse{Deletion: mapper.AccountDeletionToProto(deletion)}, nil
+}
+
+// authenticate verifies the access token of requests acting on behalf of its owner
+func (s *AuthService) authenticate(ctx context.Context, token string) (*entity.AccessClaims, error) {
+	return authenticate(ctx, s.authUseCase, token)
+}
+
+// authenticate verifies the access token of requests acting on behalf of its
+// owner with the auth use case
+func authenticate(ctx context.Context, authUseCase usecase.AuthUseCase, token string) (*entity.AccessClaims, error) {
+	if token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	claims, err := authUseCase.Authenticate(ctx, token)
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidToken:
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+// Metadata keys the gateway forwards the end user in
+const (
+	ClientIPKey        = "x-client-ip"
+	ClientUserAgentKey = "x-client-user-agent"
+)
+
+// ClientUnaryInterceptor puts the end user of a call into its context. auth-service
+// is reachable by internal callers only, so the forwarded metadata is trusted
+// and the peer address is the fallback for direct calls
+func ClientUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(usecase.NewClientContext(ctx, clientFromMetadata(ctx)), req)
+	}
+}
+
+// clientFromMetadata reads the end user from the incoming metadata and peer
+func clientFromMetadata(ctx context.Context) entity.Client {
+	var client entity.Client
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(ClientIPKey); len(v) > 0 && net.ParseIP(v[0]) != nil {
+		client.IP = v[0]
+	}
+	if v := md.Get(ClientUserAgentKey); len(v) > 0 {
+		client.UserAgent = v[0]
+	}
+
+	if client.IP == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+				client.IP = host
+			}
+		}
+	}
+
+	return client
+}
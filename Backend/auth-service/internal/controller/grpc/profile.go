@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/mapper"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+)
+
+const (
+	_defaultAssessmentsLimit = 20
+	_maxAssessmentsLimit     = 100
+)
+
+// ProfilePolicy is the authorization policy of ProfileService, the other
+// methods authenticate the token of the request
+var ProfilePolicy = authz.Policy{
+	authv1.ProfileService_GetUserProfile_FullMethodName: authz.RequirePermissions(authz.PermProfilesRead),
+}
+
+// ProfileService implements the gRPC profile service
+type ProfileService struct {
+	authv1.UnimplementedProfileServiceServer
+	authUseCase    usecase.AuthUseCase
+	profileUseCase usecase.ProfileUseCase
+}
+
+// NewProfileService creates a new instance of ProfileService
+func NewProfileService(authUseCase usecase.AuthUseCase, profileUseCase usecase.ProfileUseCase) *ProfileService {
+	return &ProfileService{
+		authUseCase:    authUseCase,
+		profileUseCase: profileUseCase,
+	}
+}
+
+// GetProfile implements the GetProfile RPC method
+func (s *ProfileService) GetProfile(ctx context.Context, req *authv1.GetProfileRequest) (*authv1.GetProfileResponse, error) {
+	claims, err := authenticate(ctx, s.authUseCase, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileUseCase.GetProfile(ctx, claims.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &authv1.GetProfileResponse{Profile: mapper.ProfileToProto(profile)}, nil
+}
+
+// UpdateProfile implements the UpdateProfile RPC method
+func (s *ProfileService) UpdateProfile(ctx context.Context, req *authv1.UpdateProfileRequest) (*authv1.UpdateProfileResponse, error) {
+	claims, err := authenticate(ctx, s.authUseCase, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	var update entity.ProfileUpdate
+	if req.Horizon != nil {
+		horizon := entity.InvestmentHorizon(req.GetHorizon())
+		update.Horizon = &horizon
+	}
+	update.BaseCurrency = req.BaseCurrency
+	update.Locale = req.Locale
+	if req.GetMarkets() != nil {
+		markets := req.GetMarkets().GetValues()
+		update.Markets = &markets
+	}
+	if req.GetWatchlist() != nil {
+		watchlist := req.GetWatchlist().GetValues()
+		update.Watchlist = &watchlist
+	}
+
+	profile, err := s.profileUseCase.UpdateProfile(ctx, claims, update)
+	if err != nil {
+		switch err {
+		case usecase.ErrImpersonated:
+			return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+		case usecase.ErrInvalidHorizon, usecase.ErrInvalidCurrency, usecase.ErrInvalidMarket,
+			usecase.ErrInvalidSymbol, usecase.ErrWatchlistFull, usecase.ErrInvalidLocale:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.UpdateProfileResponse{Profile: mapper.ProfileToProto(profile)}, nil
+}
+
+// GetRiskQuestionnaire implements the GetRiskQuestionnaire RPC method
+func (s *ProfileService) GetRiskQuestionnaire(
+	_ context.Context,
+	_ *authv1.GetRiskQuestionnaireRequest,
+) (*authv1.GetRiskQuestionnaireResponse, error) {
+	resp := &authv1.GetRiskQuestionnaireResponse{
+		Version:   entity.RiskQuestionnaireVersion,
+		Questions: make([]*authv1.RiskQuestion, len(entity.RiskQuestionnaire)),
+	}
+	for i, q := range entity.RiskQuestionnaire {
+		resp.Questions[i] = mapper.RiskQuestionToProto(q)
+	}
+
+	return resp, nil
+}
+
+// SubmitRiskQuestionnaire implements the SubmitRiskQuestionnaire RPC method
+func (s *ProfileService) SubmitRiskQuestionnaire(
+	ctx context.Context,
+	req *authv1.SubmitRiskQuestionnaireRequest,
+) (*authv1.SubmitRiskQuestionnaireResponse, error) {
+	claims, err := authenticate(ctx, s.authUseCase, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	assessment, err := s.profileUseCase.SubmitRiskQuestionnaire(ctx, claims, req.GetAnswers())
+	if err != nil {
+		switch err {
+		case usecase.ErrImpersonated:
+			return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+		case usecase.ErrInvalidAnswers:
+			return nil, status.Error(codes.InvalidArgument, "every question must be answered with one of its options")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.SubmitRiskQuestionnaireResponse{Assessment: mapper.RiskAssessmentToProto(assessment)}, nil
+}
+
+// ListRiskAssessments implements the ListRiskAssessments RPC method
+func (s *ProfileService) ListRiskAssessments(
+	ctx context.Context,
+	req *authv1.ListRiskAssessmentsRequest,
+) (*authv1.ListRiskAssessmentsResponse, error) {
+	claims, err := authenticate(ctx, s.authUseCase, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = _defaultAssessmentsLimit
+	}
+	if limit > _maxAssessmentsLimit {
+		limit = _maxAssessmentsLimit
+	}
+
+	assessments, err := s.profileUseCase.ListRiskAssessments(ctx, claims.UserID, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &authv1.ListRiskAssessmentsResponse{
+		Assessments: make([]*authv1.RiskAssessment, len(assessments)),
+	}
+	for i, a := range assessments {
+		resp.Assessments[i] = mapper.RiskAssessmentToProto(a)
+	}
+
+	return resp, nil
+}
+
+// GetUserProfile implements the GetUserProfile RPC method
+func (s *ProfileService) GetUserProfile(ctx context.Context, req *authv1.GetUserProfileRequest) (*authv1.GetUserProfileResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	profile, err := s.profileUseCase.GetUserProfile(ctx, userID)
+	if err != nil {
+		switch err {
+		case usecase.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &authv1.GetUserProfileResponse{Profile: mapper.ProfileToProto(profile)}, nil
+}
@@ -0,0 +1,105 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	grpcController "github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/controller/grpc"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+)
+
+// fakeVerifier accepts the tokens it knows
+type fakeVerifier map[string]*authz.Claims
+
+func (v fakeVerifier) Verify(_ context.Context, token string) (*authz.Claims, error) {
+	c, ok := v[token]
+	if !ok {
+		return nil, errors.New("signature is invalid")
+	}
+	return c, nil
+}
+
+// fakeProfiles returns an empty profile of every user
+type fakeProfiles struct {
+	usecase.ProfileUseCase
+}
+
+func (fakeProfiles) GetUserProfile(_ context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	return &entity.Profile{UserID: userID, BaseCurrency: "USD"}, nil
+}
+
+// profileClient serves ProfileService behind the authorization of app.Run
+func profileClient(t *testing.T, v authz.Verifier) authv1.ProfileServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		authz.UnaryServerInterceptor(v, grpcController.AdminPolicy),
+		authz.UnaryServerInterceptor(v, grpcController.ProfilePolicy),
+	))
+	authv1.RegisterProfileServiceServer(srv, grpcController.NewProfileService(nil, fakeProfiles{}))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return authv1.NewProfileServiceClient(conn)
+}
+
+func TestGetUserProfilePolicy(t *testing.T) {
+	t.Parallel()
+
+	client := profileClient(t, fakeVerifier{
+		"user":    {UserID: "u1", Role: authz.RoleUser, Permissions: entity.RoleUser.Permissions()},
+		"support": {UserID: "u2", Role: authz.RoleSupport, Permissions: entity.RoleSupport.Permissions()},
+	})
+
+	tests := []struct {
+		name  string
+		token string
+		code  codes.Code
+	}{
+		{name: "no token", code: codes.Unauthenticated},
+		{name: "forged token", token: "forged", code: codes.Unauthenticated},
+		// a user may read their own profile with GetProfile only
+		{name: "user", token: "user", code: codes.PermissionDenied},
+		{name: "support", token: "support", code: codes.OK},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tc.token != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tc.token)
+			}
+
+			userID := uuid.NewString()
+			resp, err := client.GetUserProfile(ctx, &authv1.GetUserProfileRequest{UserId: userID})
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, userID, resp.GetProfile().GetUserId())
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// _jwksMaxAge lets verifiers cache the key set, new keys are published
+// well ahead of signing so a stale copy is never missing a signing key
+const _jwksMaxAge = "public, max-age=300"
+
+// NewRouter mounts the public HTTP endpoints of auth-service
+func NewRouter(engine *gin.Engine, keys usecase.KeyRing) {
+	engine.GET("/.well-known/jwks.json", jwks(keys))
+}
+
+// jwks serves the public signing keys for offline token verification
+func jwks(keys usecase.KeyRing) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", _jwksMaxAge)
+		c.JSON(http.StatusOK, keys.JWKS())
+	}
+}
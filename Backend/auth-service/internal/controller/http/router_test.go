@@ -0,0 +1,137 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	httpController "github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/controller/http"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase/keys"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+)
+
+// memoryKeys stores signing keys in memory, newest first
+type memoryKeys struct {
+	repo.SigningKeyRepository
+	keys []*entity.SigningKey
+}
+
+func (m *memoryKeys) Create(_ context.Context, key *entity.SigningKey) error {
+	m.keys = append([]*entity.SigningKey{key}, m.keys...)
+	return nil
+}
+
+func (m *memoryKeys) List(context.Context) ([]*entity.SigningKey, error) {
+	return m.keys, nil
+}
+
+func (m *memoryKeys) DeleteSuperseded(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+// jwksServer serves the router with a ring of one RS256 and one EdDSA key
+func jwksServer(t *testing.T) (*httptest.Server, *keys.Ring) {
+	t.Helper()
+
+	old, err := entity.GenerateSigningKey(entity.AlgRS256, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	ring := keys.New(&memoryKeys{keys: []*entity.SigningKey{old}}, entity.AlgEdDSA, 24*time.Hour, time.Hour, 15*time.Minute)
+	require.NoError(t, ring.Sync(context.Background()))
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	httpController.NewRouter(engine, ring)
+
+	srv := httptest.NewServer(engine)
+	t.Cleanup(srv.Close)
+
+	return srv, ring
+}
+
+func TestJWKS(t *testing.T) {
+	t.Parallel()
+
+	srv, ring := jwksServer(t)
+
+	resp, err := http.Get(srv.URL + "/.well-known/jwks.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "public, max-age=300", resp.Header.Get("Cache-Control"))
+
+	var set entity.JWKS
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&set))
+	require.Equal(t, *ring.JWKS(), set)
+	require.Len(t, set.Keys, 2)
+
+	// public keys only
+	for _, k := range set.Keys {
+		require.Equal(t, "sig", k.Use)
+	}
+	raw, err := json.Marshal(set)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), `"d"`)
+}
+
+func TestJWKSVerifiesTokens(t *testing.T) {
+	t.Parallel()
+
+	srv, ring := jwksServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	verifier, err := authz.NewJWKSVerifier(ctx, srv.URL+"/.well-known/jwks.json", "auth-service", time.Minute)
+	require.NoError(t, err)
+
+	// tokens of both the published successor and the signing key verify offline
+	for _, k := range ring.JWKS().Keys {
+		key := ring.Key(k.Kid)
+
+		tok := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), jwt.MapClaims{
+			"iss":   "auth-service",
+			"sub":   "user-1",
+			"sid":   "session-1",
+			"email": "user-1@example.com",
+			"role":  string(entity.RoleAnalyst),
+			"perms": []string{"tweets:export"},
+			"exp":   time.Now().Add(time.Minute).Unix(),
+
+			"email_verified": true,
+		})
+		tok.Header["kid"] = key.ID
+		signed, err := tok.SignedString(key.Private)
+		require.NoError(t, err)
+
+		claims, err := verifier.Verify(ctx, signed)
+		require.NoError(t, err, key.Algorithm)
+		require.Equal(t, "user-1", claims.UserID)
+		require.Equal(t, "session-1", claims.SessionID)
+		require.Equal(t, "user-1@example.com", claims.Email)
+		require.True(t, claims.EmailVerified)
+		require.Equal(t, []string{"tweets:export"}, claims.Permissions)
+	}
+
+	// a key that was never published does not
+	stranger, err := entity.GenerateSigningKey(entity.AlgEdDSA, time.Now())
+	require.NoError(t, err)
+
+	tok := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"iss": "auth-service", "sub": "user-1", "exp": time.Now().Add(time.Minute).Unix()})
+	tok.Header["kid"] = stranger.ID
+	signed, err := tok.SignedString(stranger.Private)
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, signed)
+	require.Error(t, err)
+}
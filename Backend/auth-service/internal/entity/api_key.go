@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyPrefix starts every API key, so that leaked keys are easy to spot
+const APIKeyPrefix = "fa_"
+
+// APIKey is a personal key for programmatic access. Only the hash of the key
+// is stored, the key itself is shown once when it is created
+type APIKey struct {
+	ID         uuid.UUID  `db:"id"           json:"id"`
+	UserID     uuid.UUID  `db:"user_id"      json:"user_id"`
+	Name       string     `db:"name"         json:"name"`
+	Prefix     string     `db:"prefix"       json:"prefix"` // first characters of the key to tell keys apart
+	KeyHash    string     `db:"key_hash"     json:"-"`
+	Scopes     []string   `db:"scopes"       json:"scopes"` // permissions the key grants, a subset of the role's
+	CreatedAt  time.Time  `db:"created_at"   json:"created_at"`
+	ExpiresAt  time.Time  `db:"expires_at"   json:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at" json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `db:"revoked_at"   json:"revoked_at,omitempty"`
+}
+
+// IsActive reports whether the key can be used
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && now.Before(k.ExpiresAt)
+}
+
+// NewAPIKey is a created key together with the key itself
+type NewAPIKey struct {
+	APIKey *APIKey
+	Key    string
+}
+
+// APIKeyClaims is what a valid API key authenticates
+type APIKeyClaims struct {
+	KeyID uuid.UUID
+	User  *User
+
+	// Permissions are the scopes of the key the user's role still grants,
+	// none while the user's tokens would grant none
+	Permissions []string
+}
@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEventType names a security relevant event
+type AuditEventType string
+
+// Types of audit events
+const (
+	AuditSignUp         AuditEventType = "sign_up"
+	AuditSignIn         AuditEventType = "sign_in"
+	AuditSignInMFA      AuditEventType = "sign_in.mfa"
+	AuditSignInLockout  AuditEventType = "sign_in.lockout"
+	AuditSignOut        AuditEventType = "sign_out"
+	AuditTokenRejected  AuditEventType = "token.rejected"
+	AuditRefreshReused  AuditEventType = "refresh.reused"
+	AuditSessionRevoked AuditEventType = "session.revoked"
+
+	AuditEmailVerified          AuditEventType = "email.verified"
+	AuditPasswordResetRequested AuditEventType = "password.reset_requested"
+	AuditPasswordReset          AuditEventType = "password.reset"
+	AuditPasswordChanged        AuditEventType = "password.changed"
+
+	AuditMFAEnabled            AuditEventType = "mfa.enabled"
+	AuditMFADisabled           AuditEventType = "mfa.disabled"
+	AuditRecoveryCodesRenewed  AuditEventType = "mfa.recovery_codes_renewed"
+	AuditMFAReset              AuditEventType = "mfa.reset"
+	AuditMFARequirementChanged AuditEventType = "mfa.requirement_changed"
+	AuditIdentityLinked        AuditEventType = "identity.linked"
+	AuditAPIKeyCreated         AuditEventType = "api_key.created"
+	AuditAPIKeyRevoked         AuditEventType = "api_key.revoked"
+
+	AuditUserRoleChanged  AuditEventType = "user.role_changed"
+	AuditUserDisabled     AuditEventType = "user.disabled"
+	AuditUserEnabled      AuditEventType = "user.enabled"
+	AuditUserImpersonated AuditEventType = "user.impersonated"
+	AuditUserUnlocked     AuditEventType = "user.unlocked"
+
+	AuditDataExported             AuditEventType = "data.exported"
+	AuditAccountDeletionRequested AuditEventType = "account.deletion_requested"
+	AuditAccountDeletionRetried   AuditEventType = "account.deletion_retried"
+	AuditAccountDeletionFailed    AuditEventType = "account.deletion_failed"
+	AuditAccountDeleted           AuditEventType = "account.deleted"
+)
+
+// AuditOutcome is whether the audited action succeeded
+type AuditOutcome string
+
+// Outcomes of audited actions
+const (
+	AuditSuccess AuditOutcome = "success"
+	AuditFailure AuditOutcome = "failure"
+)
+
+// AuditEvent is an entry of the security audit log
+type AuditEvent struct {
+	ID        uuid.UUID         `json:"id"`
+	Type      AuditEventType    `json:"type"`
+	Outcome   AuditOutcome      `json:"outcome"`
+	ActorID   uuid.UUID         `json:"actor_id"`  // uuid.Nil for anonymous callers
+	TargetID  uuid.UUID         `json:"target_id"` // uuid.Nil if the target is not a known user
+	IP        string            `json:"ip,omitempty"`
+	UserAgent string            `json:"user_agent,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// Client describes the end user a request was made by
+type Client struct {
+	IP        string
+	UserAgent string
+}
+
+// AuditFilter selects events in audit log queries, zero fields match every event
+type AuditFilter struct {
+	ActorID  uuid.UUID
+	TargetID uuid.UUID
+	Type     AuditEventType
+	Outcome  AuditOutcome
+	IP       string
+	Since    time.Time // events at or after
+	Until    time.Time // events before
+	Limit    int
+	After    *AuditCursor // continue after the last event of the previous page
+}
+
+// AuditCursor is the position of an event in the log, which is ordered from
+// the newest event to the oldest
+type AuditCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+var _errInvalidCursor = errors.New("invalid cursor")
+
+// Cursor returns the position of the event
+func (e *AuditEvent) Cursor() *AuditCursor {
+	return &AuditCursor{CreatedAt: e.CreatedAt, ID: e.ID}
+}
+
+// String encodes the cursor for clients, who pass it back unchanged
+func (c *AuditCursor) String() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + "_" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseAuditCursor decodes a cursor of AuditCursor.String
+func ParseAuditCursor(s string) (*AuditCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, _errInvalidCursor
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return nil, _errInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, _errInvalidCursor
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, _errInvalidCursor
+	}
+
+	return &AuditCursor{CreatedAt: time.Unix(0, n).UTC(), ID: uid}, nil
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Identity providers users can sign in with
+const (
+	ProviderGoogle = "google"
+	ProviderGitHub = "github"
+)
+
+// ExternalIdentity links a user to their account at an identity provider
+type ExternalIdentity struct {
+	ID         uuid.UUID `db:"id"           json:"id"`
+	UserID     uuid.UUID `db:"user_id"      json:"user_id"`
+	Provider   string    `db:"provider"     json:"provider"`
+	Subject    string    `db:"subject"      json:"subject"` // account id at the provider, stable across email changes
+	Email      string    `db:"email"        json:"email"`   // email the provider reported when the identity was last used
+	CreatedAt  time.Time `db:"created_at"   json:"created_at"`
+	LastUsedAt time.Time `db:"last_used_at" json:"last_used_at"`
+}
+
+// ExternalProfile is what an identity provider reports about the user who signed in
+type ExternalProfile struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// OAuthState is an authorization started at an identity provider and awaiting
+// its callback. Only the hash of the state is stored, the PKCE verifier and the
+// nonce never leave the service
+type OAuthState struct {
+	ID           uuid.UUID `db:"id"            json:"id"`
+	Provider     string    `db:"provider"      json:"provider"`
+	StateHash    string    `db:"state_hash"    json:"-"`
+	CodeVerifier string    `db:"code_verifier" json:"-"`
+	Nonce        string    `db:"nonce"         json:"-"`
+	CreatedAt    time.Time `db:"created_at"    json:"created_at"`
+	ExpiresAt    time.Time `db:"expires_at"    json:"expires_at"`
+}
+
+// OAuthRedirect is where to send the user to sign in at an identity provider
+type OAuthRedirect struct {
+	URL       string
+	State     string // to be echoed by the callback, bind it to the user agent
+	ExpiresAt time.Time
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MFA is the TOTP second factor of a user, pending until the first code confirms the enrollment
+type MFA struct {
+	UserID    uuid.UUID  `db:"user_id"    json:"user_id"`
+	Secret    string     `db:"secret"     json:"-"`
+	EnabledAt *time.Time `db:"enabled_at" json:"enabled_at,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+
+	// LastUsedStep is the TOTP time step of the last accepted code, codes of
+	// that step and earlier ones are rejected so that a code works only once
+	LastUsedStep int64 `db:"last_used_step" json:"-"`
+}
+
+// IsEnabled reports whether the enrollment was confirmed and sign-ins need a code
+func (m *MFA) IsEnabled() bool {
+	return m != nil && m.EnabledAt != nil
+}
+
+// MFAEnrollment is what a user adds to an authenticator app
+type MFAEnrollment struct {
+	Secret string
+	URI    string // otpauth:// provisioning URI
+}
+
+// MFAChallenge is the second step of a sign-in with MFA, the password was
+// checked and a code is awaited. Only the hash of the token is stored
+type MFAChallenge struct {
+	ID        uuid.UUID  `db:"id"         json:"id"`
+	UserID    uuid.UUID  `db:"user_id"    json:"user_id"`
+	TokenHash string     `db:"token_hash" json:"-"`
+	Attempts  int        `db:"attempts"   json:"attempts"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+	ExpiresAt time.Time  `db:"expires_at" json:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"    json:"used_at,omitempty"`
+
+	// Token is the opaque challenge token, set only when the challenge is issued
+	Token string `db:"-" json:"-"`
+}
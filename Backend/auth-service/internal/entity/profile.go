@@ -0,0 +1,224 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Profile defaults of users who have not changed them
+const (
+	DefaultCurrency = "USD"
+	DefaultLocale   = "en-US"
+)
+
+// RiskTolerance is how much risk a user accepts, scored by the risk questionnaire
+type RiskTolerance string
+
+// Risk tolerances from the lowest to the highest
+const (
+	RiskConservative RiskTolerance = "conservative"
+	RiskModerate     RiskTolerance = "moderate"
+	RiskBalanced     RiskTolerance = "balanced"
+	RiskGrowth       RiskTolerance = "growth"
+	RiskAggressive   RiskTolerance = "aggressive"
+)
+
+// InvestmentHorizon is how long a user plans to stay invested
+type InvestmentHorizon string
+
+// Investment horizons
+const (
+	HorizonShort  InvestmentHorizon = "short"  // under 3 years
+	HorizonMedium InvestmentHorizon = "medium" // 3 to 10 years
+	HorizonLong   InvestmentHorizon = "long"   // over 10 years
+)
+
+// ParseInvestmentHorizon returns the horizon with the name
+func ParseInvestmentHorizon(name string) (InvestmentHorizon, error) {
+	switch h := InvestmentHorizon(name); h {
+	case HorizonShort, HorizonMedium, HorizonLong:
+		return h, nil
+	default:
+		return "", errors.New("unknown investment horizon")
+	}
+}
+
+// Markets users can prefer
+var Markets = []string{"us", "eu", "uk", "jp", "cn", "hk", "in", "emerging", "crypto"}
+
+// Profile is the investment profile of a user that advice is personalised with
+type Profile struct {
+	UserID        uuid.UUID         `json:"user_id"`
+	RiskTolerance RiskTolerance     `json:"risk_tolerance,omitempty"` // empty until the questionnaire is answered
+	RiskScore     int               `json:"risk_score"`
+	AssessedAt    *time.Time        `json:"assessed_at,omitempty"`
+	Horizon       InvestmentHorizon `json:"horizon,omitempty"` // empty until set
+	BaseCurrency  string            `json:"base_currency"`     // ISO 4217 code
+	Markets       []string          `json:"markets"`
+	Watchlist     []string          `json:"watchlist"` // ticker symbols in upper case
+	Locale        string            `json:"locale"`    // BCP 47 language tag
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// NewProfile returns the profile of a user who has not set one up
+func NewProfile(userID uuid.UUID) *Profile {
+	return &Profile{
+		UserID:       userID,
+		BaseCurrency: DefaultCurrency,
+		Markets:      []string{},
+		Watchlist:    []string{},
+		Locale:       DefaultLocale,
+	}
+}
+
+// ProfileUpdate holds the preferences to change, nil fields are kept
+type ProfileUpdate struct {
+	Horizon      *InvestmentHorizon
+	BaseCurrency *string
+	Markets      *[]string
+	Watchlist    *[]string
+	Locale       *string
+}
+
+// RiskQuestion is a question of the risk questionnaire
+type RiskQuestion struct {
+	ID      string       `json:"id"`
+	Text    string       `json:"text"`
+	Options []RiskOption `json:"options"`
+}
+
+// RiskOption is an answer to a risk question and the points it scores
+type RiskOption struct {
+	ID    string `json:"id"`
+	Text  string `json:"text"`
+	Score int    `json:"score"`
+}
+
+// RiskQuestionnaireVersion changes whenever the questions or scores do,
+// assessments keep the version they were answered in
+const RiskQuestionnaireVersion = "2025-06"
+
+// RiskQuestionnaire lists the questions every assessment answers
+var RiskQuestionnaire = []RiskQuestion{
+	{
+		ID:   "horizon",
+		Text: "When will you need most of the money you invest?",
+		Options: []RiskOption{
+			{ID: "under_3_years", Text: "In less than 3 years", Score: 0},
+			{ID: "3_to_10_years", Text: "In 3 to 10 years", Score: 2},
+			{ID: "over_10_years", Text: "In more than 10 years", Score: 4},
+		},
+	},
+	{
+		ID:   "drawdown",
+		Text: "Your investments fall 20% in a month. What do you do?",
+		Options: []RiskOption{
+			{ID: "sell_all", Text: "Sell everything", Score: 0},
+			{ID: "sell_some", Text: "Sell some of them", Score: 1},
+			{ID: "hold", Text: "Wait for them to recover", Score: 3},
+			{ID: "buy_more", Text: "Buy more while prices are low", Score: 4},
+		},
+	},
+	{
+		ID:   "goal",
+		Text: "What do you invest for above all?",
+		Options: []RiskOption{
+			{ID: "preserve", Text: "Keeping what I have", Score: 0},
+			{ID: "income", Text: "A steady income", Score: 1},
+			{ID: "balanced", Text: "Growth with limited swings", Score: 2},
+			{ID: "growth", Text: "The highest growth", Score: 4},
+		},
+	},
+	{
+		ID:   "experience",
+		Text: "How long have you invested in stocks or funds?",
+		Options: []RiskOption{
+			{ID: "never", Text: "Never", Score: 0},
+			{ID: "under_2_years", Text: "Less than 2 years", Score: 1},
+			{ID: "2_to_5_years", Text: "2 to 5 years", Score: 2},
+			{ID: "over_5_years", Text: "More than 5 years", Score: 3},
+		},
+	},
+	{
+		ID:   "income",
+		Text: "How secure is your income?",
+		Options: []RiskOption{
+			{ID: "insecure", Text: "Not secure", Score: 0},
+			{ID: "somewhat", Text: "Somewhat secure", Score: 1},
+			{ID: "secure", Text: "Secure", Score: 2},
+			{ID: "very_secure", Text: "Very secure", Score: 3},
+		},
+	},
+	{
+		ID:   "max_loss",
+		Text: "What is the largest yearly loss you could live with?",
+		Options: []RiskOption{
+			{ID: "5_percent", Text: "5%", Score: 0},
+			{ID: "10_percent", Text: "10%", Score: 1},
+			{ID: "20_percent", Text: "20%", Score: 3},
+			{ID: "over_30_percent", Text: "30% or more", Score: 4},
+		},
+	},
+}
+
+// _riskBands are the lowest scores of the tolerances, from the highest tolerance down
+var _riskBands = []struct {
+	minScore  int
+	tolerance RiskTolerance
+}{
+	{minScore: 19, tolerance: RiskAggressive},
+	{minScore: 15, tolerance: RiskGrowth},
+	{minScore: 10, tolerance: RiskBalanced},
+	{minScore: 5, tolerance: RiskModerate},
+	{minScore: 0, tolerance: RiskConservative},
+}
+
+// RiskAssessment is an answered risk questionnaire, the profile takes the
+// tolerance of the latest one
+type RiskAssessment struct {
+	ID        uuid.UUID         `json:"id"`
+	UserID    uuid.UUID         `json:"user_id"`
+	Version   string            `json:"version"`
+	Answers   map[string]string `json:"answers"` // option id by question id
+	Score     int               `json:"score"`
+	Tolerance RiskTolerance     `json:"tolerance"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// ScoreRisk scores answers to every question of the questionnaire and
+// returns the score with its tolerance
+func ScoreRisk(answers map[string]string) (int, RiskTolerance, error) {
+	if len(answers) != len(RiskQuestionnaire) {
+		return 0, "", errors.New("every question must be answered once")
+	}
+
+	score := 0
+	for _, q := range RiskQuestionnaire {
+		option, ok := q.option(answers[q.ID])
+		if !ok {
+			return 0, "", errors.New("unknown answer to " + q.ID)
+		}
+		score += option.Score
+	}
+
+	for _, band := range _riskBands {
+		if score >= band.minScore {
+			return score, band.tolerance, nil
+		}
+	}
+
+	return score, RiskConservative, nil
+}
+
+// option returns the option of the question with the id
+func (q RiskQuestion) option(id string) (RiskOption, bool) {
+	for _, o := range q.Options {
+		if o.ID == id {
+			return o, true
+		}
+	}
+
+	return RiskOption{}, false
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"errors"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+)
+
+var _errUnknownRole = errors.New("unknown role")
+
+// Role is the set of permissions a user has, embedded into access tokens
+type Role string
+
+// Roles of users, every new user is a RoleUser
+const (
+	RoleUser    Role = authz.RoleUser
+	RoleAnalyst Role = authz.RoleAnalyst
+	RoleSupport Role = authz.RoleSupport
+	RoleAdmin   Role = authz.RoleAdmin
+)
+
+// _rolePermissions is the role table, a user gets the permissions of their role only
+var _rolePermissions = map[Role][]string{
+	RoleUser: {
+		authz.PermTweetsRead,
+	},
+	RoleAnalyst: {
+		authz.PermTweetsRead,
+		authz.PermTweetsExport,
+	},
+	RoleSupport: {
+		authz.PermTweetsRead,
+		authz.PermUsersRead,
+		authz.PermUsersImpersonate,
+		authz.PermProfilesRead,
+	},
+	RoleAdmin: {
+		authz.PermTweetsRead,
+		authz.PermTweetsExport,
+		authz.PermTweetsAdmin,
+		authz.PermUsersRead,
+		authz.PermUsersWrite,
+		authz.PermUsersImpersonate,
+		authz.PermAuditRead,
+		authz.PermProfilesRead,
+		authz.PermPlansUnlimited,
+	},
+}
+
+// ParseRole returns the role with the name
+func ParseRole(name string) (Role, error) {
+	role := Role(name)
+	if _, ok := _rolePermissions[role]; !ok {
+		return "", _errUnknownRole
+	}
+
+	return role, nil
+}
+
+// Permissions returns the permissions granted to the role
+func (r Role) Permissions() []string {
+	return _rolePermissions[r]
+}
+
+// Impersonable reports whether support may act as users with the role,
+// staff accounts are never impersonated
+func (r Role) Impersonable() bool {
+	return r == RoleUser || r == RoleAnalyst
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a single issued refresh token. Tokens issued by rotation share
+// the FamilyID of the sign-in that started the chain
+type Session struct {
+	ID        uuid.UUID  `db:"id"         json:"id"`
+	FamilyID  uuid.UUID  `db:"family_id"  json:"family_id"`
+	UserID    uuid.UUID  `db:"user_id"    json:"user_id"`
+	TokenHash string     `db:"token_hash" json:"-"`
+	AccessJTI uuid.UUID  `db:"access_jti" json:"-"`
+	StartedAt time.Time  `db:"started_at" json:"started_at"` // sign-in time of the family, filled by listings
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+	ExpiresAt time.Time  `db:"expires_at" json:"expires_at"`
+	RotatedAt *time.Time `db:"rotated_at" json:"rotated_at,omitempty"`
+	RevokedAt *time.Time `db:"revoked_at" json:"revoked_at,omitempty"`
+}
+
+// IsExpired reports whether the refresh token can no longer be used because of its age
+func (s *Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// TokenPair is the result of a successful authentication or refresh
+type TokenPair struct {
+	UserID           uuid.UUID
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+
+	// MFAEnrollmentRequired is set when the role of the user requires MFA and
+	// the user has not enabled it yet, the access token grants no permissions
+	MFAEnrollmentRequired bool
+}
+
+// AccessClaims are the claims of a verified access token
+type AccessClaims struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID // FamilyID of the session the token was issued for
+	JTI       uuid.UUID
+	Role      Role // empty while Permissions are withheld
+	ExpiresAt time.Time
+
+	// Permissions granted by Role, none while the email of the user
+	// is unverified and the service restricts unverified users, or while
+	// the role requires MFA and the user has not enabled it
+	Permissions []string
+
+	// ActorID is the staff member impersonating UserID, uuid.Nil for tokens
+	// the user signed in for
+	ActorID uuid.UUID
+}
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Signing algorithms of access tokens
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+const _rsaKeyBits = 2048
+
+var _errUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+
+// SigningKey is a private key access tokens are signed with,
+// tokens name it by ID in the kid header
+type SigningKey struct {
+	ID        string        `db:"kid"         json:"kid"`
+	Algorithm string        `db:"algorithm"   json:"alg"`
+	Private   crypto.Signer `db:"private_key" json:"-"`
+	CreatedAt time.Time     `db:"created_at"  json:"created_at"`
+}
+
+// GenerateSigningKey creates a new random key for the algorithm
+func GenerateSigningKey(algorithm string, now time.Time) (*SigningKey, error) {
+	var (
+		private crypto.Signer
+		err     error
+	)
+
+	switch algorithm {
+	case AlgRS256:
+		private, err = rsa.GenerateKey(rand.Reader, _rsaKeyBits)
+	case AlgEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, _errUnsupportedAlgorithm
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{
+		ID:        uuid.NewString(),
+		Algorithm: algorithm,
+		Private:   private,
+		CreatedAt: now,
+	}, nil
+}
+
+// Public returns the public key tokens signed with the key are verified with
+func (k *SigningKey) Public() crypto.PublicKey {
+	return k.Private.Public()
+}
+
+// JWK returns the public key in JSON Web Key format
+func (k *SigningKey) JWK() JWK {
+	jwk := JWK{
+		Use: "sig",
+		Alg: k.Algorithm,
+		Kid: k.ID,
+	}
+
+	switch pub := k.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+
+	return jwk
+}
+
+// JWK is a public key as defined by RFC 7517, RSA keys set N and E, Ed25519 keys set Crv and X
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "strings"
+
+// ThrottleKey identifies what failed sign-ins are counted for
+type ThrottleKey string
+
+// AccountThrottleKey counts the failures for an email, registered or not,
+// so that locked and unknown accounts answer alike
+func AccountThrottleKey(email string) ThrottleKey {
+	return ThrottleKey("account:" + strings.ToLower(strings.TrimSpace(email)))
+}
+
+// IPThrottleKey counts the failures from a client address across accounts
+func IPThrottleKey(ip string) ThrottleKey {
+	return ThrottleKey("ip:" + ip)
+}
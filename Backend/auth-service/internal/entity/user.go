@@ -19,28 +19,36 @@ var (
 
 // User represents a user in the system
 type User struct {
-	ID           uuid.UUID `db:"id"           json:"id"`
-	Email        string    `db:"email"        json:"email"`
-	PasswordHash string    `db:"password_hash" json:"-"`
-	Username     string    `db:"username"     json:"username"`
-	IsAdmin      bool      `db:"is_admin"     json:"is_admin"`
-	CreatedAt    time.Time `db:"created_at"   json:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at"   json:"updated_at"`
+	ID           uuid.UUID  `db:"id"                json:"id"`
+	Email        string     `db:"email"             json:"email"`
+	PasswordHash string     `db:"password_hash"     json:"-"`
+	Username     string     `db:"username"          json:"username"`
+	Role         Role       `db:"role"              json:"role"`
+	DisabledAt   *time.Time `db:"disabled_at"       json:"disabled_at,omitempty"`
+	VerifiedAt   *time.Time `db:"email_verified_at" json:"email_verified_at,omitempty"`
+	CreatedAt    time.Time  `db:"created_at"        json:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at"        json:"updated_at"`
+}
+
+// UserFilter selects users in admin listings, zero fields match everyone
+type UserFilter struct {
+	Query    string // substring of the email or username
+	Role     Role
+	Disabled *bool
+	Limit    int
+	Offset   int
 }
 
 // NewUser creates a new user with validation
-func NewUser(email, password, username string, isAdmin bool) (*User, error) {
+func NewUser(email, password, username string, role Role) (*User, error) {
 	if email == "" {
 		return nil, _errEmptyEmail
 	}
 	if len(email) < 3 || len(email) > 254 {
 		return nil, _errInvalidEmail
 	}
-	if password == "" {
-		return nil, _errEmptyPassword
-	}
-	if len(password) < 8 {
-		return nil, _errInvalidPassword
+	if err := ValidatePassword(password); err != nil {
+		return nil, err
 	}
 	if username == "" {
 		return nil, _errEmptyUsername
@@ -60,12 +68,27 @@ func NewUser(email, password, username string, isAdmin bool) (*User, error) {
 		Email:        email,
 		PasswordHash: hash,
 		Username:     username,
-		IsAdmin:      isAdmin,
+		Role:         role,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}, nil
 }
 
+// IsAdmin reports whether the user has the admin role
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+// IsDisabled reports whether the account was disabled, disabled users cannot sign in
+func (u *User) IsDisabled() bool {
+	return u.DisabledAt != nil
+}
+
+// IsVerified reports whether the user confirmed the email
+func (u *User) IsVerified() bool {
+	return u.VerifiedAt != nil
+}
+
 // SetPassword sets the password for the user
 func (u *User) SetPassword(password string) error {
 	hash, err := HashPassword(password)
@@ -77,6 +100,18 @@ func (u *User) SetPassword(password string) error {
 	return nil
 }
 
+// ValidatePassword checks the password policy
+func ValidatePassword(password string) error {
+	if password == "" {
+		return _errEmptyPassword
+	}
+	if len(password) < 8 {
+		return _errInvalidPassword
+	}
+
+	return nil
+}
+
 // HashPassword hashes a password using bcrypt
 func HashPassword(password string) (string, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeletionStepAccount is the step of an account deletion that deletes the
+// account itself, it comes after the steps of the other services
+const DeletionStepAccount = "auth"
+
+// DataFile is a file of a data export archive
+type DataFile struct {
+	Name        string // file name in the folder of the service
+	ContentType string
+	Content     []byte
+}
+
+// DataExport is the archive of the data every service keeps about a user
+type DataExport struct {
+	FileName  string
+	Archive   []byte // zip archive with a folder per service
+	CreatedAt time.Time
+}
+
+// DeletionStatus is the state of an account deletion
+type DeletionStatus string
+
+// Account deletion states
+const (
+	DeletionPending   DeletionStatus = "pending"   // steps are left, they are retried until they succeed
+	DeletionCompleted DeletionStatus = "completed" // the data is deleted or anonymised everywhere
+	DeletionFailed    DeletionStatus = "failed"    // retries ran out, staff must retry the deletion
+)
+
+// AccountDeletion tracks the deletion of the data of an account across
+// services, it outlives the account
+type AccountDeletion struct {
+	ID            uuid.UUID      `json:"id"`
+	UserID        uuid.UUID      `json:"user_id"`
+	Status        DeletionStatus `json:"status"`
+	Pending       []string       `json:"pending"`  // services left to delete the data, DeletionStepAccount last
+	Attempts      int            `json:"attempts"` // failed attempts since the deletion was requested or retried
+	LastError     string         `json:"last_error,omitempty"`
+	NextAttemptAt time.Time      `json:"next_attempt_at"`
+	RequestedAt   time.Time      `json:"requested_at"`
+	CompletedAt   *time.Time     `json:"completed_at,omitempty"`
+}
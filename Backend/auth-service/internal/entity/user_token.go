@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenPurpose is what a mailed user token can be used for
+type TokenPurpose string
+
+// Purposes of user tokens
+const (
+	PurposeEmailVerification TokenPurpose = "email_verification"
+	PurposePasswordReset     TokenPurpose = "password_reset"
+)
+
+// UserToken is a single-use token mailed to a user, only its hash is stored
+type UserToken struct {
+	ID        uuid.UUID    `db:"id"         json:"id"`
+	UserID    uuid.UUID    `db:"user_id"    json:"user_id"`
+	Purpose   TokenPurpose `db:"purpose"    json:"purpose"`
+	TokenHash string       `db:"token_hash" json:"-"`
+	CreatedAt time.Time    `db:"created_at" json:"created_at"`
+	ExpiresAt time.Time    `db:"expires_at" json:"expires_at"`
+	UsedAt    *time.Time   `db:"used_at"    json:"used_at,omitempty"`
+}
+
+// Mail is a plain text email
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,25 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+)
+
+// APIKeyToProto converts API key entity to protobuf message, the hash is never sent
+func APIKeyToProto(k *entity.APIKey) *authv1.APIKey {
+	pb := &authv1.APIKey{
+		Id:        k.ID.String(),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: k.CreatedAt.Format(time.RFC3339),
+		ExpiresAt: k.ExpiresAt.Format(time.RFC3339),
+	}
+	if k.LastUsedAt != nil {
+		pb.LastUsedAt = k.LastUsedAt.Format(time.RFC3339)
+	}
+
+	return pb
+}
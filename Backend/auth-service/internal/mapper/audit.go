@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+)
+
+// AuditEventToProto converts audit event entity to protobuf message
+func AuditEventToProto(e *entity.AuditEvent) *authv1.AuditEvent {
+	pb := &authv1.AuditEvent{
+		Id:        e.ID.String(),
+		Type:      string(e.Type),
+		Outcome:   string(e.Outcome),
+		Ip:        e.IP,
+		UserAgent: e.UserAgent,
+		Details:   e.Details,
+		CreatedAt: e.CreatedAt.Format(time.RFC3339Nano),
+	}
+	if e.ActorID != uuid.Nil {
+		pb.ActorId = e.ActorID.String()
+	}
+	if e.TargetID != uuid.Nil {
+		pb.TargetId = e.TargetID.String()
+	}
+
+	return pb
+}
@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+)
+
+// ProfileToProto converts profile entity to protobuf message
+func ProfileToProto(p *entity.Profile) *authv1.Profile {
+	pb := &authv1.Profile{
+		UserId:        p.UserID.String(),
+		RiskTolerance: string(p.RiskTolerance),
+		RiskScore:     int32(p.RiskScore),
+		Horizon:       string(p.Horizon),
+		BaseCurrency:  p.BaseCurrency,
+		Markets:       p.Markets,
+		Watchlist:     p.Watchlist,
+		Locale:        p.Locale,
+	}
+	if p.AssessedAt != nil {
+		pb.AssessedAt = p.AssessedAt.Format(time.RFC3339)
+	}
+	if !p.UpdatedAt.IsZero() {
+		pb.UpdatedAt = p.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return pb
+}
+
+// RiskAssessmentToProto converts risk assessment entity to protobuf message
+func RiskAssessmentToProto(a *entity.RiskAssessment) *authv1.RiskAssessment {
+	return &authv1.RiskAssessment{
+		Id:        a.ID.String(),
+		Version:   a.Version,
+		Answers:   a.Answers,
+		Score:     int32(a.Score),
+		Tolerance: string(a.Tolerance),
+		CreatedAt: a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RiskQuestionToProto converts risk question to protobuf message, the scores
+// of the options are not shown
+func RiskQuestionToProto(q entity.RiskQuestion) *authv1.RiskQuestion {
+	pb := &authv1.RiskQuestion{
+		Id:      q.ID,
+		Text:    q.Text,
+		Options: make([]*authv1.RiskOption, len(q.Options)),
+	}
+	for i, o := range q.Options {
+		pb.Options[i] = &authv1.RiskOption{Id: o.ID, Text: o.Text}
+	}
+
+	return pb
+}
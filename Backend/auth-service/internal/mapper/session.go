@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+	"github.com/google/uuid"
+)
+
+// SessionToProto converts session entity to protobuf message,
+// current is the session of the token the request was made with
+func SessionToProto(s *entity.Session, current uuid.UUID) *authv1.Session {
+	return &authv1.Session{
+		Id:         s.FamilyID.String(),
+		CreatedAt:  s.StartedAt.Format(time.RFC3339),
+		LastUsedAt: s.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:  s.ExpiresAt.Format(time.RFC3339),
+		Current:    s.FamilyID == current,
+	}
+}
@@ -10,14 +10,23 @@ import (
 
 // UserToProto converts user entity to protobuf message
 func UserToProto(u *entity.User) *authv1.User {
-	return &authv1.User{
+	pb := &authv1.User{
 		Id:        u.ID.String(),
 		Email:     u.Email,
 		Username:  u.Username,
-		IsAdmin:   u.IsAdmin,
+		IsAdmin:   u.IsAdmin(),
+		Role:      string(u.Role),
 		CreatedAt: u.CreatedAt.Format(time.RFC3339),
 		UpdatedAt: u.UpdatedAt.Format(time.RFC3339),
 	}
+	if u.DisabledAt != nil {
+		pb.DisabledAt = u.DisabledAt.Format(time.RFC3339)
+	}
+	if u.VerifiedAt != nil {
+		pb.EmailVerifiedAt = u.VerifiedAt.Format(time.RFC3339)
+	}
+
+	return pb
 }
 
 // UserFromProto creates user entity from protobuf message
@@ -37,26 +46,49 @@ func UserFromProto(data *authv1.User) (*entity.User, error) {
 		return nil, err
 	}
 
-	return &entity.User{
+	role, err := entity.ParseRole(data.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &entity.User{
 		ID:        id,
 		Email:     data.Email,
 		Username:  data.Username,
-		IsAdmin:   data.IsAdmin,
+		Role:      role,
 		CreatedAt: createdAt,
 		UpdatedAt: updatedAt,
-	}, nil
+	}
+	if data.DisabledAt != "" {
+		disabledAt, err := time.Parse(time.RFC3339, data.DisabledAt)
+		if err != nil {
+			return nil, err
+		}
+		user.DisabledAt = &disabledAt
+	}
+	if data.EmailVerifiedAt != "" {
+		verifiedAt, err := time.Parse(time.RFC3339, data.EmailVerifiedAt)
+		if err != nil {
+			return nil, err
+		}
+		user.VerifiedAt = &verifiedAt
+	}
+
+	return user, nil
 }
 
 // UserToMap converts user entity to map for database operations
 func UserToMap(u *entity.User) map[string]interface{} {
 	return map[string]interface{}{
-		"id":            u.ID.String(),
-		"email":         u.Email,
-		"password_hash": u.PasswordHash,
-		"username":      u.Username,
-		"is_admin":      u.IsAdmin,
-		"created_at":    u.CreatedAt,
-		"updated_at":    u.UpdatedAt,
+		"id":                u.ID.String(),
+		"email":             u.Email,
+		"password_hash":     u.PasswordHash,
+		"username":          u.Username,
+		"role":              string(u.Role),
+		"disabled_at":       u.DisabledAt,
+		"email_verified_at": u.VerifiedAt,
+		"created_at":        u.CreatedAt,
+		"updated_at":        u.UpdatedAt,
 	}
 }
 
@@ -72,7 +104,9 @@ func UserFromMap(data map[string]interface{}) (*entity.User, error) {
 		Email:        data["email"].(string),
 		PasswordHash: data["password_hash"].(string),
 		Username:     data["username"].(string),
-		IsAdmin:      data["is_admin"].(bool),
+		Role:         entity.Role(data["role"].(string)),
+		DisabledAt:   data["disabled_at"].(*time.Time),
+		VerifiedAt:   data["email_verified_at"].(*time.Time),
 		CreatedAt:    data["created_at"].(time.Time),
 		UpdatedAt:    data["updated_at"].(time.Time),
 	}, nil
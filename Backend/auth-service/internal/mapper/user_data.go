@@ -0,0 +1,25 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	authv1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+)
+
+// AccountDeletionToProto converts account deletion entity to protobuf message,
+// errors of failed attempts are only in the audit log
+func AccountDeletionToProto(d *entity.AccountDeletion) *authv1.AccountDeletion {
+	pb := &authv1.AccountDeletion{
+		Id:              d.ID.String(),
+		Status:          string(d.Status),
+		PendingServices: d.Pending,
+		Attempts:        int32(d.Attempts),
+		RequestedAt:     d.RequestedAt.Format(time.RFC3339),
+	}
+	if d.CompletedAt != nil {
+		pb.CompletedAt = d.CompletedAt.Format(time.RFC3339)
+	}
+
+	return pb
+}
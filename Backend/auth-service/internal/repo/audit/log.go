@@ -0,0 +1,58 @@
+// Package audit implements storage of the security audit log
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/logger"
+)
+
+// Log writes every audit event to the service log
+type Log struct {
+	l logger.Interface
+}
+
+// NewLog creates a new instance of Log
+func NewLog(l logger.Interface) *Log {
+	return &Log{l: l}
+}
+
+// Record logs the event as JSON
+func (a *Log) Record(_ context.Context, event entity.AuditEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("Log - Record - json.Marshal: %w", err)
+	}
+
+	a.l.Info("audit: %s", data)
+	return nil
+}
+
+// Fallback records audit events in the primary log, events it fails to store
+// are written to the service log so that none is lost
+type Fallback struct {
+	repo.AuditLog
+	log *Log
+}
+
+// NewFallback creates a new instance of Fallback
+func NewFallback(primary repo.AuditLog, l logger.Interface) *Fallback {
+	return &Fallback{AuditLog: primary, log: NewLog(l)}
+}
+
+// Record appends the event to the primary log, the error of the primary log
+// is returned even when the event is logged, callers decide whether to go on
+func (f *Fallback) Record(ctx context.Context, event entity.AuditEvent) error {
+	err := f.AuditLog.Record(ctx, event)
+	if err != nil {
+		if logErr := f.log.Record(ctx, event); logErr != nil {
+			return fmt.Errorf("%w, %w", err, logErr)
+		}
+	}
+
+	return err
+}
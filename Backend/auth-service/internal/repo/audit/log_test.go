@@ -0,0 +1,65 @@
+package audit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo/audit"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/logger"
+)
+
+// fakeLogger records the info messages
+type fakeLogger struct {
+	logger.Interface
+	infos []string
+}
+
+func (l *fakeLogger) Info(message string, args ...interface{}) {
+	l.infos = append(l.infos, fmt.Sprintf(message, args...))
+}
+
+// failingLog is a primary audit log that cannot store events
+type failingLog struct {
+	repo.AuditLog
+	err error
+}
+
+func (f failingLog) Record(context.Context, entity.AuditEvent) error {
+	return f.err
+}
+
+func TestFallback(t *testing.T) {
+	t.Parallel()
+
+	event := entity.AuditEvent{
+		ID:      uuid.New(),
+		Type:    entity.AuditSignIn,
+		Outcome: entity.AuditFailure,
+	}
+
+	t.Run("stored", func(t *testing.T) {
+		t.Parallel()
+
+		l := &fakeLogger{}
+		require.NoError(t, audit.NewFallback(failingLog{}, l).Record(context.Background(), event))
+		require.Empty(t, l.infos)
+	})
+
+	t.Run("primary fails", func(t *testing.T) {
+		t.Parallel()
+
+		// the event reaches the service log and the caller still learns of the failure
+		l := &fakeLogger{}
+		err := audit.NewFallback(failingLog{err: context.DeadlineExceeded}, l).Record(context.Background(), event)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Len(t, l.infos, 1)
+		require.Contains(t, l.infos[0], event.ID.String())
+		require.Contains(t, l.infos[0], string(entity.AuditSignIn))
+	})
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
 	"github.com/google/uuid"
@@ -30,6 +31,11 @@ type UserRepository interface {
 	// Returns error if database operation fails
 	List(ctx context.Context) ([]*entity.User, error)
 
+	// Search retrieves a page of users matching the filter, newest first.
+	// Returns the page and the number of all matching users.
+	// Returns error if database operation fails
+	Search(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error)
+
 	// Update updates an existing user's information.
 	// Returns error if user update fails
 	Update(ctx context.Context, user *entity.User) error
@@ -38,3 +44,328 @@ type UserRepository interface {
 	// Returns error if user deletion fails
 	Delete(ctx context.Context, id uuid.UUID) error
 }
+
+// SessionRepository defines the interface for refresh token storage
+type SessionRepository interface {
+	// Create stores a newly issued refresh token.
+	// Returns error if database operation fails
+	Create(ctx context.Context, session *entity.Session) error
+
+	// GetByTokenHash retrieves a refresh token by its hash.
+	// Returns nil, nil if the token is unknown.
+	// Returns error if database operation fails
+	GetByTokenHash(ctx context.Context, hash string) (*entity.Session, error)
+
+	// Rotate marks the token as rotated and stores its successor in one transaction.
+	// Returns false if the token was already rotated or revoked.
+	// Returns error if database operation fails
+	Rotate(ctx context.Context, id uuid.UUID, next *entity.Session) (bool, error)
+
+	// ListActive retrieves the current token of every active family of the user,
+	// newest sign-in first. Returns empty slice if the user has no sessions.
+	// Returns error if database operation fails
+	ListActive(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+
+	// RevokeFamily revokes all tokens of the user's family that are not revoked yet.
+	// Returns the revoked tokens, empty if the family is unknown or already revoked.
+	// Returns error if database operation fails
+	RevokeFamily(ctx context.Context, userID, familyID uuid.UUID) ([]*entity.Session, error)
+
+	// RevokeAll revokes all tokens of the user that are not revoked yet.
+	// Returns the revoked tokens, empty if the user has no active sessions.
+	// Returns error if database operation fails
+	RevokeAll(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+}
+
+// TokenDenylist defines the interface for revoked access token storage,
+// entries are only needed until the token expires on its own
+type TokenDenylist interface {
+	// Add denies the token with the jti until expiresAt.
+	// Returns error if storage operation fails
+	Add(ctx context.Context, jti uuid.UUID, expiresAt time.Time) error
+
+	// Contains reports whether the token with the jti is denied.
+	// Returns error if storage operation fails
+	Contains(ctx context.Context, jti uuid.UUID) (bool, error)
+}
+
+// UserTokenRepository defines the interface for storage of single-use tokens mailed to users
+type UserTokenRepository interface {
+	// Issue stores a new token, unused tokens of the user with the same purpose
+	// stop working so that only the latest mail is valid.
+	// Returns error if database operation fails
+	Issue(ctx context.Context, token *entity.UserToken) error
+
+	// Consume marks the token with the hash and purpose as used.
+	// Returns nil, nil if the token is unknown, used or expired.
+	// Returns error if database operation fails
+	Consume(ctx context.Context, hash string, purpose entity.TokenPurpose) (*entity.UserToken, error)
+
+	// Invalidate marks all unused tokens of the user with the purpose as used.
+	// Returns error if database operation fails
+	Invalidate(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose) error
+}
+
+// MFARepository defines the interface for TOTP second factor storage
+type MFARepository interface {
+	// Get retrieves the second factor of the user, pending or enabled.
+	// Returns nil, nil if the user has not started an enrollment.
+	// Returns error if database operation fails
+	Get(ctx context.Context, userID uuid.UUID) (*entity.MFA, error)
+
+	// SavePending stores a new pending enrollment, replacing a pending one.
+	// Returns false if the user already has MFA enabled.
+	// Returns error if database operation fails
+	SavePending(ctx context.Context, mfa *entity.MFA) (bool, error)
+
+	// Enable confirms the pending enrollment of the user with the code of the
+	// step and stores the hashes of the recovery codes in one transaction.
+	// Returns false if there is no pending enrollment.
+	// Returns error if database operation fails
+	Enable(ctx context.Context, userID uuid.UUID, step int64, codeHashes []string, at time.Time) (bool, error)
+
+	// UseStep records the step of an accepted code.
+	// Returns false if a code of the same or a later step was accepted before.
+	// Returns error if database operation fails
+	UseStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+
+	// UseRecoveryCode marks the unused recovery code with the hash as used.
+	// Returns false if the user has no such unused code.
+	// Returns error if database operation fails
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string, at time.Time) (bool, error)
+
+	// ReplaceRecoveryCodes replaces all recovery codes of the user.
+	// Returns error if database operation fails
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+
+	// Delete removes the second factor of the user with its recovery codes.
+	// Returns error if database operation fails
+	Delete(ctx context.Context, userID uuid.UUID) error
+
+	// CreateChallenge stores a new sign-in challenge.
+	// Returns error if database operation fails
+	CreateChallenge(ctx context.Context, challenge *entity.MFAChallenge) error
+
+	// AttemptChallenge counts an attempt on the unused, unexpired challenge with
+	// the hash that has fewer than maxAttempts attempts and returns it.
+	// Returns nil, nil if there is no such challenge.
+	// Returns error if database operation fails
+	AttemptChallenge(ctx context.Context, hash string, at time.Time, maxAttempts int) (*entity.MFAChallenge, error)
+
+	// ConsumeChallenge marks the challenge as used.
+	// Returns false if it was used before.
+	// Returns error if database operation fails
+	ConsumeChallenge(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)
+
+	// RequiredRoles retrieves the roles whose users must have MFA enabled.
+	// Returns empty slice if MFA is optional for all roles.
+	// Returns error if database operation fails
+	RequiredRoles(ctx context.Context) ([]entity.Role, error)
+
+	// SetRoleRequired changes whether users of the role must have MFA enabled.
+	// Returns error if database operation fails
+	SetRoleRequired(ctx context.Context, role entity.Role, required bool, by uuid.UUID, at time.Time) error
+}
+
+// ThrottleRepository defines the interface for failed sign-in counters
+type ThrottleRepository interface {
+	// LockedUntil retrieves the latest lock of the keys that lasts beyond now.
+	// Returns the zero time if none of the keys is locked.
+	// Returns error if database operation fails
+	LockedUntil(ctx context.Context, keys []entity.ThrottleKey, now time.Time) (time.Time, error)
+
+	// RecordFailure counts a failed sign-in for the key, a counter whose last
+	// failure is older than the window starts over.
+	// Returns the number of failures including this one.
+	// Returns error if database operation fails
+	RecordFailure(ctx context.Context, key entity.ThrottleKey, now time.Time, window time.Duration) (int, error)
+
+	// Lock rejects sign-ins for the key until the given time.
+	// Returns error if database operation fails
+	Lock(ctx context.Context, key entity.ThrottleKey, until time.Time) error
+
+	// Reset forgets the failures and the lock of the key.
+	// Returns error if database operation fails
+	Reset(ctx context.Context, key entity.ThrottleKey) error
+}
+
+// IdentityRepository defines the interface for storage of external identities
+// and of authorizations started at identity providers
+type IdentityRepository interface {
+	// Get retrieves the identity with the subject at the provider.
+	// Returns nil, nil if the identity is not linked to a user.
+	// Returns error if database operation fails
+	Get(ctx context.Context, provider, subject string) (*entity.ExternalIdentity, error)
+
+	// Create links the identity to its user.
+	// Returns error if database operation fails
+	Create(ctx context.Context, identity *entity.ExternalIdentity) error
+
+	// CreateWithUser stores a new user and links the identity to it in one transaction.
+	// Returns error if database operation fails
+	CreateWithUser(ctx context.Context, user *entity.User, identity *entity.ExternalIdentity) error
+
+	// ListByUser retrieves the identities linked to the user, oldest first.
+	// Returns empty slice if the user has none.
+	// Returns error if database operation fails
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.ExternalIdentity, error)
+
+	// Touch records a sign-in with the identity and the email the provider reported.
+	// Returns error if database operation fails
+	Touch(ctx context.Context, id uuid.UUID, email string, at time.Time) error
+
+	// CreateState stores a started authorization.
+	// Returns error if database operation fails
+	CreateState(ctx context.Context, state *entity.OAuthState) error
+
+	// ConsumeState removes the unexpired authorization with the state hash
+	// started at the provider and returns it.
+	// Returns nil, nil if there is no such authorization.
+	// Returns error if database operation fails
+	ConsumeState(ctx context.Context, hash, provider string, at time.Time) (*entity.OAuthState, error)
+}
+
+// IdentityProvider defines the interface for OAuth2/OIDC providers users sign in with
+type IdentityProvider interface {
+	// Name returns the name of the provider used in routes and stored identities
+	Name() string
+
+	// AuthCodeURL returns the authorization endpoint URL the user is sent to,
+	// it carries the state, the nonce and the S256 challenge of the PKCE verifier.
+	// Returns error if the provider configuration cannot be loaded
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+
+	// Exchange redeems the authorization code with the PKCE verifier and
+	// returns the profile of the user, ID tokens must carry the nonce.
+	// Returns error if the provider rejects the code or its tokens are invalid
+	Exchange(ctx context.Context, code, verifier, nonce string) (*entity.ExternalProfile, error)
+}
+
+// APIKeyRepository defines the interface for personal API key storage
+type APIKeyRepository interface {
+	// Create stores a new key.
+	// Returns error if database operation fails
+	Create(ctx context.Context, key *entity.APIKey) error
+
+	// ListByUser retrieves the keys of the user that are not revoked, newest first.
+	// Returns empty slice if the user has no keys.
+	// Returns error if database operation fails
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.APIKey, error)
+
+	// CountActive counts the keys of the user that are neither revoked nor expired at the time.
+	// Returns error if database operation fails
+	CountActive(ctx context.Context, userID uuid.UUID, at time.Time) (int, error)
+
+	// GetByHash retrieves a key by its hash.
+	// Returns nil, nil if key not found.
+	// Returns error if database operation fails
+	GetByHash(ctx context.Context, hash string) (*entity.APIKey, error)
+
+	// Touch records a use of the key, at most once a minute.
+	// Returns error if database operation fails
+	Touch(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// Revoke revokes the user's key if it is not revoked yet.
+	// Returns false if the user has no such key.
+	// Returns error if database operation fails
+	Revoke(ctx context.Context, userID, id uuid.UUID, at time.Time) (bool, error)
+}
+
+// AuditLog defines the interface for the append-only security audit log
+type AuditLog interface {
+	// Record appends the event to the log.
+	// Returns error if the event could not be stored
+	Record(ctx context.Context, event entity.AuditEvent) error
+
+	// List retrieves up to filter.Limit events matching the filter, newest first.
+	// Returns empty slice if no events match.
+	// Returns error if database operation fails
+	List(ctx context.Context, filter entity.AuditFilter) ([]*entity.AuditEvent, error)
+}
+
+// ProfileRepository defines the interface for investment profile storage
+type ProfileRepository interface {
+	// Get retrieves the profile of the user.
+	// Returns nil, nil if the user has no profile yet.
+	// Returns error if database operation fails
+	Get(ctx context.Context, userID uuid.UUID) (*entity.Profile, error)
+
+	// SavePreferences creates the profile or replaces its preferences, the risk fields are kept.
+	// Returns error if database operation fails
+	SavePreferences(ctx context.Context, profile *entity.Profile) error
+
+	// SaveAssessment stores the assessment and makes it the risk tolerance of the user's profile.
+	// Returns error if database operation fails
+	SaveAssessment(ctx context.Context, assessment *entity.RiskAssessment) error
+
+	// ListAssessments retrieves up to limit assessments of the user, newest first.
+	// Returns empty slice if the user has none.
+	// Returns error if database operation fails
+	ListAssessments(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.RiskAssessment, error)
+}
+
+// AccountDeletionRepository defines the interface for account deletion tracking
+type AccountDeletionRepository interface {
+	// Create stores a new deletion.
+	// Returns error if database operation fails
+	Create(ctx context.Context, deletion *entity.AccountDeletion) error
+
+	// Get retrieves a deletion by its UUID.
+	// Returns nil, nil if deletion not found.
+	// Returns error if database operation fails
+	Get(ctx context.Context, id uuid.UUID) (*entity.AccountDeletion, error)
+
+	// GetUnfinished retrieves the pending or failed deletion of the user.
+	// Returns nil, nil if the user has none.
+	// Returns error if database operation fails
+	GetUnfinished(ctx context.Context, userID uuid.UUID) (*entity.AccountDeletion, error)
+
+	// Claim retrieves up to limit pending deletions whose next attempt is due
+	// at the time and postpones their next attempt by lease, so that other
+	// instances skip them while they are processed.
+	// Returns empty slice if none is due.
+	// Returns error if database operation fails
+	Claim(ctx context.Context, at time.Time, lease time.Duration, limit int) ([]*entity.AccountDeletion, error)
+
+	// Update saves the progress of the deletion.
+	// Returns error if database operation fails
+	Update(ctx context.Context, deletion *entity.AccountDeletion) error
+}
+
+// UserDataService defines the interface for other services keeping data of users
+type UserDataService interface {
+	// Name returns the name of the service, the folder of its files in export archives
+	Name() string
+
+	// Export retrieves the data the service keeps about the user.
+	// Returns error if the service fails or cannot be reached
+	Export(ctx context.Context, userID uuid.UUID) ([]entity.DataFile, error)
+
+	// Delete deletes or anonymises the data of the user, users without data succeed.
+	// Returns error if the service fails or cannot be reached
+	Delete(ctx context.Context, userID, deletionID uuid.UUID) error
+}
+
+// Mailer defines the interface for sending emails
+type Mailer interface {
+	// Send delivers the mail.
+	// Returns error if the mail could not be handed over for delivery
+	Send(ctx context.Context, mail entity.Mail) error
+}
+
+// SigningKeyRepository defines the interface for token signing key storage
+type SigningKeyRepository interface {
+	// Create stores a newly generated key.
+	// Returns error if database operation fails
+	Create(ctx context.Context, key *entity.SigningKey) error
+
+	// List retrieves all stored keys, newest first.
+	// Returns empty slice if no keys found.
+	// Returns error if database operation fails
+	List(ctx context.Context) ([]*entity.SigningKey, error)
+
+	// DeleteSuperseded removes keys that have a newer key created before the given time.
+	// Returns the number of removed keys.
+	// Returns error if database operation fails
+	DeleteSuperseded(ctx context.Context, before time.Time) (int64, error)
+}
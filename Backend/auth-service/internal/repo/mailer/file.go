@@ -0,0 +1,67 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/logger"
+)
+
+const _mailSeparator = "\r\n---\r\n"
+
+// File appends every mail to a file instead of delivering it, for local development
+type File struct {
+	path string
+	from string
+
+	mu sync.Mutex
+}
+
+// NewFile creates a new instance of File, the directory of path is created if missing
+func NewFile(path, from string) (*File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	return &File{path: path, from: from}, nil
+}
+
+// Send appends the mail to the file
+func (m *File) Send(_ context.Context, msg entity.Mail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("File - Send - os.OpenFile: %w", err)
+	}
+	defer f.Close()
+
+	if _, err = f.Write(append(message(m.from, msg, time.Now()), _mailSeparator...)); err != nil {
+		return fmt.Errorf("File - Send - f.Write: %w", err)
+	}
+
+	return nil
+}
+
+// Log writes every mail to the service log instead of delivering it, for local development.
+// Mails carry single-use tokens, so it must not be used in production
+type Log struct {
+	l logger.Interface
+}
+
+// NewLog creates a new instance of Log
+func NewLog(l logger.Interface) *Log {
+	return &Log{l: l}
+}
+
+// Send logs the mail
+func (m *Log) Send(_ context.Context, msg entity.Mail) error {
+	m.l.Info("mail to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
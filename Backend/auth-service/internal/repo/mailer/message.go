@@ -0,0 +1,28 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+)
+
+// message renders the mail as an RFC 5322 message with a plain text body
+func message(from string, mail entity.Mail, now time.Time) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mail.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(mail.Body)
+	b.WriteString("\r\n")
+
+	return b.Bytes()
+}
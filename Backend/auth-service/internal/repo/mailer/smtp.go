@@ -0,0 +1,103 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+)
+
+// SMTP delivers mail through an SMTP relay, upgrading to TLS with STARTTLS
+// when the server offers it
+type SMTP struct {
+	addr    string
+	host    string
+	from    string
+	auth    smtp.Auth
+	timeout time.Duration
+}
+
+// NewSMTP creates a new instance of SMTP, no authentication is done without a username
+func NewSMTP(host, port, username, password, from string, timeout time.Duration) (*SMTP, error) {
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("mail.ParseAddress(%s): %w", from, err)
+	}
+
+	m := &SMTP{
+		addr:    net.JoinHostPort(host, port),
+		host:    host,
+		from:    from,
+		timeout: timeout,
+	}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return m, nil
+}
+
+// Send delivers the mail to the relay
+func (m *SMTP) Send(ctx context.Context, msg entity.Mail) error {
+	from, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("SMTP - Send - mail.ParseAddress: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("SMTP - Send - DialContext: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP - Send - smtp.NewClient: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("SMTP - Send - c.StartTLS: %w", err)
+		}
+	}
+	if m.auth != nil {
+		if err = c.Auth(m.auth); err != nil {
+			return fmt.Errorf("SMTP - Send - c.Auth: %w", err)
+		}
+	}
+
+	if err = c.Mail(from.Address); err != nil {
+		return fmt.Errorf("SMTP - Send - c.Mail: %w", err)
+	}
+	if err = c.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("SMTP - Send - c.Rcpt: %w", err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP - Send - c.Data: %w", err)
+	}
+	if _, err = w.Write(message(m.from, msg, time.Now())); err != nil {
+		return fmt.Errorf("SMTP - Send - w.Write: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("SMTP - Send - w.Close: %w", err)
+	}
+
+	if err = c.Quit(); err != nil {
+		return fmt.Errorf("SMTP - Send - c.Quit: %w", err)
+	}
+
+	return nil
+}
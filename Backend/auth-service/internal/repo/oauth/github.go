@@ -0,0 +1,120 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+)
+
+const _githubAPIURL = "https://api.github.com"
+
+// GitHub signs users in with GitHub OAuth apps. GitHub does not speak OIDC,
+// the profile and the verified email come from its REST API
+type GitHub struct {
+	config oauth2.Config
+	apiURL string
+}
+
+// NewGitHub creates the provider for the OAuth app, redirectURL is the
+// callback URL registered with the app
+func NewGitHub(clientID, clientSecret, redirectURL string) *GitHub {
+	return &GitHub{
+		config: oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Endpoint:     github.Endpoint,
+			Scopes:       []string{"read:user", "user:email"},
+		},
+		apiURL: _githubAPIURL,
+	}
+}
+
+// Name returns the name of the provider
+func (p *GitHub) Name() string {
+	return entity.ProviderGitHub
+}
+
+// AuthCodeURL returns the authorization URL for the state and PKCE verifier, GitHub has no nonce
+func (p *GitHub) AuthCodeURL(_ context.Context, state, _, verifier string) (string, error) {
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Exchange redeems the code and returns the profile with the primary email
+func (p *GitHub) Exchange(ctx context.Context, code, verifier, _ string) (*entity.ExternalProfile, error) {
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("GitHub - Exchange - config.Exchange: %w", err)
+	}
+	client := p.config.Client(ctx, token)
+
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	if err := p.get(ctx, client, "/user", &user); err != nil {
+		return nil, err
+	}
+	if user.ID == 0 {
+		return nil, errors.New("GitHub - Exchange - user without id")
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := p.get(ctx, client, "/user/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	profile := &entity.ExternalProfile{
+		Subject: strconv.FormatInt(user.ID, 10),
+		Name:    user.Name,
+	}
+	if profile.Name == "" {
+		profile.Name = user.Login
+	}
+	for _, e := range emails {
+		if e.Primary {
+			profile.Email = e.Email
+			profile.EmailVerified = e.Verified
+			break
+		}
+	}
+
+	return profile, nil
+}
+
+// get decodes the JSON response of the API path into v
+func (p *GitHub) get(ctx context.Context, client *http.Client, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("GitHub - get - http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("GitHub - get - client.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GitHub - get - %s: unexpected status %s", path, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("GitHub - get - %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// Package oauth implements the identity providers users sign in with
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+)
+
+// OIDC is an OpenID Connect provider found by discovery at its issuer URL,
+// Google among others. Discovery happens on first use, so the service starts
+// while the provider is unreachable
+type OIDC struct {
+	name   string
+	issuer string
+	config oauth2.Config
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// NewOIDC creates the provider for the issuer, redirectURL is the callback
+// registered with the provider for the client
+func NewOIDC(name, issuer, clientID, clientSecret, redirectURL string) *OIDC {
+	return &OIDC{
+		name:   name,
+		issuer: issuer,
+		config: oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{oidc.ScopeOpenID, "email", "profile"},
+		},
+	}
+}
+
+// Name returns the name of the provider
+func (p *OIDC) Name() string {
+	return p.name
+}
+
+// AuthCodeURL returns the authorization endpoint URL for the state, nonce and PKCE verifier
+func (p *OIDC) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	config := p.config
+	config.Endpoint = provider.Endpoint()
+
+	return config.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Exchange redeems the code and returns the profile from the verified ID token
+func (p *OIDC) Exchange(ctx context.Context, code, verifier, nonce string) (*entity.ExternalProfile, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config := p.config
+	config.Endpoint = provider.Endpoint()
+
+	token, err := config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("OIDC - Exchange - config.Exchange: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("OIDC - Exchange - no id_token in the token response")
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: p.config.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("OIDC - Exchange - Verify: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("OIDC - Exchange - id_token nonce mismatch")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("OIDC - Exchange - idToken.Claims: %w", err)
+	}
+
+	return &entity.ExternalProfile{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// discover loads the provider configuration once it is reachable
+func (p *OIDC) discover(ctx context.Context) (*oidc.Provider, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider != nil {
+		return p.provider, nil
+	}
+
+	provider, err := oidc.NewProvider(ctx, p.issuer)
+	if err != nil {
+		return nil, fmt.Errorf("OIDC - discover - oidc.NewProvider: %w", err)
+	}
+	p.provider = provider
+
+	return provider, nil
+}
@@ -0,0 +1,274 @@
+package oauth_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo/oauth"
+)
+
+const (
+	_clientID     = "client-id"
+	_clientSecret = "client-secret"
+	_redirectURL  = "http://gateway.test/api/auth/oauth/google/callback"
+)
+
+// authorization is a code the mock issuer handed out
+type authorization struct {
+	challenge string
+	nonce     string
+}
+
+// mockIssuer is a minimal OIDC provider: discovery, JWKS, an authorization
+// step without a user interface and a token endpoint that checks PKCE
+type mockIssuer struct {
+	*httptest.Server
+
+	key *entity.SigningKey
+
+	mu     sync.Mutex
+	codes  map[string]authorization
+	claims jwt.MapClaims // merged into the ID token claims
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+
+	key, err := entity.GenerateSigningKey(entity.AlgRS256, time.Now())
+	require.NoError(t, err)
+
+	m := &mockIssuer{key: key, codes: map[string]authorization{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"issuer":                                m.URL,
+			"authorization_endpoint":                m.URL + "/authorize",
+			"token_endpoint":                        m.URL + "/token",
+			"jwks_uri":                              m.URL + "/jwks",
+			"response_types_supported":              []string{"code"},
+			"subject_types_supported":               []string{"public"},
+			"id_token_signing_alg_values_supported": []string{entity.AlgRS256},
+			"code_challenge_methods_supported":      []string{"S256"},
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, entity.JWKS{Keys: []entity.JWK{key.JWK()}})
+	})
+	mux.HandleFunc("POST /token", m.token)
+
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+// authorize plays the user consenting at the authorization URL and returns the code of the callback
+func (m *mockIssuer) authorize(t *testing.T, authURL string) string {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, m.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+
+	q := u.Query()
+	require.Equal(t, _clientID, q.Get("client_id"))
+	require.Equal(t, _redirectURL, q.Get("redirect_uri"))
+	require.Equal(t, "code", q.Get("response_type"))
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+	require.Contains(t, q.Get("scope"), "openid")
+	require.NotEmpty(t, q.Get("state"))
+
+	code := uuid.NewString()
+	m.mu.Lock()
+	m.codes[code] = authorization{challenge: q.Get("code_challenge"), nonce: q.Get("nonce")}
+	m.mu.Unlock()
+
+	return code
+}
+
+func (m *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if id, secret, _ := r.BasicAuth(); id != _clientID || secret != _clientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	m.mu.Lock()
+	auth, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	claims := m.claims
+	m.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != auth.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	idClaims := jwt.MapClaims{
+		"iss":            m.URL,
+		"sub":            "110169484474386276334",
+		"aud":            _clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"nonce":          auth.nonce,
+		"email":          "jane@example.com",
+		"email_verified": true,
+		"name":           "Jane Doe",
+	}
+	for k, v := range claims {
+		idClaims[k] = v
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, idClaims)
+	token.Header["kid"] = m.key.ID
+	idToken, err := token.SignedString(m.key.Private)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": uuid.NewString(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestOIDC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		verifier func(verifier string) string // verifier sent with the exchange
+		nonce    func(nonce string) string    // nonce expected by the exchange
+		profile  *entity.ExternalProfile
+	}{
+		{
+			name: "verified email",
+			profile: &entity.ExternalProfile{
+				Subject:       "110169484474386276334",
+				Email:         "jane@example.com",
+				EmailVerified: true,
+				Name:          "Jane Doe",
+			},
+		},
+		{
+			name:   "unverified email",
+			claims: jwt.MapClaims{"email_verified": false},
+			profile: &entity.ExternalProfile{
+				Subject: "110169484474386276334",
+				Email:   "jane@example.com",
+				Name:    "Jane Doe",
+			},
+		},
+		{
+			name:     "wrong pkce verifier",
+			verifier: func(string) string { return oauth2.GenerateVerifier() },
+		},
+		{
+			name:  "nonce of another authorization",
+			nonce: func(string) string { return "another nonce" },
+		},
+		{
+			name:   "token for another client",
+			claims: jwt.MapClaims{"aud": "another-client"},
+		},
+		{
+			name:   "token of another issuer",
+			claims: jwt.MapClaims{"iss": "https://issuer.example.com"},
+		},
+		{
+			name:   "expired token",
+			claims: jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			issuer := newMockIssuer(t)
+			issuer.claims = tc.claims
+			p := oauth.NewOIDC(entity.ProviderGoogle, issuer.URL, _clientID, _clientSecret, _redirectURL)
+			ctx := context.Background()
+
+			verifier, nonce := oauth2.GenerateVerifier(), uuid.NewString()
+			authURL, err := p.AuthCodeURL(ctx, "state", nonce, verifier)
+			require.NoError(t, err)
+			code := issuer.authorize(t, authURL)
+
+			if tc.verifier != nil {
+				verifier = tc.verifier(verifier)
+			}
+			if tc.nonce != nil {
+				nonce = tc.nonce(nonce)
+			}
+
+			profile, err := p.Exchange(ctx, code, verifier, nonce)
+
+			if tc.profile == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.profile, profile)
+		})
+	}
+
+	t.Run("code works once", func(t *testing.T) {
+		t.Parallel()
+
+		issuer := newMockIssuer(t)
+		p := oauth.NewOIDC(entity.ProviderGoogle, issuer.URL, _clientID, _clientSecret, _redirectURL)
+		ctx := context.Background()
+
+		verifier, nonce := oauth2.GenerateVerifier(), uuid.NewString()
+		authURL, err := p.AuthCodeURL(ctx, "state", nonce, verifier)
+		require.NoError(t, err)
+		code := issuer.authorize(t, authURL)
+
+		_, err = p.Exchange(ctx, code, verifier, nonce)
+		require.NoError(t, err)
+		_, err = p.Exchange(ctx, code, verifier, nonce)
+		require.Error(t, err)
+	})
+
+	t.Run("issuer unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		issuer := newMockIssuer(t)
+		issuer.Close()
+		p := oauth.NewOIDC(entity.ProviderGoogle, issuer.URL, _clientID, _clientSecret, _redirectURL)
+
+		_, err := p.AuthCodeURL(context.Background(), "state", "nonce", oauth2.GenerateVerifier())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,166 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// _touchInterval is how stale last_used_at must be before a use updates it,
+// so that busy keys do not write on every request
+const _touchInterval = time.Minute
+
+// APIKeyRepository implements interface for personal API key storage
+type APIKeyRepository struct {
+	*postgres.Postgres
+}
+
+// NewAPIKeyPostgres creates a new instance of APIKeyPostgres
+func NewAPIKeyPostgres(pg *postgres.Postgres) *APIKeyRepository {
+	return &APIKeyRepository{pg}
+}
+
+// Create stores a new key
+func (r *APIKeyRepository) Create(ctx context.Context, key *entity.APIKey) error {
+	const query = `
+		INSERT INTO api_keys (id, user_id, name, prefix, key_hash, scopes, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.Pool.Exec(ctx, query,
+		key.ID,        // $1
+		key.UserID,    // $2
+		key.Name,      // $3
+		key.Prefix,    // $4
+		key.KeyHash,   // $5
+		key.Scopes,    // $6
+		key.CreatedAt, // $7
+		key.ExpiresAt, // $8
+	)
+	if err != nil {
+		return fmt.Errorf("APIKeyRepository - Create - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ListByUser retrieves the keys of the user that are not revoked, newest first
+func (r *APIKeyRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.APIKey, error) {
+	const query = `
+		SELECT id, user_id, name, prefix, key_hash, scopes, created_at, expires_at, last_used_at, revoked_at
+		FROM api_keys
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+	rows, err := r.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("APIKeyRepository - ListByUser - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]*entity.APIKey, 0, _defaultEntityCap)
+
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("APIKeyRepository - ListByUser - rows.Scan: %w", err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("APIKeyRepository - ListByUser - rows.Err: %w", err)
+	}
+
+	return keys, nil
+}
+
+// CountActive counts the keys of the user that are neither revoked nor expired
+func (r *APIKeyRepository) CountActive(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	const query = `
+		SELECT COUNT(*)
+		FROM api_keys
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2
+	`
+	var n int
+	if err := r.Pool.QueryRow(ctx, query, userID, at).Scan(&n); err != nil {
+		return 0, fmt.Errorf("APIKeyRepository - CountActive - r.Pool.QueryRow: %w", err)
+	}
+
+	return n, nil
+}
+
+// GetByHash retrieves a key by its hash
+func (r *APIKeyRepository) GetByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	const query = `
+		SELECT id, user_id, name, prefix, key_hash, scopes, created_at, expires_at, last_used_at, revoked_at
+		FROM api_keys
+		WHERE key_hash = $1
+	`
+	key, err := scanAPIKey(r.Pool.QueryRow(ctx, query, hash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("APIKeyRepository - GetByHash - r.Pool.QueryRow: %w", err)
+	}
+
+	return key, nil
+}
+
+// Touch records a use of the key, at most once a minute
+func (r *APIKeyRepository) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	const query = `
+		UPDATE api_keys
+		SET last_used_at = $1
+		WHERE id = $2 AND (last_used_at IS NULL OR last_used_at < $3)
+	`
+	if _, err := r.Pool.Exec(ctx, query, at, id, at.Add(-_touchInterval)); err != nil {
+		return fmt.Errorf("APIKeyRepository - Touch - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// Revoke revokes the user's key if it is not revoked yet
+func (r *APIKeyRepository) Revoke(ctx context.Context, userID, id uuid.UUID, at time.Time) (bool, error) {
+	const query = `
+		UPDATE api_keys
+		SET revoked_at = $1
+		WHERE id = $2 AND user_id = $3 AND revoked_at IS NULL
+	`
+	res, err := r.Pool.Exec(ctx, query, at, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("APIKeyRepository - Revoke - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// scanAPIKey scans a row of all api_keys columns
+func scanAPIKey(row pgx.Row) (*entity.APIKey, error) {
+	var key entity.APIKey
+	err := row.Scan(
+		&key.ID,
+		&key.UserID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Scopes,
+		&key.CreatedAt,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
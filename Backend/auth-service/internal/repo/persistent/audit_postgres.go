@@ -0,0 +1,162 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+)
+
+// AuditRepository implements interface for the security audit log
+type AuditRepository struct {
+	*postgres.Postgres
+}
+
+// NewAuditPostgres creates a new instance of AuditPostgres
+func NewAuditPostgres(pg *postgres.Postgres) *AuditRepository {
+	return &AuditRepository{pg}
+}
+
+// Record appends the event to the log
+func (r *AuditRepository) Record(ctx context.Context, event entity.AuditEvent) error {
+	const query = `
+		INSERT INTO audit_events (id, type, outcome, actor_id, target_id, ip, user_agent, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	details := event.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	_, err := r.Pool.Exec(ctx, query,
+		event.ID,                 // $1
+		event.Type,               // $2
+		event.Outcome,            // $3
+		nullUUID(event.ActorID),  // $4
+		nullUUID(event.TargetID), // $5
+		event.IP,                 // $6
+		event.UserAgent,          // $7
+		details,                  // $8
+		event.CreatedAt,          // $9
+	)
+	if err != nil {
+		return fmt.Errorf("AuditRepository - Record - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves the events matching the filter, newest first
+func (r *AuditRepository) List(ctx context.Context, filter entity.AuditFilter) ([]*entity.AuditEvent, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if filter.ActorID != uuid.Nil {
+		args = append(args, filter.ActorID)
+		conds = append(conds, fmt.Sprintf("actor_id = $%d", len(args)))
+	}
+	if filter.TargetID != uuid.Nil {
+		args = append(args, filter.TargetID)
+		conds = append(conds, fmt.Sprintf("target_id = $%d", len(args)))
+	}
+	if filter.Type != "" {
+		args = append(args, filter.Type)
+		conds = append(conds, fmt.Sprintf("type = $%d", len(args)))
+	}
+	if filter.Outcome != "" {
+		args = append(args, filter.Outcome)
+		conds = append(conds, fmt.Sprintf("outcome = $%d", len(args)))
+	}
+	if filter.IP != "" {
+		args = append(args, filter.IP)
+		conds = append(conds, fmt.Sprintf("ip = $%d", len(args)))
+	}
+	if !filter.Since.IsZero() {
+		args = append(args, filter.Since)
+		conds = append(conds, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if !filter.Until.IsZero() {
+		args = append(args, filter.Until)
+		conds = append(conds, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	if filter.After != nil {
+		args = append(args, filter.After.CreatedAt, filter.After.ID)
+		conds = append(conds, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`
+		SELECT id, type, outcome, actor_id, target_id, ip, user_agent, details, created_at
+		FROM audit_events
+		%s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d
+	`, where, len(args))
+
+	rows, err := r.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("AuditRepository - List - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*entity.AuditEvent, 0, filter.Limit)
+
+	for rows.Next() {
+		var (
+			e             entity.AuditEvent
+			actor, target uuid.NullUUID
+		)
+		err = rows.Scan(
+			&e.ID,
+			&e.Type,
+			&e.Outcome,
+			&actor,
+			&target,
+			&e.IP,
+			&e.UserAgent,
+			&e.Details,
+			&e.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("AuditRepository - List - rows.Scan: %w", err)
+		}
+		e.ActorID = actor.UUID
+		e.TargetID = target.UUID
+
+		events = append(events, &e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("AuditRepository - List - rows.Err: %w", err)
+	}
+
+	return events, nil
+}
+
+// Purge removes the events older than the given time, it is the only way
+// events leave the log
+func (r *AuditRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	const query = `DELETE FROM audit_events WHERE created_at < $1`
+
+	res, err := r.Pool.Exec(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("AuditRepository - Purge - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// nullUUID stores uuid.Nil as NULL
+func nullUUID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
@@ -0,0 +1,175 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const _deletionColumns = `id, user_id, status, pending, attempts, last_error, next_attempt_at, requested_at, completed_at`
+
+// DeletionRepository implements interface for account deletion tracking
+type DeletionRepository struct {
+	*postgres.Postgres
+}
+
+// NewDeletionPostgres creates a new instance of DeletionPostgres
+func NewDeletionPostgres(pg *postgres.Postgres) *DeletionRepository {
+	return &DeletionRepository{pg}
+}
+
+// Create stores a new deletion
+func (r *DeletionRepository) Create(ctx context.Context, d *entity.AccountDeletion) error {
+	const query = `
+		INSERT INTO account_deletions (` + _deletionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.Pool.Exec(ctx, query,
+		d.ID,            // $1
+		d.UserID,        // $2
+		d.Status,        // $3
+		d.Pending,       // $4
+		d.Attempts,      // $5
+		d.LastError,     // $6
+		d.NextAttemptAt, // $7
+		d.RequestedAt,   // $8
+		d.CompletedAt,   // $9
+	)
+	if err != nil {
+		return fmt.Errorf("DeletionRepository - Create - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves a deletion by its UUID
+func (r *DeletionRepository) Get(ctx context.Context, id uuid.UUID) (*entity.AccountDeletion, error) {
+	const query = `SELECT ` + _deletionColumns + ` FROM account_deletions WHERE id = $1`
+
+	d, err := scanDeletion(r.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("DeletionRepository - Get - r.Pool.QueryRow: %w", err)
+	}
+
+	return d, nil
+}
+
+// GetUnfinished retrieves the pending or failed deletion of the user
+func (r *DeletionRepository) GetUnfinished(ctx context.Context, userID uuid.UUID) (*entity.AccountDeletion, error) {
+	const query = `
+		SELECT ` + _deletionColumns + `
+		FROM account_deletions
+		WHERE user_id = $1 AND status <> 'completed'
+	`
+	d, err := scanDeletion(r.Pool.QueryRow(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("DeletionRepository - GetUnfinished - r.Pool.QueryRow: %w", err)
+	}
+
+	return d, nil
+}
+
+// Claim retrieves due pending deletions and postpones their next attempt by lease,
+// locked rows are skipped so that instances never claim the same deletion
+func (r *DeletionRepository) Claim(
+	ctx context.Context,
+	at time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*entity.AccountDeletion, error) {
+	const query = `
+		UPDATE account_deletions
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM account_deletions
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + _deletionColumns
+
+	rows, err := r.Pool.Query(ctx, query, at, at.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("DeletionRepository - Claim - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	deletions := make([]*entity.AccountDeletion, 0, _defaultEntityCap)
+
+	for rows.Next() {
+		d, err := scanDeletion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("DeletionRepository - Claim - rows.Scan: %w", err)
+		}
+
+		deletions = append(deletions, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("DeletionRepository - Claim - rows.Err: %w", err)
+	}
+
+	return deletions, nil
+}
+
+// Update saves the progress of the deletion
+func (r *DeletionRepository) Update(ctx context.Context, d *entity.AccountDeletion) error {
+	const query = `
+		UPDATE account_deletions
+		SET status = $1,
+			pending = $2,
+			attempts = $3,
+			last_error = $4,
+			next_attempt_at = $5,
+			completed_at = $6
+		WHERE id = $7
+	`
+	_, err := r.Pool.Exec(ctx, query,
+		d.Status,        // $1
+		d.Pending,       // $2
+		d.Attempts,      // $3
+		d.LastError,     // $4
+		d.NextAttemptAt, // $5
+		d.CompletedAt,   // $6
+		d.ID,            // $7
+	)
+	if err != nil {
+		return fmt.Errorf("DeletionRepository - Update - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// scanDeletion scans a row selected with _deletionColumns
+func scanDeletion(row pgx.Row) (*entity.AccountDeletion, error) {
+	var d entity.AccountDeletion
+	err := row.Scan(
+		&d.ID,
+		&d.UserID,
+		&d.Status,
+		&d.Pending,
+		&d.Attempts,
+		&d.LastError,
+		&d.NextAttemptAt,
+		&d.RequestedAt,
+		&d.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
@@ -0,0 +1,60 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+)
+
+// DenylistRepository implements interface for revoked access token storage
+type DenylistRepository struct {
+	*postgres.Postgres
+}
+
+// NewDenylistPostgres creates a new instance of DenylistPostgres
+func NewDenylistPostgres(pg *postgres.Postgres) *DenylistRepository {
+	return &DenylistRepository{pg}
+}
+
+// Add denies the token with the jti until expiresAt
+func (r *DenylistRepository) Add(ctx context.Context, jti uuid.UUID, expiresAt time.Time) error {
+	const query = `
+		INSERT INTO revoked_tokens (jti, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING
+	`
+	_, err := r.Pool.Exec(ctx, query, jti, expiresAt)
+	if err != nil {
+		return fmt.Errorf("DenylistRepository - Add - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// Contains reports whether the token with the jti is denied
+func (r *DenylistRepository) Contains(ctx context.Context, jti uuid.UUID) (bool, error) {
+	const query = `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)`
+
+	var denied bool
+	if err := r.Pool.QueryRow(ctx, query, jti).Scan(&denied); err != nil {
+		return false, fmt.Errorf("DenylistRepository - Contains - r.Pool.QueryRow: %w", err)
+	}
+
+	return denied, nil
+}
+
+// Purge deletes entries of tokens that have expired on their own,
+// Postgres has no TTL so it has to run periodically
+func (r *DenylistRepository) Purge(ctx context.Context) (int64, error) {
+	const query = `DELETE FROM revoked_tokens WHERE expires_at <= $1`
+
+	res, err := r.Pool.Exec(ctx, query, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("DenylistRepository - Purge - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected(), nil
+}
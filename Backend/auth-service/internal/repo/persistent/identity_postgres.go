@@ -0,0 +1,219 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const _insertIdentity = `
+	INSERT INTO external_identities (id, user_id, provider, subject, email, created_at, last_used_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+// IdentityRepository implements interface for external identity storage
+type IdentityRepository struct {
+	*postgres.Postgres
+}
+
+// NewIdentityPostgres creates a new instance of IdentityPostgres
+func NewIdentityPostgres(pg *postgres.Postgres) *IdentityRepository {
+	return &IdentityRepository{pg}
+}
+
+// Get retrieves the identity with the subject at the provider
+func (r *IdentityRepository) Get(ctx context.Context, provider, subject string) (*entity.ExternalIdentity, error) {
+	const query = `
+		SELECT id, user_id, provider, subject, email, created_at, last_used_at
+		FROM external_identities
+		WHERE provider = $1 AND subject = $2
+	`
+	var identity entity.ExternalIdentity
+	err := r.Pool.QueryRow(ctx, query, provider, subject).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Provider,
+		&identity.Subject,
+		&identity.Email,
+		&identity.CreatedAt,
+		&identity.LastUsedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("IdentityRepository - Get - r.Pool.QueryRow: %w", err)
+	}
+
+	return &identity, nil
+}
+
+// Create links the identity to its user
+func (r *IdentityRepository) Create(ctx context.Context, identity *entity.ExternalIdentity) error {
+	_, err := r.Pool.Exec(ctx, _insertIdentity, identityArgs(identity)...)
+	if err != nil {
+		return fmt.Errorf("IdentityRepository - Create - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// CreateWithUser stores a new user and links the identity to it in one transaction
+func (r *IdentityRepository) CreateWithUser(ctx context.Context, user *entity.User, identity *entity.ExternalIdentity) error {
+	return pgx.BeginFunc(ctx, r.Pool, func(tx pgx.Tx) error {
+		const insertUser = `
+			INSERT INTO users (id, email, password_hash, username, role, email_verified_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`
+		_, err := tx.Exec(ctx, insertUser,
+			user.ID,
+			user.Email,
+			user.PasswordHash,
+			user.Username,
+			user.Role,
+			user.VerifiedAt,
+			user.CreatedAt,
+			user.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("IdentityRepository - CreateWithUser - tx.Exec: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, _insertIdentity, identityArgs(identity)...); err != nil {
+			return fmt.Errorf("IdentityRepository - CreateWithUser - tx.Exec: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ListByUser retrieves the identities linked to the user, oldest first
+func (r *IdentityRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.ExternalIdentity, error) {
+	const query = `
+		SELECT id, user_id, provider, subject, email, created_at, last_used_at
+		FROM external_identities
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("IdentityRepository - ListByUser - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	identities := make([]*entity.ExternalIdentity, 0, _defaultEntityCap)
+
+	for rows.Next() {
+		var identity entity.ExternalIdentity
+		err = rows.Scan(
+			&identity.ID,
+			&identity.UserID,
+			&identity.Provider,
+			&identity.Subject,
+			&identity.Email,
+			&identity.CreatedAt,
+			&identity.LastUsedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("IdentityRepository - ListByUser - rows.Scan: %w", err)
+		}
+
+		identities = append(identities, &identity)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("IdentityRepository - ListByUser - rows.Err: %w", err)
+	}
+
+	return identities, nil
+}
+
+// Touch records a sign-in with the identity
+func (r *IdentityRepository) Touch(ctx context.Context, id uuid.UUID, email string, at time.Time) error {
+	const query = `UPDATE external_identities SET email = $1, last_used_at = $2 WHERE id = $3`
+
+	if _, err := r.Pool.Exec(ctx, query, email, at, id); err != nil {
+		return fmt.Errorf("IdentityRepository - Touch - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// CreateState stores a started authorization
+func (r *IdentityRepository) CreateState(ctx context.Context, state *entity.OAuthState) error {
+	const query = `
+		INSERT INTO oauth_states (id, provider, state_hash, code_verifier, nonce, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.Pool.Exec(ctx, query,
+		state.ID,
+		state.Provider,
+		state.StateHash,
+		state.CodeVerifier,
+		state.Nonce,
+		state.CreatedAt,
+		state.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("IdentityRepository - CreateState - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeState removes the unexpired authorization and returns it, so that a state works only once
+func (r *IdentityRepository) ConsumeState(ctx context.Context, hash, provider string, at time.Time) (*entity.OAuthState, error) {
+	const query = `
+		DELETE FROM oauth_states
+		WHERE state_hash = $1 AND provider = $2 AND expires_at > $3
+		RETURNING id, provider, state_hash, code_verifier, nonce, created_at, expires_at
+	`
+	var state entity.OAuthState
+	err := r.Pool.QueryRow(ctx, query, hash, provider, at).Scan(
+		&state.ID,
+		&state.Provider,
+		&state.StateHash,
+		&state.CodeVerifier,
+		&state.Nonce,
+		&state.CreatedAt,
+		&state.ExpiresAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("IdentityRepository - ConsumeState - r.Pool.QueryRow: %w", err)
+	}
+
+	return &state, nil
+}
+
+// PurgeStates removes expired authorizations whose callback never came
+func (r *IdentityRepository) PurgeStates(ctx context.Context) (int64, error) {
+	const query = `DELETE FROM oauth_states WHERE expires_at <= $1`
+
+	res, err := r.Pool.Exec(ctx, query, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("IdentityRepository - PurgeStates - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// identityArgs returns the arguments of _insertIdentity
+func identityArgs(identity *entity.ExternalIdentity) []any {
+	return []any{
+		identity.ID,
+		identity.UserID,
+		identity.Provider,
+		identity.Subject,
+		identity.Email,
+		identity.CreatedAt,
+		identity.LastUsedAt,
+	}
+}
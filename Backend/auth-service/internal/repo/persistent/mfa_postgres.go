@@ -0,0 +1,291 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// MFARepository implements interface for TOTP second factor storage
+type MFARepository struct {
+	*postgres.Postgres
+}
+
+// NewMFAPostgres creates a new instance of MFAPostgres
+func NewMFAPostgres(pg *postgres.Postgres) *MFARepository {
+	return &MFARepository{pg}
+}
+
+// Get retrieves the second factor of the user
+func (r *MFARepository) Get(ctx context.Context, userID uuid.UUID) (*entity.MFA, error) {
+	const query = `
+		SELECT user_id, secret, enabled_at, last_used_step, created_at
+		FROM user_mfa
+		WHERE user_id = $1
+	`
+	var mfa entity.MFA
+	err := r.Pool.QueryRow(ctx, query, userID).Scan(
+		&mfa.UserID,
+		&mfa.Secret,
+		&mfa.EnabledAt,
+		&mfa.LastUsedStep,
+		&mfa.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("MFARepository - Get - r.Pool.QueryRow: %w", err)
+	}
+
+	return &mfa, nil
+}
+
+// SavePending stores a pending enrollment unless MFA is already enabled
+func (r *MFARepository) SavePending(ctx context.Context, mfa *entity.MFA) (bool, error) {
+	const query = `
+		INSERT INTO user_mfa (user_id, secret, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, created_at = EXCLUDED.created_at
+		WHERE user_mfa.enabled_at IS NULL
+	`
+	res, err := r.Pool.Exec(ctx, query, mfa.UserID, mfa.Secret, mfa.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("MFARepository - SavePending - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// Enable confirms a pending enrollment and stores its recovery codes
+func (r *MFARepository) Enable(
+	ctx context.Context,
+	userID uuid.UUID,
+	step int64,
+	codeHashes []string,
+	at time.Time,
+) (bool, error) {
+	enabled := false
+	err := pgx.BeginFunc(ctx, r.Pool, func(tx pgx.Tx) error {
+		const enable = `
+			UPDATE user_mfa
+			SET enabled_at = $1, last_used_step = $2
+			WHERE user_id = $3 AND enabled_at IS NULL
+		`
+		res, err := tx.Exec(ctx, enable, at, step, userID)
+		if err != nil {
+			return fmt.Errorf("MFARepository - Enable - tx.Exec: %w", err)
+		}
+		if res.RowsAffected() == 0 {
+			return nil
+		}
+		enabled = true
+
+		return replaceRecoveryCodes(ctx, tx, userID, codeHashes)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return enabled, nil
+}
+
+// UseStep records the step of an accepted code if it is later than the last one
+func (r *MFARepository) UseStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	const query = `
+		UPDATE user_mfa
+		SET last_used_step = $1
+		WHERE user_id = $2 AND last_used_step < $1
+	`
+	res, err := r.Pool.Exec(ctx, query, step, userID)
+	if err != nil {
+		return false, fmt.Errorf("MFARepository - UseStep - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// UseRecoveryCode marks an unused recovery code as used
+func (r *MFARepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string, at time.Time) (bool, error) {
+	const query = `
+		UPDATE mfa_recovery_codes
+		SET used_at = $1
+		WHERE user_id = $2 AND code_hash = $3 AND used_at IS NULL
+	`
+	res, err := r.Pool.Exec(ctx, query, at, userID, hash)
+	if err != nil {
+		return false, fmt.Errorf("MFARepository - UseRecoveryCode - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// ReplaceRecoveryCodes replaces all recovery codes of the user
+func (r *MFARepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	return pgx.BeginFunc(ctx, r.Pool, func(tx pgx.Tx) error {
+		return replaceRecoveryCodes(ctx, tx, userID, codeHashes)
+	})
+}
+
+// Delete removes the second factor of the user, recovery codes are removed by cascade
+func (r *MFARepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	const query = `DELETE FROM user_mfa WHERE user_id = $1`
+
+	if _, err := r.Pool.Exec(ctx, query, userID); err != nil {
+		return fmt.Errorf("MFARepository - Delete - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// CreateChallenge stores a new sign-in challenge
+func (r *MFARepository) CreateChallenge(ctx context.Context, challenge *entity.MFAChallenge) error {
+	const query = `
+		INSERT INTO mfa_challenges (id, user_id, token_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.Pool.Exec(ctx, query,
+		challenge.ID,        // $1
+		challenge.UserID,    // $2
+		challenge.TokenHash, // $3
+		challenge.CreatedAt, // $4
+		challenge.ExpiresAt, // $5
+	)
+	if err != nil {
+		return fmt.Errorf("MFARepository - CreateChallenge - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// AttemptChallenge counts an attempt on a usable challenge and returns it
+func (r *MFARepository) AttemptChallenge(
+	ctx context.Context,
+	hash string,
+	at time.Time,
+	maxAttempts int,
+) (*entity.MFAChallenge, error) {
+	const query = `
+		UPDATE mfa_challenges
+		SET attempts = attempts + 1
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2 AND attempts < $3
+		RETURNING id, user_id, token_hash, attempts, created_at, expires_at, used_at
+	`
+	var challenge entity.MFAChallenge
+	err := r.Pool.QueryRow(ctx, query, hash, at, maxAttempts).Scan(
+		&challenge.ID,
+		&challenge.UserID,
+		&challenge.TokenHash,
+		&challenge.Attempts,
+		&challenge.CreatedAt,
+		&challenge.ExpiresAt,
+		&challenge.UsedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("MFARepository - AttemptChallenge - r.Pool.QueryRow: %w", err)
+	}
+
+	return &challenge, nil
+}
+
+// ConsumeChallenge marks an unused challenge as used
+func (r *MFARepository) ConsumeChallenge(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	const query = `
+		UPDATE mfa_challenges
+		SET used_at = $1
+		WHERE id = $2 AND used_at IS NULL
+	`
+	res, err := r.Pool.Exec(ctx, query, at, id)
+	if err != nil {
+		return false, fmt.Errorf("MFARepository - ConsumeChallenge - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// PurgeChallenges removes challenges that have expired
+func (r *MFARepository) PurgeChallenges(ctx context.Context) (int64, error) {
+	const query = `DELETE FROM mfa_challenges WHERE expires_at <= $1`
+
+	res, err := r.Pool.Exec(ctx, query, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("MFARepository - PurgeChallenges - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// RequiredRoles retrieves the roles whose users must have MFA enabled
+func (r *MFARepository) RequiredRoles(ctx context.Context) ([]entity.Role, error) {
+	const query = `SELECT role FROM mfa_role_policies WHERE required ORDER BY role`
+
+	rows, err := r.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("MFARepository - RequiredRoles - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	roles := make([]entity.Role, 0, _defaultEntityCap)
+
+	for rows.Next() {
+		var role entity.Role
+		if err = rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("MFARepository - RequiredRoles - rows.Scan: %w", err)
+		}
+		roles = append(roles, role)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("MFARepository - RequiredRoles - rows.Err: %w", err)
+	}
+
+	return roles, nil
+}
+
+// SetRoleRequired changes whether users of the role must have MFA enabled
+func (r *MFARepository) SetRoleRequired(
+	ctx context.Context,
+	role entity.Role,
+	required bool,
+	by uuid.UUID,
+	at time.Time,
+) error {
+	const query = `
+		INSERT INTO mfa_role_policies (role, required, updated_at, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (role) DO UPDATE
+		SET required = EXCLUDED.required, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by
+	`
+	if _, err := r.Pool.Exec(ctx, query, role, required, at, by); err != nil {
+		return fmt.Errorf("MFARepository - SetRoleRequired - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// replaceRecoveryCodes deletes the recovery codes of the user and inserts new ones in tx
+func replaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userID uuid.UUID, codeHashes []string) error {
+	const remove = `DELETE FROM mfa_recovery_codes WHERE user_id = $1`
+	if _, err := tx.Exec(ctx, remove, userID); err != nil {
+		return fmt.Errorf("replaceRecoveryCodes - tx.Exec: %w", err)
+	}
+
+	const insert = `
+		INSERT INTO mfa_recovery_codes (user_id, code_hash)
+		SELECT $1, unnest($2::text[])
+	`
+	if _, err := tx.Exec(ctx, insert, userID, codeHashes); err != nil {
+		return fmt.Errorf("replaceRecoveryCodes - tx.Exec: %w", err)
+	}
+
+	return nil
+}
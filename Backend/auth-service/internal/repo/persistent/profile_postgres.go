@@ -0,0 +1,186 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ProfileRepository implements interface for investment profile storage
+type ProfileRepository struct {
+	*postgres.Postgres
+}
+
+// NewProfilePostgres creates a new instance of ProfilePostgres
+func NewProfilePostgres(pg *postgres.Postgres) *ProfileRepository {
+	return &ProfileRepository{pg}
+}
+
+// Get retrieves the profile of the user
+func (r *ProfileRepository) Get(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	const query = `
+		SELECT user_id, risk_tolerance, risk_score, assessed_at, horizon, base_currency, markets, watchlist, locale, updated_at
+		FROM user_profiles
+		WHERE user_id = $1
+	`
+	var (
+		p         entity.Profile
+		tolerance *string
+		score     *int
+		horizon   *string
+	)
+	err := r.Pool.QueryRow(ctx, query, userID).Scan(
+		&p.UserID,
+		&tolerance,
+		&score,
+		&p.AssessedAt,
+		&horizon,
+		&p.BaseCurrency,
+		&p.Markets,
+		&p.Watchlist,
+		&p.Locale,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ProfileRepository - Get - r.Pool.QueryRow: %w", err)
+	}
+
+	if tolerance != nil {
+		p.RiskTolerance = entity.RiskTolerance(*tolerance)
+	}
+	if score != nil {
+		p.RiskScore = *score
+	}
+	if horizon != nil {
+		p.Horizon = entity.InvestmentHorizon(*horizon)
+	}
+
+	return &p, nil
+}
+
+// SavePreferences creates the profile or replaces its preferences
+func (r *ProfileRepository) SavePreferences(ctx context.Context, p *entity.Profile) error {
+	const query = `
+		INSERT INTO user_profiles (user_id, horizon, base_currency, markets, watchlist, locale, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id) DO UPDATE
+		SET horizon = EXCLUDED.horizon,
+			base_currency = EXCLUDED.base_currency,
+			markets = EXCLUDED.markets,
+			watchlist = EXCLUDED.watchlist,
+			locale = EXCLUDED.locale,
+			updated_at = EXCLUDED.updated_at
+	`
+	var horizon *string
+	if p.Horizon != "" {
+		h := string(p.Horizon)
+		horizon = &h
+	}
+
+	_, err := r.Pool.Exec(ctx, query,
+		p.UserID,       // $1
+		horizon,        // $2
+		p.BaseCurrency, // $3
+		p.Markets,      // $4
+		p.Watchlist,    // $5
+		p.Locale,       // $6
+		p.UpdatedAt,    // $7
+	)
+	if err != nil {
+		return fmt.Errorf("ProfileRepository - SavePreferences - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// SaveAssessment stores the assessment and makes it the risk tolerance of the profile
+func (r *ProfileRepository) SaveAssessment(ctx context.Context, a *entity.RiskAssessment) error {
+	return pgx.BeginFunc(ctx, r.Pool, func(tx pgx.Tx) error {
+		const insert = `
+			INSERT INTO risk_assessments (id, user_id, version, answers, score, tolerance, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`
+		_, err := tx.Exec(ctx, insert,
+			a.ID,        // $1
+			a.UserID,    // $2
+			a.Version,   // $3
+			a.Answers,   // $4
+			a.Score,     // $5
+			a.Tolerance, // $6
+			a.CreatedAt, // $7
+		)
+		if err != nil {
+			return fmt.Errorf("ProfileRepository - SaveAssessment - tx.Exec: %w", err)
+		}
+
+		// the other columns keep their defaults until preferences are saved
+		const upsert = `
+			INSERT INTO user_profiles (user_id, risk_tolerance, risk_score, assessed_at, updated_at)
+			VALUES ($1, $2, $3, $4, $4)
+			ON CONFLICT (user_id) DO UPDATE
+			SET risk_tolerance = EXCLUDED.risk_tolerance,
+				risk_score = EXCLUDED.risk_score,
+				assessed_at = EXCLUDED.assessed_at,
+				updated_at = EXCLUDED.updated_at
+		`
+		if _, err := tx.Exec(ctx, upsert, a.UserID, a.Tolerance, a.Score, a.CreatedAt); err != nil {
+			return fmt.Errorf("ProfileRepository - SaveAssessment - tx.Exec: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ListAssessments retrieves up to limit assessments of the user, newest first
+func (r *ProfileRepository) ListAssessments(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit int,
+) ([]*entity.RiskAssessment, error) {
+	const query = `
+		SELECT id, user_id, version, answers, score, tolerance, created_at
+		FROM risk_assessments
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	rows, err := r.Pool.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ProfileRepository - ListAssessments - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	assessments := make([]*entity.RiskAssessment, 0, _defaultEntityCap)
+
+	for rows.Next() {
+		var a entity.RiskAssessment
+		err = rows.Scan(
+			&a.ID,
+			&a.UserID,
+			&a.Version,
+			&a.Answers,
+			&a.Score,
+			&a.Tolerance,
+			&a.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("ProfileRepository - ListAssessments - rows.Scan: %w", err)
+		}
+
+		assessments = append(assessments, &a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ProfileRepository - ListAssessments - rows.Err: %w", err)
+	}
+
+	return assessments, nil
+}
@@ -0,0 +1,235 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// SessionRepository implements interface for refresh token storage
+type SessionRepository struct {
+	*postgres.Postgres
+}
+
+// NewSessionPostgres creates a new instance of SessionPostgres
+func NewSessionPostgres(pg *postgres.Postgres) *SessionRepository {
+	return &SessionRepository{pg}
+}
+
+const _insertSessionQuery = `
+	INSERT INTO sessions (id, family_id, user_id, token_hash, access_jti, created_at, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+// Create stores a newly issued refresh token
+func (r *SessionRepository) Create(ctx context.Context, s *entity.Session) error {
+	_, err := r.Pool.Exec(ctx, _insertSessionQuery,
+		s.ID,        // $1
+		s.FamilyID,  // $2
+		s.UserID,    // $3
+		s.TokenHash, // $4
+		s.AccessJTI, // $5
+		s.CreatedAt, // $6
+		s.ExpiresAt, // $7
+	)
+	if err != nil {
+		return fmt.Errorf("SessionRepository - Create - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetByTokenHash retrieves a refresh token by its hash
+func (r *SessionRepository) GetByTokenHash(ctx context.Context, hash string) (*entity.Session, error) {
+	const query = `
+		SELECT id, family_id, user_id, token_hash, access_jti, created_at, expires_at, rotated_at, revoked_at
+		FROM sessions
+		WHERE token_hash = $1
+	`
+	var s entity.Session
+	err := r.Pool.QueryRow(ctx, query, hash).Scan(
+		&s.ID,
+		&s.FamilyID,
+		&s.UserID,
+		&s.TokenHash,
+		&s.AccessJTI,
+		&s.CreatedAt,
+		&s.ExpiresAt,
+		&s.RotatedAt,
+		&s.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("SessionRepository - GetByTokenHash - r.Pool.QueryRow: %w", err)
+	}
+
+	return &s, nil
+}
+
+// Rotate marks the token as rotated and stores its successor. The update is
+// conditional, so of two concurrent rotations of the same token only one wins
+func (r *SessionRepository) Rotate(ctx context.Context, id uuid.UUID, next *entity.Session) (bool, error) {
+	const query = `
+		UPDATE sessions
+		SET rotated_at = $1
+		WHERE id = $2 AND rotated_at IS NULL AND revoked_at IS NULL
+	`
+	rotated := false
+	err := pgx.BeginFunc(ctx, r.Pool, func(tx pgx.Tx) error {
+		res, err := tx.Exec(ctx, query, next.CreatedAt, id)
+		if err != nil {
+			return fmt.Errorf("tx.Exec(UPDATE sessions): %w", err)
+		}
+		if res.RowsAffected() == 0 {
+			return nil
+		}
+
+		_, err = tx.Exec(ctx, _insertSessionQuery,
+			next.ID,        // $1
+			next.FamilyID,  // $2
+			next.UserID,    // $3
+			next.TokenHash, // $4
+			next.AccessJTI, // $5
+			next.CreatedAt, // $6
+			next.ExpiresAt, // $7
+		)
+		if err != nil {
+			return fmt.Errorf("tx.Exec(INSERT sessions): %w", err)
+		}
+
+		rotated = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("SessionRepository - Rotate - pgx.BeginFunc: %w", err)
+	}
+
+	return rotated, nil
+}
+
+// ListActive retrieves the current token of every active family of the user
+func (r *SessionRepository) ListActive(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	const query = `
+		SELECT s.id, s.family_id, s.user_id, s.access_jti, s.created_at, s.expires_at,
+			(SELECT MIN(f.created_at) FROM sessions f WHERE f.family_id = s.family_id) AS started_at
+		FROM sessions s
+		WHERE s.user_id = $1
+			AND s.rotated_at IS NULL
+			AND s.revoked_at IS NULL
+			AND s.expires_at > $2
+		ORDER BY started_at DESC
+	`
+	rows, err := r.Pool.Query(ctx, query, userID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("SessionRepository - ListActive - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := make([]*entity.Session, 0, _defaultEntityCap)
+
+	for rows.Next() {
+		var s entity.Session
+		err = rows.Scan(
+			&s.ID,
+			&s.FamilyID,
+			&s.UserID,
+			&s.AccessJTI,
+			&s.CreatedAt,
+			&s.ExpiresAt,
+			&s.StartedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("SessionRepository - ListActive - rows.Scan: %w", err)
+		}
+
+		sessions = append(sessions, &s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("SessionRepository - ListActive - rows.Err: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeFamily revokes all tokens of the user's family that are not revoked yet
+func (r *SessionRepository) RevokeFamily(ctx context.Context, userID, familyID uuid.UUID) ([]*entity.Session, error) {
+	const query = `
+		UPDATE sessions
+		SET revoked_at = $1
+		WHERE user_id = $2 AND family_id = $3 AND revoked_at IS NULL
+		RETURNING id, family_id, user_id, access_jti, created_at, expires_at, rotated_at, revoked_at
+	`
+	rows, err := r.Pool.Query(ctx, query, time.Now().UTC(), userID, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("SessionRepository - RevokeFamily - r.Pool.Query: %w", err)
+	}
+
+	revoked, err := scanRevoked(rows)
+	if err != nil {
+		return nil, fmt.Errorf("SessionRepository - RevokeFamily - %w", err)
+	}
+
+	return revoked, nil
+}
+
+// RevokeAll revokes all tokens of the user that are not revoked yet
+func (r *SessionRepository) RevokeAll(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	const query = `
+		UPDATE sessions
+		SET revoked_at = $1
+		WHERE user_id = $2 AND revoked_at IS NULL
+		RETURNING id, family_id, user_id, access_jti, created_at, expires_at, rotated_at, revoked_at
+	`
+	rows, err := r.Pool.Query(ctx, query, time.Now().UTC(), userID)
+	if err != nil {
+		return nil, fmt.Errorf("SessionRepository - RevokeAll - r.Pool.Query: %w", err)
+	}
+
+	revoked, err := scanRevoked(rows)
+	if err != nil {
+		return nil, fmt.Errorf("SessionRepository - RevokeAll - %w", err)
+	}
+
+	return revoked, nil
+}
+
+// scanRevoked collects the sessions returned by a revoking update and closes the rows
+func scanRevoked(rows pgx.Rows) ([]*entity.Session, error) {
+	defer rows.Close()
+
+	var revoked []*entity.Session
+
+	for rows.Next() {
+		var s entity.Session
+		err := rows.Scan(
+			&s.ID,
+			&s.FamilyID,
+			&s.UserID,
+			&s.AccessJTI,
+			&s.CreatedAt,
+			&s.ExpiresAt,
+			&s.RotatedAt,
+			&s.RevokedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		revoked = append(revoked, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,133 @@
+package persistent
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+)
+
+const _pemPrivateKey = "PRIVATE KEY"
+
+// SigningKeyRepository implements interface for token signing key storage
+type SigningKeyRepository struct {
+	*postgres.Postgres
+}
+
+// NewSigningKeyPostgres creates a new instance of SigningKeyPostgres
+func NewSigningKeyPostgres(pg *postgres.Postgres) *SigningKeyRepository {
+	return &SigningKeyRepository{pg}
+}
+
+// Create stores a newly generated key
+func (r *SigningKeyRepository) Create(ctx context.Context, key *entity.SigningKey) error {
+	der, err := x509.MarshalPKCS8PrivateKey(key.Private)
+	if err != nil {
+		return fmt.Errorf("SigningKeyRepository - Create - x509.MarshalPKCS8PrivateKey: %w", err)
+	}
+	encoded := pem.EncodeToMemory(&pem.Block{Type: _pemPrivateKey, Bytes: der})
+
+	const query = `
+		INSERT INTO signing_keys (kid, algorithm, private_key, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err = r.Pool.Exec(ctx, query,
+		key.ID,          // $1
+		key.Algorithm,   // $2
+		string(encoded), // $3
+		key.CreatedAt,   // $4
+	)
+	if err != nil {
+		return fmt.Errorf("SigningKeyRepository - Create - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves all stored keys, newest first
+func (r *SigningKeyRepository) List(ctx context.Context) ([]*entity.SigningKey, error) {
+	const query = `
+		SELECT kid, algorithm, private_key, created_at
+		FROM signing_keys
+		ORDER BY created_at DESC
+	`
+	rows, err := r.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("SigningKeyRepository - List - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]*entity.SigningKey, 0, _defaultEntityCap)
+
+	for rows.Next() {
+		var (
+			key     entity.SigningKey
+			encoded string
+		)
+		err = rows.Scan(
+			&key.ID,
+			&key.Algorithm,
+			&encoded,
+			&key.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("SigningKeyRepository - List - rows.Scan: %w", err)
+		}
+
+		key.Private, err = parsePrivateKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("SigningKeyRepository - List - parsePrivateKey(%s): %w", key.ID, err)
+		}
+
+		keys = append(keys, &key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("SigningKeyRepository - List - rows.Err: %w", err)
+	}
+
+	return keys, nil
+}
+
+// DeleteSuperseded removes keys that have a newer key created before the given time
+func (r *SigningKeyRepository) DeleteSuperseded(ctx context.Context, before time.Time) (int64, error) {
+	const query = `
+		DELETE FROM signing_keys k
+		WHERE EXISTS (
+			SELECT 1 FROM signing_keys n
+			WHERE n.created_at > k.created_at AND n.created_at < $1
+		)
+	`
+	res, err := r.Pool.Exec(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("SigningKeyRepository - DeleteSuperseded - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// parsePrivateKey decodes a PKCS #8 PEM private key
+func parsePrivateKey(encoded string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil || block.Type != _pemPrivateKey {
+		return nil, errors.New("no private key PEM block")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,107 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+)
+
+// ThrottleRepository implements interface for failed sign-in counter storage
+type ThrottleRepository struct {
+	*postgres.Postgres
+}
+
+// NewThrottlePostgres creates a new instance of ThrottlePostgres
+func NewThrottlePostgres(pg *postgres.Postgres) *ThrottleRepository {
+	return &ThrottleRepository{pg}
+}
+
+// LockedUntil retrieves the latest lock of the keys that lasts beyond now
+func (r *ThrottleRepository) LockedUntil(ctx context.Context, keys []entity.ThrottleKey, now time.Time) (time.Time, error) {
+	const query = `
+		SELECT COALESCE(MAX(locked_until), 'epoch')
+		FROM sign_in_throttles
+		WHERE key = ANY($1) AND locked_until > $2
+	`
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = string(key)
+	}
+
+	var until time.Time
+	if err := r.Pool.QueryRow(ctx, query, names, now).Scan(&until); err != nil {
+		return time.Time{}, fmt.Errorf("ThrottleRepository - LockedUntil - r.Pool.QueryRow: %w", err)
+	}
+	if !until.After(now) {
+		return time.Time{}, nil
+	}
+
+	return until, nil
+}
+
+// RecordFailure counts a failed sign-in for the key
+func (r *ThrottleRepository) RecordFailure(
+	ctx context.Context,
+	key entity.ThrottleKey,
+	now time.Time,
+	window time.Duration,
+) (int, error) {
+	const query = `
+		INSERT INTO sign_in_throttles (key, failures, last_failed_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE
+				WHEN sign_in_throttles.last_failed_at < $3 THEN 1
+				ELSE sign_in_throttles.failures + 1
+			END,
+			last_failed_at = EXCLUDED.last_failed_at
+		RETURNING failures
+	`
+	var failures int
+	if err := r.Pool.QueryRow(ctx, query, key, now, now.Add(-window)).Scan(&failures); err != nil {
+		return 0, fmt.Errorf("ThrottleRepository - RecordFailure - r.Pool.QueryRow: %w", err)
+	}
+
+	return failures, nil
+}
+
+// Lock rejects sign-ins for the key until the given time
+func (r *ThrottleRepository) Lock(ctx context.Context, key entity.ThrottleKey, until time.Time) error {
+	const query = `UPDATE sign_in_throttles SET locked_until = $1 WHERE key = $2`
+
+	if _, err := r.Pool.Exec(ctx, query, until, key); err != nil {
+		return fmt.Errorf("ThrottleRepository - Lock - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// Reset forgets the failures and the lock of the key
+func (r *ThrottleRepository) Reset(ctx context.Context, key entity.ThrottleKey) error {
+	const query = `DELETE FROM sign_in_throttles WHERE key = $1`
+
+	if _, err := r.Pool.Exec(ctx, query, key); err != nil {
+		return fmt.Errorf("ThrottleRepository - Reset - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// Purge removes counters that are neither locked nor recent enough to count anymore
+func (r *ThrottleRepository) Purge(ctx context.Context, window time.Duration) (int64, error) {
+	const query = `
+		DELETE FROM sign_in_throttles
+		WHERE last_failed_at < $1 AND (locked_until IS NULL OR locked_until <= $2)
+	`
+	now := time.Now().UTC()
+
+	res, err := r.Pool.Exec(ctx, query, now.Add(-window), now)
+	if err != nil {
+		return 0, fmt.Errorf("ThrottleRepository - Purge - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected(), nil
+}
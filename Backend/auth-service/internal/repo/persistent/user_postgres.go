@@ -3,6 +3,7 @@ package persistent
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
@@ -13,6 +14,8 @@ import (
 
 const (
 	_defaultEntityCap = 64
+
+	_userColumns = "id, email, password_hash, username, role, disabled_at, email_verified_at, created_at, updated_at"
 )
 
 // UserRepository implements interface for user data operations
@@ -31,13 +34,16 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) (uuid.UU
 	if user.ID == uuid.Nil {
 		user.ID = uuid.New()
 	}
+	if user.Role == "" {
+		user.Role = entity.RoleUser
+	}
 	now := time.Now().UTC()
 	user.CreatedAt = now
 	returnedID = user.ID
 
 	const query = `
-		INSERT INTO users (id, email, password_hash, username, is_admin, created_at, updated_at) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7) 
+		INSERT INTO users (id, email, password_hash, username, role, email_verified_at, created_at, updated_at) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
 		RETURNING id
 	`
 	err := r.Pool.QueryRow(ctx, query,
@@ -45,9 +51,10 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) (uuid.UU
 		user.Email,        // $2
 		user.PasswordHash, // $3
 		user.Username,     // $4
-		user.IsAdmin,      // $5
-		user.CreatedAt,    // $6
-		user.UpdatedAt,    // $7
+		user.Role,         // $5
+		user.VerifiedAt,   // $6
+		user.CreatedAt,    // $7
+		user.UpdatedAt,    // $8
 	).Scan(&returnedID)
 	if err != nil {
 		return returnedID, fmt.Errorf("UserRepository - Create - r.Pool.Exec: %w", err)
@@ -58,21 +65,12 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) (uuid.UU
 
 // GetByEmail retrieves a user by email
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
-	const query = `
-		SELECT id, email, password_hash, username, is_admin, created_at, updated_at
+	query := `
+		SELECT ` + _userColumns + `
 		FROM users
 		WHERE email = $1
 	`
-	var user entity.User
-	err := r.Pool.QueryRow(ctx, query, email).Scan(
-		&user.ID,
-		&user.Email,
-		&user.PasswordHash,
-		&user.Username,
-		&user.IsAdmin,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	user, err := scanUser(r.Pool.QueryRow(ctx, query, email))
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, nil
@@ -80,37 +78,31 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*entity.
 		return nil, fmt.Errorf("UserRepository - GetByEmail - r.Pool.QueryRow: %w", err)
 	}
 
-	return &user, nil
+	return user, nil
 }
 
 // GetByID retrieves a user by ID
 func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
-	const query = `
-		SELECT id, email, password_hash, username, is_admin, created_at, updated_at
+	query := `
+		SELECT ` + _userColumns + `
 		FROM users
 		WHERE id = $1
 	`
-	var user entity.User
-	err := r.Pool.QueryRow(ctx, query, id).Scan(
-		&user.ID,
-		&user.Email,
-		&user.PasswordHash,
-		&user.Username,
-		&user.IsAdmin,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	user, err := scanUser(r.Pool.QueryRow(ctx, query, id))
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("UserRepository - GetByID - r.Pool.QueryRow: %w", err)
 	}
 
-	return &user, nil
+	return user, nil
 }
 
 // List retrieves all users
 func (r *UserRepository) List(ctx context.Context) ([]*entity.User, error) {
-	const query = `
-		SELECT id, email, password_hash, username, is_admin, created_at, updated_at
+	query := `
+		SELECT ` + _userColumns + `
 		FROM users
 	`
 	rows, err := r.Pool.Query(ctx, query)
@@ -121,6 +113,70 @@ func (r *UserRepository) List(ctx context.Context) ([]*entity.User, error) {
 
 	users := make([]*entity.User, 0, _defaultEntityCap)
 
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("UserRepository - List - rows.Scan: %w", err)
+		}
+
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("UserRepository - List - rows.Err: %w", err)
+	}
+
+	return users, nil
+}
+
+// Search retrieves a page of users matching the filter, newest first,
+// along with the number of all matching users
+func (r *UserRepository) Search(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if filter.Query != "" {
+		args = append(args, "%"+escapeLike(filter.Query)+"%")
+		conds = append(conds, fmt.Sprintf("(email ILIKE $%d OR username ILIKE $%d)", len(args), len(args)))
+	}
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		conds = append(conds, fmt.Sprintf("role = $%d", len(args)))
+	}
+	if filter.Disabled != nil {
+		if *filter.Disabled {
+			conds = append(conds, "disabled_at IS NOT NULL")
+		} else {
+			conds = append(conds, "disabled_at IS NULL")
+		}
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(`
+		SELECT %s, count(*) OVER ()
+		FROM users
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, _userColumns, where, len(args)-1, len(args))
+
+	rows, err := r.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("UserRepository - Search - r.Pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		users = make([]*entity.User, 0, filter.Limit)
+		total int
+	)
+
 	for rows.Next() {
 		var user entity.User
 		err = rows.Scan(
@@ -128,22 +184,25 @@ func (r *UserRepository) List(ctx context.Context) ([]*entity.User, error) {
 			&user.Email,
 			&user.PasswordHash,
 			&user.Username,
-			&user.IsAdmin,
+			&user.Role,
+			&user.DisabledAt,
+			&user.VerifiedAt,
 			&user.CreatedAt,
 			&user.UpdatedAt,
+			&total,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("UserRepository - List - rows.Scan: %w", err)
+			return nil, 0, fmt.Errorf("UserRepository - Search - rows.Scan: %w", err)
 		}
 
 		users = append(users, &user)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("UserRepository - List - rows.Err: %w", err)
+		return nil, 0, fmt.Errorf("UserRepository - Search - rows.Err: %w", err)
 	}
 
-	return users, nil
+	return users, total, nil
 }
 
 // Update updates an existing user
@@ -153,17 +212,21 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 		SET email = $1,
 			password_hash = $2,
 			username = $3,
-			is_admin = $4,
-			updated_at = $5
-		WHERE id = $6
+			role = $4,
+			disabled_at = $5,
+			email_verified_at = $6,
+			updated_at = $7
+		WHERE id = $8
 	`
 	res, err := r.Pool.Exec(ctx, query,
 		user.Email,        // $1
 		user.PasswordHash, // $2
 		user.Username,     // $3
-		user.IsAdmin,      // $4
-		user.UpdatedAt,    // $5
-		user.ID,           // $6
+		user.Role,         // $4
+		user.DisabledAt,   // $5
+		user.VerifiedAt,   // $6
+		user.UpdatedAt,    // $7
+		user.ID,           // $8
 	)
 	if err != nil {
 		return fmt.Errorf("UserRepository - Update - r.Pool.Exec: %w", err)
@@ -185,3 +248,29 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// scanUser scans a row selected with _userColumns
+func scanUser(row pgx.Row) (*entity.User, error) {
+	var user entity.User
+	err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&user.Username,
+		&user.Role,
+		&user.DisabledAt,
+		&user.VerifiedAt,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
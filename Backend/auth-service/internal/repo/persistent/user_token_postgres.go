@@ -0,0 +1,108 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// UserTokenRepository implements interface for mailed user token storage
+type UserTokenRepository struct {
+	*postgres.Postgres
+}
+
+// NewUserTokenPostgres creates a new instance of UserTokenPostgres
+func NewUserTokenPostgres(pg *postgres.Postgres) *UserTokenRepository {
+	return &UserTokenRepository{pg}
+}
+
+// Issue stores a new token and marks the unused tokens of the user with the same purpose as used
+func (r *UserTokenRepository) Issue(ctx context.Context, token *entity.UserToken) error {
+	return pgx.BeginFunc(ctx, r.Pool, func(tx pgx.Tx) error {
+		const supersede = `
+			UPDATE user_tokens
+			SET used_at = $1
+			WHERE user_id = $2 AND purpose = $3 AND used_at IS NULL
+		`
+		if _, err := tx.Exec(ctx, supersede, token.CreatedAt, token.UserID, token.Purpose); err != nil {
+			return fmt.Errorf("UserTokenRepository - Issue - tx.Exec: %w", err)
+		}
+
+		const insert = `
+			INSERT INTO user_tokens (id, user_id, purpose, token_hash, created_at, expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`
+		_, err := tx.Exec(ctx, insert,
+			token.ID,        // $1
+			token.UserID,    // $2
+			token.Purpose,   // $3
+			token.TokenHash, // $4
+			token.CreatedAt, // $5
+			token.ExpiresAt, // $6
+		)
+		if err != nil {
+			return fmt.Errorf("UserTokenRepository - Issue - tx.Exec: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Consume marks an unused, unexpired token with the hash and purpose as used
+func (r *UserTokenRepository) Consume(ctx context.Context, hash string, purpose entity.TokenPurpose) (*entity.UserToken, error) {
+	const query = `
+		UPDATE user_tokens
+		SET used_at = $1
+		WHERE token_hash = $2 AND purpose = $3 AND used_at IS NULL AND expires_at > $1
+		RETURNING id, user_id, purpose, token_hash, created_at, expires_at, used_at
+	`
+	var token entity.UserToken
+	err := r.Pool.QueryRow(ctx, query, time.Now().UTC(), hash, purpose).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Purpose,
+		&token.TokenHash,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&token.UsedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("UserTokenRepository - Consume - r.Pool.QueryRow: %w", err)
+	}
+
+	return &token, nil
+}
+
+// Invalidate marks the unused tokens of the user with the purpose as used
+func (r *UserTokenRepository) Invalidate(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose) error {
+	const query = `
+		UPDATE user_tokens
+		SET used_at = $1
+		WHERE user_id = $2 AND purpose = $3 AND used_at IS NULL
+	`
+	if _, err := r.Pool.Exec(ctx, query, time.Now().UTC(), userID, purpose); err != nil {
+		return fmt.Errorf("UserTokenRepository - Invalidate - r.Pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// Purge removes tokens that have expired
+func (r *UserTokenRepository) Purge(ctx context.Context) (int64, error) {
+	const query = `DELETE FROM user_tokens WHERE expires_at <= $1`
+
+	res, err := r.Pool.Exec(ctx, query, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("UserTokenRepository - Purge - r.Pool.Exec: %w", err)
+	}
+
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,94 @@
+// Package userdata implements repo.UserDataService over the UserDataService
+// gRPC contract other services serve
+package userdata
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	userdatav1 "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/userdata/v1"
+)
+
+// _maxExportSize is the largest export a service may answer with, chat
+// histories easily exceed the 4 MiB default of gRPC
+const _maxExportSize = 64 << 20
+
+// Service implements repo.UserDataService for a service serving UserDataService
+type Service struct {
+	name    string
+	conn    *grpc.ClientConn
+	client  userdatav1.UserDataServiceClient
+	timeout time.Duration
+}
+
+// New dials the service at addr, the connection is established lazily
+func New(name, addr string, timeout time.Duration) (*Service, error) {
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(_maxExportSize)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("grpc.NewClient(%s): %w", addr, err)
+	}
+
+	return &Service{
+		name:    name,
+		conn:    conn,
+		client:  userdatav1.NewUserDataServiceClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+// Name returns the name of the service
+func (s *Service) Name() string {
+	return s.name
+}
+
+// Export retrieves the files the service exports for the user
+func (s *Service) Export(ctx context.Context, userID uuid.UUID) ([]entity.DataFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.ExportUserData(ctx, &userdatav1.ExportUserDataRequest{UserId: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("%s: s.client.ExportUserData(): %w", s.name, err)
+	}
+
+	files := make([]entity.DataFile, len(resp.GetFiles()))
+	for i, f := range resp.GetFiles() {
+		files[i] = entity.DataFile{
+			Name:        f.GetName(),
+			ContentType: f.GetContentType(),
+			Content:     f.GetContent(),
+		}
+	}
+
+	return files, nil
+}
+
+// Delete asks the service to delete the data of the user
+func (s *Service) Delete(ctx context.Context, userID, deletionID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, err := s.client.DeleteUserData(ctx, &userdatav1.DeleteUserDataRequest{
+		UserId:     userID.String(),
+		DeletionId: deletionID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: s.client.DeleteUserData(): %w", s.name, err)
+	}
+
+	return nil
+}
+
+// Close closes the underlying connection
+func (s *Service) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,292 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+const (
+	_mailedToken = "mailed-token"
+	_newPassword = "another horse battery"
+)
+
+var _linkRe = regexp.MustCompile(`https://app\.example/[a-z-]+\?token=\S+`)
+
+// mailedToken returns the token of the link in the mail
+func mailedToken(t *testing.T, mail entity.Mail) string {
+	t.Helper()
+
+	link := _linkRe.FindString(mail.Body)
+	require.NotEmpty(t, link, mail.Body)
+
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+
+	return u.Query().Get("token")
+}
+
+// expectConsume sets up the redemption of _mailedToken for the purpose by the user
+func (m *authMocks) expectConsume(user *entity.User, purpose entity.TokenPurpose) {
+	m.userTokens.EXPECT().Consume(gomock.Any(), sha256Hex(_mailedToken), purpose).
+		Return(&entity.UserToken{UserID: user.ID, Purpose: purpose}, nil)
+	m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+}
+
+func TestSignUpMailFails(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+
+	m.users.EXPECT().GetByEmail(gomock.Any(), "jane@example.com").Return(nil, nil)
+	m.users.EXPECT().Create(gomock.Any(), gomock.Any()).Return(uuid.New(), nil)
+	m.userTokens.EXPECT().Issue(gomock.Any(), gomock.Any()).Return(nil)
+	m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("smtp: connection refused"))
+	m.expectSession(t)
+
+	// the user is created by then, the sign-up succeeds and the mail can be resent
+	pair, err := useCase.SignUp(context.Background(), "jane@example.com", _password, "jane")
+	require.NoError(t, err)
+	require.NotEmpty(t, pair.AccessToken)
+	require.Len(t, m.eventsOf(entity.AuditSignUp), 1)
+
+	require.Len(t, m.logger.errors, 1)
+	require.Contains(t, m.logger.errors[0], "connection refused")
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unverified", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+
+		m.expectConsume(user, entity.PurposeEmailVerification)
+		m.users.EXPECT().Update(gomock.Any(), user).DoAndReturn(func(_ context.Context, u *entity.User) error {
+			require.Equal(t, _now, *u.VerifiedAt)
+			return nil
+		})
+
+		require.NoError(t, useCase.VerifyEmail(context.Background(), _mailedToken))
+		require.Len(t, m.eventsOf(entity.AuditEmailVerified), 1)
+	})
+
+	t.Run("already verified", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+		verifiedAt := _now.Add(-time.Hour)
+		user.VerifiedAt = &verifiedAt
+
+		m.expectConsume(user, entity.PurposeEmailVerification)
+
+		require.NoError(t, useCase.VerifyEmail(context.Background(), _mailedToken))
+		require.Equal(t, verifiedAt, *user.VerifiedAt)
+		require.Empty(t, m.events)
+	})
+
+	t.Run("used or expired", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		m.userTokens.EXPECT().Consume(gomock.Any(), gomock.Any(), entity.PurposeEmailVerification).Return(nil, nil)
+
+		err := useCase.VerifyEmail(context.Background(), _mailedToken)
+		require.ErrorIs(t, err, usecase.ErrInvalidToken)
+	})
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+
+	var (
+		issued *entity.UserToken
+		mail   entity.Mail
+	)
+	m.users.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(user, nil)
+	m.userTokens.EXPECT().Issue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tok *entity.UserToken) error {
+		issued = tok
+		return nil
+	})
+	m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, msg entity.Mail) error {
+		mail = msg
+		return nil
+	})
+
+	require.NoError(t, useCase.RequestPasswordReset(context.Background(), user.Email))
+
+	// the mail carries the token, only its hash is stored
+	require.Equal(t, user.Email, mail.To)
+	token := mailedToken(t, mail)
+	require.Equal(t, sha256Hex(token), issued.TokenHash)
+	require.Equal(t, entity.PurposePasswordReset, issued.Purpose)
+	require.Equal(t, user.ID, issued.UserID)
+	require.Equal(t, _now.Add(time.Hour), issued.ExpiresAt)
+	require.Len(t, m.eventsOf(entity.AuditPasswordResetRequested), 1)
+}
+
+func TestRequestPasswordResetUnknownEmail(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+
+	// answered like a known email, nothing is mailed
+	m.users.EXPECT().GetByEmail(gomock.Any(), "nobody@example.com").Return(nil, nil)
+
+	require.NoError(t, useCase.RequestPasswordReset(context.Background(), "nobody@example.com"))
+	require.Empty(t, m.events)
+}
+
+func TestResetPassword(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+
+	session := refreshSession(user.ID)
+	m.expectConsume(user, entity.PurposePasswordReset)
+	m.users.EXPECT().Update(gomock.Any(), user).Return(nil)
+	m.sessions.EXPECT().RevokeAll(gomock.Any(), user.ID).Return([]*entity.Session{session}, nil)
+	m.denylist.EXPECT().Add(gomock.Any(), session.AccessJTI, gomock.Any()).Return(nil)
+
+	require.NoError(t, useCase.ResetPassword(context.Background(), _mailedToken, _newPassword))
+
+	// receiving the mail proves the email
+	require.True(t, user.CheckPassword(_newPassword))
+	require.False(t, user.CheckPassword(_password))
+	require.Equal(t, _now, *user.VerifiedAt)
+	require.Len(t, m.eventsOf(entity.AuditPasswordReset), 1)
+}
+
+func TestResetPasswordRejected(t *testing.T) {
+	t.Parallel()
+
+	t.Run("weak password", func(t *testing.T) {
+		t.Parallel()
+
+		// the token is not used up by a rejected password
+		useCase, _ := authUseCase(t)
+
+		err := useCase.ResetPassword(context.Background(), _mailedToken, "short")
+		require.ErrorIs(t, err, usecase.ErrWeakPassword)
+	})
+
+	t.Run("disabled user", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+		disabledAt := _now.Add(-time.Hour)
+		user.DisabledAt = &disabledAt
+		m.expectConsume(user, entity.PurposePasswordReset)
+
+		err := useCase.ResetPassword(context.Background(), _mailedToken, _newPassword)
+		require.ErrorIs(t, err, usecase.ErrUserDisabled)
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+
+	current := refreshSession(user.ID)
+	other := refreshSession(user.ID)
+	claims := &entity.AccessClaims{UserID: user.ID, SessionID: current.FamilyID}
+
+	m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	m.users.EXPECT().Update(gomock.Any(), user).Return(nil)
+	m.userTokens.EXPECT().Invalidate(gomock.Any(), user.ID, entity.PurposePasswordReset).Return(nil)
+	m.sessions.EXPECT().ListActive(gomock.Any(), user.ID).Return([]*entity.Session{current, other}, nil)
+	m.sessions.EXPECT().RevokeFamily(gomock.Any(), user.ID, other.FamilyID).Return([]*entity.Session{other}, nil)
+	m.denylist.EXPECT().Add(gomock.Any(), other.AccessJTI, gomock.Any()).Return(nil)
+
+	// the session changing the password stays signed in
+	require.NoError(t, useCase.ChangePassword(context.Background(), claims, _password, _newPassword))
+	require.True(t, user.CheckPassword(_newPassword))
+
+	events := m.eventsOf(entity.AuditPasswordChanged)
+	require.Len(t, events, 1)
+	require.Equal(t, entity.AuditSuccess, events[0].Outcome)
+}
+
+func TestChangePasswordRejected(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong current password", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		err := useCase.ChangePassword(context.Background(), &entity.AccessClaims{UserID: user.ID}, "guess", _newPassword)
+		require.ErrorIs(t, err, usecase.ErrInvalidPassword)
+		require.True(t, user.CheckPassword(_password))
+
+		events := m.eventsOf(entity.AuditPasswordChanged)
+		require.Len(t, events, 1)
+		require.Equal(t, entity.AuditFailure, events[0].Outcome)
+	})
+
+	t.Run("impersonated", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, _ := authUseCase(t)
+
+		claims := &entity.AccessClaims{UserID: uuid.New(), ActorID: uuid.New()}
+		err := useCase.ChangePassword(context.Background(), claims, _password, _newPassword)
+		require.ErrorIs(t, err, usecase.ErrImpersonated)
+	})
+
+	t.Run("weak password", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, _ := authUseCase(t)
+
+		err := useCase.ChangePassword(context.Background(), &entity.AccessClaims{UserID: uuid.New()}, _password, "short")
+		require.ErrorIs(t, err, usecase.ErrWeakPassword)
+	})
+}
+
+func TestValidateTokenWithheldPermissions(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	key, err := entity.GenerateSigningKey("EdDSA", _now.Add(-time.Hour))
+	require.NoError(t, err)
+	m.keys.EXPECT().Key(key.ID).Return(key)
+
+	// an admin signed in before enrolling in MFA is granted nothing by the token
+	user := testUser(t, entity.RoleAdmin)
+	jti := uuid.New()
+	token := signAccess(t, key, user.ID, jti, jwt.MapClaims{"role": nil, "perms": []string{}})
+
+	m.denylist.EXPECT().Contains(gomock.Any(), jti).Return(false, nil)
+	m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+	// nor by role, although the user is an admin
+	got, claims, err := useCase.ValidateToken(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, user, got)
+	require.Empty(t, claims.Permissions)
+	require.Empty(t, claims.Role)
+}
@@ -0,0 +1,341 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	claims := func() *entity.AccessClaims {
+		return &entity.AccessClaims{
+			UserID:      userID,
+			Role:        entity.RoleAnalyst,
+			Permissions: entity.RoleAnalyst.Permissions(),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		claims func() *entity.AccessClaims
+		key    string
+		scopes []string
+		stored []string // scopes of the stored key
+		ttl    time.Duration
+		active int
+		err    error
+	}{
+		{
+			name:   "default lifetime",
+			key:    "ci",
+			scopes: []string{authz.PermTweetsRead},
+			stored: []string{authz.PermTweetsRead},
+		},
+		{
+			name:   "own lifetime and duplicate scopes",
+			key:    " exports ",
+			scopes: []string{authz.PermTweetsRead, authz.PermTweetsExport, authz.PermTweetsRead},
+			stored: []string{authz.PermTweetsExport, authz.PermTweetsRead},
+			ttl:    24 * time.Hour,
+		},
+		{
+			name:   "scope the token lacks",
+			key:    "ci",
+			scopes: []string{authz.PermTweetsRead, authz.PermUsersWrite},
+			err:    usecase.ErrInvalidScope,
+		},
+		{
+			name: "without scopes",
+			key:  "ci",
+			err:  usecase.ErrInvalidScope,
+		},
+		{
+			name:   "blank name",
+			key:    "  ",
+			scopes: []string{authz.PermTweetsRead},
+			err:    usecase.ErrInvalidAPIKeyName,
+		},
+		{
+			name:   "name too long",
+			key:    strings.Repeat("k", 65),
+			scopes: []string{authz.PermTweetsRead},
+			err:    usecase.ErrInvalidAPIKeyName,
+		},
+		{
+			name:   "lifetime too long",
+			key:    "ci",
+			scopes: []string{authz.PermTweetsRead},
+			ttl:    366 * 24 * time.Hour,
+			err:    usecase.ErrInvalidAPIKeyTTL,
+		},
+		{
+			name:   "too many keys",
+			key:    "ci",
+			scopes: []string{authz.PermTweetsRead},
+			active: 3,
+			err:    usecase.ErrAPIKeyLimit,
+		},
+		{
+			name: "impersonation token",
+			claims: func() *entity.AccessClaims {
+				c := claims()
+				c.ActorID = uuid.New()
+				return c
+			},
+			key:    "ci",
+			scopes: []string{authz.PermTweetsRead},
+			err:    usecase.ErrImpersonated,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, m := authUseCase(t)
+
+			var stored *entity.APIKey
+			validated := tc.err == nil || tc.err == usecase.ErrAPIKeyLimit
+			if validated {
+				m.apiKeys.EXPECT().CountActive(gomock.Any(), userID, _now).Return(tc.active, nil)
+			}
+			if tc.err == nil {
+				m.apiKeys.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, key *entity.APIKey) error {
+						stored = key
+						return nil
+					})
+			}
+
+			c := claims()
+			if tc.claims != nil {
+				c = tc.claims()
+			}
+
+			created, err := uc.CreateAPIKey(context.Background(), c, tc.key, tc.scopes, tc.ttl)
+
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			ttl := tc.ttl
+			if ttl == 0 {
+				ttl = 90 * 24 * time.Hour
+			}
+			require.Same(t, stored, created.APIKey)
+			require.Equal(t, strings.TrimSpace(tc.key), stored.Name)
+			require.Equal(t, userID, stored.UserID)
+			require.Equal(t, _now.Add(ttl), stored.ExpiresAt)
+			require.Equal(t, tc.stored, stored.Scopes)
+
+			require.True(t, strings.HasPrefix(created.Key, entity.APIKeyPrefix))
+			require.True(t, strings.HasPrefix(created.Key, stored.Prefix))
+			require.Len(t, stored.Prefix, len(entity.APIKeyPrefix)+8)
+			require.Equal(t, sha256Hex(created.Key), stored.KeyHash)
+			require.Len(t, m.eventsOf(entity.AuditAPIKeyCreated), 1)
+		})
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	const secret = entity.APIKeyPrefix + "c2VjcmV0LWtleS1vZi10aGUtdGVzdC11c2VyLTEyMzQ"
+
+	activeKey := func(user *entity.User, scopes ...string) *entity.APIKey {
+		return &entity.APIKey{
+			ID:        uuid.New(),
+			UserID:    user.ID,
+			KeyHash:   sha256Hex(secret),
+			Scopes:    scopes,
+			CreatedAt: _now.Add(-time.Hour),
+			ExpiresAt: _now.Add(time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name  string
+		mock  func(t *testing.T, m *authMocks) *entity.APIKey
+		perms []string
+		err   error
+	}{
+		{
+			name: "valid key",
+			mock: func(t *testing.T, m *authMocks) *entity.APIKey {
+				user := testUser(t, entity.RoleAnalyst)
+				key := activeKey(user, authz.PermTweetsExport)
+
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), sha256Hex(secret)).Return(key, nil)
+				m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+				m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return(nil, nil)
+				m.apiKeys.EXPECT().Touch(gomock.Any(), key.ID, _now).Return(nil)
+				return key
+			},
+			perms: []string{authz.PermTweetsExport},
+		},
+		{
+			name: "scopes the role lost are dropped",
+			mock: func(t *testing.T, m *authMocks) *entity.APIKey {
+				user := testUser(t, entity.RoleUser)
+				key := activeKey(user, authz.PermTweetsRead, authz.PermTweetsExport)
+
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), sha256Hex(secret)).Return(key, nil)
+				m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+				m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return(nil, nil)
+				m.apiKeys.EXPECT().Touch(gomock.Any(), key.ID, _now).Return(nil)
+				return key
+			},
+			perms: []string{authz.PermTweetsRead},
+		},
+		{
+			name: "admin key scoped to reading",
+			mock: func(t *testing.T, m *authMocks) *entity.APIKey {
+				user := testUser(t, entity.RoleAdmin)
+				key := activeKey(user, authz.PermTweetsRead)
+
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), sha256Hex(secret)).Return(key, nil)
+				m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+				m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return(nil, nil)
+				m.apiKeys.EXPECT().Touch(gomock.Any(), key.ID, _now).Return(nil)
+				return key
+			},
+			perms: []string{authz.PermTweetsRead},
+		},
+		{
+			name: "role requires mfa the user has not enabled",
+			mock: func(t *testing.T, m *authMocks) *entity.APIKey {
+				user := testUser(t, entity.RoleAnalyst)
+				key := activeKey(user, authz.PermTweetsRead)
+
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), sha256Hex(secret)).Return(key, nil)
+				m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+				m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return([]entity.Role{entity.RoleAnalyst}, nil)
+				m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+				m.apiKeys.EXPECT().Touch(gomock.Any(), key.ID, _now).Return(nil)
+				return key
+			},
+			perms: []string{},
+		},
+		{
+			name: "unknown key",
+			mock: func(_ *testing.T, m *authMocks) *entity.APIKey {
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), sha256Hex(secret)).Return(nil, nil)
+				return nil
+			},
+			err: usecase.ErrInvalidToken,
+		},
+		{
+			name: "expired key",
+			mock: func(t *testing.T, m *authMocks) *entity.APIKey {
+				key := activeKey(testUser(t, entity.RoleUser), authz.PermTweetsRead)
+				key.ExpiresAt = _now
+
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), sha256Hex(secret)).Return(key, nil)
+				return nil
+			},
+			err: usecase.ErrInvalidToken,
+		},
+		{
+			name: "revoked key",
+			mock: func(t *testing.T, m *authMocks) *entity.APIKey {
+				key := activeKey(testUser(t, entity.RoleUser), authz.PermTweetsRead)
+				revokedAt := _now.Add(-time.Minute)
+				key.RevokedAt = &revokedAt
+
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), sha256Hex(secret)).Return(key, nil)
+				return nil
+			},
+			err: usecase.ErrInvalidToken,
+		},
+		{
+			name: "disabled user",
+			mock: func(t *testing.T, m *authMocks) *entity.APIKey {
+				user := testUser(t, entity.RoleUser)
+				user.DisabledAt = &_now
+				key := activeKey(user, authz.PermTweetsRead)
+
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), sha256Hex(secret)).Return(key, nil)
+				m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+				return nil
+			},
+			err: usecase.ErrUserDisabled,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, m := authUseCase(t)
+			key := tc.mock(t, m)
+
+			claims, err := uc.AuthenticateAPIKey(context.Background(), secret)
+
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.Equal(t, key.ID, claims.KeyID)
+			require.Equal(t, key.UserID, claims.User.ID)
+			require.Equal(t, tc.perms, claims.Permissions)
+		})
+	}
+
+	t.Run("not an api key", func(t *testing.T) {
+		t.Parallel()
+
+		uc, _ := authUseCase(t)
+
+		_, err := uc.AuthenticateAPIKey(context.Background(), "eyJhbGciOiJFZERTQSJ9.e30.sig")
+
+		require.ErrorIs(t, err, usecase.ErrInvalidToken)
+	})
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("revokes the key", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		claims := &entity.AccessClaims{UserID: uuid.New()}
+		keyID := uuid.New()
+
+		m.apiKeys.EXPECT().Revoke(gomock.Any(), claims.UserID, keyID, _now).Return(true, nil)
+
+		require.NoError(t, uc.RevokeAPIKey(context.Background(), claims, keyID))
+
+		events := m.eventsOf(entity.AuditAPIKeyRevoked)
+		require.Len(t, events, 1)
+		require.Equal(t, claims.UserID, events[0].ActorID)
+		require.Equal(t, keyID.String(), events[0].Details["key_id"])
+	})
+
+	t.Run("unknown or revoked key", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		claims := &entity.AccessClaims{UserID: uuid.New()}
+		keyID := uuid.New()
+
+		m.apiKeys.EXPECT().Revoke(gomock.Any(), claims.UserID, keyID, _now).Return(false, nil)
+
+		err := uc.RevokeAPIKey(context.Background(), claims, keyID)
+
+		require.ErrorIs(t, err, usecase.ErrAPIKeyNotFound)
+	})
+}
@@ -0,0 +1,188 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase/auth"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	events := func(n int) []*entity.AuditEvent {
+		events := make([]*entity.AuditEvent, n)
+		for i := range events {
+			events[i] = &entity.AuditEvent{
+				ID:        uuid.New(),
+				Type:      entity.AuditSignIn,
+				CreatedAt: _now.Add(-time.Duration(i) * time.Minute),
+			}
+		}
+		return events
+	}
+
+	tests := []struct {
+		name   string
+		stored int // events the log returns for the page
+		page   int
+		next   bool
+	}{
+		{name: "last page", stored: 2, page: 2},
+		{name: "exactly a page", stored: 3, page: 3},
+		{name: "more pages", stored: 4, page: 3, next: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, m := authUseCase(t)
+			stored := events(tc.stored)
+			after := &entity.AuditCursor{CreatedAt: _now, ID: uuid.New()}
+
+			m.audit.EXPECT().List(gomock.Any(), entity.AuditFilter{
+				Type:  entity.AuditSignIn,
+				Limit: 4,
+				After: after,
+			}).Return(stored, nil)
+
+			page, next, err := uc.ListAuditEvents(context.Background(), entity.AuditFilter{
+				Type:  entity.AuditSignIn,
+				Limit: 3,
+				After: after,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, stored[:tc.page], page)
+			if !tc.next {
+				require.Nil(t, next)
+				return
+			}
+			require.Equal(t, page[len(page)-1].Cursor(), next)
+		})
+	}
+}
+
+func TestAuditCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := &entity.AuditCursor{CreatedAt: _now.Add(123 * time.Nanosecond), ID: uuid.New()}
+
+	parsed, err := entity.ParseAuditCursor(cursor.String())
+
+	require.NoError(t, err)
+	require.Equal(t, cursor, parsed)
+
+	for _, s := range []string{"", "bm90LWEtY3Vyc29y", "MTIzX25vdC1hLXV1aWQ"} {
+		_, err := entity.ParseAuditCursor(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestAuthenticateRecordsRejectedToken(t *testing.T) {
+	t.Parallel()
+
+	uc, m := authUseCase(t)
+
+	_, err := uc.Authenticate(clientContext(), "not-a-token")
+
+	require.ErrorIs(t, err, usecase.ErrInvalidToken)
+
+	events := m.eventsOf(entity.AuditTokenRejected)
+	require.Len(t, events, 1)
+	require.Equal(t, entity.AuditFailure, events[0].Outcome)
+	require.Equal(t, "access_token", events[0].Details["kind"])
+	require.Equal(t, _client.IP, events[0].IP)
+	require.Equal(t, _client.UserAgent, events[0].UserAgent)
+}
+
+func TestAuditFailsOnAuthentication(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, uc *auth.UseCase, m *authMocks) error
+		err  error
+	}{
+		{
+			name: "rejected access token",
+			run: func(_ *testing.T, uc *auth.UseCase, _ *authMocks) error {
+				_, err := uc.Authenticate(clientContext(), "not-a-token")
+				return err
+			},
+			err: usecase.ErrInvalidToken,
+		},
+		{
+			name: "rejected refresh token",
+			run: func(_ *testing.T, uc *auth.UseCase, m *authMocks) error {
+				m.sessions.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(nil, nil)
+				_, err := uc.Refresh(clientContext(), "unknown")
+				return err
+			},
+			err: usecase.ErrInvalidToken,
+		},
+		{
+			name: "unknown api key",
+			run: func(_ *testing.T, uc *auth.UseCase, m *authMocks) error {
+				m.apiKeys.EXPECT().GetByHash(gomock.Any(), gomock.Any()).Return(nil, nil)
+				_, err := uc.AuthenticateAPIKey(clientContext(), entity.APIKeyPrefix+"unknown")
+				return err
+			},
+			err: usecase.ErrInvalidToken,
+		},
+		{
+			name: "sign-in",
+			run: func(t *testing.T, uc *auth.UseCase, m *authMocks) error {
+				user := testUser(t, entity.RoleUser)
+				m.expectPasswordChecked(user)
+				m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+				m.expectSession(t)
+
+				pair, _, err := uc.SignIn(clientContext(), user.Email, _password)
+				if err == nil {
+					require.NotEmpty(t, pair.AccessToken)
+				}
+				return err
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// the outcome is the one of a stored event, the event is logged
+			uc, m := authUseCase(t)
+			m.auditErr = errors.New("audit_events: connection refused")
+
+			require.ErrorIs(t, tc.run(t, uc, m), tc.err)
+			require.Len(t, m.logger.errors, 1)
+			require.Contains(t, m.logger.errors[0], "connection refused")
+		})
+	}
+}
+
+func TestAuditFailsOnAdminChange(t *testing.T) {
+	t.Parallel()
+
+	uc, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+	m.auditErr = errors.New("audit_events: connection refused")
+
+	m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	m.throttle.EXPECT().Reset(gomock.Any(), entity.AccountThrottleKey(user.Email)).Return(nil)
+
+	// changes by staff fail closed, they must not go unaudited
+	err := uc.UnlockUser(clientContext(), uuid.New(), user.ID)
+	require.ErrorContains(t, err, "connection refused")
+	require.Empty(t, m.logger.errors)
+}
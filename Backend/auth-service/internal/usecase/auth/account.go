@@ -0,0 +1,324 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+// UnverifiedPolicy is how users who have not verified their email are treated
+type UnverifiedPolicy string
+
+// Policies for unverified users
+const (
+	// UnverifiedAllow treats unverified users like verified ones
+	UnverifiedAllow UnverifiedPolicy = "allow"
+	// UnverifiedRestrict lets unverified users sign in with tokens granting no permissions
+	UnverifiedRestrict UnverifiedPolicy = "restrict"
+	// UnverifiedBlock rejects sign-in until the email is verified
+	UnverifiedBlock UnverifiedPolicy = "block"
+)
+
+var _errUnknownUnverifiedPolicy = errors.New("unknown unverified user policy")
+
+// ParseUnverifiedPolicy returns the policy with the name
+func ParseUnverifiedPolicy(name string) (UnverifiedPolicy, error) {
+	switch p := UnverifiedPolicy(name); p {
+	case UnverifiedAllow, UnverifiedRestrict, UnverifiedBlock:
+		return p, nil
+	default:
+		return "", _errUnknownUnverifiedPolicy
+	}
+}
+
+// blocked reports whether the user may not sign in before verifying the email
+func (uc *UseCase) blocked(user *entity.User) bool {
+	return uc.unverified == UnverifiedBlock && !user.IsVerified()
+}
+
+// permissions returns the permissions put into the user's access tokens and
+// whether they are withheld until the user enables MFA
+func (uc *UseCase) permissions(ctx context.Context, user *entity.User) ([]string, bool, error) {
+	if uc.unverified == UnverifiedRestrict && !user.IsVerified() {
+		return []string{}, false, nil
+	}
+
+	enroll, err := uc.mfaEnrollmentRequired(ctx, user)
+	if err != nil {
+		return nil, false, err
+	}
+	if enroll {
+		return []string{}, true, nil
+	}
+
+	return user.Role.Permissions(), false, nil
+}
+
+// ResendVerification mails a new verification link to the user with the email.
+// Unknown, verified and disabled users are ignored so that callers cannot
+// tell which emails are registered
+func (uc *UseCase) ResendVerification(ctx context.Context, email string) error {
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("uc.userRepo.GetByEmail(): %w", err)
+	}
+	if user == nil || user.IsVerified() || user.IsDisabled() {
+		return nil
+	}
+
+	return uc.sendVerification(ctx, user, uc.now())
+}
+
+// VerifyEmail marks the email of the token's user as verified
+func (uc *UseCase) VerifyEmail(ctx context.Context, token string) error {
+	user, err := uc.consumeUserToken(ctx, token, entity.PurposeEmailVerification)
+	if err != nil {
+		return err
+	}
+	if user.IsVerified() {
+		return nil
+	}
+
+	now := uc.now()
+	user.VerifiedAt = &now
+	user.UpdatedAt = now
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("uc.userRepo.Update(): %w", err)
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditEmailVerified,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+	}, now)
+}
+
+// RequestPasswordReset mails a password reset link to the user with the email.
+// Unknown and disabled users are ignored so that callers cannot tell which
+// emails are registered
+func (uc *UseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("uc.userRepo.GetByEmail(): %w", err)
+	}
+	if user == nil || user.IsDisabled() {
+		return nil
+	}
+
+	now := uc.now()
+	token, err := uc.issueUserToken(ctx, user.ID, entity.PurposePasswordReset, uc.resetTTL, now)
+	if err != nil {
+		return err
+	}
+
+	mail := entity.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nsomeone asked to reset the password of your account. Follow the link to choose a new one:\n\n%s\n\n"+
+				"The link expires in %s. If it was not you, ignore this mail, your password stays the same.\n",
+			user.Username, uc.link("/reset-password", token), uc.resetTTL,
+		),
+	}
+	if err := uc.mailer.Send(ctx, mail); err != nil {
+		return fmt.Errorf("uc.mailer.Send(): %w", err)
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditPasswordResetRequested,
+		Outcome:  entity.AuditSuccess,
+		TargetID: user.ID,
+	}, now)
+}
+
+// ResetPassword sets a new password with a mailed reset token and signs the
+// user out everywhere. Receiving the mail proves the email, so it is verified too
+func (uc *UseCase) ResetPassword(ctx context.Context, token, password string) error {
+	if err := entity.ValidatePassword(password); err != nil {
+		return usecase.ErrWeakPassword
+	}
+
+	user, err := uc.consumeUserToken(ctx, token, entity.PurposePasswordReset)
+	if err != nil {
+		return err
+	}
+	if user.IsDisabled() {
+		return usecase.ErrUserDisabled
+	}
+
+	if err := user.SetPassword(password); err != nil {
+		return fmt.Errorf("user.SetPassword(): %w", err)
+	}
+	now := uc.now()
+	if !user.IsVerified() {
+		user.VerifiedAt = &now
+	}
+	user.UpdatedAt = now
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("uc.userRepo.Update(): %w", err)
+	}
+
+	if err := uc.revokeAll(ctx, user.ID); err != nil {
+		return err
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditPasswordReset,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+	}, now)
+}
+
+// ChangePassword replaces the password of the token owner after checking the
+// current one, other sessions of the user are revoked
+func (uc *UseCase) ChangePassword(ctx context.Context, claims *entity.AccessClaims, current, password string) error {
+	if claims.ActorID != uuid.Nil {
+		return usecase.ErrImpersonated
+	}
+	if err := entity.ValidatePassword(password); err != nil {
+		return usecase.ErrWeakPassword
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return usecase.ErrUserNotFound
+	}
+	now := uc.now()
+	if !user.CheckPassword(current) {
+		err := uc.audit(ctx, entity.AuditEvent{
+			Type:     entity.AuditPasswordChanged,
+			Outcome:  entity.AuditFailure,
+			ActorID:  user.ID,
+			TargetID: user.ID,
+			Details:  map[string]string{"reason": usecase.ErrInvalidPassword.Error()},
+		}, now)
+		if err != nil {
+			return err
+		}
+		return usecase.ErrInvalidPassword
+	}
+
+	if err := user.SetPassword(password); err != nil {
+		return fmt.Errorf("user.SetPassword(): %w", err)
+	}
+	user.UpdatedAt = now
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("uc.userRepo.Update(): %w", err)
+	}
+
+	// a reset link mailed before is for the old password
+	if err := uc.userTokens.Invalidate(ctx, user.ID, entity.PurposePasswordReset); err != nil {
+		return fmt.Errorf("uc.userTokens.Invalidate(): %w", err)
+	}
+
+	sessions, err := uc.sessionRepo.ListActive(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("uc.sessionRepo.ListActive(): %w", err)
+	}
+	for _, s := range sessions {
+		if s.FamilyID == claims.SessionID {
+			continue
+		}
+		if _, err := uc.revokeFamily(ctx, user.ID, s.FamilyID); err != nil {
+			return err
+		}
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditPasswordChanged,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+	}, now)
+}
+
+// sendVerification mails an email verification link to the user
+func (uc *UseCase) sendVerification(ctx context.Context, user *entity.User, now time.Time) error {
+	token, err := uc.issueUserToken(ctx, user.ID, entity.PurposeEmailVerification, uc.verificationTTL, now)
+	if err != nil {
+		return err
+	}
+
+	mail := entity.Mail{
+		To:      user.Email,
+		Subject: "Confirm your email",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nplease confirm your email by following the link:\n\n%s\n\n"+
+				"The link expires in %s. If you did not sign up, ignore this mail.\n",
+			user.Username, uc.link("/verify-email", token), uc.verificationTTL,
+		),
+	}
+	if err := uc.mailer.Send(ctx, mail); err != nil {
+		return fmt.Errorf("uc.mailer.Send(): %w", err)
+	}
+
+	return nil
+}
+
+// issueUserToken stores a new single-use token for the user and returns it,
+// earlier tokens with the same purpose stop working
+func (uc *UseCase) issueUserToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	purpose entity.TokenPurpose,
+	ttl time.Duration,
+	now time.Time,
+) (string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = uc.userTokens.Issue(ctx, &entity.UserToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hashToken(token),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	})
+	if err != nil {
+		return "", fmt.Errorf("uc.userTokens.Issue(): %w", err)
+	}
+
+	return token, nil
+}
+
+// consumeUserToken uses up a mailed token and returns its user.
+// Returns ErrInvalidToken if the token is unknown, used or expired
+func (uc *UseCase) consumeUserToken(ctx context.Context, token string, purpose entity.TokenPurpose) (*entity.User, error) {
+	consumed, err := uc.userTokens.Consume(ctx, hashToken(token), purpose)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userTokens.Consume(): %w", err)
+	}
+	if consumed == nil {
+		return nil, usecase.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, consumed.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil, usecase.ErrInvalidToken
+	}
+
+	return user, nil
+}
+
+// link returns the URL of a page of the app carrying the token
+func (uc *UseCase) link(path, token string) string {
+	return uc.appURL + path + "?" + url.Values{"token": {token}}.Encode()
+}
@@ -0,0 +1,279 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+// ListUsers returns a page of users matching the filter and the number of all matches
+func (uc *UseCase) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error) {
+	users, total, err := uc.userRepo.Search(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("uc.userRepo.Search(): %w", err)
+	}
+
+	return users, total, nil
+}
+
+// GetUser returns a user by id
+func (uc *UseCase) GetUser(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil, usecase.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+// SetUserRole changes the role of a user. Tokens carry the role, so the
+// user's sessions are revoked and the new role applies from the next sign-in
+func (uc *UseCase) SetUserRole(ctx context.Context, actorID, userID uuid.UUID, role entity.Role) (*entity.User, error) {
+	if actorID == userID {
+		return nil, usecase.ErrSelfModification
+	}
+
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == role {
+		return user, nil
+	}
+
+	from := user.Role
+	now := uc.now()
+	user.Role = role
+	user.UpdatedAt = now
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("uc.userRepo.Update(): %w", err)
+	}
+
+	if err := uc.revokeAll(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditUserRoleChanged,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorID,
+		TargetID: user.ID,
+		Details:  map[string]string{"from": string(from), "to": string(role)},
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// DisableUser blocks a user from signing in and revokes all their sessions
+func (uc *UseCase) DisableUser(ctx context.Context, actorID, userID uuid.UUID) (*entity.User, error) {
+	if actorID == userID {
+		return nil, usecase.ErrSelfModification
+	}
+
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	if !user.IsDisabled() {
+		user.DisabledAt = &now
+		user.UpdatedAt = now
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("uc.userRepo.Update(): %w", err)
+		}
+	}
+
+	// revoke even when already disabled, in case a previous call failed half way
+	if err := uc.revokeAll(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditUserDisabled,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorID,
+		TargetID: user.ID,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// EnableUser lets a disabled user sign in again
+func (uc *UseCase) EnableUser(ctx context.Context, actorID, userID uuid.UUID) (*entity.User, error) {
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsDisabled() {
+		return user, nil
+	}
+
+	now := uc.now()
+	user.DisabledAt = nil
+	user.UpdatedAt = now
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("uc.userRepo.Update(): %w", err)
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditUserEnabled,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorID,
+		TargetID: user.ID,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// Impersonate issues an access token acting as the user for the actor. The
+// token has no refresh token and no stored session, it lives for the access
+// token TTL and can still be revoked by its jti through SignOut
+func (uc *UseCase) Impersonate(ctx context.Context, actorID, userID uuid.UUID) (*entity.TokenPair, error) {
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := uc.now()
+	if !user.Role.Impersonable() {
+		err := uc.audit(ctx, entity.AuditEvent{
+			Type:     entity.AuditUserImpersonated,
+			Outcome:  entity.AuditFailure,
+			ActorID:  actorID,
+			TargetID: user.ID,
+			Details:  map[string]string{"reason": usecase.ErrNotImpersonable.Error()},
+		}, now)
+		if err != nil {
+			return nil, err
+		}
+		return nil, usecase.ErrNotImpersonable
+	}
+	if user.IsDisabled() {
+		return nil, usecase.ErrUserDisabled
+	}
+
+	session := &entity.Session{
+		FamilyID:  uuid.New(),
+		UserID:    user.ID,
+		AccessJTI: uuid.New(),
+	}
+
+	perms, _, err := uc.permissions(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := uc.makeToken(user, session, actorID, perms, now)
+	if err != nil {
+		return nil, fmt.Errorf("uc.makeToken(): %w", err)
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditUserImpersonated,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorID,
+		TargetID: user.ID,
+		Details:  map[string]string{"session_id": session.FamilyID.String()},
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.TokenPair{
+		UserID:          user.ID,
+		AccessToken:     access,
+		AccessExpiresAt: now.Add(uc.accessTTL),
+	}, nil
+}
+
+// MFAPolicy returns the roles whose users must have MFA enabled
+func (uc *UseCase) MFAPolicy(ctx context.Context) ([]entity.Role, error) {
+	roles, err := uc.mfaRepo.RequiredRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.RequiredRoles(): %w", err)
+	}
+
+	return roles, nil
+}
+
+// SetMFARequirement changes whether users of the role must have MFA enabled.
+// Users of the role without MFA get tokens without permissions from their
+// next sign-in or refresh on, until they enroll
+func (uc *UseCase) SetMFARequirement(ctx context.Context, actorID uuid.UUID, role entity.Role, required bool) error {
+	now := uc.now()
+	if err := uc.mfaRepo.SetRoleRequired(ctx, role, required, actorID, now); err != nil {
+		return fmt.Errorf("uc.mfaRepo.SetRoleRequired(): %w", err)
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:    entity.AuditMFARequirementChanged,
+		Outcome: entity.AuditSuccess,
+		ActorID: actorID,
+		Details: map[string]string{"role": string(role), "required": strconv.FormatBool(required)},
+	}, now)
+}
+
+// ResetMFA removes the second factor of a user who lost both the
+// authenticator and the recovery codes, the user signs in with the password only
+func (uc *UseCase) ResetMFA(ctx context.Context, actorID, userID uuid.UUID) error {
+	if actorID == userID {
+		return usecase.ErrSelfModification
+	}
+
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.mfaRepo.Delete(ctx, user.ID); err != nil {
+		return fmt.Errorf("uc.mfaRepo.Delete(): %w", err)
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditMFAReset,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorID,
+		TargetID: user.ID,
+	}, uc.now())
+}
+
+// ListAuditEvents returns a page of the audit log matching the filter, newest
+// first, and the cursor of the next page, nil on the last page. The filter
+// limit must be positive
+func (uc *UseCase) ListAuditEvents(
+	ctx context.Context,
+	filter entity.AuditFilter,
+) ([]*entity.AuditEvent, *entity.AuditCursor, error) {
+	limit := filter.Limit
+
+	// one more event tells whether there is a next page
+	filter.Limit++
+	events, err := uc.auditLog.List(ctx, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("uc.auditLog.List(): %w", err)
+	}
+
+	if len(events) <= limit {
+		return events, nil, nil
+	}
+
+	events = events[:limit]
+	return events, events[limit-1].Cursor(), nil
+}
@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+const (
+	_maxAPIKeyNameLength = 64
+
+	// _apiKeyPrefixLength is how much of the key listings show, the key
+	// prefix and 8 random characters
+	_apiKeyPrefixLength = len(entity.APIKeyPrefix) + 8
+)
+
+// CreateAPIKey creates a key for the user of the claims, it grants the scopes
+// and expires after ttl, or after the default lifetime when ttl is zero. The
+// key is returned only this once
+func (uc *UseCase) CreateAPIKey(
+	ctx context.Context,
+	claims *entity.AccessClaims,
+	name string,
+	scopes []string,
+	ttl time.Duration,
+) (*entity.NewAPIKey, error) {
+	if claims.ActorID != uuid.Nil {
+		return nil, usecase.ErrImpersonated
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > _maxAPIKeyNameLength {
+		return nil, usecase.ErrInvalidAPIKeyName
+	}
+
+	if ttl == 0 {
+		ttl = uc.apiKeyTTL
+	}
+	if ttl < 0 || ttl > uc.apiKeyMaxTTL {
+		return nil, usecase.ErrInvalidAPIKeyTTL
+	}
+
+	// a key never grants more than the token it is created with
+	scopes = slices.Compact(slices.Sorted(slices.Values(scopes)))
+	if len(scopes) == 0 {
+		return nil, usecase.ErrInvalidScope
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(claims.Permissions, scope) {
+			return nil, usecase.ErrInvalidScope
+		}
+	}
+
+	now := uc.now()
+	n, err := uc.apiKeys.CountActive(ctx, claims.UserID, now)
+	if err != nil {
+		return nil, fmt.Errorf("uc.apiKeys.CountActive(): %w", err)
+	}
+	if n >= uc.apiKeysPerUser {
+		return nil, usecase.ErrAPIKeyLimit
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	secret := entity.APIKeyPrefix + token
+
+	key := &entity.APIKey{
+		ID:        uuid.New(),
+		UserID:    claims.UserID,
+		Name:      name,
+		Prefix:    secret[:_apiKeyPrefixLength],
+		KeyHash:   hashToken(secret),
+		Scopes:    scopes,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+	if err := uc.apiKeys.Create(ctx, key); err != nil {
+		return nil, fmt.Errorf("uc.apiKeys.Create(): %w", err)
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditAPIKeyCreated,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  claims.UserID,
+		TargetID: claims.UserID,
+		Details: map[string]string{
+			"key_id": key.ID.String(),
+			"name":   key.Name,
+			"scopes": strings.Join(key.Scopes, ","),
+		},
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.NewAPIKey{APIKey: key, Key: secret}, nil
+}
+
+// ListAPIKeys returns the keys of the user that are not revoked
+func (uc *UseCase) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]*entity.APIKey, error) {
+	keys, err := uc.apiKeys.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.apiKeys.ListByUser(): %w", err)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey revokes one of the keys of the user of the claims, requests
+// with it are rejected from then on
+func (uc *UseCase) RevokeAPIKey(ctx context.Context, claims *entity.AccessClaims, keyID uuid.UUID) error {
+	now := uc.now()
+	revoked, err := uc.apiKeys.Revoke(ctx, claims.UserID, keyID, now)
+	if err != nil {
+		return fmt.Errorf("uc.apiKeys.Revoke(): %w", err)
+	}
+	if !revoked {
+		return usecase.ErrAPIKeyNotFound
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditAPIKeyRevoked,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorOf(claims),
+		TargetID: claims.UserID,
+		Details:  map[string]string{"key_id": keyID.String()},
+	}, now)
+}
+
+// AuthenticateAPIKey checks a key and returns its user with the permissions
+// the key grants. The user's account is checked like on every token refresh,
+// the scopes are cut down to what the user's tokens would grant right now
+func (uc *UseCase) AuthenticateAPIKey(ctx context.Context, secret string) (*entity.APIKeyClaims, error) {
+	if !strings.HasPrefix(secret, entity.APIKeyPrefix) {
+		return nil, uc.rejectToken(ctx, _kindAPIKey, uuid.Nil)
+	}
+
+	key, err := uc.apiKeys.GetByHash(ctx, hashToken(secret))
+	if err != nil {
+		return nil, fmt.Errorf("uc.apiKeys.GetByHash(): %w", err)
+	}
+
+	if key == nil {
+		return nil, uc.rejectToken(ctx, _kindAPIKey, uuid.Nil)
+	}
+	now := uc.now()
+	if !key.IsActive(now) {
+		return nil, uc.rejectToken(ctx, _kindAPIKey, key.UserID)
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, key.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil, uc.rejectToken(ctx, _kindAPIKey, uuid.Nil)
+	}
+	if user.IsDisabled() {
+		return nil, usecase.ErrUserDisabled
+	}
+	if uc.blocked(user) {
+		return nil, usecase.ErrEmailNotVerified
+	}
+
+	granted, _, err := uc.permissions(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	perms := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		if slices.Contains(granted, scope) {
+			perms = append(perms, scope)
+		}
+	}
+
+	if err := uc.apiKeys.Touch(ctx, key.ID, now); err != nil {
+		return nil, fmt.Errorf("uc.apiKeys.Touch(): %w", err)
+	}
+
+	return &entity.APIKeyClaims{
+		KeyID:       key.ID,
+		User:        user,
+		Permissions: perms,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+// Kinds of credentials in token.rejected events
+const (
+	_kindAccessToken  = "access_token"
+	_kindRefreshToken = "refresh_token"
+	_kindAPIKey       = "api_key"
+)
+
+// audit records the event with the client and time of the request
+func (uc *UseCase) audit(ctx context.Context, event entity.AuditEvent, now time.Time) error {
+	client := usecase.ClientFromContext(ctx)
+
+	event.ID = uuid.New()
+	event.IP = client.IP
+	event.UserAgent = client.UserAgent
+	event.CreatedAt = now
+
+	if err := uc.auditLog.Record(ctx, event); err != nil {
+		return fmt.Errorf("uc.auditLog.Record(): %w", err)
+	}
+
+	return nil
+}
+
+// auditAuthentication records an event of signing in or presenting a
+// credential. Unlike admin changes these go on when the event cannot be
+// stored, the audit log falls back to the service log and a rejected
+// credential has to stay rejected as such
+func (uc *UseCase) auditAuthentication(ctx context.Context, event entity.AuditEvent, now time.Time) {
+	if err := uc.audit(ctx, event, now); err != nil {
+		uc.logger.Error("auth - auditAuthentication - %s: %v", event.Type, err)
+	}
+}
+
+// rejectToken records a rejected credential of the kind and returns
+// usecase.ErrInvalidToken. targetID is the user the credential was issued to
+// if it is known
+func (uc *UseCase) rejectToken(ctx context.Context, kind string, targetID uuid.UUID) error {
+	uc.auditAuthentication(ctx, entity.AuditEvent{
+		Type:     entity.AuditTokenRejected,
+		Outcome:  entity.AuditFailure,
+		TargetID: targetID,
+		Details:  map[string]string{"kind": kind},
+	}, uc.now())
+
+	return usecase.ErrInvalidToken
+}
+
+// actorOf returns who acts with the claims, the admin when impersonating
+func actorOf(claims *entity.AccessClaims) uuid.UUID {
+	if claims.ActorID != uuid.Nil {
+		return claims.ActorID
+	}
+
+	return claims.UserID
+}
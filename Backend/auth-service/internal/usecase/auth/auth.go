@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,32 +11,174 @@ import (
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/logger"
 )
 
+// _methodPassword is the sign-in method of SignIn, identity provider sign-ins
+// are recorded with the name of the provider
+const _methodPassword = "password"
+
+// Config holds the settings of the authentication UseCase
+type Config struct {
+	Issuer     string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+
+	VerificationTTL  time.Duration // lifetime of mailed email verification tokens
+	ResetTTL         time.Duration // lifetime of mailed password reset tokens
+	UnverifiedPolicy UnverifiedPolicy
+	AppURL           string // base URL of the links in mails
+
+	MFAIssuer         string        // issuer shown by authenticator apps
+	ChallengeTTL      time.Duration // time to enter the code after the password
+	ChallengeAttempts int           // codes that can be tried per challenge
+
+	Lockout LockoutPolicy
+
+	OAuthStateTTL time.Duration // time to come back from the identity provider
+
+	APIKeyTTL      time.Duration // lifetime of API keys created without one
+	APIKeyMaxTTL   time.Duration // longest lifetime an API key may be created with
+	APIKeysPerUser int           // active API keys a user may have
+
+	Deletion DeletionPolicy
+
+	// Clock returns the current time, time.Now when nil
+	Clock func() time.Time
+
+	// Logger reports failures that do not fail the request
+	Logger logger.Interface
+}
+
 // UseCase implements the authentication use case
 type UseCase struct {
-	userRepo repo.UserRepository
-	jwtKey   []byte
-	tokenTTL time.Duration
+	userRepo    repo.UserRepository
+	sessionRepo repo.SessionRepository
+	denylist    repo.TokenDenylist
+	userTokens  repo.UserTokenRepository
+	mfaRepo     repo.MFARepository
+	throttle    repo.ThrottleRepository
+	auditLog    repo.AuditLog
+	identities  repo.IdentityRepository
+	apiKeys     repo.APIKeyRepository
+	profiles    repo.ProfileRepository
+	deletions   repo.AccountDeletionRepository
+	keys        usecase.KeyRing
+	mailer      repo.Mailer
+	providers   map[string]repo.IdentityProvider
+	services    []repo.UserDataService
+	clock       func() time.Time
+	logger      logger.Interface
+
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+
+	verificationTTL time.Duration
+	resetTTL        time.Duration
+	unverified      UnverifiedPolicy
+	appURL          string
+
+	mfaIssuer         string
+	challengeTTL      time.Duration
+	challengeAttempts int
+
+	lockout LockoutPolicy
+
+	oauthStateTTL time.Duration
+
+	apiKeyTTL      time.Duration
+	apiKeyMaxTTL   time.Duration
+	apiKeysPerUser int
+
+	deletion DeletionPolicy
 }
 
 // New creates a new instance of authentication UseCase
-func New(userRepo repo.UserRepository, jwtKey string, tokenTTL int) *UseCase {
+func New(
+	userRepo repo.UserRepository,
+	sessionRepo repo.SessionRepository,
+	denylist repo.TokenDenylist,
+	userTokens repo.UserTokenRepository,
+	mfaRepo repo.MFARepository,
+	throttle repo.ThrottleRepository,
+	auditLog repo.AuditLog,
+	identities repo.IdentityRepository,
+	apiKeys repo.APIKeyRepository,
+	profiles repo.ProfileRepository,
+	deletions repo.AccountDeletionRepository,
+	keys usecase.KeyRing,
+	mailer repo.Mailer,
+	providers []repo.IdentityProvider,
+	services []repo.UserDataService,
+	cfg Config,
+) *UseCase {
+	clock := cfg.Clock
+	if clock == nil {
+		clock = time.Now
+	}
+
+	byName := make(map[string]repo.IdentityProvider, len(providers))
+	for _, p := range providers {
+		byName[p.Name()] = p
+	}
+
 	return &UseCase{
-		userRepo: userRepo,
-		jwtKey:   []byte(jwtKey),
-		tokenTTL: time.Duration(tokenTTL) * time.Minute,
+		userRepo:        userRepo,
+		sessionRepo:     sessionRepo,
+		denylist:        denylist,
+		userTokens:      userTokens,
+		mfaRepo:         mfaRepo,
+		throttle:        throttle,
+		auditLog:        auditLog,
+		identities:      identities,
+		apiKeys:         apiKeys,
+		profiles:        profiles,
+		deletions:       deletions,
+		keys:            keys,
+		mailer:          mailer,
+		providers:       byName,
+		services:        services,
+		clock:           clock,
+		logger:          cfg.Logger,
+		issuer:          cfg.Issuer,
+		accessTTL:       cfg.AccessTTL,
+		refreshTTL:      cfg.RefreshTTL,
+		verificationTTL: cfg.VerificationTTL,
+		resetTTL:        cfg.ResetTTL,
+		unverified:      cfg.UnverifiedPolicy,
+		appURL:          cfg.AppURL,
+
+		mfaIssuer:         cfg.MFAIssuer,
+		challengeTTL:      cfg.ChallengeTTL,
+		challengeAttempts: cfg.ChallengeAttempts,
+
+		lockout: cfg.Lockout,
+
+		oauthStateTTL: cfg.OAuthStateTTL,
+
+		apiKeyTTL:      cfg.APIKeyTTL,
+		apiKeyMaxTTL:   cfg.APIKeyMaxTTL,
+		apiKeysPerUser: cfg.APIKeysPerUser,
+
+		deletion: cfg.Deletion,
 	}
 }
 
-// SignUp registers a new user
-func (uc *UseCase) SignUp(ctx context.Context, email, password, username string) (string, error) {
+// now returns the current time of the use case clock in UTC
+func (uc *UseCase) now() time.Time {
+	return uc.clock().UTC()
+}
+
+// SignUp registers a new user and mails them an email verification link.
+// While unverified users are blocked only the user id of the pair is set
+func (uc *UseCase) SignUp(ctx context.Context, email, password, username string) (*entity.TokenPair, error) {
 	existingUser, err := uc.userRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return "", fmt.Errorf("uc.userRepo.GetByEmail(): %w", err)
+		return nil, fmt.Errorf("uc.userRepo.GetByEmail(): %w", err)
 	}
 	if existingUser != nil {
-		return "", usecase.ErrUserAlreadyExists
+		return nil, usecase.ErrUserAlreadyExists
 	}
 
 	// create & hash
@@ -43,34 +186,141 @@ func (uc *UseCase) SignUp(ctx context.Context, email, password, username string)
 		ID:       uuid.New(),
 		Email:    email,
 		Username: username,
-		IsAdmin:  false,
+		Role:     entity.RoleUser,
 	}
 	if err = user.SetPassword(password); err != nil {
-		return "", fmt.Errorf("user.SetPassword(): %w", err)
+		return nil, fmt.Errorf("user.SetPassword(): %w", err)
 	}
-	now := time.Now().UTC()
+	now := uc.now()
 	user.CreatedAt = now
 
 	_, err = uc.userRepo.Create(ctx, user)
 	if err != nil {
-		return "", fmt.Errorf("uc.userRepo.Create(): %w", err)
+		return nil, fmt.Errorf("uc.userRepo.Create(): %w", err)
 	}
 
-	return uc.makeToken(user, now)
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditSignUp,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// the account exists by now, a failed mail must not fail the sign-up,
+	// the user can ask for another one with ResendVerification
+	if err = uc.sendVerification(ctx, user, now); err != nil {
+		uc.logger.Error("auth - SignUp - uc.sendVerification: %v", err)
+	}
+	if uc.blocked(user) {
+		return &entity.TokenPair{UserID: user.ID}, nil
+	}
+
+	return uc.startSession(ctx, user, now)
 }
 
-// SignIn authenticates a user and returns a JWT token
-func (uc *UseCase) SignIn(ctx context.Context, email, password string) (string, error) {
+// SignIn authenticates a user and returns a token pair. Users with MFA
+// enabled get a challenge instead, the pair is issued by VerifyMFA.
+//
+// Failures are counted per email and per client address, both get locked for
+// growing periods once they pass their threshold. Unknown emails are counted
+// and answered like wrong passwords, so responses do not tell them apart
+func (uc *UseCase) SignIn(ctx context.Context, email, password string) (*entity.TokenPair, *entity.MFAChallenge, error) {
+	client := usecase.ClientFromContext(ctx)
+	now := uc.now()
+
+	locked, err := uc.locked(ctx, email, client, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	if locked {
+		return nil, nil, uc.rejectSignIn(ctx, nil, email, _methodPassword, usecase.ErrTooManyAttempts, now)
+	}
+
 	user, err := uc.userRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return "", fmt.Errorf("uc.userRepo.GetByEmail(): %w", err)
+		return nil, nil, fmt.Errorf("uc.userRepo.GetByEmail(): %w", err)
 	}
 
 	// password check
-	if user == nil || !user.CheckPassword(password) { // user == nil exactly means that email is not right
-		return "", usecase.ErrInvalidCredentials
+	if !checkPassword(user, password) {
+		if err := uc.recordFailure(ctx, email, user, client, now); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, uc.rejectSignIn(ctx, user, email, _methodPassword, usecase.ErrInvalidCredentials, now)
+	}
+	if err := uc.throttle.Reset(ctx, entity.AccountThrottleKey(email)); err != nil {
+		return nil, nil, fmt.Errorf("uc.throttle.Reset(): %w", err)
+	}
+
+	return uc.signInUser(ctx, user, _methodPassword, now)
+}
+
+// signInUser issues a token pair to the user authenticated with the method,
+// or a challenge for VerifyMFA if the user has MFA enabled
+func (uc *UseCase) signInUser(
+	ctx context.Context,
+	user *entity.User,
+	method string,
+	now time.Time,
+) (*entity.TokenPair, *entity.MFAChallenge, error) {
+	if user.IsDisabled() {
+		return nil, nil, uc.rejectSignIn(ctx, user, user.Email, method, usecase.ErrUserDisabled, now)
+	}
+	if uc.blocked(user) {
+		return nil, nil, uc.rejectSignIn(ctx, user, user.Email, method, usecase.ErrEmailNotVerified, now)
 	}
 
-	now := time.Now().UTC()
-	return uc.makeToken(user, now)
+	mfa, err := uc.mfaRepo.Get(ctx, user.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("uc.mfaRepo.Get(): %w", err)
+	}
+
+	uc.auditAuthentication(ctx, entity.AuditEvent{
+		Type:     entity.AuditSignIn,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+		Details:  map[string]string{"method": method, "mfa": strconv.FormatBool(mfa.IsEnabled())},
+	}, now)
+
+	if mfa.IsEnabled() {
+		challenge, err := uc.newChallenge(ctx, user.ID, now)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, challenge, nil
+	}
+
+	pair, err := uc.startSession(ctx, user, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pair, nil, nil
+}
+
+// rejectSignIn records the sign-in rejected with the reason and returns the
+// reason. user is nil for unknown emails
+func (uc *UseCase) rejectSignIn(
+	ctx context.Context,
+	user *entity.User,
+	email, method string,
+	reason error,
+	now time.Time,
+) error {
+	event := entity.AuditEvent{
+		Type:    entity.AuditSignIn,
+		Outcome: entity.AuditFailure,
+		Details: map[string]string{"method": method, "email": email, "reason": reason.Error()},
+	}
+	if user != nil {
+		event.TargetID = user.ID
+	}
+
+	uc.auditAuthentication(ctx, event, now)
+
+	return reason
 }
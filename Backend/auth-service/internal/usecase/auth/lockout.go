@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+)
+
+// LockoutPolicy is how failed sign-ins slow down further attempts. Once a
+// counter reaches its threshold every failure locks sign-ins for twice as
+// long as the one before, starting at BaseDelay and capped at MaxDelay.
+// A zero threshold turns counting for its key off
+type LockoutPolicy struct {
+	AccountThreshold int           // failures of one email before locking
+	IPThreshold      int           // failures from one address, across emails, before locking
+	BaseDelay        time.Duration // first lock
+	MaxDelay         time.Duration // longest lock
+	Window           time.Duration // failures older than this are forgotten
+}
+
+// delay returns how long sign-ins are locked after the failures
+func (p LockoutPolicy) delay(failures, threshold int) time.Duration {
+	if threshold <= 0 || failures < threshold {
+		return 0
+	}
+
+	d := p.BaseDelay
+	for i := threshold; i < failures && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+
+	return min(d, p.MaxDelay)
+}
+
+// _dummyUser has a password hash of the same cost as real ones, unknown
+// emails are checked against it so that they take as long as wrong passwords
+var _dummyUser = sync.OnceValue(func() *entity.User {
+	user := &entity.User{}
+	_ = user.SetPassword(uuid.NewString())
+	return user
+})
+
+// checkPassword reports whether the password is the one of the user, nil users never match
+func checkPassword(user *entity.User, password string) bool {
+	if user == nil {
+		_dummyUser().CheckPassword(password)
+		return false
+	}
+
+	return user.CheckPassword(password)
+}
+
+// locked reports whether sign-ins for the email or from the client are locked
+func (uc *UseCase) locked(ctx context.Context, email string, client entity.Client, now time.Time) (bool, error) {
+	keys := []entity.ThrottleKey{entity.AccountThrottleKey(email)}
+	if client.IP != "" {
+		keys = append(keys, entity.IPThrottleKey(client.IP))
+	}
+
+	until, err := uc.throttle.LockedUntil(ctx, keys, now)
+	if err != nil {
+		return false, fmt.Errorf("uc.throttle.LockedUntil(): %w", err)
+	}
+
+	return !until.IsZero(), nil
+}
+
+// recordFailure counts a failed sign-in for the email and the client and
+// locks the ones that reached their threshold. user is nil for unknown emails
+func (uc *UseCase) recordFailure(
+	ctx context.Context,
+	email string,
+	user *entity.User,
+	client entity.Client,
+	now time.Time,
+) error {
+	if err := uc.countFailure(ctx, entity.AccountThrottleKey(email), uc.lockout.AccountThreshold, user, now,
+		map[string]string{"scope": "account", "email": email}); err != nil {
+		return err
+	}
+
+	if client.IP == "" {
+		return nil
+	}
+
+	return uc.countFailure(ctx, entity.IPThrottleKey(client.IP), uc.lockout.IPThreshold, user, now,
+		map[string]string{"scope": "ip"})
+}
+
+// countFailure counts a failure for the key and locks it with an audit event once over the threshold
+func (uc *UseCase) countFailure(
+	ctx context.Context,
+	key entity.ThrottleKey,
+	threshold int,
+	user *entity.User,
+	now time.Time,
+	details map[string]string,
+) error {
+	if threshold <= 0 {
+		return nil
+	}
+
+	failures, err := uc.throttle.RecordFailure(ctx, key, now, uc.lockout.Window)
+	if err != nil {
+		return fmt.Errorf("uc.throttle.RecordFailure(): %w", err)
+	}
+
+	d := uc.lockout.delay(failures, threshold)
+	if d == 0 {
+		return nil
+	}
+
+	until := now.Add(d)
+	if err := uc.throttle.Lock(ctx, key, until); err != nil {
+		return fmt.Errorf("uc.throttle.Lock(): %w", err)
+	}
+
+	details["failures"] = strconv.Itoa(failures)
+	details["locked_until"] = until.Format(time.RFC3339)
+
+	event := entity.AuditEvent{
+		Type:    entity.AuditSignInLockout,
+		Outcome: entity.AuditFailure,
+		Details: details,
+	}
+	if user != nil {
+		event.TargetID = user.ID
+	}
+	uc.auditAuthentication(ctx, event, now)
+
+	return nil
+}
+
+// UnlockUser lifts the lock and forgets the failed sign-ins of the user's account.
+// Locks of client addresses stay, they expire on their own
+func (uc *UseCase) UnlockUser(ctx context.Context, actorID, userID uuid.UUID) error {
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.throttle.Reset(ctx, entity.AccountThrottleKey(user.Email)); err != nil {
+		return fmt.Errorf("uc.throttle.Reset(): %w", err)
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditUserUnlocked,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorID,
+		TargetID: user.ID,
+	}, uc.now())
+}
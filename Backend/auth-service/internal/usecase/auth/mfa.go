@@ -0,0 +1,410 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/totp"
+)
+
+const (
+	// _totpSkew is the number of time steps a code may be early or late
+	_totpSkew = 1
+
+	_recoveryCodeCount = 10
+	_recoveryCodeBytes = 10 // 80 bits, enough for the unsalted hashToken
+)
+
+var _recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// VerifyMFA completes a sign-in started by SignIn with a TOTP or recovery
+// code and returns the token pair. Every try counts against the attempts of
+// the challenge, so codes cannot be guessed without the password again
+func (uc *UseCase) VerifyMFA(ctx context.Context, challengeToken, code string) (*entity.TokenPair, error) {
+	now := uc.now()
+	challenge, err := uc.mfaRepo.AttemptChallenge(ctx, hashToken(challengeToken), now, uc.challengeAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.AttemptChallenge(): %w", err)
+	}
+	if challenge == nil {
+		return nil, usecase.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil, usecase.ErrInvalidToken
+	}
+	if user.IsDisabled() {
+		return nil, usecase.ErrUserDisabled
+	}
+
+	mfa, err := uc.mfaRepo.Get(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.Get(): %w", err)
+	}
+	if !mfa.IsEnabled() {
+		// reset by an admin after the password was checked
+		return nil, usecase.ErrInvalidToken
+	}
+
+	if err := uc.verifyCode(ctx, mfa, code, entity.AuditSignInMFA, now); err != nil {
+		return nil, err
+	}
+
+	consumed, err := uc.mfaRepo.ConsumeChallenge(ctx, challenge.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.ConsumeChallenge(): %w", err)
+	}
+	if !consumed {
+		return nil, usecase.ErrInvalidToken
+	}
+
+	uc.auditAuthentication(ctx, entity.AuditEvent{
+		Type:     entity.AuditSignInMFA,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+	}, now)
+
+	return uc.startSession(ctx, user, now)
+}
+
+// EnrollMFA starts a new enrollment with a fresh secret, replacing a pending
+// one. MFA is enabled once ConfirmMFA gets a code of the secret
+func (uc *UseCase) EnrollMFA(ctx context.Context, claims *entity.AccessClaims) (*entity.MFAEnrollment, error) {
+	if claims.ActorID != uuid.Nil {
+		return nil, usecase.ErrImpersonated
+	}
+
+	user, err := uc.GetUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("totp.GenerateSecret(): %w", err)
+	}
+
+	saved, err := uc.mfaRepo.SavePending(ctx, &entity.MFA{
+		UserID:    user.ID,
+		Secret:    secret,
+		CreatedAt: uc.now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.SavePending(): %w", err)
+	}
+	if !saved {
+		return nil, usecase.ErrMFAAlreadyEnabled
+	}
+
+	return &entity.MFAEnrollment{
+		Secret: secret,
+		URI:    totp.URI(uc.mfaIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmMFA enables the pending enrollment with a code of its secret and
+// returns the recovery codes, they are shown only this once
+func (uc *UseCase) ConfirmMFA(ctx context.Context, claims *entity.AccessClaims, code string) ([]string, error) {
+	if claims.ActorID != uuid.Nil {
+		return nil, usecase.ErrImpersonated
+	}
+
+	mfa, err := uc.mfaRepo.Get(ctx, claims.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.Get(): %w", err)
+	}
+	if mfa == nil {
+		return nil, usecase.ErrMFANotEnabled
+	}
+	if mfa.IsEnabled() {
+		return nil, usecase.ErrMFAAlreadyEnabled
+	}
+
+	now := uc.now()
+	step, ok := totp.Validate(mfa.Secret, normalizeCode(code), now, _totpSkew)
+	if !ok {
+		return nil, usecase.ErrInvalidMFACode
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := uc.mfaRepo.Enable(ctx, claims.UserID, step, hashes, now)
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.Enable(): %w", err)
+	}
+	if !enabled {
+		return nil, usecase.ErrMFAAlreadyEnabled
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditMFAEnabled,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  claims.UserID,
+		TargetID: claims.UserID,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// DisableMFA turns MFA off after checking a code, unless the role of the user requires it
+func (uc *UseCase) DisableMFA(ctx context.Context, claims *entity.AccessClaims, code string) error {
+	if claims.ActorID != uuid.Nil {
+		return usecase.ErrImpersonated
+	}
+
+	mfa, err := uc.enabledMFA(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+
+	// the token has no role while the permissions are withheld
+	user, err := uc.GetUser(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+
+	required, err := uc.mfaRequired(ctx, user.Role)
+	if err != nil {
+		return err
+	}
+	if required {
+		return usecase.ErrMFAEnforced
+	}
+
+	now := uc.now()
+	if err := uc.verifyCode(ctx, mfa, code, entity.AuditMFADisabled, now); err != nil {
+		return err
+	}
+
+	if err := uc.mfaRepo.Delete(ctx, claims.UserID); err != nil {
+		return fmt.Errorf("uc.mfaRepo.Delete(): %w", err)
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditMFADisabled,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  claims.UserID,
+		TargetID: claims.UserID,
+	}, now)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes after checking a code
+// and returns the new ones, the old ones stop working
+func (uc *UseCase) RegenerateRecoveryCodes(ctx context.Context, claims *entity.AccessClaims, code string) ([]string, error) {
+	if claims.ActorID != uuid.Nil {
+		return nil, usecase.ErrImpersonated
+	}
+
+	mfa, err := uc.enabledMFA(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	if err := uc.verifyCode(ctx, mfa, code, entity.AuditRecoveryCodesRenewed, now); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.mfaRepo.ReplaceRecoveryCodes(ctx, claims.UserID, hashes); err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.ReplaceRecoveryCodes(): %w", err)
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditRecoveryCodesRenewed,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  claims.UserID,
+		TargetID: claims.UserID,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// newChallenge stores a sign-in challenge for the user, the returned one carries the token
+func (uc *UseCase) newChallenge(ctx context.Context, userID uuid.UUID, now time.Time) (*entity.MFAChallenge, error) {
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := &entity.MFAChallenge{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		CreatedAt: now,
+		ExpiresAt: now.Add(uc.challengeTTL),
+		Token:     token,
+	}
+	if err := uc.mfaRepo.CreateChallenge(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.CreateChallenge(): %w", err)
+	}
+
+	return challenge, nil
+}
+
+// enabledMFA returns the second factor of the user.
+// Returns ErrMFANotEnabled if the user has not enabled MFA
+func (uc *UseCase) enabledMFA(ctx context.Context, userID uuid.UUID) (*entity.MFA, error) {
+	mfa, err := uc.mfaRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.Get(): %w", err)
+	}
+	if !mfa.IsEnabled() {
+		return nil, usecase.ErrMFANotEnabled
+	}
+
+	return mfa, nil
+}
+
+// checkCode accepts a TOTP code of a step later than the last accepted one
+// or an unused recovery code, either is used up by the check
+func (uc *UseCase) checkCode(ctx context.Context, mfa *entity.MFA, code string, now time.Time) error {
+	code = normalizeCode(code)
+
+	if isTOTPCode(code) {
+		step, ok := totp.Validate(mfa.Secret, code, now, _totpSkew)
+		if !ok || step <= mfa.LastUsedStep {
+			return usecase.ErrInvalidMFACode
+		}
+
+		used, err := uc.mfaRepo.UseStep(ctx, mfa.UserID, step)
+		if err != nil {
+			return fmt.Errorf("uc.mfaRepo.UseStep(): %w", err)
+		}
+		if !used {
+			return usecase.ErrInvalidMFACode
+		}
+
+		return nil
+	}
+
+	used, err := uc.mfaRepo.UseRecoveryCode(ctx, mfa.UserID, hashToken(code), now)
+	if err != nil {
+		return fmt.Errorf("uc.mfaRepo.UseRecoveryCode(): %w", err)
+	}
+	if !used {
+		return usecase.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+// verifyCode checks the code like checkCode, a wrong code is recorded as a
+// failure of the event it was entered for
+func (uc *UseCase) verifyCode(
+	ctx context.Context,
+	mfa *entity.MFA,
+	code string,
+	event entity.AuditEventType,
+	now time.Time,
+) error {
+	err := uc.checkCode(ctx, mfa, code, now)
+	if !errors.Is(err, usecase.ErrInvalidMFACode) {
+		return err
+	}
+
+	uc.auditAuthentication(ctx, entity.AuditEvent{
+		Type:     event,
+		Outcome:  entity.AuditFailure,
+		ActorID:  mfa.UserID,
+		TargetID: mfa.UserID,
+		Details:  map[string]string{"reason": err.Error()},
+	}, now)
+
+	return err
+}
+
+// mfaRequired reports whether users of the role must have MFA enabled
+func (uc *UseCase) mfaRequired(ctx context.Context, role entity.Role) (bool, error) {
+	roles, err := uc.mfaRepo.RequiredRoles(ctx)
+	if err != nil {
+		return false, fmt.Errorf("uc.mfaRepo.RequiredRoles(): %w", err)
+	}
+
+	return slices.Contains(roles, role), nil
+}
+
+// mfaEnrollmentRequired reports whether the role of the user requires MFA the user has not enabled
+func (uc *UseCase) mfaEnrollmentRequired(ctx context.Context, user *entity.User) (bool, error) {
+	required, err := uc.mfaRequired(ctx, user.Role)
+	if err != nil || !required {
+		return false, err
+	}
+
+	mfa, err := uc.mfaRepo.Get(ctx, user.ID)
+	if err != nil {
+		return false, fmt.Errorf("uc.mfaRepo.Get(): %w", err)
+	}
+
+	return !mfa.IsEnabled(), nil
+}
+
+// newRecoveryCodes generates recovery codes formatted for reading and their hashes
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, _recoveryCodeCount)
+	hashes := make([]string, _recoveryCodeCount)
+
+	buf := make([]byte, _recoveryCodeBytes)
+	for i := range codes {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, fmt.Errorf("rand.Read(): %w", err)
+		}
+
+		raw := strings.ToLower(_recoveryEncoding.EncodeToString(buf))
+		hashes[i] = hashToken(raw)
+
+		groups := make([]string, 0, len(raw)/4)
+		for j := 0; j < len(raw); j += 4 {
+			groups = append(groups, raw[j:min(j+4, len(raw))])
+		}
+		codes[i] = strings.Join(groups, "-")
+	}
+
+	return codes, hashes, nil
+}
+
+// normalizeCode drops the separators users type or copy along with a code
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+// isTOTPCode reports whether the normalized code looks like a TOTP code rather than a recovery code
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
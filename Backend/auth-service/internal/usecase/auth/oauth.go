@@ -0,0 +1,224 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+const _maxUsernameLength = 32
+
+// StartOAuth begins a sign-in at the identity provider. The state, the nonce
+// and the PKCE verifier are stored for the callback, only the state leaves the
+// service and should be bound to the user agent by the caller
+func (uc *UseCase) StartOAuth(ctx context.Context, provider string) (*entity.OAuthRedirect, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return nil, usecase.ErrUnknownProvider
+	}
+
+	var secrets [3]string
+	for i := range secrets {
+		token, err := randomToken()
+		if err != nil {
+			return nil, err
+		}
+		secrets[i] = token
+	}
+	state, nonce, verifier := secrets[0], secrets[1], secrets[2]
+
+	now := uc.now()
+	pending := &entity.OAuthState{
+		ID:           uuid.New(),
+		Provider:     provider,
+		StateHash:    hashToken(state),
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(uc.oauthStateTTL),
+	}
+
+	url, err := p.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return nil, fmt.Errorf("p.AuthCodeURL(): %w", err)
+	}
+	if err := uc.identities.CreateState(ctx, pending); err != nil {
+		return nil, fmt.Errorf("uc.identities.CreateState(): %w", err)
+	}
+
+	return &entity.OAuthRedirect{
+		URL:       url,
+		State:     state,
+		ExpiresAt: pending.ExpiresAt,
+	}, nil
+}
+
+// CompleteOAuth finishes a sign-in with the code the identity provider
+// redirected back with and signs the user of the identity in like SignIn
+func (uc *UseCase) CompleteOAuth(
+	ctx context.Context,
+	provider, code, state string,
+) (*entity.TokenPair, *entity.MFAChallenge, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return nil, nil, usecase.ErrUnknownProvider
+	}
+
+	now := uc.now()
+	pending, err := uc.identities.ConsumeState(ctx, hashToken(state), provider, now)
+	if err != nil {
+		return nil, nil, fmt.Errorf("uc.identities.ConsumeState(): %w", err)
+	}
+	if pending == nil {
+		return nil, nil, usecase.ErrInvalidToken
+	}
+
+	profile, err := p.Exchange(ctx, code, pending.CodeVerifier, pending.Nonce)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", usecase.ErrOAuthFailed, err)
+	}
+
+	user, err := uc.identityUser(ctx, p, profile, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return uc.signInUser(ctx, user, provider, now)
+}
+
+// identityUser returns the user the identity is linked to. Unknown identities
+// with a verified email are linked to the account with that email, or a new
+// verified account is created for them
+func (uc *UseCase) identityUser(
+	ctx context.Context,
+	p repo.IdentityProvider,
+	profile *entity.ExternalProfile,
+	now time.Time,
+) (*entity.User, error) {
+	identity, err := uc.identities.Get(ctx, p.Name(), profile.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("uc.identities.Get(): %w", err)
+	}
+
+	if identity != nil {
+		user, err := uc.userRepo.GetByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+		}
+		if user == nil {
+			return nil, usecase.ErrUserNotFound
+		}
+		if err := uc.identities.Touch(ctx, identity.ID, profile.Email, now); err != nil {
+			return nil, fmt.Errorf("uc.identities.Touch(): %w", err)
+		}
+		return user, nil
+	}
+
+	// only an email the provider vouches for proves the account is the user's
+	if profile.Email == "" || !profile.EmailVerified {
+		return nil, usecase.ErrExternalEmailNotVerified
+	}
+
+	identity = &entity.ExternalIdentity{
+		ID:         uuid.New(),
+		Provider:   p.Name(),
+		Subject:    profile.Subject,
+		Email:      profile.Email,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, profile.Email)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userRepo.GetByEmail(): %w", err)
+	}
+
+	created := user == nil
+	if created {
+		user, err = newExternalUser(profile, now)
+		if err != nil {
+			return nil, err
+		}
+		identity.UserID = user.ID
+
+		if err := uc.identities.CreateWithUser(ctx, user, identity); err != nil {
+			return nil, fmt.Errorf("uc.identities.CreateWithUser(): %w", err)
+		}
+	} else {
+		// whoever registered the email unverified may not be its owner,
+		// linking would let them in to the account the owner signs in to
+		if !user.IsVerified() {
+			return nil, usecase.ErrAccountNotLinkable
+		}
+		identity.UserID = user.ID
+
+		if err := uc.identities.Create(ctx, identity); err != nil {
+			return nil, fmt.Errorf("uc.identities.Create(): %w", err)
+		}
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditIdentityLinked,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+		Details: map[string]string{
+			"provider": p.Name(),
+			"subject":  profile.Subject,
+			"new_user": fmt.Sprint(created),
+		},
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// newExternalUser creates a verified user for the profile. The password is
+// random and unknown, a password reset sets one
+func newExternalUser(profile *entity.ExternalProfile, now time.Time) (*entity.User, error) {
+	password, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	user := &entity.User{
+		ID:         uuid.New(),
+		Email:      profile.Email,
+		Username:   externalUsername(profile),
+		Role:       entity.RoleUser,
+		VerifiedAt: &now,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := user.SetPassword(password); err != nil {
+		return nil, fmt.Errorf("user.SetPassword(): %w", err)
+	}
+
+	return user, nil
+}
+
+// externalUsername picks a username of valid length from the profile name or the email
+func externalUsername(profile *entity.ExternalProfile) string {
+	name := strings.TrimSpace(profile.Name)
+	if utf8.RuneCountInString(name) < 3 {
+		name, _, _ = strings.Cut(profile.Email, "@")
+	}
+	if utf8.RuneCountInString(name) < 3 {
+		return "user"
+	}
+	if runes := []rune(name); len(runes) > _maxUsernameLength {
+		name = string(runes[:_maxUsernameLength])
+	}
+
+	return name
+}
@@ -0,0 +1,257 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// _opaqueTokenBytes is the entropy of refresh tokens and mailed tokens
+const _opaqueTokenBytes = 32
+
+// Refresh exchanges a refresh token for a new token pair. Every refresh token
+// is single use: presenting a rotated one again means it leaked, so the whole
+// family descending from that sign-in is revoked
+func (uc *UseCase) Refresh(ctx context.Context, refreshToken string) (*entity.TokenPair, error) {
+	session, err := uc.sessionRepo.GetByTokenHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		return nil, fmt.Errorf("uc.sessionRepo.GetByTokenHash(): %w", err)
+	}
+	if session == nil {
+		return nil, uc.rejectToken(ctx, _kindRefreshToken, uuid.Nil)
+	}
+	if session.RevokedAt != nil {
+		return nil, uc.rejectToken(ctx, _kindRefreshToken, session.UserID)
+	}
+	if session.RotatedAt != nil {
+		return nil, uc.revokeReused(ctx, session)
+	}
+
+	now := uc.now()
+	if session.IsExpired(now) {
+		return nil, uc.rejectToken(ctx, _kindRefreshToken, session.UserID)
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil, usecase.ErrUserNotFound
+	}
+	if user.IsDisabled() {
+		return nil, usecase.ErrUserDisabled
+	}
+	if uc.blocked(user) {
+		return nil, usecase.ErrEmailNotVerified
+	}
+
+	next, token, err := uc.newSession(user.ID, session.FamilyID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := uc.sessionRepo.Rotate(ctx, session.ID, next)
+	if err != nil {
+		return nil, fmt.Errorf("uc.sessionRepo.Rotate(): %w", err)
+	}
+	if !rotated {
+		// a concurrent request rotated the token between the read and the update
+		return nil, uc.revokeReused(ctx, session)
+	}
+
+	return uc.tokenPair(ctx, user, next, token, now)
+}
+
+// startSession opens a new token family for the user and returns its first token pair
+func (uc *UseCase) startSession(ctx context.Context, user *entity.User, now time.Time) (*entity.TokenPair, error) {
+	session, token, err := uc.newSession(user.ID, uuid.New(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.sessionRepo.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("uc.sessionRepo.Create(): %w", err)
+	}
+
+	return uc.tokenPair(ctx, user, session, token, now)
+}
+
+// newSession generates a refresh token of the family, only its hash is kept in the session
+// together with the jti of the access token issued alongside
+func (uc *UseCase) newSession(userID, familyID uuid.UUID, now time.Time) (*entity.Session, string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &entity.Session{
+		ID:        uuid.New(),
+		FamilyID:  familyID,
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		AccessJTI: uuid.New(),
+		CreatedAt: now,
+		ExpiresAt: now.Add(uc.refreshTTL),
+	}, token, nil
+}
+
+// tokenPair signs an access token to go with the refresh token of the session
+func (uc *UseCase) tokenPair(
+	ctx context.Context,
+	user *entity.User,
+	session *entity.Session,
+	refreshToken string,
+	now time.Time,
+) (*entity.TokenPair, error) {
+	perms, enroll, err := uc.permissions(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := uc.makeToken(user, session, uuid.Nil, perms, now)
+	if err != nil {
+		return nil, fmt.Errorf("uc.makeToken(): %w", err)
+	}
+
+	return &entity.TokenPair{
+		UserID:           user.ID,
+		AccessToken:      access,
+		AccessExpiresAt:  now.Add(uc.accessTTL),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: session.ExpiresAt,
+
+		MFAEnrollmentRequired: enroll,
+	}, nil
+}
+
+// SignOut revokes the session of the access token and the token itself
+func (uc *UseCase) SignOut(ctx context.Context, claims *entity.AccessClaims) error {
+	if err := uc.denylist.Add(ctx, claims.JTI, claims.ExpiresAt); err != nil {
+		return fmt.Errorf("uc.denylist.Add(): %w", err)
+	}
+
+	if _, err := uc.revokeFamily(ctx, claims.UserID, claims.SessionID); err != nil {
+		return err
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditSignOut,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorOf(claims),
+		TargetID: claims.UserID,
+		Details:  map[string]string{"session_id": claims.SessionID.String()},
+	}, uc.now())
+}
+
+// ListSessions returns the active sessions of the user
+func (uc *UseCase) ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	sessions, err := uc.sessionRepo.ListActive(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.sessionRepo.ListActive(): %w", err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes one of the user's sessions along with its live access tokens
+func (uc *UseCase) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	n, err := uc.revokeFamily(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return usecase.ErrSessionNotFound
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditSessionRevoked,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  userID,
+		TargetID: userID,
+		Details:  map[string]string{"session_id": sessionID.String()},
+	}, uc.now())
+}
+
+// revokeAll revokes every session of the user along with its live access tokens
+func (uc *UseCase) revokeAll(ctx context.Context, userID uuid.UUID) error {
+	revoked, err := uc.sessionRepo.RevokeAll(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("uc.sessionRepo.RevokeAll(): %w", err)
+	}
+
+	return uc.denyAccessTokens(ctx, revoked)
+}
+
+// revokeReused revokes the family of a reused refresh token
+func (uc *UseCase) revokeReused(ctx context.Context, session *entity.Session) error {
+	if _, err := uc.revokeFamily(ctx, session.UserID, session.FamilyID); err != nil {
+		return err
+	}
+
+	uc.auditAuthentication(ctx, entity.AuditEvent{
+		Type:     entity.AuditRefreshReused,
+		Outcome:  entity.AuditFailure,
+		TargetID: session.UserID,
+		Details:  map[string]string{"session_id": session.FamilyID.String()},
+	}, uc.now())
+
+	return usecase.ErrRefreshTokenReused
+}
+
+// revokeFamily revokes the refresh tokens of a family and denies access tokens
+// issued with them that have not expired yet. Returns the number of revoked tokens
+func (uc *UseCase) revokeFamily(ctx context.Context, userID, familyID uuid.UUID) (int, error) {
+	revoked, err := uc.sessionRepo.RevokeFamily(ctx, userID, familyID)
+	if err != nil {
+		return 0, fmt.Errorf("uc.sessionRepo.RevokeFamily(): %w", err)
+	}
+
+	if err := uc.denyAccessTokens(ctx, revoked); err != nil {
+		return 0, err
+	}
+
+	return len(revoked), nil
+}
+
+// denyAccessTokens denies the access tokens issued with revoked refresh tokens
+// that have not expired yet
+func (uc *UseCase) denyAccessTokens(ctx context.Context, revoked []*entity.Session) error {
+	now := uc.now()
+	for _, s := range revoked {
+		expiresAt := s.CreatedAt.Add(uc.accessTTL)
+		if s.AccessJTI == uuid.Nil || !expiresAt.After(now) {
+			continue
+		}
+		if err := uc.denylist.Add(ctx, s.AccessJTI, expiresAt); err != nil {
+			return fmt.Errorf("uc.denylist.Add(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// randomToken generates an opaque token for refresh tokens, mailed links and MFA challenges
+func randomToken() (string, error) {
+	buf := make([]byte, _opaqueTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("rand.Read(): %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken returns the hex SHA-256 digest of an opaque token. Tokens are
+// random with 256 bits of entropy, so a fast unsalted hash is enough
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
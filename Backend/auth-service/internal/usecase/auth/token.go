@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,26 +12,68 @@ import (
 	"github.com/google/uuid"
 )
 
-// makeToken creates a JWT-token for user
-func (uc *UseCase) makeToken(user *entity.User, currentTime time.Time) (string, error) {
+// makeToken creates a JWT-token for user, bound to the session by the sid claim
+// and identified by the jti claim so that it can be revoked before it expires.
+// The role and its permissions are embedded so that services can authorize
+// without asking auth-service. The role is left out while the permissions are
+// withheld, so that no service can grant by role what the token does not, a
+// non-nil actor is set as the act claim of impersonation tokens
+func (uc *UseCase) makeToken(
+	user *entity.User,
+	session *entity.Session,
+	actorID uuid.UUID,
+	perms []string,
+	currentTime time.Time,
+) (string, error) {
+	key, err := uc.keys.Signing()
+	if err != nil {
+		return "", err
+	}
+
 	claims := jwt.MapClaims{
-		"sub":      user.ID.String(),
-		"email":    user.Email,
-		"username": user.Username,
-		"exp":      currentTime.Add(uc.tokenTTL).Unix(),
-		"iat":      currentTime.Unix(),
-	}
-	tok := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return tok.SignedString(uc.jwtKey)
+		"iss":            uc.issuer,
+		"sub":            user.ID.String(),
+		"sid":            session.FamilyID.String(),
+		"jti":            session.AccessJTI.String(),
+		"email":          user.Email,
+		"username":       user.Username,
+		"perms":          perms,
+		"email_verified": user.IsVerified(),
+		"exp":            currentTime.Add(uc.accessTTL).Unix(),
+		"iat":            currentTime.Unix(),
+	}
+	if len(perms) > 0 {
+		claims["role"] = string(user.Role)
+		claims["is_admin"] = user.IsAdmin()
+	}
+	if actorID != uuid.Nil {
+		claims["act"] = map[string]string{"sub": actorID.String()}
+	}
+	tok := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	tok.Header["kid"] = key.ID
+	return tok.SignedString(key.Private)
 }
 
-// ValidateToken validates a JWT token and returns the associated user
-func (uc *UseCase) ValidateToken(ctx context.Context, tokenString string) (*entity.User, error) {
+// Authenticate verifies a JWT token and checks it against the denylist,
+// rejected tokens are recorded in the audit log
+func (uc *UseCase) Authenticate(ctx context.Context, tokenString string) (*entity.AccessClaims, error) {
+	claims, err := uc.authenticate(ctx, tokenString)
+	if errors.Is(err, usecase.ErrInvalidToken) {
+		return nil, uc.rejectToken(ctx, _kindAccessToken, uuid.Nil)
+	}
+
+	return claims, err
+}
+
+// authenticate verifies a JWT token and checks it against the denylist
+func (uc *UseCase) authenticate(ctx context.Context, tokenString string) (*entity.AccessClaims, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, _ := token.Header["kid"].(string)
+		key := uc.keys.Key(kid)
+		if key == nil || token.Method.Alg() != key.Algorithm {
 			return nil, usecase.ErrInvalidToken
 		}
-		return uc.jwtKey, nil
+		return key.Public(), nil
 	})
 	if err != nil || !token.Valid {
 		return nil, usecase.ErrInvalidToken
@@ -42,26 +85,124 @@ func (uc *UseCase) ValidateToken(ctx context.Context, tokenString string) (*enti
 		return nil, usecase.ErrInvalidToken
 	}
 
-	if exp, ok := claims["exp"].(float64); !ok || float64(time.Now().Unix()) > exp {
+	if !claims.VerifyIssuer(uc.issuer, true) {
 		return nil, usecase.ErrInvalidToken
 	}
 
-	subject, ok := claims["sub"].(string)
-	if !ok {
+	exp, ok := claims["exp"].(float64)
+	if !ok || float64(uc.now().Unix()) > exp {
 		return nil, usecase.ErrInvalidToken
 	}
-	userID, err := uuid.Parse(subject)
+
+	userID, err := uuidClaim(claims, "sub")
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := uuidClaim(claims, "sid")
+	if err != nil {
+		return nil, err
+	}
+	jti, err := uuidClaim(claims, "jti")
+	if err != nil {
+		return nil, err
+	}
+
+	// tokens withholding the permissions have no role
+	var role entity.Role
+	if roleName, ok := claims["role"]; ok {
+		name, _ := roleName.(string)
+		if role, err = entity.ParseRole(name); err != nil {
+			return nil, usecase.ErrInvalidToken
+		}
+	}
+
+	perms, err := stringsClaim(claims, "perms")
+	if err != nil {
+		return nil, err
+	}
+
+	var actorID uuid.UUID
+	if act, ok := claims["act"].(map[string]interface{}); ok {
+		if actorID, err = uuidClaim(act, "sub"); err != nil {
+			return nil, err
+		}
+	}
+
+	denied, err := uc.denylist.Contains(ctx, jti)
 	if err != nil {
+		return nil, fmt.Errorf("uc.denylist.Contains(): %w", err)
+	}
+	if denied {
 		return nil, usecase.ErrInvalidToken
 	}
 
-	user, err := uc.userRepo.GetByID(ctx, userID)
+	return &entity.AccessClaims{
+		UserID:      userID,
+		SessionID:   sessionID,
+		JTI:         jti,
+		Role:        role,
+		Permissions: perms,
+		ExpiresAt:   time.Unix(int64(exp), 0).UTC(),
+		ActorID:     actorID,
+	}, nil
+}
+
+// ValidateToken validates a JWT token and returns the associated user with the
+// token claims. The claims hold what the token grants, which is less than the
+// user's role while permissions are withheld
+func (uc *UseCase) ValidateToken(ctx context.Context, tokenString string) (*entity.User, *entity.AccessClaims, error) {
+	claims, err := uc.Authenticate(ctx, tokenString)
 	if err != nil {
-		return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+		return nil, nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
 	}
 	if user == nil {
-		return nil, usecase.ErrUserNotFound
+		return nil, nil, usecase.ErrUserNotFound
+	}
+	if user.IsDisabled() {
+		return nil, nil, usecase.ErrUserDisabled
+	}
+
+	return user, claims, nil
+}
+
+// stringsClaim parses a claim holding a list of strings, a missing claim is an empty list
+func stringsClaim(claims map[string]interface{}, name string) ([]string, error) {
+	raw, ok := claims[name]
+	if !ok {
+		return nil, nil
+	}
+
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, usecase.ErrInvalidToken
+	}
+
+	values := make([]string, len(items))
+	for i, item := range items {
+		if values[i], ok = item.(string); !ok {
+			return nil, usecase.ErrInvalidToken
+		}
+	}
+
+	return values, nil
+}
+
+// uuidClaim parses a string claim holding a UUID
+func uuidClaim(claims map[string]interface{}, name string) (uuid.UUID, error) {
+	raw, ok := claims[name].(string)
+	if !ok {
+		return uuid.Nil, usecase.ErrInvalidToken
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, usecase.ErrInvalidToken
 	}
 
-	return user, nil
+	return id, nil
 }
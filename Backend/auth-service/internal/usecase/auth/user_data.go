@@ -0,0 +1,503 @@
+package auth
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+const (
+	_contentTypeJSON = "application/json"
+
+	_auditExportPage = 500 // audit events read per query of an export
+
+	_deletionBatch = 20               // deletions claimed per ProcessDeletions run
+	_deletionLease = 10 * time.Minute // time a claimed deletion is hidden from other instances
+)
+
+// DeletionPolicy is how failed account deletions are retried. Every failed
+// attempt waits twice as long as the one before, starting at BaseDelay and
+// capped at MaxDelay
+type DeletionPolicy struct {
+	BaseDelay   time.Duration // wait after the first failed attempt
+	MaxDelay    time.Duration // longest wait between attempts
+	MaxAttempts int           // failed attempts before the deletion is left to staff
+}
+
+// delay returns how long to wait after the failed attempts
+func (p DeletionPolicy) delay(attempts int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempts && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+
+	return min(d, p.MaxDelay)
+}
+
+// ExportUserData collects the data of the claims' user kept by auth-service
+// and every other service into a zip archive with a folder per service. The
+// export fails as a whole if a service cannot be reached
+func (uc *UseCase) ExportUserData(ctx context.Context, claims *entity.AccessClaims) (*entity.DataExport, error) {
+	if claims.ActorID != uuid.Nil {
+		return nil, usecase.ErrImpersonated
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil, usecase.ErrUserNotFound
+	}
+	now := uc.now()
+
+	folders := make(map[string][]entity.DataFile, len(uc.services)+1)
+	if folders[entity.DeletionStepAccount], err = uc.exportAccount(ctx, user); err != nil {
+		return nil, err
+	}
+	for _, s := range uc.services {
+		files, err := s.Export(ctx, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", usecase.ErrServiceUnavailable, err)
+		}
+		folders[s.Name()] = files
+	}
+
+	archive, err := zipFolders(user.ID, folders, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditDataExported,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+		Details:  map[string]string{"size": strconv.Itoa(len(archive))},
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.DataExport{
+		FileName:  "financialadviser-export-" + now.Format("20060102-150405") + ".zip",
+		Archive:   archive,
+		CreatedAt: now,
+	}, nil
+}
+
+// exportAccount returns the files of the data auth-service keeps about the
+// user, secrets such as password and token hashes are left out
+func (uc *UseCase) exportAccount(ctx context.Context, user *entity.User) ([]entity.DataFile, error) {
+	mfa, err := uc.mfaRepo.Get(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.mfaRepo.Get(): %w", err)
+	}
+	identities, err := uc.identities.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.identities.ListByUser(): %w", err)
+	}
+	sessions, err := uc.sessionRepo.ListActive(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.sessionRepo.ListActive(): %w", err)
+	}
+	apiKeys, err := uc.apiKeys.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.apiKeys.ListByUser(): %w", err)
+	}
+	profile, err := uc.profiles.Get(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.profiles.Get(): %w", err)
+	}
+	assessments, err := uc.profiles.ListAssessments(ctx, user.ID, math.MaxInt32)
+	if err != nil {
+		return nil, fmt.Errorf("uc.profiles.ListAssessments(): %w", err)
+	}
+	events, err := uc.auditTrail(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var mfaEnabledAt *time.Time
+	if mfa != nil {
+		mfaEnabledAt = mfa.EnabledAt
+	}
+
+	return jsonFiles(map[string]any{
+		"account.json": map[string]any{
+			"user":                user,
+			"mfa_enabled_at":      mfaEnabledAt,
+			"external_identities": identities,
+		},
+		"sessions.json":         sessions,
+		"api_keys.json":         apiKeys,
+		"profile.json":          profile,
+		"risk_assessments.json": assessments,
+		"security_events.json":  events,
+	})
+}
+
+// auditTrail returns every audit event concerning the user, newest first
+func (uc *UseCase) auditTrail(ctx context.Context, userID uuid.UUID) ([]*entity.AuditEvent, error) {
+	filter := entity.AuditFilter{TargetID: userID, Limit: _auditExportPage}
+
+	var events []*entity.AuditEvent
+	for {
+		page, err := uc.auditLog.List(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("uc.auditLog.List(): %w", err)
+		}
+		events = append(events, page...)
+
+		if len(page) < filter.Limit {
+			return events, nil
+		}
+		filter.After = page[len(page)-1].Cursor()
+	}
+}
+
+// DeleteAccount starts the deletion of the claims' user after checking their
+// password. The account is disabled and signed out right away, the data is
+// deleted in the background by ProcessDeletions. An unfinished deletion of
+// the user is returned instead of starting another one
+func (uc *UseCase) DeleteAccount(
+	ctx context.Context,
+	claims *entity.AccessClaims,
+	password string,
+) (*entity.AccountDeletion, error) {
+	if claims.ActorID != uuid.Nil {
+		return nil, usecase.ErrImpersonated
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil, usecase.ErrUserNotFound
+	}
+	now := uc.now()
+	if !user.CheckPassword(password) {
+		err := uc.audit(ctx, entity.AuditEvent{
+			Type:     entity.AuditAccountDeletionRequested,
+			Outcome:  entity.AuditFailure,
+			ActorID:  user.ID,
+			TargetID: user.ID,
+			Details:  map[string]string{"reason": usecase.ErrInvalidPassword.Error()},
+		}, now)
+		if err != nil {
+			return nil, err
+		}
+		return nil, usecase.ErrInvalidPassword
+	}
+
+	deletion, err := uc.deletions.GetUnfinished(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.deletions.GetUnfinished(): %w", err)
+	}
+	if deletion == nil {
+		pending := make([]string, 0, len(uc.services)+1)
+		for _, s := range uc.services {
+			pending = append(pending, s.Name())
+		}
+
+		deletion = &entity.AccountDeletion{
+			ID:            uuid.New(),
+			UserID:        user.ID,
+			Status:        entity.DeletionPending,
+			Pending:       append(pending, entity.DeletionStepAccount),
+			NextAttemptAt: now,
+			RequestedAt:   now,
+		}
+		if err := uc.deletions.Create(ctx, deletion); err != nil {
+			return nil, fmt.Errorf("uc.deletions.Create(): %w", err)
+		}
+	}
+
+	if !user.IsDisabled() {
+		user.DisabledAt = &now
+		user.UpdatedAt = now
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("uc.userRepo.Update(): %w", err)
+		}
+	}
+	if err := uc.revokeAll(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditAccountDeletionRequested,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  user.ID,
+		TargetID: user.ID,
+		Details:  map[string]string{"deletion_id": deletion.ID.String()},
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+// AccountDeletion returns the deletion with the id, users track their
+// deletion by its id as they cannot sign in any more
+func (uc *UseCase) AccountDeletion(ctx context.Context, id uuid.UUID) (*entity.AccountDeletion, error) {
+	deletion, err := uc.deletions.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("uc.deletions.Get(): %w", err)
+	}
+	if deletion == nil {
+		return nil, usecase.ErrDeletionNotFound
+	}
+
+	return deletion, nil
+}
+
+// RetryAccountDeletion schedules a pending or failed deletion right away
+// with a fresh budget of attempts
+func (uc *UseCase) RetryAccountDeletion(ctx context.Context, actorID, id uuid.UUID) (*entity.AccountDeletion, error) {
+	deletion, err := uc.AccountDeletion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deletion.Status == entity.DeletionCompleted {
+		return nil, usecase.ErrDeletionCompleted
+	}
+
+	now := uc.now()
+	deletion.Status = entity.DeletionPending
+	deletion.Attempts = 0
+	deletion.NextAttemptAt = now
+	if err := uc.deletions.Update(ctx, deletion); err != nil {
+		return nil, fmt.Errorf("uc.deletions.Update(): %w", err)
+	}
+
+	err = uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditAccountDeletionRetried,
+		Outcome:  entity.AuditSuccess,
+		ActorID:  actorID,
+		TargetID: deletion.UserID,
+		Details:  map[string]string{"deletion_id": deletion.ID.String()},
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+// ProcessDeletions runs the due steps of pending deletions and returns how
+// many deletions completed. Failed steps are retried with a growing delay
+// until the policy gives up on the deletion
+func (uc *UseCase) ProcessDeletions(ctx context.Context) (int, error) {
+	now := uc.now()
+
+	due, err := uc.deletions.Claim(ctx, now, _deletionLease, _deletionBatch)
+	if err != nil {
+		return 0, fmt.Errorf("uc.deletions.Claim(): %w", err)
+	}
+
+	completed := 0
+	for _, deletion := range due {
+		if err := uc.processDeletion(ctx, deletion, now); err != nil {
+			return completed, err
+		}
+		if deletion.Status == entity.DeletionCompleted {
+			completed++
+		}
+	}
+
+	return completed, nil
+}
+
+// processDeletion runs the pending steps of the deletion in order. The
+// account itself is deleted only after every other service succeeded, so
+// that a failed deletion can still be found by its user
+func (uc *UseCase) processDeletion(ctx context.Context, deletion *entity.AccountDeletion, now time.Time) error {
+	var (
+		pending  []string
+		failures []string
+	)
+	for _, step := range deletion.Pending {
+		if len(failures) > 0 && step == entity.DeletionStepAccount {
+			pending = append(pending, step)
+			continue
+		}
+		if err := uc.deletionStep(ctx, deletion, step); err != nil {
+			pending = append(pending, step)
+			failures = append(failures, err.Error())
+		}
+	}
+	deletion.Pending = pending
+
+	if len(failures) == 0 {
+		deletion.Status = entity.DeletionCompleted
+		deletion.Pending = []string{}
+		deletion.LastError = ""
+		deletion.CompletedAt = &now
+		if err := uc.deletions.Update(ctx, deletion); err != nil {
+			return fmt.Errorf("uc.deletions.Update(): %w", err)
+		}
+
+		return uc.audit(ctx, entity.AuditEvent{
+			Type:     entity.AuditAccountDeleted,
+			Outcome:  entity.AuditSuccess,
+			TargetID: deletion.UserID,
+			Details:  map[string]string{"deletion_id": deletion.ID.String()},
+		}, now)
+	}
+
+	deletion.Attempts++
+	deletion.LastError = strings.Join(failures, "; ")
+	deletion.NextAttemptAt = now.Add(uc.deletion.delay(deletion.Attempts))
+	if deletion.Attempts >= uc.deletion.MaxAttempts {
+		deletion.Status = entity.DeletionFailed
+	}
+	if err := uc.deletions.Update(ctx, deletion); err != nil {
+		return fmt.Errorf("uc.deletions.Update(): %w", err)
+	}
+
+	if deletion.Status != entity.DeletionFailed {
+		return nil
+	}
+
+	return uc.audit(ctx, entity.AuditEvent{
+		Type:     entity.AuditAccountDeletionFailed,
+		Outcome:  entity.AuditFailure,
+		TargetID: deletion.UserID,
+		Details: map[string]string{
+			"deletion_id": deletion.ID.String(),
+			"pending":     strings.Join(deletion.Pending, ","),
+			"error":       deletion.LastError,
+		},
+	}, now)
+}
+
+// deletionStep deletes the data of the deletion's user kept by the service
+// named step, DeletionStepAccount deletes the account in auth-service
+func (uc *UseCase) deletionStep(ctx context.Context, deletion *entity.AccountDeletion, step string) error {
+	if step == entity.DeletionStepAccount {
+		return uc.deleteUser(ctx, deletion.UserID)
+	}
+
+	for _, s := range uc.services {
+		if s.Name() == step {
+			return s.Delete(ctx, deletion.UserID, deletion.ID)
+		}
+	}
+
+	return fmt.Errorf("%s: service not configured", step)
+}
+
+// deleteUser deletes the account with everything that references it and
+// forgets its failed sign-ins. Audit events stay until the audit retention
+// purges them, a security log must outlive the accounts it is about
+func (uc *UseCase) deleteUser(ctx context.Context, userID uuid.UUID) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("uc.userRepo.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil
+	}
+
+	if err := uc.throttle.Reset(ctx, entity.AccountThrottleKey(user.Email)); err != nil {
+		return fmt.Errorf("uc.throttle.Reset(): %w", err)
+	}
+	if err := uc.userRepo.Delete(ctx, user.ID); err != nil {
+		return fmt.Errorf("uc.userRepo.Delete(): %w", err)
+	}
+
+	return nil
+}
+
+// jsonFiles encodes the values into indented JSON files of the names, sorted by name
+func jsonFiles(values map[string]any) ([]entity.DataFile, error) {
+	files := make([]entity.DataFile, 0, len(values))
+	for name, v := range values {
+		content, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("json.MarshalIndent(%s): %w", name, err)
+		}
+		files = append(files, entity.DataFile{Name: name, ContentType: _contentTypeJSON, Content: content})
+	}
+	slices.SortFunc(files, func(a, b entity.DataFile) int { return strings.Compare(a.Name, b.Name) })
+
+	return files, nil
+}
+
+// zipFolders writes the files of every service into its folder of a zip
+// archive, next to a manifest listing what the archive holds
+func zipFolders(userID uuid.UUID, folders map[string][]entity.DataFile, now time.Time) ([]byte, error) {
+	type manifestFile struct {
+		Name        string `json:"name"`
+		ContentType string `json:"content_type"`
+		Size        int    `json:"size"`
+	}
+	manifest := struct {
+		UserID    uuid.UUID                 `json:"user_id"`
+		CreatedAt time.Time                 `json:"created_at"`
+		Services  map[string][]manifestFile `json:"services"`
+	}{
+		UserID:    userID,
+		CreatedAt: now,
+		Services:  make(map[string][]manifestFile, len(folders)),
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	write := func(name string, content []byte) error {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: now})
+		if err != nil {
+			return fmt.Errorf("w.CreateHeader(%s): %w", name, err)
+		}
+		if _, err := f.Write(content); err != nil {
+			return fmt.Errorf("f.Write(%s): %w", name, err)
+		}
+		return nil
+	}
+
+	for _, service := range slices.Sorted(maps.Keys(folders)) {
+		manifest.Services[service] = []manifestFile{}
+		for _, file := range folders[service] {
+			// names come from other services, keep them inside their folder
+			name := path.Base(path.Clean("/" + file.Name))
+			if err := write(service+"/"+name, file.Content); err != nil {
+				return nil, err
+			}
+			manifest.Services[service] = append(manifest.Services[service], manifestFile{
+				Name:        service + "/" + name,
+				ContentType: file.ContentType,
+				Size:        len(file.Content),
+			})
+		}
+	}
+
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("json.MarshalIndent(manifest): %w", err)
+	}
+	if err := write("manifest.json", content); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("w.Close(): %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,212 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase/auth"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/logger"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/totp"
+)
+
+const (
+	_secret   = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	_password = "correct horse battery"
+)
+
+// _now is the fixed clock of the use case, in the middle of a TOTP step
+var _now = time.Date(2025, 6, 6, 12, 0, 10, 0, time.UTC)
+
+type authMocks struct {
+	users      *MockUserRepository
+	sessions   *MockSessionRepository
+	denylist   *MockTokenDenylist
+	userTokens *MockUserTokenRepository
+	mfa        *MockMFARepository
+	throttle   *MockThrottleRepository
+	audit      *MockAuditLog
+	identities *MockIdentityRepository
+	apiKeys    *MockAPIKeyRepository
+	profiles   *MockProfileRepository
+	deletions  *MockAccountDeletionRepository
+	provider   *MockIdentityProvider
+	brain      *MockUserDataService
+	keys       *MockKeyRing
+	mailer     *MockMailer
+	logger     *fakeLogger
+
+	// events are the audit events recorded so far
+	events []entity.AuditEvent
+	// auditErr fails the audit log instead, when set
+	auditErr error
+}
+
+// fakeLogger records error messages
+type fakeLogger struct {
+	logger.Interface
+	errors []string
+}
+
+func (l *fakeLogger) Error(message interface{}, args ...interface{}) {
+	l.errors = append(l.errors, fmt.Sprintf(fmt.Sprint(message), args...))
+}
+
+func authUseCase(t *testing.T) (*auth.UseCase, *authMocks) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	m := &authMocks{
+		users:      NewMockUserRepository(mockCtl),
+		sessions:   NewMockSessionRepository(mockCtl),
+		denylist:   NewMockTokenDenylist(mockCtl),
+		userTokens: NewMockUserTokenRepository(mockCtl),
+		mfa:        NewMockMFARepository(mockCtl),
+		throttle:   NewMockThrottleRepository(mockCtl),
+		audit:      NewMockAuditLog(mockCtl),
+		identities: NewMockIdentityRepository(mockCtl),
+		apiKeys:    NewMockAPIKeyRepository(mockCtl),
+		profiles:   NewMockProfileRepository(mockCtl),
+		deletions:  NewMockAccountDeletionRepository(mockCtl),
+		provider:   NewMockIdentityProvider(mockCtl),
+		brain:      NewMockUserDataService(mockCtl),
+		keys:       NewMockKeyRing(mockCtl),
+		mailer:     NewMockMailer(mockCtl),
+		logger:     &fakeLogger{},
+	}
+	m.provider.EXPECT().Name().Return(entity.ProviderGoogle).AnyTimes()
+	m.brain.EXPECT().Name().Return("brain").AnyTimes()
+	m.audit.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, e entity.AuditEvent) error {
+			if m.auditErr != nil {
+				return m.auditErr
+			}
+			m.events = append(m.events, e)
+			return nil
+		}).AnyTimes()
+
+	useCase := auth.New(
+		m.users,
+		m.sessions,
+		m.denylist,
+		m.userTokens,
+		m.mfa,
+		m.throttle,
+		m.audit,
+		m.identities,
+		m.apiKeys,
+		m.profiles,
+		m.deletions,
+		m.keys,
+		m.mailer,
+		[]repo.IdentityProvider{m.provider},
+		[]repo.UserDataService{m.brain},
+		auth.Config{
+			Issuer:            "auth-service",
+			AccessTTL:         15 * time.Minute,
+			RefreshTTL:        time.Hour,
+			VerificationTTL:   24 * time.Hour,
+			ResetTTL:          time.Hour,
+			UnverifiedPolicy:  auth.UnverifiedAllow,
+			AppURL:            "https://app.example",
+			MFAIssuer:         "FinancialAdviser",
+			ChallengeTTL:      5 * time.Minute,
+			ChallengeAttempts: 5,
+			Clock:             func() time.Time { return _now },
+			Lockout: auth.LockoutPolicy{
+				AccountThreshold: 3,
+				IPThreshold:      10,
+				BaseDelay:        30 * time.Second,
+				MaxDelay:         10 * time.Minute,
+				Window:           24 * time.Hour,
+			},
+			OAuthStateTTL:  10 * time.Minute,
+			APIKeyTTL:      90 * 24 * time.Hour,
+			APIKeyMaxTTL:   365 * 24 * time.Hour,
+			APIKeysPerUser: 3,
+			Deletion: auth.DeletionPolicy{
+				BaseDelay:   time.Minute,
+				MaxDelay:    time.Hour,
+				MaxAttempts: 3,
+			},
+			Logger: m.logger,
+		},
+	)
+
+	return useCase, m
+}
+
+// eventsOf returns the recorded audit events of the type
+func (m *authMocks) eventsOf(typ entity.AuditEventType) []entity.AuditEvent {
+	var events []entity.AuditEvent
+	for _, e := range m.events {
+		if e.Type == typ {
+			events = append(events, e)
+		}
+	}
+
+	return events
+}
+
+// expectSession sets up the calls of issuing a token pair for a user whose role does not require MFA
+func (m *authMocks) expectSession(t *testing.T) {
+	t.Helper()
+
+	key, err := entity.GenerateSigningKey("EdDSA", _now.Add(-time.Hour))
+	require.NoError(t, err)
+
+	m.sessions.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return(nil, nil)
+	m.keys.EXPECT().Signing().Return(key, nil)
+}
+
+// expectPasswordChecked sets up the calls around a successful password check of an unlocked account
+func (m *authMocks) expectPasswordChecked(user *entity.User) {
+	m.throttle.EXPECT().LockedUntil(gomock.Any(), gomock.Any(), _now).Return(time.Time{}, nil)
+	m.users.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(user, nil)
+	m.throttle.EXPECT().Reset(gomock.Any(), entity.AccountThrottleKey(user.Email)).Return(nil)
+}
+
+func testUser(t *testing.T, role entity.Role) *entity.User {
+	t.Helper()
+
+	user := &entity.User{ID: uuid.New(), Email: "jane@example.com", Username: "jane", Role: role}
+	require.NoError(t, user.SetPassword(_password))
+
+	return user
+}
+
+func enabledMFA(userID uuid.UUID, lastUsedStep int64) *entity.MFA {
+	enabledAt := _now.Add(-24 * time.Hour)
+
+	return &entity.MFA{
+		UserID:       userID,
+		Secret:       _secret,
+		EnabledAt:    &enabledAt,
+		LastUsedStep: lastUsedStep,
+	}
+}
+
+func codeAt(t *testing.T, step int64) string {
+	t.Helper()
+
+	code, err := totp.Code(_secret, step)
+	require.NoError(t, err)
+
+	return code
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+)
+
+type clientKey struct{}
+
+// NewClientContext returns a copy of ctx carrying the end user the request was made by
+func NewClientContext(ctx context.Context, client entity.Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+// ClientFromContext returns the end user of the request, empty if unknown
+func ClientFromContext(ctx context.Context) entity.Client {
+	client, _ := ctx.Value(clientKey{}).(entity.Client)
+	return client
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=contracts.go -destination=./mocks_usecase_test.go -package=usecase_test
@@ -11,14 +13,213 @@ import (
 // AuthUseCase defines the interface for authentication operations
 type AuthUseCase interface {
 	// SignUp creates a new user with the provided credentials.
-	// Returns a JWT token and error if any
-	SignUp(ctx context.Context, email, password, username string) (string, error)
+	// Returns an access and refresh token pair and error if any
+	SignUp(ctx context.Context, email, password, username string) (*entity.TokenPair, error)
 
 	// SignIn authenticates a user with the provided credentials.
-	// Returns a JWT token and error if any
-	SignIn(ctx context.Context, email, password string) (string, error)
+	// Returns an access and refresh token pair, or a challenge for VerifyMFA
+	// if the user has MFA enabled, and error if any.
+	// Returns ErrInvalidCredentials for unknown emails and wrong passwords alike
+	// and ErrTooManyAttempts while the account or the client is locked
+	SignIn(ctx context.Context, email, password string) (*entity.TokenPair, *entity.MFAChallenge, error)
 
-	// ValidateToken validates a JWT token and returns the associated user.
+	// StartOAuth begins a sign-in at the identity provider.
+	// Returns where to send the user and ErrUnknownProvider if the provider is not configured
+	StartOAuth(ctx context.Context, provider string) (*entity.OAuthRedirect, error)
+
+	// CompleteOAuth finishes a sign-in with the code the identity provider
+	// redirected back with. Unknown identities are linked to the account with
+	// the same email or get a new account, the provider must have verified the email.
+	// Returns a token pair or an MFA challenge like SignIn, ErrInvalidToken if
+	// the state is unknown, used or expired and ErrOAuthFailed if the provider
+	// rejects the code
+	CompleteOAuth(ctx context.Context, provider, code, state string) (*entity.TokenPair, *entity.MFAChallenge, error)
+
+	// VerifyMFA completes a sign-in with a TOTP or recovery code.
+	// Returns ErrInvalidToken if the challenge is unknown, used, expired or out of attempts
+	// and ErrInvalidMFACode if the code is wrong
+	VerifyMFA(ctx context.Context, challengeToken, code string) (*entity.TokenPair, error)
+
+	// Refresh rotates a refresh token and issues a new token pair.
+	// Returns ErrRefreshTokenReused if the token was rotated before
+	Refresh(ctx context.Context, refreshToken string) (*entity.TokenPair, error)
+
+	// ValidateToken validates a JWT token and returns the associated user with the token claims.
 	// Returns nil user and error if token is invalid or user not found
-	ValidateToken(ctx context.Context, token string) (*entity.User, error)
+	ValidateToken(ctx context.Context, token string) (*entity.User, *entity.AccessClaims, error)
+
+	// Authenticate verifies a JWT token, including revocation, and returns its claims.
+	// Returns ErrInvalidToken if the token is invalid, expired or revoked
+	Authenticate(ctx context.Context, token string) (*entity.AccessClaims, error)
+
+	// SignOut revokes the access token of the claims and its session
+	SignOut(ctx context.Context, claims *entity.AccessClaims) error
+
+	// ListSessions returns the active sessions of the user
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+
+	// RevokeSession revokes a session of the user.
+	// Returns ErrSessionNotFound if the user has no such active session
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+
+	// CreateAPIKey creates a personal API key granting the scopes, the key is
+	// returned only this once. A zero ttl means the default lifetime.
+	// Returns ErrInvalidScope if a scope is not a permission of the claims,
+	// ErrAPIKeyLimit if the user has too many active keys and ErrImpersonated
+	// for impersonation tokens
+	CreateAPIKey(ctx context.Context, claims *entity.AccessClaims, name string, scopes []string, ttl time.Duration) (*entity.NewAPIKey, error)
+
+	// ListAPIKeys returns the API keys of the user that are not revoked
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]*entity.APIKey, error)
+
+	// RevokeAPIKey revokes an API key of the user of the claims.
+	// Returns ErrAPIKeyNotFound if the user has no such key that is not revoked
+	RevokeAPIKey(ctx context.Context, claims *entity.AccessClaims, keyID uuid.UUID) error
+
+	// AuthenticateAPIKey checks an API key and returns its user and the permissions it grants.
+	// Returns ErrInvalidToken if the key is unknown, revoked or expired
+	AuthenticateAPIKey(ctx context.Context, key string) (*entity.APIKeyClaims, error)
+
+	// ResendVerification mails a new email verification link.
+	// Returns nil for unknown emails so that registered ones cannot be probed
+	ResendVerification(ctx context.Context, email string) error
+
+	// VerifyEmail confirms the email with a mailed token.
+	// Returns ErrInvalidToken if the token is unknown, used or expired
+	VerifyEmail(ctx context.Context, token string) error
+
+	// RequestPasswordReset mails a password reset link.
+	// Returns nil for unknown emails so that registered ones cannot be probed
+	RequestPasswordReset(ctx context.Context, email string) error
+
+	// ResetPassword sets a new password with a mailed token and revokes all sessions.
+	// Returns ErrInvalidToken if the token is unknown, used or expired
+	ResetPassword(ctx context.Context, token, password string) error
+
+	// ChangePassword sets a new password after checking the current one and
+	// revokes the other sessions of the user.
+	// Returns ErrInvalidPassword if the current password does not match
+	ChangePassword(ctx context.Context, claims *entity.AccessClaims, current, password string) error
+
+	// EnrollMFA starts a TOTP enrollment and returns the secret and provisioning URI.
+	// Returns ErrMFAAlreadyEnabled if the user has MFA enabled
+	EnrollMFA(ctx context.Context, claims *entity.AccessClaims) (*entity.MFAEnrollment, error)
+
+	// ConfirmMFA enables the pending enrollment with a code and returns the recovery codes.
+	// Returns ErrMFANotEnabled if there is no enrollment and ErrInvalidMFACode if the code is wrong
+	ConfirmMFA(ctx context.Context, claims *entity.AccessClaims, code string) ([]string, error)
+
+	// DisableMFA turns MFA off after checking a TOTP or recovery code.
+	// Returns ErrMFAEnforced if the role of the user requires MFA
+	DisableMFA(ctx context.Context, claims *entity.AccessClaims, code string) error
+
+	// RegenerateRecoveryCodes replaces the recovery codes after checking a TOTP or recovery code.
+	// Returns ErrMFANotEnabled if the user has not enabled MFA
+	RegenerateRecoveryCodes(ctx context.Context, claims *entity.AccessClaims, code string) ([]string, error)
+
+	// ExportUserData returns a zip archive of the data every service keeps about the token owner.
+	// Returns ErrImpersonated for impersonation tokens and ErrServiceUnavailable if a service fails
+	ExportUserData(ctx context.Context, claims *entity.AccessClaims) (*entity.DataExport, error)
+
+	// DeleteAccount checks the password, disables the account of the token owner and
+	// starts deleting its data across services, or returns the deletion already started.
+	// Returns ErrImpersonated for impersonation tokens and ErrInvalidPassword if the password does not match
+	DeleteAccount(ctx context.Context, claims *entity.AccessClaims, password string) (*entity.AccountDeletion, error)
+
+	// AccountDeletion returns the progress of an account deletion.
+	// Returns ErrDeletionNotFound if there is no such deletion
+	AccountDeletion(ctx context.Context, id uuid.UUID) (*entity.AccountDeletion, error)
+}
+
+// AdminUseCase defines the interface for user management by staff,
+// callers are authorized before
+type AdminUseCase interface {
+	// ListUsers returns a page of users matching the filter and the number of all matches
+	ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error)
+
+	// GetUser returns a user by id.
+	// Returns ErrUserNotFound if there is no such user
+	GetUser(ctx context.Context, id uuid.UUID) (*entity.User, error)
+
+	// SetUserRole changes the role of a user and revokes their sessions.
+	// Returns ErrSelfModification if the actor is the user
+	SetUserRole(ctx context.Context, actorID, userID uuid.UUID, role entity.Role) (*entity.User, error)
+
+	// DisableUser blocks a user from signing in and revokes their sessions.
+	// Returns ErrSelfModification if the actor is the user
+	DisableUser(ctx context.Context, actorID, userID uuid.UUID) (*entity.User, error)
+
+	// EnableUser lets a disabled user sign in again
+	EnableUser(ctx context.Context, actorID, userID uuid.UUID) (*entity.User, error)
+
+	// Impersonate issues an access token acting as the user, without a refresh token.
+	// Returns ErrNotImpersonable for staff accounts and ErrUserDisabled for disabled ones
+	Impersonate(ctx context.Context, actorID, userID uuid.UUID) (*entity.TokenPair, error)
+
+	// MFAPolicy returns the roles whose users must have MFA enabled
+	MFAPolicy(ctx context.Context) ([]entity.Role, error)
+
+	// SetMFARequirement changes whether users of the role must have MFA enabled
+	SetMFARequirement(ctx context.Context, actorID uuid.UUID, role entity.Role, required bool) error
+
+	// ResetMFA removes the second factor of a user who lost it.
+	// Returns ErrSelfModification if the actor is the user
+	ResetMFA(ctx context.Context, actorID, userID uuid.UUID) error
+
+	// UnlockUser lifts the sign-in lock of a user's account after failed attempts.
+	// Returns ErrUserNotFound if there is no such user
+	UnlockUser(ctx context.Context, actorID, userID uuid.UUID) error
+
+	// ListAuditEvents returns a page of the audit log matching the filter, newest first,
+	// and the cursor of the next page, nil on the last page. The filter limit must be positive
+	ListAuditEvents(ctx context.Context, filter entity.AuditFilter) ([]*entity.AuditEvent, *entity.AuditCursor, error)
+
+	// RetryAccountDeletion schedules a pending or failed account deletion right away with fresh attempts.
+	// Returns ErrDeletionNotFound if there is no such deletion and ErrDeletionCompleted if it completed
+	RetryAccountDeletion(ctx context.Context, actorID, id uuid.UUID) (*entity.AccountDeletion, error)
+}
+
+// ProfileUseCase defines the interface for investment profiles
+type ProfileUseCase interface {
+	// GetProfile returns the profile of the user, the defaults if they have not set one up
+	GetProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error)
+
+	// GetUserProfile returns the profile of a user for other services.
+	// Returns ErrUserNotFound if there is no such user
+	GetUserProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error)
+
+	// UpdateProfile changes the preferences set in the update on the profile of the token owner.
+	// Returns ErrImpersonated for impersonation tokens and ErrInvalidHorizon, ErrInvalidCurrency,
+	// ErrInvalidMarket, ErrInvalidSymbol, ErrWatchlistFull or ErrInvalidLocale for invalid preferences
+	UpdateProfile(ctx context.Context, claims *entity.AccessClaims, update entity.ProfileUpdate) (*entity.Profile, error)
+
+	// SubmitRiskQuestionnaire scores the answers of the token owner and sets the tolerance of their profile.
+	// Returns ErrImpersonated for impersonation tokens and ErrInvalidAnswers unless every
+	// question is answered with one of its options
+	SubmitRiskQuestionnaire(
+		ctx context.Context,
+		claims *entity.AccessClaims,
+		answers map[string]string,
+	) (*entity.RiskAssessment, error)
+
+	// ListRiskAssessments returns up to limit past assessments of the user, newest first
+	ListRiskAssessments(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.RiskAssessment, error)
+}
+
+// KeyRing holds the keys access tokens are signed and verified with
+type KeyRing interface {
+	// Sync rotates keys when due and reloads them from storage.
+	// Returns error if storage operation fails
+	Sync(ctx context.Context) error
+
+	// Signing returns the key new tokens are signed with.
+	// Returns ErrNoSigningKey if no key is loaded
+	Signing() (*entity.SigningKey, error)
+
+	// Key returns the key with the kid.
+	// Returns nil if the key is unknown
+	Key(kid string) *entity.SigningKey
+
+	// JWKS returns the public keys tokens may currently be signed with
+	JWKS() *entity.JWKS
 }
@@ -23,4 +23,104 @@ var (
 
 	// ErrInvalidCredentials is returned when the credentials are invalid
 	ErrInvalidCredentials = errors.New("invalid email or password")
+
+	// ErrRefreshTokenReused is returned when an already rotated refresh token is presented again,
+	// the whole token family is revoked by then
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+
+	// ErrSessionNotFound is returned when a session does not exist, is not active or belongs to another user
+	ErrSessionNotFound = errors.New("session not found")
+
+	// ErrUserDisabled is returned when a disabled user signs in or uses a token
+	ErrUserDisabled = errors.New("user disabled")
+
+	// ErrSelfModification is returned when an admin changes their own role or disables themselves
+	ErrSelfModification = errors.New("cannot modify own account")
+
+	// ErrNotImpersonable is returned when impersonating a staff account
+	ErrNotImpersonable = errors.New("user cannot be impersonated")
+
+	// ErrEmailNotVerified is returned when an unverified user signs in while unverified users are blocked
+	ErrEmailNotVerified = errors.New("email not verified")
+
+	// ErrWeakPassword is returned when a new password does not meet the password policy
+	ErrWeakPassword = errors.New("password must be at least 8 characters long")
+
+	// ErrImpersonated is returned when an impersonation token is used for an action only the user may take
+	ErrImpersonated = errors.New("not allowed while impersonating")
+
+	// ErrInvalidMFACode is returned when a TOTP or recovery code is wrong, expired or used before
+	ErrInvalidMFACode = errors.New("invalid mfa code")
+
+	// ErrMFAAlreadyEnabled is returned when enrolling a user who already has MFA enabled
+	ErrMFAAlreadyEnabled = errors.New("mfa already enabled")
+
+	// ErrMFANotEnabled is returned when confirming, disabling or using MFA the user has not set up
+	ErrMFANotEnabled = errors.New("mfa not enabled")
+
+	// ErrMFAEnforced is returned when a user disables MFA their role is required to have
+	ErrMFAEnforced = errors.New("mfa is required for the role")
+
+	// ErrTooManyAttempts is returned when sign-ins for the account or from the client are locked after failures
+	ErrTooManyAttempts = errors.New("too many sign-in attempts")
+
+	// ErrUnknownProvider is returned when signing in with an identity provider that is not configured
+	ErrUnknownProvider = errors.New("unknown identity provider")
+
+	// ErrOAuthFailed is returned when the identity provider rejects the authorization code or its tokens
+	ErrOAuthFailed = errors.New("identity provider sign-in failed")
+
+	// ErrExternalEmailNotVerified is returned when the identity provider did not verify the email of a new identity
+	ErrExternalEmailNotVerified = errors.New("identity provider email not verified")
+
+	// ErrAccountNotLinkable is returned when a new identity matches an account whose email is not verified
+	ErrAccountNotLinkable = errors.New("account email must be verified before linking")
+
+	// ErrInvalidAPIKeyName is returned when an API key name is empty or too long
+	ErrInvalidAPIKeyName = errors.New("api key name must be 1 to 64 characters long")
+
+	// ErrInvalidAPIKeyTTL is returned when an API key lifetime is negative or longer than allowed
+	ErrInvalidAPIKeyTTL = errors.New("invalid api key lifetime")
+
+	// ErrInvalidScope is returned when an API key is created without scopes or with permissions the caller lacks
+	ErrInvalidScope = errors.New("invalid api key scopes")
+
+	// ErrAPIKeyLimit is returned when a user creates more active API keys than allowed
+	ErrAPIKeyLimit = errors.New("too many api keys")
+
+	// ErrAPIKeyNotFound is returned when an API key does not exist, is revoked or belongs to another user
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
+	// ErrInvalidHorizon is returned when an investment horizon is unknown
+	ErrInvalidHorizon = errors.New("invalid investment horizon")
+
+	// ErrInvalidCurrency is returned when a base currency is not an ISO 4217 code
+	ErrInvalidCurrency = errors.New("invalid currency")
+
+	// ErrInvalidMarket is returned when a preferred market is unknown
+	ErrInvalidMarket = errors.New("invalid market")
+
+	// ErrInvalidSymbol is returned when a watchlisted ticker symbol is malformed
+	ErrInvalidSymbol = errors.New("invalid ticker symbol")
+
+	// ErrWatchlistFull is returned when a watchlist holds more symbols than allowed
+	ErrWatchlistFull = errors.New("too many watchlisted symbols")
+
+	// ErrInvalidLocale is returned when a locale is not a BCP 47 language tag
+	ErrInvalidLocale = errors.New("invalid locale")
+
+	// ErrInvalidAnswers is returned when a risk questionnaire is not fully answered with its options
+	ErrInvalidAnswers = errors.New("invalid risk questionnaire answers")
+
+	// ErrServiceUnavailable is returned when a service keeping data of users cannot be reached
+	ErrServiceUnavailable = errors.New("service unavailable")
+
+	// ErrDeletionNotFound is returned when an account deletion does not exist
+	ErrDeletionNotFound = errors.New("account deletion not found")
+
+	// ErrDeletionCompleted is returned when a completed account deletion is retried
+	ErrDeletionCompleted = errors.New("account deletion already completed")
+
+	// ErrNoSigningKey is returned when tokens are issued before any signing key is loaded
+	ErrNoSigningKey = errors.New("no signing key")
 )
@@ -0,0 +1,128 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+// Ring keeps the signing keys of all replicas in sync with storage.
+//
+// A key is published in the JWKS as soon as it is generated but signs only
+// after the prepublish period, so verifiers with a cached JWKS learn about it
+// before they see tokens signed with it. A superseded key stays published
+// until the last token it signed has expired
+type Ring struct {
+	repo       repo.SigningKeyRepository
+	algorithm  string
+	rotation   time.Duration
+	prepublish time.Duration
+	tokenTTL   time.Duration
+
+	mu   sync.RWMutex
+	keys []*entity.SigningKey // newest first
+}
+
+// New creates a new key Ring, keys are loaded by Sync
+func New(
+	repo repo.SigningKeyRepository,
+	algorithm string,
+	rotation, prepublish, tokenTTL time.Duration,
+) *Ring {
+	return &Ring{
+		repo:       repo,
+		algorithm:  algorithm,
+		rotation:   rotation,
+		prepublish: prepublish,
+		tokenTTL:   tokenTTL,
+	}
+}
+
+// Sync generates a new key when the newest one is due for rotation or uses
+// another algorithm, removes keys nothing can be signed with anymore and
+// reloads the ring from storage
+func (r *Ring) Sync(ctx context.Context) error {
+	keys, err := r.repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("r.repo.List(): %w", err)
+	}
+
+	now := time.Now().UTC()
+	if len(keys) == 0 || keys[0].Algorithm != r.algorithm || now.Sub(keys[0].CreatedAt) >= r.rotation {
+		key, err := entity.GenerateSigningKey(r.algorithm, now)
+		if err != nil {
+			return fmt.Errorf("entity.GenerateSigningKey(%s): %w", r.algorithm, err)
+		}
+		if err := r.repo.Create(ctx, key); err != nil {
+			return fmt.Errorf("r.repo.Create(): %w", err)
+		}
+	}
+
+	// a key stops signing once its successor is through the prepublish period
+	if _, err := r.repo.DeleteSuperseded(ctx, now.Add(-r.prepublish-r.tokenTTL)); err != nil {
+		return fmt.Errorf("r.repo.DeleteSuperseded(): %w", err)
+	}
+
+	keys, err = r.repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("r.repo.List(): %w", err)
+	}
+
+	r.mu.Lock()
+	r.keys = keys
+	r.mu.Unlock()
+
+	return nil
+}
+
+// Signing returns the newest key past the prepublish period, or the oldest
+// key while there is none yet, right after the very first key was generated
+func (r *Ring) Signing() (*entity.SigningKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.keys) == 0 {
+		return nil, usecase.ErrNoSigningKey
+	}
+
+	now := time.Now().UTC()
+	for _, key := range r.keys {
+		if !key.CreatedAt.Add(r.prepublish).After(now) {
+			return key, nil
+		}
+	}
+
+	return r.keys[len(r.keys)-1], nil
+}
+
+// Key returns the key with the kid, nil if it is unknown
+func (r *Ring) Key(kid string) *entity.SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, key := range r.keys {
+		if key.ID == kid {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// JWKS returns the public keys of all keys in the ring
+func (r *Ring) JWKS() *entity.JWKS {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	set := &entity.JWKS{Keys: make([]entity.JWK, len(r.keys))}
+	for i, key := range r.keys {
+		set.Keys[i] = key.JWK()
+	}
+
+	return set
+}
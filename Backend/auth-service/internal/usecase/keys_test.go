@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase/keys"
+)
+
+const (
+	_rotation   = 30 * 24 * time.Hour
+	_prepublish = time.Hour
+	_tokenTTL   = 15 * time.Minute
+)
+
+// keyRing returns a ring of EdDSA keys whose storage holds stored, keys
+// generated by Sync are added to it
+func keyRing(t *testing.T, stored ...*entity.SigningKey) (*keys.Ring, *MockSigningKeyRepository) {
+	t.Helper()
+
+	repo := NewMockSigningKeyRepository(gomock.NewController(t))
+	repo.EXPECT().List(gomock.Any()).DoAndReturn(func(context.Context) ([]*entity.SigningKey, error) {
+		return stored, nil
+	}).AnyTimes()
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key *entity.SigningKey) error {
+		stored = append([]*entity.SigningKey{key}, stored...)
+		return nil
+	}).AnyTimes()
+
+	return keys.New(repo, entity.AlgEdDSA, _rotation, _prepublish, _tokenTTL), repo
+}
+
+func signingKey(t *testing.T, alg string, age time.Duration) *entity.SigningKey {
+	t.Helper()
+
+	key, err := entity.GenerateSigningKey(alg, time.Now().UTC().Add(-age))
+	require.NoError(t, err)
+
+	return key
+}
+
+func TestRingFirstKey(t *testing.T) {
+	t.Parallel()
+
+	ring, repo := keyRing(t)
+
+	_, err := ring.Signing()
+	require.ErrorIs(t, err, usecase.ErrNoSigningKey)
+
+	repo.EXPECT().DeleteSuperseded(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, before time.Time) (int64, error) {
+		require.WithinDuration(t, time.Now().Add(-_prepublish-_tokenTTL), before, time.Minute)
+		return 0, nil
+	})
+	require.NoError(t, ring.Sync(context.Background()))
+
+	// with nothing else to sign with, the first key signs right away
+	key, err := ring.Signing()
+	require.NoError(t, err)
+	require.Equal(t, entity.AlgEdDSA, key.Algorithm)
+	require.Same(t, key, ring.Key(key.ID))
+	require.Len(t, ring.JWKS().Keys, 1)
+}
+
+func TestRingRotation(t *testing.T) {
+	t.Parallel()
+
+	old := signingKey(t, entity.AlgEdDSA, _rotation+time.Minute)
+	ring, repo := keyRing(t, old)
+	repo.EXPECT().DeleteSuperseded(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+	require.NoError(t, ring.Sync(context.Background()))
+
+	// the successor is published but the old key signs until verifiers know it
+	jwks := ring.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	require.Equal(t, old.ID, jwks.Keys[1].Kid)
+	require.NotNil(t, ring.Key(jwks.Keys[0].Kid))
+
+	key, err := ring.Signing()
+	require.NoError(t, err)
+	require.Equal(t, old.ID, key.ID)
+}
+
+func TestRingSigningAfterPrepublish(t *testing.T) {
+	t.Parallel()
+
+	next := signingKey(t, entity.AlgEdDSA, _prepublish+time.Minute)
+	old := signingKey(t, entity.AlgEdDSA, _rotation)
+	ring, repo := keyRing(t, next, old)
+	repo.EXPECT().DeleteSuperseded(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+	require.NoError(t, ring.Sync(context.Background()))
+
+	key, err := ring.Signing()
+	require.NoError(t, err)
+	require.Equal(t, next.ID, key.ID)
+
+	// tokens signed by the old key still verify
+	require.Same(t, old, ring.Key(old.ID))
+	require.Nil(t, ring.Key("unknown"))
+}
+
+func TestRingAlgorithmChange(t *testing.T) {
+	t.Parallel()
+
+	rsa := signingKey(t, entity.AlgRS256, time.Hour)
+	ring, repo := keyRing(t, rsa)
+	repo.EXPECT().DeleteSuperseded(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+	require.NoError(t, ring.Sync(context.Background()))
+
+	jwks := ring.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	require.Equal(t, "OKP", jwks.Keys[0].Kty)
+	require.Equal(t, "Ed25519", jwks.Keys[0].Crv)
+	require.NotEmpty(t, jwks.Keys[0].X)
+	require.Equal(t, "RSA", jwks.Keys[1].Kty)
+	require.NotEmpty(t, jwks.Keys[1].N)
+	require.Equal(t, "AQAB", jwks.Keys[1].E)
+}
+
+func TestRingSyncFails(t *testing.T) {
+	t.Parallel()
+
+	ring, repo := keyRing(t, signingKey(t, entity.AlgEdDSA, time.Hour))
+	repo.EXPECT().DeleteSuperseded(gomock.Any(), gomock.Any()).Return(int64(0), context.DeadlineExceeded)
+
+	// the ring keeps what it had when storage fails
+	err := ring.Sync(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Empty(t, ring.JWKS().Keys)
+}
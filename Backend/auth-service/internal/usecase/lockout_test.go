@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+var _client = entity.Client{IP: "203.0.113.7", UserAgent: "curl/8.5.0"}
+
+func clientContext() context.Context {
+	return usecase.NewClientContext(context.Background(), _client)
+}
+
+func TestSignInLocked(t *testing.T) {
+	t.Parallel()
+
+	uc, m := authUseCase(t)
+
+	keys := []entity.ThrottleKey{entity.AccountThrottleKey(" Jane@Example.com"), entity.IPThrottleKey(_client.IP)}
+	m.throttle.EXPECT().LockedUntil(gomock.Any(), keys, _now).Return(_now.Add(time.Minute), nil)
+
+	_, _, err := uc.SignIn(clientContext(), " Jane@Example.com", _password)
+
+	require.ErrorIs(t, err, usecase.ErrTooManyAttempts)
+
+	events := m.eventsOf(entity.AuditSignIn)
+	require.Len(t, events, 1)
+	require.Equal(t, entity.AuditFailure, events[0].Outcome)
+	require.Equal(t, usecase.ErrTooManyAttempts.Error(), events[0].Details["reason"])
+	require.Equal(t, _client.IP, events[0].IP)
+}
+
+func TestSignInFailures(t *testing.T) {
+	t.Parallel()
+
+	accountKey := entity.AccountThrottleKey("jane@example.com")
+	ipKey := entity.IPThrottleKey(_client.IP)
+
+	tests := []struct {
+		name            string
+		known           bool
+		accountFailures int
+		ipFailures      int
+		accountLock     time.Duration
+		ipLock          time.Duration
+	}{
+		{name: "unknown email below threshold", accountFailures: 1, ipFailures: 1},
+		{name: "wrong password below threshold", known: true, accountFailures: 2, ipFailures: 2},
+		{name: "unknown email reaching threshold", accountFailures: 3, ipFailures: 3, accountLock: 30 * time.Second},
+		{name: "wrong password reaching threshold", known: true, accountFailures: 3, ipFailures: 3, accountLock: 30 * time.Second},
+		{name: "lock doubles", known: true, accountFailures: 5, ipFailures: 5, accountLock: 2 * time.Minute},
+		{name: "lock is capped", known: true, accountFailures: 40, ipFailures: 9, accountLock: 10 * time.Minute},
+		{name: "address reaching threshold", accountFailures: 1, ipFailures: 11, ipLock: time.Minute},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, m := authUseCase(t)
+
+			var user *entity.User
+			if tc.known {
+				user = testUser(t, entity.RoleUser)
+			}
+
+			m.throttle.EXPECT().LockedUntil(gomock.Any(), gomock.Any(), _now).Return(time.Time{}, nil)
+			m.users.EXPECT().GetByEmail(gomock.Any(), "jane@example.com").Return(user, nil)
+			m.throttle.EXPECT().RecordFailure(gomock.Any(), accountKey, _now, 24*time.Hour).Return(tc.accountFailures, nil)
+			m.throttle.EXPECT().RecordFailure(gomock.Any(), ipKey, _now, 24*time.Hour).Return(tc.ipFailures, nil)
+
+			if tc.accountLock > 0 {
+				m.throttle.EXPECT().Lock(gomock.Any(), accountKey, _now.Add(tc.accountLock)).Return(nil)
+			}
+			if tc.ipLock > 0 {
+				m.throttle.EXPECT().Lock(gomock.Any(), ipKey, _now.Add(tc.ipLock)).Return(nil)
+			}
+
+			_, _, err := uc.SignIn(clientContext(), "jane@example.com", "wrong password")
+
+			// unknown emails and wrong passwords are indistinguishable
+			require.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+
+			locks := 0
+			if tc.accountLock > 0 {
+				locks++
+			}
+			if tc.ipLock > 0 {
+				locks++
+			}
+			events := m.eventsOf(entity.AuditSignInLockout)
+			require.Len(t, events, locks)
+
+			for _, e := range events {
+				require.Equal(t, entity.AuditSignInLockout, e.Type)
+				require.Equal(t, entity.AuditFailure, e.Outcome)
+				require.Equal(t, _client.IP, e.IP)
+				require.Equal(t, _client.UserAgent, e.UserAgent)
+				require.Equal(t, _now, e.CreatedAt)
+				if tc.known {
+					require.Equal(t, user.ID, e.TargetID)
+				} else {
+					require.Equal(t, uuid.Nil, e.TargetID)
+				}
+			}
+
+			failures := m.eventsOf(entity.AuditSignIn)
+			require.Len(t, failures, 1)
+			require.Equal(t, entity.AuditFailure, failures[0].Outcome)
+			require.Equal(t, usecase.ErrInvalidCredentials.Error(), failures[0].Details["reason"])
+		})
+	}
+}
+
+func TestSignInResetsAccountFailures(t *testing.T) {
+	t.Parallel()
+
+	uc, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+
+	m.expectPasswordChecked(user)
+	m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+	m.expectSession(t)
+
+	pair, _, err := uc.SignIn(clientContext(), user.Email, _password)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, pair.AccessToken)
+
+	events := m.eventsOf(entity.AuditSignIn)
+	require.Len(t, events, 1)
+	require.Equal(t, entity.AuditSuccess, events[0].Outcome)
+	require.Equal(t, user.ID, events[0].ActorID)
+	require.Equal(t, "password", events[0].Details["method"])
+}
+
+func TestUnlockUser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("resets the account and audits", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+		actorID := uuid.New()
+
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+		m.throttle.EXPECT().Reset(gomock.Any(), entity.AccountThrottleKey(user.Email)).Return(nil)
+
+		require.NoError(t, uc.UnlockUser(clientContext(), actorID, user.ID))
+
+		events := m.eventsOf(entity.AuditUserUnlocked)
+		require.Len(t, events, 1)
+		require.Equal(t, actorID, events[0].ActorID)
+		require.Equal(t, user.ID, events[0].TargetID)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		userID := uuid.New()
+
+		m.users.EXPECT().GetByID(gomock.Any(), userID).Return(nil, nil)
+
+		require.ErrorIs(t, uc.UnlockUser(clientContext(), uuid.New(), userID), usecase.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,307 @@
+package usecase_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/totp"
+)
+
+func TestSignInMFA(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without mfa issues tokens", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+
+		m.expectPasswordChecked(user)
+		m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+		m.expectSession(t)
+
+		pair, challenge, err := uc.SignIn(context.Background(), user.Email, _password)
+
+		require.NoError(t, err)
+		require.Nil(t, challenge)
+		require.NotEmpty(t, pair.AccessToken)
+		require.Equal(t, _now.Add(15*time.Minute), pair.AccessExpiresAt)
+		require.False(t, pair.MFAEnrollmentRequired)
+	})
+
+	t.Run("with mfa returns a challenge", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+
+		var stored *entity.MFAChallenge
+		m.expectPasswordChecked(user)
+		m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(enabledMFA(user.ID, 0), nil)
+		m.mfa.EXPECT().CreateChallenge(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, c *entity.MFAChallenge) error {
+				stored = c
+				return nil
+			})
+
+		pair, challenge, err := uc.SignIn(context.Background(), user.Email, _password)
+
+		require.NoError(t, err)
+		require.Nil(t, pair)
+		require.NotEmpty(t, challenge.Token)
+		require.Equal(t, sha256Hex(challenge.Token), stored.TokenHash)
+		require.Equal(t, user.ID, stored.UserID)
+		require.Equal(t, _now.Add(5*time.Minute), stored.ExpiresAt)
+	})
+
+	t.Run("enforced role without mfa gets no permissions", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		user := testUser(t, entity.RoleAdmin)
+		key, err := entity.GenerateSigningKey("EdDSA", _now.Add(-time.Hour))
+		require.NoError(t, err)
+
+		m.expectPasswordChecked(user)
+		m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil).Times(2)
+		m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return([]entity.Role{entity.RoleAdmin}, nil)
+		m.sessions.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		m.keys.EXPECT().Signing().Return(key, nil)
+
+		pair, _, err := uc.SignIn(context.Background(), user.Email, _password)
+		require.NoError(t, err)
+		require.True(t, pair.MFAEnrollmentRequired)
+
+		// the token is signed for the fixed clock, so only its payload is checked,
+		// nothing in it lets a service authorize by role either
+		claims := accessClaims(t, pair.AccessToken)
+		require.Empty(t, claims["perms"])
+		require.NotContains(t, claims, "role")
+		require.NotContains(t, claims, "is_admin")
+	})
+}
+
+func TestVerifyMFA(t *testing.T) {
+	t.Parallel()
+
+	step := totp.Step(_now)
+
+	tests := []struct {
+		name string
+		code string
+		mock func(m *authMocks, mfa *entity.MFA)
+		err  error
+	}{
+		{
+			name: "current code",
+			code: codeAt(t, step),
+			mock: func(m *authMocks, mfa *entity.MFA) {
+				m.mfa.EXPECT().UseStep(gomock.Any(), mfa.UserID, step).Return(true, nil)
+			},
+		},
+		{
+			name: "code of the previous step within skew",
+			code: codeAt(t, step-1),
+			mock: func(m *authMocks, mfa *entity.MFA) {
+				m.mfa.EXPECT().UseStep(gomock.Any(), mfa.UserID, step-1).Return(true, nil)
+			},
+		},
+		{
+			name: "code typed with a space",
+			code: codeAt(t, step)[:3] + " " + codeAt(t, step)[3:],
+			mock: func(m *authMocks, mfa *entity.MFA) {
+				m.mfa.EXPECT().UseStep(gomock.Any(), mfa.UserID, step).Return(true, nil)
+			},
+		},
+		{
+			name: "code outside skew",
+			code: codeAt(t, step-2),
+			err:  usecase.ErrInvalidMFACode,
+		},
+		{
+			name: "replayed code",
+			code: codeAt(t, step-1),
+			mock: func(_ *authMocks, mfa *entity.MFA) {
+				mfa.LastUsedStep = step - 1
+			},
+			err: usecase.ErrInvalidMFACode,
+		},
+		{
+			name: "code used concurrently",
+			code: codeAt(t, step),
+			mock: func(m *authMocks, mfa *entity.MFA) {
+				m.mfa.EXPECT().UseStep(gomock.Any(), mfa.UserID, step).Return(false, nil)
+			},
+			err: usecase.ErrInvalidMFACode,
+		},
+		{
+			name: "recovery code",
+			code: "ABCD-EFGH-IJKL-MNOP",
+			mock: func(m *authMocks, mfa *entity.MFA) {
+				m.mfa.EXPECT().UseRecoveryCode(gomock.Any(), mfa.UserID, sha256Hex("abcdefghijklmnop"), _now).Return(true, nil)
+			},
+		},
+		{
+			name: "used recovery code",
+			code: "abcd-efgh-ijkl-mnop",
+			mock: func(m *authMocks, mfa *entity.MFA) {
+				m.mfa.EXPECT().UseRecoveryCode(gomock.Any(), mfa.UserID, gomock.Any(), _now).Return(false, nil)
+			},
+			err: usecase.ErrInvalidMFACode,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, m := authUseCase(t)
+			user := testUser(t, entity.RoleUser)
+			mfa := enabledMFA(user.ID, step-10)
+			challenge := &entity.MFAChallenge{ID: uuid.New(), UserID: user.ID, Attempts: 1}
+
+			m.mfa.EXPECT().AttemptChallenge(gomock.Any(), sha256Hex("challenge"), _now, 5).Return(challenge, nil)
+			m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+			m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(mfa, nil)
+			if tc.mock != nil {
+				tc.mock(m, mfa)
+			}
+			if tc.err == nil {
+				m.mfa.EXPECT().ConsumeChallenge(gomock.Any(), challenge.ID, _now).Return(true, nil)
+				m.expectSession(t)
+			}
+
+			pair, err := uc.VerifyMFA(context.Background(), "challenge", tc.code)
+
+			require.ErrorIs(t, err, tc.err)
+			if tc.err == nil {
+				require.NotEmpty(t, pair.AccessToken)
+				require.NotEmpty(t, pair.RefreshToken)
+			}
+		})
+	}
+
+	t.Run("unknown, expired or exhausted challenge", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		m.mfa.EXPECT().AttemptChallenge(gomock.Any(), gomock.Any(), _now, 5).Return(nil, nil)
+
+		_, err := uc.VerifyMFA(context.Background(), "challenge", codeAt(t, step))
+
+		require.ErrorIs(t, err, usecase.ErrInvalidToken)
+	})
+}
+
+func TestConfirmMFA(t *testing.T) {
+	t.Parallel()
+
+	claims := &entity.AccessClaims{UserID: uuid.New(), Role: entity.RoleUser}
+	pending := &entity.MFA{UserID: claims.UserID, Secret: _secret}
+
+	t.Run("valid code enables mfa", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+
+		var hashes []string
+		m.mfa.EXPECT().Get(gomock.Any(), claims.UserID).Return(pending, nil)
+		m.mfa.EXPECT().Enable(gomock.Any(), claims.UserID, totp.Step(_now), gomock.Any(), _now).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, _ int64, h []string, _ time.Time) (bool, error) {
+				hashes = h
+				return true, nil
+			})
+
+		codes, err := uc.ConfirmMFA(context.Background(), claims, codeAt(t, totp.Step(_now)))
+
+		require.NoError(t, err)
+		require.Len(t, codes, 10)
+		require.Len(t, hashes, 10)
+		for i, code := range codes {
+			require.Regexp(t, regexp.MustCompile(`^[a-z2-7]{4}(-[a-z2-7]{4}){3}$`), code)
+			require.Equal(t, sha256Hex(regexp.MustCompile(`-`).ReplaceAllString(code, "")), hashes[i])
+		}
+	})
+
+	t.Run("wrong code", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		m.mfa.EXPECT().Get(gomock.Any(), claims.UserID).Return(pending, nil)
+
+		_, err := uc.ConfirmMFA(context.Background(), claims, codeAt(t, totp.Step(_now)-3))
+
+		require.ErrorIs(t, err, usecase.ErrInvalidMFACode)
+	})
+
+	t.Run("already enabled", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		m.mfa.EXPECT().Get(gomock.Any(), claims.UserID).Return(enabledMFA(claims.UserID, 0), nil)
+
+		_, err := uc.ConfirmMFA(context.Background(), claims, codeAt(t, totp.Step(_now)))
+
+		require.ErrorIs(t, err, usecase.ErrMFAAlreadyEnabled)
+	})
+
+	t.Run("impersonated", func(t *testing.T) {
+		t.Parallel()
+
+		uc, _ := authUseCase(t)
+		impersonated := *claims
+		impersonated.ActorID = uuid.New()
+
+		_, err := uc.ConfirmMFA(context.Background(), &impersonated, codeAt(t, totp.Step(_now)))
+
+		require.ErrorIs(t, err, usecase.ErrImpersonated)
+	})
+}
+
+func TestDisableMFA(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enforced for the role", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		user := testUser(t, entity.RoleAdmin)
+
+		// the role is the stored one, a token withholding permissions has none
+		claims := &entity.AccessClaims{UserID: user.ID}
+
+		m.mfa.EXPECT().Get(gomock.Any(), claims.UserID).Return(enabledMFA(claims.UserID, 0), nil)
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+		m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return([]entity.Role{entity.RoleAdmin}, nil)
+
+		err := uc.DisableMFA(context.Background(), claims, codeAt(t, totp.Step(_now)))
+
+		require.ErrorIs(t, err, usecase.ErrMFAEnforced)
+	})
+
+	t.Run("optional for the role", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+		claims := &entity.AccessClaims{UserID: user.ID, Role: entity.RoleUser}
+
+		m.mfa.EXPECT().Get(gomock.Any(), claims.UserID).Return(enabledMFA(claims.UserID, 0), nil)
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+		m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return([]entity.Role{entity.RoleAdmin}, nil)
+		m.mfa.EXPECT().UseStep(gomock.Any(), claims.UserID, totp.Step(_now)).Return(true, nil)
+		m.mfa.EXPECT().Delete(gomock.Any(), claims.UserID).Return(nil)
+
+		err := uc.DisableMFA(context.Background(), claims, codeAt(t, totp.Step(_now)))
+
+		require.NoError(t, err)
+	})
+}
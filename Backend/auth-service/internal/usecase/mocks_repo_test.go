@@ -0,0 +1,1373 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contracts.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contracts.go -destination=../usecase/mocks_repo_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRepository is a mock of UserRepository interface.
+type MockUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserRepositoryMockRecorder is the mock recorder for MockUserRepository.
+type MockUserRepositoryMockRecorder struct {
+	mock *MockUserRepository
+}
+
+// NewMockUserRepository creates a new mock instance.
+func NewMockUserRepository(ctrl *gomock.Controller) *MockUserRepository {
+	mock := &MockUserRepository{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserRepository) Create(ctx context.Context, user *entity.User) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserRepositoryMockRecorder) Create(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepository)(nil).Create), ctx, user)
+}
+
+// Delete mocks base method.
+func (m *MockUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), ctx, id)
+}
+
+// GetByEmail mocks base method.
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, email)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmail indicates an expected call of GetByEmail.
+func (mr *MockUserRepositoryMockRecorder) GetByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUserRepository)(nil).GetByEmail), ctx, email)
+}
+
+// GetByID mocks base method.
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUserRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockUserRepository) List(ctx context.Context) ([]*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx)
+}
+
+// Search mocks base method.
+func (m *MockUserRepository) Search(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entity.User)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockUserRepositoryMockRecorder) Search(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUserRepository)(nil).Search), ctx, filter)
+}
+
+// Update mocks base method.
+func (m *MockUserRepository) Update(ctx context.Context, user *entity.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserRepositoryMockRecorder) Update(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), ctx, user)
+}
+
+// MockSessionRepository is a mock of SessionRepository interface.
+type MockSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionRepositoryMockRecorder is the mock recorder for MockSessionRepository.
+type MockSessionRepositoryMockRecorder struct {
+	mock *MockSessionRepository
+}
+
+// NewMockSessionRepository creates a new mock instance.
+func NewMockSessionRepository(ctrl *gomock.Controller) *MockSessionRepository {
+	mock := &MockSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSessionRepository) Create(ctx context.Context, session *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSessionRepositoryMockRecorder) Create(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionRepository)(nil).Create), ctx, session)
+}
+
+// GetByTokenHash mocks base method.
+func (m *MockSessionRepository) GetByTokenHash(ctx context.Context, hash string) (*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTokenHash", ctx, hash)
+	ret0, _ := ret[0].(*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTokenHash indicates an expected call of GetByTokenHash.
+func (mr *MockSessionRepositoryMockRecorder) GetByTokenHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockSessionRepository)(nil).GetByTokenHash), ctx, hash)
+}
+
+// ListActive mocks base method.
+func (m *MockSessionRepository) ListActive(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActive", ctx, userID)
+	ret0, _ := ret[0].([]*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActive indicates an expected call of ListActive.
+func (mr *MockSessionRepositoryMockRecorder) ListActive(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActive", reflect.TypeOf((*MockSessionRepository)(nil).ListActive), ctx, userID)
+}
+
+// RevokeAll mocks base method.
+func (m *MockSessionRepository) RevokeAll(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAll", ctx, userID)
+	ret0, _ := ret[0].([]*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockSessionRepositoryMockRecorder) RevokeAll(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockSessionRepository)(nil).RevokeAll), ctx, userID)
+}
+
+// RevokeFamily mocks base method.
+func (m *MockSessionRepository) RevokeFamily(ctx context.Context, userID, familyID uuid.UUID) ([]*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", ctx, userID, familyID)
+	ret0, _ := ret[0].([]*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockSessionRepositoryMockRecorder) RevokeFamily(ctx, userID, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockSessionRepository)(nil).RevokeFamily), ctx, userID, familyID)
+}
+
+// Rotate mocks base method.
+func (m *MockSessionRepository) Rotate(ctx context.Context, id uuid.UUID, next *entity.Session) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, id, next)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockSessionRepositoryMockRecorder) Rotate(ctx, id, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockSessionRepository)(nil).Rotate), ctx, id, next)
+}
+
+// MockTokenDenylist is a mock of TokenDenylist interface.
+type MockTokenDenylist struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenDenylistMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenDenylistMockRecorder is the mock recorder for MockTokenDenylist.
+type MockTokenDenylistMockRecorder struct {
+	mock *MockTokenDenylist
+}
+
+// NewMockTokenDenylist creates a new mock instance.
+func NewMockTokenDenylist(ctrl *gomock.Controller) *MockTokenDenylist {
+	mock := &MockTokenDenylist{ctrl: ctrl}
+	mock.recorder = &MockTokenDenylistMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenDenylist) EXPECT() *MockTokenDenylistMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockTokenDenylist) Add(ctx context.Context, jti uuid.UUID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, jti, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockTokenDenylistMockRecorder) Add(ctx, jti, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockTokenDenylist)(nil).Add), ctx, jti, expiresAt)
+}
+
+// Contains mocks base method.
+func (m *MockTokenDenylist) Contains(ctx context.Context, jti uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contains", ctx, jti)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contains indicates an expected call of Contains.
+func (mr *MockTokenDenylistMockRecorder) Contains(ctx, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contains", reflect.TypeOf((*MockTokenDenylist)(nil).Contains), ctx, jti)
+}
+
+// MockUserTokenRepository is a mock of UserTokenRepository interface.
+type MockUserTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserTokenRepositoryMockRecorder is the mock recorder for MockUserTokenRepository.
+type MockUserTokenRepositoryMockRecorder struct {
+	mock *MockUserTokenRepository
+}
+
+// NewMockUserTokenRepository creates a new mock instance.
+func NewMockUserTokenRepository(ctrl *gomock.Controller) *MockUserTokenRepository {
+	mock := &MockUserTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockUserTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserTokenRepository) EXPECT() *MockUserTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockUserTokenRepository) Consume(ctx context.Context, hash string, purpose entity.TokenPurpose) (*entity.UserToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, hash, purpose)
+	ret0, _ := ret[0].(*entity.UserToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockUserTokenRepositoryMockRecorder) Consume(ctx, hash, purpose any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockUserTokenRepository)(nil).Consume), ctx, hash, purpose)
+}
+
+// Invalidate mocks base method.
+func (m *MockUserTokenRepository) Invalidate(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invalidate", ctx, userID, purpose)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockUserTokenRepositoryMockRecorder) Invalidate(ctx, userID, purpose any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockUserTokenRepository)(nil).Invalidate), ctx, userID, purpose)
+}
+
+// Issue mocks base method.
+func (m *MockUserTokenRepository) Issue(ctx context.Context, token *entity.UserToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockUserTokenRepositoryMockRecorder) Issue(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockUserTokenRepository)(nil).Issue), ctx, token)
+}
+
+// MockMFARepository is a mock of MFARepository interface.
+type MockMFARepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFARepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMFARepositoryMockRecorder is the mock recorder for MockMFARepository.
+type MockMFARepositoryMockRecorder struct {
+	mock *MockMFARepository
+}
+
+// NewMockMFARepository creates a new mock instance.
+func NewMockMFARepository(ctrl *gomock.Controller) *MockMFARepository {
+	mock := &MockMFARepository{ctrl: ctrl}
+	mock.recorder = &MockMFARepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFARepository) EXPECT() *MockMFARepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttemptChallenge mocks base method.
+func (m *MockMFARepository) AttemptChallenge(ctx context.Context, hash string, at time.Time, maxAttempts int) (*entity.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttemptChallenge", ctx, hash, at, maxAttempts)
+	ret0, _ := ret[0].(*entity.MFAChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttemptChallenge indicates an expected call of AttemptChallenge.
+func (mr *MockMFARepositoryMockRecorder) AttemptChallenge(ctx, hash, at, maxAttempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttemptChallenge", reflect.TypeOf((*MockMFARepository)(nil).AttemptChallenge), ctx, hash, at, maxAttempts)
+}
+
+// ConsumeChallenge mocks base method.
+func (m *MockMFARepository) ConsumeChallenge(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeChallenge", ctx, id, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeChallenge indicates an expected call of ConsumeChallenge.
+func (mr *MockMFARepositoryMockRecorder) ConsumeChallenge(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeChallenge", reflect.TypeOf((*MockMFARepository)(nil).ConsumeChallenge), ctx, id, at)
+}
+
+// CreateChallenge mocks base method.
+func (m *MockMFARepository) CreateChallenge(ctx context.Context, challenge *entity.MFAChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChallenge", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChallenge indicates an expected call of CreateChallenge.
+func (mr *MockMFARepositoryMockRecorder) CreateChallenge(ctx, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChallenge", reflect.TypeOf((*MockMFARepository)(nil).CreateChallenge), ctx, challenge)
+}
+
+// Delete mocks base method.
+func (m *MockMFARepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMFARepositoryMockRecorder) Delete(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMFARepository)(nil).Delete), ctx, userID)
+}
+
+// Enable mocks base method.
+func (m *MockMFARepository) Enable(ctx context.Context, userID uuid.UUID, step int64, codeHashes []string, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, userID, step, codeHashes, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enable indicates an expected call of Enable.
+func (mr *MockMFARepositoryMockRecorder) Enable(ctx, userID, step, codeHashes, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockMFARepository)(nil).Enable), ctx, userID, step, codeHashes, at)
+}
+
+// Get mocks base method.
+func (m *MockMFARepository) Get(ctx context.Context, userID uuid.UUID) (*entity.MFA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*entity.MFA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMFARepositoryMockRecorder) Get(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMFARepository)(nil).Get), ctx, userID)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockMFARepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", ctx, userID, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockMFARepositoryMockRecorder) ReplaceRecoveryCodes(ctx, userID, codeHashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockMFARepository)(nil).ReplaceRecoveryCodes), ctx, userID, codeHashes)
+}
+
+// RequiredRoles mocks base method.
+func (m *MockMFARepository) RequiredRoles(ctx context.Context) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequiredRoles", ctx)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequiredRoles indicates an expected call of RequiredRoles.
+func (mr *MockMFARepositoryMockRecorder) RequiredRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequiredRoles", reflect.TypeOf((*MockMFARepository)(nil).RequiredRoles), ctx)
+}
+
+// SavePending mocks base method.
+func (m *MockMFARepository) SavePending(ctx context.Context, mfa *entity.MFA) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePending", ctx, mfa)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SavePending indicates an expected call of SavePending.
+func (mr *MockMFARepositoryMockRecorder) SavePending(ctx, mfa any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePending", reflect.TypeOf((*MockMFARepository)(nil).SavePending), ctx, mfa)
+}
+
+// SetRoleRequired mocks base method.
+func (m *MockMFARepository) SetRoleRequired(ctx context.Context, role entity.Role, required bool, by uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoleRequired", ctx, role, required, by, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoleRequired indicates an expected call of SetRoleRequired.
+func (mr *MockMFARepositoryMockRecorder) SetRoleRequired(ctx, role, required, by, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoleRequired", reflect.TypeOf((*MockMFARepository)(nil).SetRoleRequired), ctx, role, required, by, at)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockMFARepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, userID, hash, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockMFARepositoryMockRecorder) UseRecoveryCode(ctx, userID, hash, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockMFARepository)(nil).UseRecoveryCode), ctx, userID, hash, at)
+}
+
+// UseStep mocks base method.
+func (m *MockMFARepository) UseStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseStep", ctx, userID, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseStep indicates an expected call of UseStep.
+func (mr *MockMFARepositoryMockRecorder) UseStep(ctx, userID, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseStep", reflect.TypeOf((*MockMFARepository)(nil).UseStep), ctx, userID, step)
+}
+
+// MockThrottleRepository is a mock of ThrottleRepository interface.
+type MockThrottleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockThrottleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockThrottleRepositoryMockRecorder is the mock recorder for MockThrottleRepository.
+type MockThrottleRepositoryMockRecorder struct {
+	mock *MockThrottleRepository
+}
+
+// NewMockThrottleRepository creates a new mock instance.
+func NewMockThrottleRepository(ctrl *gomock.Controller) *MockThrottleRepository {
+	mock := &MockThrottleRepository{ctrl: ctrl}
+	mock.recorder = &MockThrottleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockThrottleRepository) EXPECT() *MockThrottleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockThrottleRepository) Lock(ctx context.Context, key entity.ThrottleKey, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, key, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockThrottleRepositoryMockRecorder) Lock(ctx, key, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockThrottleRepository)(nil).Lock), ctx, key, until)
+}
+
+// LockedUntil mocks base method.
+func (m *MockThrottleRepository) LockedUntil(ctx context.Context, keys []entity.ThrottleKey, now time.Time) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockedUntil", ctx, keys, now)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockedUntil indicates an expected call of LockedUntil.
+func (mr *MockThrottleRepositoryMockRecorder) LockedUntil(ctx, keys, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedUntil", reflect.TypeOf((*MockThrottleRepository)(nil).LockedUntil), ctx, keys, now)
+}
+
+// RecordFailure mocks base method.
+func (m *MockThrottleRepository) RecordFailure(ctx context.Context, key entity.ThrottleKey, now time.Time, window time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", ctx, key, now, window)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockThrottleRepositoryMockRecorder) RecordFailure(ctx, key, now, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockThrottleRepository)(nil).RecordFailure), ctx, key, now, window)
+}
+
+// Reset mocks base method.
+func (m *MockThrottleRepository) Reset(ctx context.Context, key entity.ThrottleKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockThrottleRepositoryMockRecorder) Reset(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockThrottleRepository)(nil).Reset), ctx, key)
+}
+
+// MockIdentityRepository is a mock of IdentityRepository interface.
+type MockIdentityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityRepositoryMockRecorder is the mock recorder for MockIdentityRepository.
+type MockIdentityRepositoryMockRecorder struct {
+	mock *MockIdentityRepository
+}
+
+// NewMockIdentityRepository creates a new mock instance.
+func NewMockIdentityRepository(ctrl *gomock.Controller) *MockIdentityRepository {
+	mock := &MockIdentityRepository{ctrl: ctrl}
+	mock.recorder = &MockIdentityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityRepository) EXPECT() *MockIdentityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeState mocks base method.
+func (m *MockIdentityRepository) ConsumeState(ctx context.Context, hash, provider string, at time.Time) (*entity.OAuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeState", ctx, hash, provider, at)
+	ret0, _ := ret[0].(*entity.OAuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeState indicates an expected call of ConsumeState.
+func (mr *MockIdentityRepositoryMockRecorder) ConsumeState(ctx, hash, provider, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeState", reflect.TypeOf((*MockIdentityRepository)(nil).ConsumeState), ctx, hash, provider, at)
+}
+
+// Create mocks base method.
+func (m *MockIdentityRepository) Create(ctx context.Context, identity *entity.ExternalIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIdentityRepositoryMockRecorder) Create(ctx, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIdentityRepository)(nil).Create), ctx, identity)
+}
+
+// CreateState mocks base method.
+func (m *MockIdentityRepository) CreateState(ctx context.Context, state *entity.OAuthState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateState", ctx, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateState indicates an expected call of CreateState.
+func (mr *MockIdentityRepositoryMockRecorder) CreateState(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateState", reflect.TypeOf((*MockIdentityRepository)(nil).CreateState), ctx, state)
+}
+
+// CreateWithUser mocks base method.
+func (m *MockIdentityRepository) CreateWithUser(ctx context.Context, user *entity.User, identity *entity.ExternalIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithUser", ctx, user, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithUser indicates an expected call of CreateWithUser.
+func (mr *MockIdentityRepositoryMockRecorder) CreateWithUser(ctx, user, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithUser", reflect.TypeOf((*MockIdentityRepository)(nil).CreateWithUser), ctx, user, identity)
+}
+
+// Get mocks base method.
+func (m *MockIdentityRepository) Get(ctx context.Context, provider, subject string) (*entity.ExternalIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, provider, subject)
+	ret0, _ := ret[0].(*entity.ExternalIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIdentityRepositoryMockRecorder) Get(ctx, provider, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIdentityRepository)(nil).Get), ctx, provider, subject)
+}
+
+// ListByUser mocks base method.
+func (m *MockIdentityRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.ExternalIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]*entity.ExternalIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockIdentityRepositoryMockRecorder) ListByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockIdentityRepository)(nil).ListByUser), ctx, userID)
+}
+
+// Touch mocks base method.
+func (m *MockIdentityRepository) Touch(ctx context.Context, id uuid.UUID, email string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, id, email, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockIdentityRepositoryMockRecorder) Touch(ctx, id, email, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockIdentityRepository)(nil).Touch), ctx, id, email, at)
+}
+
+// MockIdentityProvider is a mock of IdentityProvider interface.
+type MockIdentityProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityProviderMockRecorder is the mock recorder for MockIdentityProvider.
+type MockIdentityProviderMockRecorder struct {
+	mock *MockIdentityProvider
+}
+
+// NewMockIdentityProvider creates a new mock instance.
+func NewMockIdentityProvider(ctrl *gomock.Controller) *MockIdentityProvider {
+	mock := &MockIdentityProvider{ctrl: ctrl}
+	mock.recorder = &MockIdentityProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityProvider) EXPECT() *MockIdentityProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthCodeURL mocks base method.
+func (m *MockIdentityProvider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthCodeURL", ctx, state, nonce, verifier)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthCodeURL indicates an expected call of AuthCodeURL.
+func (mr *MockIdentityProviderMockRecorder) AuthCodeURL(ctx, state, nonce, verifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCodeURL", reflect.TypeOf((*MockIdentityProvider)(nil).AuthCodeURL), ctx, state, nonce, verifier)
+}
+
+// Exchange mocks base method.
+func (m *MockIdentityProvider) Exchange(ctx context.Context, code, verifier, nonce string) (*entity.ExternalProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, code, verifier, nonce)
+	ret0, _ := ret[0].(*entity.ExternalProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockIdentityProviderMockRecorder) Exchange(ctx, code, verifier, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockIdentityProvider)(nil).Exchange), ctx, code, verifier, nonce)
+}
+
+// Name mocks base method.
+func (m *MockIdentityProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockIdentityProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockIdentityProvider)(nil).Name))
+}
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountActive mocks base method.
+func (m *MockAPIKeyRepository) CountActive(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActive", ctx, userID, at)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActive indicates an expected call of CountActive.
+func (mr *MockAPIKeyRepositoryMockRecorder) CountActive(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActive", reflect.TypeOf((*MockAPIKeyRepository)(nil).CountActive), ctx, userID, at)
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyRepository) Create(ctx context.Context, key *entity.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyRepositoryMockRecorder) Create(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyRepository)(nil).Create), ctx, key)
+}
+
+// GetByHash mocks base method.
+func (m *MockAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", ctx, hash)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockAPIKeyRepositoryMockRecorder) GetByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockAPIKeyRepository)(nil).GetByHash), ctx, hash)
+}
+
+// ListByUser mocks base method.
+func (m *MockAPIKeyRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockAPIKeyRepositoryMockRecorder) ListByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockAPIKeyRepository)(nil).ListByUser), ctx, userID)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyRepository) Revoke(ctx context.Context, userID, id uuid.UUID, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, userID, id, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyRepositoryMockRecorder) Revoke(ctx, userID, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyRepository)(nil).Revoke), ctx, userID, id, at)
+}
+
+// Touch mocks base method.
+func (m *MockAPIKeyRepository) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockAPIKeyRepositoryMockRecorder) Touch(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockAPIKeyRepository)(nil).Touch), ctx, id, at)
+}
+
+// MockAuditLog is a mock of AuditLog interface.
+type MockAuditLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogMockRecorder is the mock recorder for MockAuditLog.
+type MockAuditLogMockRecorder struct {
+	mock *MockAuditLog
+}
+
+// NewMockAuditLog creates a new mock instance.
+func NewMockAuditLog(ctrl *gomock.Controller) *MockAuditLog {
+	mock := &MockAuditLog{ctrl: ctrl}
+	mock.recorder = &MockAuditLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLog) EXPECT() *MockAuditLogMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockAuditLog) List(ctx context.Context, filter entity.AuditFilter) ([]*entity.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*entity.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditLogMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditLog)(nil).List), ctx, filter)
+}
+
+// Record mocks base method.
+func (m *MockAuditLog) Record(ctx context.Context, event entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditLogMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditLog)(nil).Record), ctx, event)
+}
+
+// MockProfileRepository is a mock of ProfileRepository interface.
+type MockProfileRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProfileRepositoryMockRecorder is the mock recorder for MockProfileRepository.
+type MockProfileRepositoryMockRecorder struct {
+	mock *MockProfileRepository
+}
+
+// NewMockProfileRepository creates a new mock instance.
+func NewMockProfileRepository(ctrl *gomock.Controller) *MockProfileRepository {
+	mock := &MockProfileRepository{ctrl: ctrl}
+	mock.recorder = &MockProfileRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileRepository) EXPECT() *MockProfileRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockProfileRepository) Get(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*entity.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProfileRepositoryMockRecorder) Get(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProfileRepository)(nil).Get), ctx, userID)
+}
+
+// ListAssessments mocks base method.
+func (m *MockProfileRepository) ListAssessments(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.RiskAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssessments", ctx, userID, limit)
+	ret0, _ := ret[0].([]*entity.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssessments indicates an expected call of ListAssessments.
+func (mr *MockProfileRepositoryMockRecorder) ListAssessments(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssessments", reflect.TypeOf((*MockProfileRepository)(nil).ListAssessments), ctx, userID, limit)
+}
+
+// SaveAssessment mocks base method.
+func (m *MockProfileRepository) SaveAssessment(ctx context.Context, assessment *entity.RiskAssessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAssessment", ctx, assessment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAssessment indicates an expected call of SaveAssessment.
+func (mr *MockProfileRepositoryMockRecorder) SaveAssessment(ctx, assessment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAssessment", reflect.TypeOf((*MockProfileRepository)(nil).SaveAssessment), ctx, assessment)
+}
+
+// SavePreferences mocks base method.
+func (m *MockProfileRepository) SavePreferences(ctx context.Context, profile *entity.Profile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePreferences", ctx, profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePreferences indicates an expected call of SavePreferences.
+func (mr *MockProfileRepositoryMockRecorder) SavePreferences(ctx, profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePreferences", reflect.TypeOf((*MockProfileRepository)(nil).SavePreferences), ctx, profile)
+}
+
+// MockAccountDeletionRepository is a mock of AccountDeletionRepository interface.
+type MockAccountDeletionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountDeletionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountDeletionRepositoryMockRecorder is the mock recorder for MockAccountDeletionRepository.
+type MockAccountDeletionRepositoryMockRecorder struct {
+	mock *MockAccountDeletionRepository
+}
+
+// NewMockAccountDeletionRepository creates a new mock instance.
+func NewMockAccountDeletionRepository(ctrl *gomock.Controller) *MockAccountDeletionRepository {
+	mock := &MockAccountDeletionRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountDeletionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountDeletionRepository) EXPECT() *MockAccountDeletionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockAccountDeletionRepository) Claim(ctx context.Context, at time.Time, lease time.Duration, limit int) ([]*entity.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, at, lease, limit)
+	ret0, _ := ret[0].([]*entity.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockAccountDeletionRepositoryMockRecorder) Claim(ctx, at, lease, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockAccountDeletionRepository)(nil).Claim), ctx, at, lease, limit)
+}
+
+// Create mocks base method.
+func (m *MockAccountDeletionRepository) Create(ctx context.Context, deletion *entity.AccountDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, deletion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccountDeletionRepositoryMockRecorder) Create(ctx, deletion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountDeletionRepository)(nil).Create), ctx, deletion)
+}
+
+// Get mocks base method.
+func (m *MockAccountDeletionRepository) Get(ctx context.Context, id uuid.UUID) (*entity.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entity.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAccountDeletionRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAccountDeletionRepository)(nil).Get), ctx, id)
+}
+
+// GetUnfinished mocks base method.
+func (m *MockAccountDeletionRepository) GetUnfinished(ctx context.Context, userID uuid.UUID) (*entity.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnfinished", ctx, userID)
+	ret0, _ := ret[0].(*entity.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnfinished indicates an expected call of GetUnfinished.
+func (mr *MockAccountDeletionRepositoryMockRecorder) GetUnfinished(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnfinished", reflect.TypeOf((*MockAccountDeletionRepository)(nil).GetUnfinished), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockAccountDeletionRepository) Update(ctx context.Context, deletion *entity.AccountDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, deletion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAccountDeletionRepositoryMockRecorder) Update(ctx, deletion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccountDeletionRepository)(nil).Update), ctx, deletion)
+}
+
+// MockUserDataService is a mock of UserDataService interface.
+type MockUserDataService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDataServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserDataServiceMockRecorder is the mock recorder for MockUserDataService.
+type MockUserDataServiceMockRecorder struct {
+	mock *MockUserDataService
+}
+
+// NewMockUserDataService creates a new mock instance.
+func NewMockUserDataService(ctrl *gomock.Controller) *MockUserDataService {
+	mock := &MockUserDataService{ctrl: ctrl}
+	mock.recorder = &MockUserDataServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDataService) EXPECT() *MockUserDataServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockUserDataService) Delete(ctx context.Context, userID, deletionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, deletionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserDataServiceMockRecorder) Delete(ctx, userID, deletionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserDataService)(nil).Delete), ctx, userID, deletionID)
+}
+
+// Export mocks base method.
+func (m *MockUserDataService) Export(ctx context.Context, userID uuid.UUID) ([]entity.DataFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, userID)
+	ret0, _ := ret[0].([]entity.DataFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockUserDataServiceMockRecorder) Export(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockUserDataService)(nil).Export), ctx, userID)
+}
+
+// Name mocks base method.
+func (m *MockUserDataService) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockUserDataServiceMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockUserDataService)(nil).Name))
+}
+
+// MockMailer is a mock of Mailer interface.
+type MockMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockMailerMockRecorder is the mock recorder for MockMailer.
+type MockMailerMockRecorder struct {
+	mock *MockMailer
+}
+
+// NewMockMailer creates a new mock instance.
+func NewMockMailer(ctrl *gomock.Controller) *MockMailer {
+	mock := &MockMailer{ctrl: ctrl}
+	mock.recorder = &MockMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailer) EXPECT() *MockMailerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockMailer) Send(ctx context.Context, mail entity.Mail) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, mail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMailerMockRecorder) Send(ctx, mail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailer)(nil).Send), ctx, mail)
+}
+
+// MockSigningKeyRepository is a mock of SigningKeyRepository interface.
+type MockSigningKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSigningKeyRepositoryMockRecorder is the mock recorder for MockSigningKeyRepository.
+type MockSigningKeyRepositoryMockRecorder struct {
+	mock *MockSigningKeyRepository
+}
+
+// NewMockSigningKeyRepository creates a new mock instance.
+func NewMockSigningKeyRepository(ctrl *gomock.Controller) *MockSigningKeyRepository {
+	mock := &MockSigningKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockSigningKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeyRepository) EXPECT() *MockSigningKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSigningKeyRepository) Create(ctx context.Context, key *entity.SigningKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSigningKeyRepositoryMockRecorder) Create(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSigningKeyRepository)(nil).Create), ctx, key)
+}
+
+// DeleteSuperseded mocks base method.
+func (m *MockSigningKeyRepository) DeleteSuperseded(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSuperseded", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSuperseded indicates an expected call of DeleteSuperseded.
+func (mr *MockSigningKeyRepositoryMockRecorder) DeleteSuperseded(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSuperseded", reflect.TypeOf((*MockSigningKeyRepository)(nil).DeleteSuperseded), ctx, before)
+}
+
+// List mocks base method.
+func (m *MockSigningKeyRepository) List(ctx context.Context) ([]*entity.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*entity.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSigningKeyRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSigningKeyRepository)(nil).List), ctx)
+}
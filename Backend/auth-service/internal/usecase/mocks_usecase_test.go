@@ -0,0 +1,826 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contracts.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contracts.go -destination=./mocks_usecase_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthUseCase is a mock of AuthUseCase interface.
+type MockAuthUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthUseCaseMockRecorder is the mock recorder for MockAuthUseCase.
+type MockAuthUseCaseMockRecorder struct {
+	mock *MockAuthUseCase
+}
+
+// NewMockAuthUseCase creates a new mock instance.
+func NewMockAuthUseCase(ctrl *gomock.Controller) *MockAuthUseCase {
+	mock := &MockAuthUseCase{ctrl: ctrl}
+	mock.recorder = &MockAuthUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthUseCase) EXPECT() *MockAuthUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AccountDeletion mocks base method.
+func (m *MockAuthUseCase) AccountDeletion(ctx context.Context, id uuid.UUID) (*entity.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountDeletion", ctx, id)
+	ret0, _ := ret[0].(*entity.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountDeletion indicates an expected call of AccountDeletion.
+func (mr *MockAuthUseCaseMockRecorder) AccountDeletion(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountDeletion", reflect.TypeOf((*MockAuthUseCase)(nil).AccountDeletion), ctx, id)
+}
+
+// Authenticate mocks base method.
+func (m *MockAuthUseCase) Authenticate(ctx context.Context, token string) (*entity.AccessClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(*entity.AccessClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAuthUseCaseMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthUseCase)(nil).Authenticate), ctx, token)
+}
+
+// AuthenticateAPIKey mocks base method.
+func (m *MockAuthUseCase) AuthenticateAPIKey(ctx context.Context, key string) (*entity.APIKeyClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, key)
+	ret0, _ := ret[0].(*entity.APIKeyClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockAuthUseCaseMockRecorder) AuthenticateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockAuthUseCase)(nil).AuthenticateAPIKey), ctx, key)
+}
+
+// ChangePassword mocks base method.
+func (m *MockAuthUseCase) ChangePassword(ctx context.Context, claims *entity.AccessClaims, current, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, claims, current, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthUseCaseMockRecorder) ChangePassword(ctx, claims, current, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthUseCase)(nil).ChangePassword), ctx, claims, current, password)
+}
+
+// CompleteOAuth mocks base method.
+func (m *MockAuthUseCase) CompleteOAuth(ctx context.Context, provider, code, state string) (*entity.TokenPair, *entity.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOAuth", ctx, provider, code, state)
+	ret0, _ := ret[0].(*entity.TokenPair)
+	ret1, _ := ret[1].(*entity.MFAChallenge)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CompleteOAuth indicates an expected call of CompleteOAuth.
+func (mr *MockAuthUseCaseMockRecorder) CompleteOAuth(ctx, provider, code, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOAuth", reflect.TypeOf((*MockAuthUseCase)(nil).CompleteOAuth), ctx, provider, code, state)
+}
+
+// ConfirmMFA mocks base method.
+func (m *MockAuthUseCase) ConfirmMFA(ctx context.Context, claims *entity.AccessClaims, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmMFA", ctx, claims, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMFA indicates an expected call of ConfirmMFA.
+func (mr *MockAuthUseCaseMockRecorder) ConfirmMFA(ctx, claims, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMFA", reflect.TypeOf((*MockAuthUseCase)(nil).ConfirmMFA), ctx, claims, code)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAuthUseCase) CreateAPIKey(ctx context.Context, claims *entity.AccessClaims, name string, scopes []string, ttl time.Duration) (*entity.NewAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, claims, name, scopes, ttl)
+	ret0, _ := ret[0].(*entity.NewAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAuthUseCaseMockRecorder) CreateAPIKey(ctx, claims, name, scopes, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAuthUseCase)(nil).CreateAPIKey), ctx, claims, name, scopes, ttl)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockAuthUseCase) DeleteAccount(ctx context.Context, claims *entity.AccessClaims, password string) (*entity.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, claims, password)
+	ret0, _ := ret[0].(*entity.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockAuthUseCaseMockRecorder) DeleteAccount(ctx, claims, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockAuthUseCase)(nil).DeleteAccount), ctx, claims, password)
+}
+
+// DisableMFA mocks base method.
+func (m *MockAuthUseCase) DisableMFA(ctx context.Context, claims *entity.AccessClaims, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMFA", ctx, claims, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockAuthUseCaseMockRecorder) DisableMFA(ctx, claims, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAuthUseCase)(nil).DisableMFA), ctx, claims, code)
+}
+
+// EnrollMFA mocks base method.
+func (m *MockAuthUseCase) EnrollMFA(ctx context.Context, claims *entity.AccessClaims) (*entity.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFA", ctx, claims)
+	ret0, _ := ret[0].(*entity.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockAuthUseCaseMockRecorder) EnrollMFA(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAuthUseCase)(nil).EnrollMFA), ctx, claims)
+}
+
+// ExportUserData mocks base method.
+func (m *MockAuthUseCase) ExportUserData(ctx context.Context, claims *entity.AccessClaims) (*entity.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, claims)
+	ret0, _ := ret[0].(*entity.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockAuthUseCaseMockRecorder) ExportUserData(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockAuthUseCase)(nil).ExportUserData), ctx, claims)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAuthUseCase) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAuthUseCaseMockRecorder) ListAPIKeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAuthUseCase)(nil).ListAPIKeys), ctx, userID)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthUseCase) ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthUseCaseMockRecorder) ListSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthUseCase)(nil).ListSessions), ctx, userID)
+}
+
+// Refresh mocks base method.
+func (m *MockAuthUseCase) Refresh(ctx context.Context, refreshToken string) (*entity.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*entity.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthUseCaseMockRecorder) Refresh(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthUseCase)(nil).Refresh), ctx, refreshToken)
+}
+
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockAuthUseCase) RegenerateRecoveryCodes(ctx context.Context, claims *entity.AccessClaims, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", ctx, claims, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockAuthUseCaseMockRecorder) RegenerateRecoveryCodes(ctx, claims, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockAuthUseCase)(nil).RegenerateRecoveryCodes), ctx, claims, code)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockAuthUseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthUseCaseMockRecorder) RequestPasswordReset(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthUseCase)(nil).RequestPasswordReset), ctx, email)
+}
+
+// ResendVerification mocks base method.
+func (m *MockAuthUseCase) ResendVerification(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendVerification", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendVerification indicates an expected call of ResendVerification.
+func (mr *MockAuthUseCaseMockRecorder) ResendVerification(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerification", reflect.TypeOf((*MockAuthUseCase)(nil).ResendVerification), ctx, email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthUseCase) ResetPassword(ctx context.Context, token, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthUseCaseMockRecorder) ResetPassword(ctx, token, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthUseCase)(nil).ResetPassword), ctx, token, password)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAuthUseCase) RevokeAPIKey(ctx context.Context, claims *entity.AccessClaims, keyID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, claims, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAuthUseCaseMockRecorder) RevokeAPIKey(ctx, claims, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAuthUseCase)(nil).RevokeAPIKey), ctx, claims, keyID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthUseCase) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthUseCaseMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthUseCase)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// SignIn mocks base method.
+func (m *MockAuthUseCase) SignIn(ctx context.Context, email, password string) (*entity.TokenPair, *entity.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignIn", ctx, email, password)
+	ret0, _ := ret[0].(*entity.TokenPair)
+	ret1, _ := ret[1].(*entity.MFAChallenge)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SignIn indicates an expected call of SignIn.
+func (mr *MockAuthUseCaseMockRecorder) SignIn(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockAuthUseCase)(nil).SignIn), ctx, email, password)
+}
+
+// SignOut mocks base method.
+func (m *MockAuthUseCase) SignOut(ctx context.Context, claims *entity.AccessClaims) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignOut", ctx, claims)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignOut indicates an expected call of SignOut.
+func (mr *MockAuthUseCaseMockRecorder) SignOut(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockAuthUseCase)(nil).SignOut), ctx, claims)
+}
+
+// SignUp mocks base method.
+func (m *MockAuthUseCase) SignUp(ctx context.Context, email, password, username string) (*entity.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignUp", ctx, email, password, username)
+	ret0, _ := ret[0].(*entity.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignUp indicates an expected call of SignUp.
+func (mr *MockAuthUseCaseMockRecorder) SignUp(ctx, email, password, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockAuthUseCase)(nil).SignUp), ctx, email, password, username)
+}
+
+// StartOAuth mocks base method.
+func (m *MockAuthUseCase) StartOAuth(ctx context.Context, provider string) (*entity.OAuthRedirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartOAuth", ctx, provider)
+	ret0, _ := ret[0].(*entity.OAuthRedirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartOAuth indicates an expected call of StartOAuth.
+func (mr *MockAuthUseCaseMockRecorder) StartOAuth(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartOAuth", reflect.TypeOf((*MockAuthUseCase)(nil).StartOAuth), ctx, provider)
+}
+
+// ValidateToken mocks base method.
+func (m *MockAuthUseCase) ValidateToken(ctx context.Context, token string) (*entity.User, *entity.AccessClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateToken", ctx, token)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(*entity.AccessClaims)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidateToken indicates an expected call of ValidateToken.
+func (mr *MockAuthUseCaseMockRecorder) ValidateToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockAuthUseCase)(nil).ValidateToken), ctx, token)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockAuthUseCase) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthUseCaseMockRecorder) VerifyEmail(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthUseCase)(nil).VerifyEmail), ctx, token)
+}
+
+// VerifyMFA mocks base method.
+func (m *MockAuthUseCase) VerifyMFA(ctx context.Context, challengeToken, code string) (*entity.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFA", ctx, challengeToken, code)
+	ret0, _ := ret[0].(*entity.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthUseCaseMockRecorder) VerifyMFA(ctx, challengeToken, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthUseCase)(nil).VerifyMFA), ctx, challengeToken, code)
+}
+
+// MockAdminUseCase is a mock of AdminUseCase interface.
+type MockAdminUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminUseCaseMockRecorder is the mock recorder for MockAdminUseCase.
+type MockAdminUseCaseMockRecorder struct {
+	mock *MockAdminUseCase
+}
+
+// NewMockAdminUseCase creates a new mock instance.
+func NewMockAdminUseCase(ctrl *gomock.Controller) *MockAdminUseCase {
+	mock := &MockAdminUseCase{ctrl: ctrl}
+	mock.recorder = &MockAdminUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminUseCase) EXPECT() *MockAdminUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DisableUser mocks base method.
+func (m *MockAdminUseCase) DisableUser(ctx context.Context, actorID, userID uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableUser", ctx, actorID, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableUser indicates an expected call of DisableUser.
+func (mr *MockAdminUseCaseMockRecorder) DisableUser(ctx, actorID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUser", reflect.TypeOf((*MockAdminUseCase)(nil).DisableUser), ctx, actorID, userID)
+}
+
+// EnableUser mocks base method.
+func (m *MockAdminUseCase) EnableUser(ctx context.Context, actorID, userID uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUser", ctx, actorID, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableUser indicates an expected call of EnableUser.
+func (mr *MockAdminUseCaseMockRecorder) EnableUser(ctx, actorID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUser", reflect.TypeOf((*MockAdminUseCase)(nil).EnableUser), ctx, actorID, userID)
+}
+
+// GetUser mocks base method.
+func (m *MockAdminUseCase) GetUser(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockAdminUseCaseMockRecorder) GetUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockAdminUseCase)(nil).GetUser), ctx, id)
+}
+
+// Impersonate mocks base method.
+func (m *MockAdminUseCase) Impersonate(ctx context.Context, actorID, userID uuid.UUID) (*entity.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Impersonate", ctx, actorID, userID)
+	ret0, _ := ret[0].(*entity.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Impersonate indicates an expected call of Impersonate.
+func (mr *MockAdminUseCaseMockRecorder) Impersonate(ctx, actorID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Impersonate", reflect.TypeOf((*MockAdminUseCase)(nil).Impersonate), ctx, actorID, userID)
+}
+
+// ListAuditEvents mocks base method.
+func (m *MockAdminUseCase) ListAuditEvents(ctx context.Context, filter entity.AuditFilter) ([]*entity.AuditEvent, *entity.AuditCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, filter)
+	ret0, _ := ret[0].([]*entity.AuditEvent)
+	ret1, _ := ret[1].(*entity.AuditCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockAdminUseCaseMockRecorder) ListAuditEvents(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockAdminUseCase)(nil).ListAuditEvents), ctx, filter)
+}
+
+// ListUsers mocks base method.
+func (m *MockAdminUseCase) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, filter)
+	ret0, _ := ret[0].([]*entity.User)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockAdminUseCaseMockRecorder) ListUsers(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockAdminUseCase)(nil).ListUsers), ctx, filter)
+}
+
+// MFAPolicy mocks base method.
+func (m *MockAdminUseCase) MFAPolicy(ctx context.Context) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAPolicy", ctx)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MFAPolicy indicates an expected call of MFAPolicy.
+func (mr *MockAdminUseCaseMockRecorder) MFAPolicy(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAPolicy", reflect.TypeOf((*MockAdminUseCase)(nil).MFAPolicy), ctx)
+}
+
+// ResetMFA mocks base method.
+func (m *MockAdminUseCase) ResetMFA(ctx context.Context, actorID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetMFA", ctx, actorID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetMFA indicates an expected call of ResetMFA.
+func (mr *MockAdminUseCaseMockRecorder) ResetMFA(ctx, actorID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetMFA", reflect.TypeOf((*MockAdminUseCase)(nil).ResetMFA), ctx, actorID, userID)
+}
+
+// RetryAccountDeletion mocks base method.
+func (m *MockAdminUseCase) RetryAccountDeletion(ctx context.Context, actorID, id uuid.UUID) (*entity.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryAccountDeletion", ctx, actorID, id)
+	ret0, _ := ret[0].(*entity.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryAccountDeletion indicates an expected call of RetryAccountDeletion.
+func (mr *MockAdminUseCaseMockRecorder) RetryAccountDeletion(ctx, actorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryAccountDeletion", reflect.TypeOf((*MockAdminUseCase)(nil).RetryAccountDeletion), ctx, actorID, id)
+}
+
+// SetMFARequirement mocks base method.
+func (m *MockAdminUseCase) SetMFARequirement(ctx context.Context, actorID uuid.UUID, role entity.Role, required bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMFARequirement", ctx, actorID, role, required)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMFARequirement indicates an expected call of SetMFARequirement.
+func (mr *MockAdminUseCaseMockRecorder) SetMFARequirement(ctx, actorID, role, required any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMFARequirement", reflect.TypeOf((*MockAdminUseCase)(nil).SetMFARequirement), ctx, actorID, role, required)
+}
+
+// SetUserRole mocks base method.
+func (m *MockAdminUseCase) SetUserRole(ctx context.Context, actorID, userID uuid.UUID, role entity.Role) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRole", ctx, actorID, userID, role)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserRole indicates an expected call of SetUserRole.
+func (mr *MockAdminUseCaseMockRecorder) SetUserRole(ctx, actorID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRole", reflect.TypeOf((*MockAdminUseCase)(nil).SetUserRole), ctx, actorID, userID, role)
+}
+
+// UnlockUser mocks base method.
+func (m *MockAdminUseCase) UnlockUser(ctx context.Context, actorID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, actorID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockAdminUseCaseMockRecorder) UnlockUser(ctx, actorID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockAdminUseCase)(nil).UnlockUser), ctx, actorID, userID)
+}
+
+// MockProfileUseCase is a mock of ProfileUseCase interface.
+type MockProfileUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockProfileUseCaseMockRecorder is the mock recorder for MockProfileUseCase.
+type MockProfileUseCaseMockRecorder struct {
+	mock *MockProfileUseCase
+}
+
+// NewMockProfileUseCase creates a new mock instance.
+func NewMockProfileUseCase(ctrl *gomock.Controller) *MockProfileUseCase {
+	mock := &MockProfileUseCase{ctrl: ctrl}
+	mock.recorder = &MockProfileUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileUseCase) EXPECT() *MockProfileUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetProfile mocks base method.
+func (m *MockProfileUseCase) GetProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, userID)
+	ret0, _ := ret[0].(*entity.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockProfileUseCaseMockRecorder) GetProfile(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockProfileUseCase)(nil).GetProfile), ctx, userID)
+}
+
+// GetUserProfile mocks base method.
+func (m *MockProfileUseCase) GetUserProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProfile", ctx, userID)
+	ret0, _ := ret[0].(*entity.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProfile indicates an expected call of GetUserProfile.
+func (mr *MockProfileUseCaseMockRecorder) GetUserProfile(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockProfileUseCase)(nil).GetUserProfile), ctx, userID)
+}
+
+// ListRiskAssessments mocks base method.
+func (m *MockProfileUseCase) ListRiskAssessments(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.RiskAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRiskAssessments", ctx, userID, limit)
+	ret0, _ := ret[0].([]*entity.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRiskAssessments indicates an expected call of ListRiskAssessments.
+func (mr *MockProfileUseCaseMockRecorder) ListRiskAssessments(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRiskAssessments", reflect.TypeOf((*MockProfileUseCase)(nil).ListRiskAssessments), ctx, userID, limit)
+}
+
+// SubmitRiskQuestionnaire mocks base method.
+func (m *MockProfileUseCase) SubmitRiskQuestionnaire(ctx context.Context, claims *entity.AccessClaims, answers map[string]string) (*entity.RiskAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitRiskQuestionnaire", ctx, claims, answers)
+	ret0, _ := ret[0].(*entity.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitRiskQuestionnaire indicates an expected call of SubmitRiskQuestionnaire.
+func (mr *MockProfileUseCaseMockRecorder) SubmitRiskQuestionnaire(ctx, claims, answers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitRiskQuestionnaire", reflect.TypeOf((*MockProfileUseCase)(nil).SubmitRiskQuestionnaire), ctx, claims, answers)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockProfileUseCase) UpdateProfile(ctx context.Context, claims *entity.AccessClaims, update entity.ProfileUpdate) (*entity.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, claims, update)
+	ret0, _ := ret[0].(*entity.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockProfileUseCaseMockRecorder) UpdateProfile(ctx, claims, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockProfileUseCase)(nil).UpdateProfile), ctx, claims, update)
+}
+
+// MockKeyRing is a mock of KeyRing interface.
+type MockKeyRing struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyRingMockRecorder
+	isgomock struct{}
+}
+
+// MockKeyRingMockRecorder is the mock recorder for MockKeyRing.
+type MockKeyRingMockRecorder struct {
+	mock *MockKeyRing
+}
+
+// NewMockKeyRing creates a new mock instance.
+func NewMockKeyRing(ctrl *gomock.Controller) *MockKeyRing {
+	mock := &MockKeyRing{ctrl: ctrl}
+	mock.recorder = &MockKeyRingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyRing) EXPECT() *MockKeyRingMockRecorder {
+	return m.recorder
+}
+
+// JWKS mocks base method.
+func (m *MockKeyRing) JWKS() *entity.JWKS {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(*entity.JWKS)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockKeyRingMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockKeyRing)(nil).JWKS))
+}
+
+// Key mocks base method.
+func (m *MockKeyRing) Key(kid string) *entity.SigningKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Key", kid)
+	ret0, _ := ret[0].(*entity.SigningKey)
+	return ret0
+}
+
+// Key indicates an expected call of Key.
+func (mr *MockKeyRingMockRecorder) Key(kid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockKeyRing)(nil).Key), kid)
+}
+
+// Signing mocks base method.
+func (m *MockKeyRing) Signing() (*entity.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signing")
+	ret0, _ := ret[0].(*entity.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Signing indicates an expected call of Signing.
+func (mr *MockKeyRingMockRecorder) Signing() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signing", reflect.TypeOf((*MockKeyRing)(nil).Signing))
+}
+
+// Sync mocks base method.
+func (m *MockKeyRing) Sync(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockKeyRingMockRecorder) Sync(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockKeyRing)(nil).Sync), ctx)
+}
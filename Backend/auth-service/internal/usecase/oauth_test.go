@@ -0,0 +1,262 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+func TestStartOAuth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stores the authorization", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+
+		var state, nonce, verifier string
+		m.provider.EXPECT().AuthCodeURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, s, n, v string) (string, error) {
+				state, nonce, verifier = s, n, v
+				return "https://accounts.example.com/authorize?state=" + s, nil
+			})
+
+		var stored *entity.OAuthState
+		m.identities.EXPECT().CreateState(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, s *entity.OAuthState) error {
+				stored = s
+				return nil
+			})
+
+		redirect, err := uc.StartOAuth(context.Background(), entity.ProviderGoogle)
+
+		require.NoError(t, err)
+		require.Equal(t, "https://accounts.example.com/authorize?state="+state, redirect.URL)
+		require.Equal(t, state, redirect.State)
+		require.Equal(t, _now.Add(10*time.Minute), redirect.ExpiresAt)
+
+		require.Equal(t, entity.ProviderGoogle, stored.Provider)
+		require.Equal(t, sha256Hex(state), stored.StateHash)
+		require.Equal(t, verifier, stored.CodeVerifier)
+		require.Equal(t, nonce, stored.Nonce)
+		require.Equal(t, redirect.ExpiresAt, stored.ExpiresAt)
+
+		// the PKCE verifier must be 43 to 128 unreserved characters
+		require.GreaterOrEqual(t, len(verifier), 43)
+		require.NotEqual(t, state, verifier)
+		require.NotEqual(t, state, nonce)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		t.Parallel()
+
+		uc, _ := authUseCase(t)
+
+		_, err := uc.StartOAuth(context.Background(), entity.ProviderGitHub)
+
+		require.ErrorIs(t, err, usecase.ErrUnknownProvider)
+	})
+}
+
+func TestCompleteOAuth(t *testing.T) {
+	t.Parallel()
+
+	pending := &entity.OAuthState{
+		ID:           uuid.New(),
+		Provider:     entity.ProviderGoogle,
+		StateHash:    sha256Hex("state"),
+		CodeVerifier: "verifier",
+		Nonce:        "nonce",
+	}
+	profile := &entity.ExternalProfile{
+		Subject:       "110169484474386276334",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Name:          "Jane Doe",
+	}
+
+	verified := func(t *testing.T) *entity.User {
+		user := testUser(t, entity.RoleUser)
+		verifiedAt := _now.Add(-time.Hour)
+		user.VerifiedAt = &verifiedAt
+		return user
+	}
+
+	tests := []struct {
+		name    string
+		profile *entity.ExternalProfile
+		mock    func(t *testing.T, m *authMocks)
+		mfa     bool
+		linked  string // new_user of the identity.linked event, empty without one
+		err     error
+	}{
+		{
+			name: "linked identity",
+			mock: func(t *testing.T, m *authMocks) {
+				user := verified(t)
+				identity := &entity.ExternalIdentity{ID: uuid.New(), UserID: user.ID}
+
+				m.identities.EXPECT().Get(gomock.Any(), entity.ProviderGoogle, profile.Subject).Return(identity, nil)
+				m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+				m.identities.EXPECT().Touch(gomock.Any(), identity.ID, profile.Email, _now).Return(nil)
+				m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+				m.expectSession(t)
+			},
+		},
+		{
+			name: "linked identity with mfa",
+			mock: func(t *testing.T, m *authMocks) {
+				user := verified(t)
+				identity := &entity.ExternalIdentity{ID: uuid.New(), UserID: user.ID}
+
+				m.identities.EXPECT().Get(gomock.Any(), entity.ProviderGoogle, profile.Subject).Return(identity, nil)
+				m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+				m.identities.EXPECT().Touch(gomock.Any(), identity.ID, profile.Email, _now).Return(nil)
+				m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(enabledMFA(user.ID, 0), nil)
+				m.mfa.EXPECT().CreateChallenge(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mfa: true,
+		},
+		{
+			name: "linked identity of a disabled user",
+			mock: func(t *testing.T, m *authMocks) {
+				user := verified(t)
+				user.DisabledAt = &_now
+				identity := &entity.ExternalIdentity{ID: uuid.New(), UserID: user.ID}
+
+				m.identities.EXPECT().Get(gomock.Any(), entity.ProviderGoogle, profile.Subject).Return(identity, nil)
+				m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+				m.identities.EXPECT().Touch(gomock.Any(), identity.ID, profile.Email, _now).Return(nil)
+			},
+			err: usecase.ErrUserDisabled,
+		},
+		{
+			name: "new identity of a verified account is linked",
+			mock: func(t *testing.T, m *authMocks) {
+				user := verified(t)
+
+				m.identities.EXPECT().Get(gomock.Any(), entity.ProviderGoogle, profile.Subject).Return(nil, nil)
+				m.users.EXPECT().GetByEmail(gomock.Any(), profile.Email).Return(user, nil)
+				m.identities.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, identity *entity.ExternalIdentity) error {
+						require.Equal(t, user.ID, identity.UserID)
+						require.Equal(t, entity.ProviderGoogle, identity.Provider)
+						require.Equal(t, profile.Subject, identity.Subject)
+						return nil
+					})
+				m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+				m.expectSession(t)
+			},
+			linked: "false",
+		},
+		{
+			name: "new identity of an unverified account",
+			mock: func(t *testing.T, m *authMocks) {
+				m.identities.EXPECT().Get(gomock.Any(), entity.ProviderGoogle, profile.Subject).Return(nil, nil)
+				m.users.EXPECT().GetByEmail(gomock.Any(), profile.Email).Return(testUser(t, entity.RoleUser), nil)
+			},
+			err: usecase.ErrAccountNotLinkable,
+		},
+		{
+			name: "new identity without account gets one",
+			mock: func(t *testing.T, m *authMocks) {
+				m.identities.EXPECT().Get(gomock.Any(), entity.ProviderGoogle, profile.Subject).Return(nil, nil)
+				m.users.EXPECT().GetByEmail(gomock.Any(), profile.Email).Return(nil, nil)
+				m.identities.EXPECT().CreateWithUser(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, user *entity.User, identity *entity.ExternalIdentity) error {
+						require.Equal(t, profile.Email, user.Email)
+						require.Equal(t, "Jane Doe", user.Username)
+						require.Equal(t, entity.RoleUser, user.Role)
+						require.True(t, user.IsVerified())
+						require.NotEmpty(t, user.PasswordHash)
+						require.Equal(t, user.ID, identity.UserID)
+						return nil
+					})
+				m.mfa.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.expectSession(t)
+			},
+			linked: "true",
+		},
+		{
+			name:    "new identity with an unverified email",
+			profile: &entity.ExternalProfile{Subject: profile.Subject, Email: profile.Email},
+			mock: func(_ *testing.T, m *authMocks) {
+				m.identities.EXPECT().Get(gomock.Any(), entity.ProviderGoogle, profile.Subject).Return(nil, nil)
+			},
+			err: usecase.ErrExternalEmailNotVerified,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, m := authUseCase(t)
+
+			p := profile
+			if tc.profile != nil {
+				p = tc.profile
+			}
+			m.identities.EXPECT().ConsumeState(gomock.Any(), sha256Hex("state"), entity.ProviderGoogle, _now).Return(pending, nil)
+			m.provider.EXPECT().Exchange(gomock.Any(), "code", "verifier", "nonce").Return(p, nil)
+			tc.mock(t, m)
+
+			pair, challenge, err := uc.CompleteOAuth(context.Background(), entity.ProviderGoogle, "code", "state")
+
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			linked := m.eventsOf(entity.AuditIdentityLinked)
+			if tc.linked == "" {
+				require.Empty(t, linked)
+			} else {
+				require.Len(t, linked, 1)
+				require.Equal(t, tc.linked, linked[0].Details["new_user"])
+			}
+			signIn := m.eventsOf(entity.AuditSignIn)
+			require.Len(t, signIn, 1)
+			require.Equal(t, entity.ProviderGoogle, signIn[0].Details["method"])
+
+			if tc.mfa {
+				require.Nil(t, pair)
+				require.NotEmpty(t, challenge.Token)
+				return
+			}
+			require.Nil(t, challenge)
+			require.NotEmpty(t, pair.AccessToken)
+		})
+	}
+
+	t.Run("unknown, used or expired state", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		m.identities.EXPECT().ConsumeState(gomock.Any(), sha256Hex("state"), entity.ProviderGoogle, _now).Return(nil, nil)
+
+		_, _, err := uc.CompleteOAuth(context.Background(), entity.ProviderGoogle, "code", "state")
+
+		require.ErrorIs(t, err, usecase.ErrInvalidToken)
+	})
+
+	t.Run("provider rejects the code", func(t *testing.T) {
+		t.Parallel()
+
+		uc, m := authUseCase(t)
+		m.identities.EXPECT().ConsumeState(gomock.Any(), sha256Hex("state"), entity.ProviderGoogle, _now).Return(pending, nil)
+		m.provider.EXPECT().Exchange(gomock.Any(), "code", "verifier", "nonce").Return(nil, errors.New("invalid_grant"))
+
+		_, _, err := uc.CompleteOAuth(context.Background(), entity.ProviderGoogle, "code", "state")
+
+		require.ErrorIs(t, err, usecase.ErrOAuthFailed)
+	})
+}
@@ -0,0 +1,218 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+// _symbolPattern matches ticker symbols like AAPL, BRK.B or BTC-USD
+var _symbolPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9.\-]{0,14}$`)
+
+// Config holds the settings of the profile UseCase
+type Config struct {
+	WatchlistLimit int // symbols a watchlist may hold
+
+	// Clock returns the current time, time.Now when nil
+	Clock func() time.Time
+}
+
+// UseCase implements the investment profile use case
+type UseCase struct {
+	profiles repo.ProfileRepository
+	users    repo.UserRepository
+	clock    func() time.Time
+
+	watchlistLimit int
+}
+
+// New creates a new instance of profile UseCase
+func New(profiles repo.ProfileRepository, users repo.UserRepository, cfg Config) *UseCase {
+	clock := cfg.Clock
+	if clock == nil {
+		clock = time.Now
+	}
+
+	return &UseCase{
+		profiles:       profiles,
+		users:          users,
+		clock:          clock,
+		watchlistLimit: cfg.WatchlistLimit,
+	}
+}
+
+// now returns the current time of the use case clock in UTC
+func (uc *UseCase) now() time.Time {
+	return uc.clock().UTC()
+}
+
+// GetProfile returns the profile of the user, users who have not set one up
+// get the defaults
+func (uc *UseCase) GetProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	p, err := uc.profiles.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.profiles.Get(): %w", err)
+	}
+	if p == nil {
+		return entity.NewProfile(userID), nil
+	}
+
+	return p, nil
+}
+
+// GetUserProfile returns the profile of a user for other services, unlike
+// GetProfile the user is not known to exist
+func (uc *UseCase) GetUserProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	user, err := uc.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.users.GetByID(): %w", err)
+	}
+	if user == nil {
+		return nil, usecase.ErrUserNotFound
+	}
+
+	return uc.GetProfile(ctx, user.ID)
+}
+
+// UpdateProfile changes the preferences of the update on the profile of the
+// claims' user, the risk tolerance only changes with SubmitRiskQuestionnaire
+func (uc *UseCase) UpdateProfile(
+	ctx context.Context,
+	claims *entity.AccessClaims,
+	update entity.ProfileUpdate,
+) (*entity.Profile, error) {
+	if claims.ActorID != uuid.Nil {
+		return nil, usecase.ErrImpersonated
+	}
+
+	p, err := uc.GetProfile(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Horizon != nil {
+		if *update.Horizon == "" {
+			p.Horizon = ""
+		} else if p.Horizon, err = entity.ParseInvestmentHorizon(string(*update.Horizon)); err != nil {
+			return nil, usecase.ErrInvalidHorizon
+		}
+	}
+	if update.BaseCurrency != nil {
+		unit, err := currency.ParseISO(strings.TrimSpace(*update.BaseCurrency))
+		if err != nil {
+			return nil, usecase.ErrInvalidCurrency
+		}
+		p.BaseCurrency = unit.String()
+	}
+	if update.Markets != nil {
+		if p.Markets, err = normalizeMarkets(*update.Markets); err != nil {
+			return nil, err
+		}
+	}
+	if update.Watchlist != nil {
+		if p.Watchlist, err = uc.normalizeWatchlist(*update.Watchlist); err != nil {
+			return nil, err
+		}
+	}
+	if update.Locale != nil {
+		tag, err := language.Parse(strings.TrimSpace(*update.Locale))
+		if err != nil {
+			return nil, usecase.ErrInvalidLocale
+		}
+		p.Locale = tag.String()
+	}
+
+	p.UpdatedAt = uc.now()
+	if err := uc.profiles.SavePreferences(ctx, p); err != nil {
+		return nil, fmt.Errorf("uc.profiles.SavePreferences(): %w", err)
+	}
+
+	return p, nil
+}
+
+// SubmitRiskQuestionnaire scores the answers of the claims' user, keeps them
+// in the history and sets the tolerance of the profile
+func (uc *UseCase) SubmitRiskQuestionnaire(
+	ctx context.Context,
+	claims *entity.AccessClaims,
+	answers map[string]string,
+) (*entity.RiskAssessment, error) {
+	if claims.ActorID != uuid.Nil {
+		return nil, usecase.ErrImpersonated
+	}
+
+	score, tolerance, err := entity.ScoreRisk(answers)
+	if err != nil {
+		return nil, usecase.ErrInvalidAnswers
+	}
+
+	assessment := &entity.RiskAssessment{
+		ID:        uuid.New(),
+		UserID:    claims.UserID,
+		Version:   entity.RiskQuestionnaireVersion,
+		Answers:   answers,
+		Score:     score,
+		Tolerance: tolerance,
+		CreatedAt: uc.now(),
+	}
+	if err := uc.profiles.SaveAssessment(ctx, assessment); err != nil {
+		return nil, fmt.Errorf("uc.profiles.SaveAssessment(): %w", err)
+	}
+
+	return assessment, nil
+}
+
+// ListRiskAssessments returns up to limit past assessments of the user, newest first
+func (uc *UseCase) ListRiskAssessments(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.RiskAssessment, error) {
+	assessments, err := uc.profiles.ListAssessments(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("uc.profiles.ListAssessments(): %w", err)
+	}
+
+	return assessments, nil
+}
+
+// normalizeMarkets lowercases, sorts and deduplicates known markets
+func normalizeMarkets(markets []string) ([]string, error) {
+	normalized := make([]string, 0, len(markets))
+	for _, m := range markets {
+		m = strings.ToLower(strings.TrimSpace(m))
+		if !slices.Contains(entity.Markets, m) {
+			return nil, usecase.ErrInvalidMarket
+		}
+		normalized = append(normalized, m)
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(normalized))), nil
+}
+
+// normalizeWatchlist uppercases the symbols and drops repeated ones, the
+// order the user chose is kept
+func (uc *UseCase) normalizeWatchlist(symbols []string) ([]string, error) {
+	normalized := make([]string, 0, len(symbols))
+	for _, s := range symbols {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if !_symbolPattern.MatchString(s) {
+			return nil, usecase.ErrInvalidSymbol
+		}
+		if !slices.Contains(normalized, s) {
+			normalized = append(normalized, s)
+		}
+	}
+	if len(normalized) > uc.watchlistLimit {
+		return nil, usecase.ErrWatchlistFull
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,272 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase/profile"
+)
+
+func profileUseCase(t *testing.T) (*profile.UseCase, *MockProfileRepository, *MockUserRepository) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+	profiles := NewMockProfileRepository(mockCtl)
+	users := NewMockUserRepository(mockCtl)
+
+	uc := profile.New(profiles, users, profile.Config{
+		WatchlistLimit: 3,
+		Clock:          func() time.Time { return _now },
+	})
+
+	return uc, profiles, users
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// answers returns an answer to every question of the questionnaire, the option at index i or the last one
+func answers(i int) map[string]string {
+	a := make(map[string]string, len(entity.RiskQuestionnaire))
+	for _, q := range entity.RiskQuestionnaire {
+		a[q.ID] = q.Options[min(i, len(q.Options)-1)].ID
+	}
+	return a
+}
+
+func TestUpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name   string
+		stored *entity.Profile
+		update entity.ProfileUpdate
+		want   func(p *entity.Profile)
+		err    error
+	}{
+		{
+			name: "first update starts from the defaults",
+			update: entity.ProfileUpdate{
+				Horizon:      ptr(entity.HorizonLong),
+				BaseCurrency: ptr(" eur "),
+				Markets:      ptr([]string{"US", "crypto", "us"}),
+				Watchlist:    ptr([]string{"aapl", " BRK.B", "AAPL"}),
+				Locale:       ptr("de-de"),
+			},
+			want: func(p *entity.Profile) {
+				p.Horizon = entity.HorizonLong
+				p.BaseCurrency = "EUR"
+				p.Markets = []string{"crypto", "us"}
+				p.Watchlist = []string{"AAPL", "BRK.B"}
+				p.Locale = "de-DE"
+			},
+		},
+		{
+			name: "unset fields are kept",
+			stored: &entity.Profile{
+				UserID:        userID,
+				RiskTolerance: entity.RiskGrowth,
+				RiskScore:     16,
+				Horizon:       entity.HorizonMedium,
+				BaseCurrency:  "GBP",
+				Markets:       []string{"uk"},
+				Watchlist:     []string{"VOD"},
+				Locale:        "en-GB",
+			},
+			update: entity.ProfileUpdate{Watchlist: ptr([]string{})},
+			want: func(p *entity.Profile) {
+				p.RiskTolerance = entity.RiskGrowth
+				p.RiskScore = 16
+				p.Horizon = entity.HorizonMedium
+				p.BaseCurrency = "GBP"
+				p.Markets = []string{"uk"}
+				p.Locale = "en-GB"
+			},
+		},
+		{
+			name:   "unknown horizon",
+			update: entity.ProfileUpdate{Horizon: ptr(entity.InvestmentHorizon("forever"))},
+			err:    usecase.ErrInvalidHorizon,
+		},
+		{
+			name:   "unknown currency",
+			update: entity.ProfileUpdate{BaseCurrency: ptr("XYZ1")},
+			err:    usecase.ErrInvalidCurrency,
+		},
+		{
+			name:   "unknown market",
+			update: entity.ProfileUpdate{Markets: ptr([]string{"us", "mars"})},
+			err:    usecase.ErrInvalidMarket,
+		},
+		{
+			name:   "malformed symbol",
+			update: entity.ProfileUpdate{Watchlist: ptr([]string{"AAPL", "$TSLA"})},
+			err:    usecase.ErrInvalidSymbol,
+		},
+		{
+			name:   "too many symbols",
+			update: entity.ProfileUpdate{Watchlist: ptr([]string{"AAPL", "MSFT", "NVDA", "TSLA"})},
+			err:    usecase.ErrWatchlistFull,
+		},
+		{
+			name:   "malformed locale",
+			update: entity.ProfileUpdate{Locale: ptr(strings.Repeat("x", 20))},
+			err:    usecase.ErrInvalidLocale,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, profiles, _ := profileUseCase(t)
+
+			profiles.EXPECT().Get(gomock.Any(), userID).Return(tc.stored, nil)
+			var saved *entity.Profile
+			if tc.err == nil {
+				profiles.EXPECT().SavePreferences(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, p *entity.Profile) error {
+						saved = p
+						return nil
+					})
+			}
+
+			p, err := uc.UpdateProfile(context.Background(), &entity.AccessClaims{UserID: userID}, tc.update)
+
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			want := entity.NewProfile(userID)
+			tc.want(want)
+			want.UpdatedAt = _now
+			require.Equal(t, want, p)
+			require.Same(t, saved, p)
+		})
+	}
+
+	t.Run("impersonation token", func(t *testing.T) {
+		t.Parallel()
+
+		uc, _, _ := profileUseCase(t)
+		claims := &entity.AccessClaims{UserID: userID, ActorID: uuid.New()}
+
+		_, err := uc.UpdateProfile(context.Background(), claims, entity.ProfileUpdate{Locale: ptr("fr")})
+
+		require.ErrorIs(t, err, usecase.ErrImpersonated)
+	})
+}
+
+func TestSubmitRiskQuestionnaire(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		answers   map[string]string
+		score     int
+		tolerance entity.RiskTolerance
+		err       error
+	}{
+		{name: "lowest answers", answers: answers(0), score: 0, tolerance: entity.RiskConservative},
+		{name: "second answers", answers: answers(1), score: 7, tolerance: entity.RiskModerate},
+		{name: "third answers", answers: answers(2), score: 16, tolerance: entity.RiskGrowth},
+		{name: "highest answers", answers: answers(3), score: 22, tolerance: entity.RiskAggressive},
+		{
+			name: "unanswered question",
+			answers: func() map[string]string {
+				a := answers(0)
+				delete(a, "goal")
+				return a
+			}(),
+			err: usecase.ErrInvalidAnswers,
+		},
+		{
+			name: "unknown option",
+			answers: func() map[string]string {
+				a := answers(0)
+				a["goal"] = "lottery"
+				return a
+			}(),
+			err: usecase.ErrInvalidAnswers,
+		},
+		{
+			name: "unknown question",
+			answers: func() map[string]string {
+				a := answers(0)
+				delete(a, "goal")
+				a["pets"] = "cat"
+				return a
+			}(),
+			err: usecase.ErrInvalidAnswers,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc, profiles, _ := profileUseCase(t)
+			claims := &entity.AccessClaims{UserID: uuid.New()}
+
+			if tc.err == nil {
+				profiles.EXPECT().SaveAssessment(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			a, err := uc.SubmitRiskQuestionnaire(context.Background(), claims, tc.answers)
+
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.Equal(t, claims.UserID, a.UserID)
+			require.Equal(t, entity.RiskQuestionnaireVersion, a.Version)
+			require.Equal(t, tc.score, a.Score)
+			require.Equal(t, tc.tolerance, a.Tolerance)
+			require.Equal(t, _now, a.CreatedAt)
+		})
+	}
+}
+
+func TestGetUserProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("user without profile gets the defaults", func(t *testing.T) {
+		t.Parallel()
+
+		uc, profiles, users := profileUseCase(t)
+		user := testUser(t, entity.RoleUser)
+
+		users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+		profiles.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+
+		p, err := uc.GetUserProfile(context.Background(), user.ID)
+
+		require.NoError(t, err)
+		require.Equal(t, entity.NewProfile(user.ID), p)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		uc, _, users := profileUseCase(t)
+		userID := uuid.New()
+
+		users.EXPECT().GetByID(gomock.Any(), userID).Return(nil, nil)
+
+		_, err := uc.GetUserProfile(context.Background(), userID)
+
+		require.ErrorIs(t, err, usecase.ErrUserNotFound)
+	})
+}
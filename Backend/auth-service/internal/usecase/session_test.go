@@ -0,0 +1,213 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+const _refreshToken = "refresh-token"
+
+// refreshSession is the live session of _refreshToken, issued 10 minutes ago
+func refreshSession(userID uuid.UUID) *entity.Session {
+	return &entity.Session{
+		ID:        uuid.New(),
+		FamilyID:  uuid.New(),
+		UserID:    userID,
+		TokenHash: sha256Hex(_refreshToken),
+		AccessJTI: uuid.New(),
+		CreatedAt: _now.Add(-10 * time.Minute),
+		ExpiresAt: _now.Add(50 * time.Minute),
+	}
+}
+
+// accessClaims returns the claims of a token signed by the use case
+func accessClaims(t *testing.T, token string) jwt.MapClaims {
+	t.Helper()
+
+	claims := jwt.MapClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(token, claims)
+	require.NoError(t, err)
+
+	return claims
+}
+
+func TestRefreshRotates(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+	session := refreshSession(user.ID)
+
+	key, err := entity.GenerateSigningKey("EdDSA", _now.Add(-time.Hour))
+	require.NoError(t, err)
+
+	var next *entity.Session
+	m.sessions.EXPECT().GetByTokenHash(gomock.Any(), sha256Hex(_refreshToken)).Return(session, nil)
+	m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	m.sessions.EXPECT().Rotate(gomock.Any(), session.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, s *entity.Session) (bool, error) {
+			next = s
+			return true, nil
+		})
+	m.mfa.EXPECT().RequiredRoles(gomock.Any()).Return(nil, nil)
+	m.keys.EXPECT().Signing().Return(key, nil)
+
+	pair, err := useCase.Refresh(context.Background(), _refreshToken)
+	require.NoError(t, err)
+
+	// the successor stays in the family and only its hash is stored
+	require.NotEqual(t, _refreshToken, pair.RefreshToken)
+	require.Equal(t, session.FamilyID, next.FamilyID)
+	require.Equal(t, user.ID, next.UserID)
+	require.Equal(t, sha256Hex(pair.RefreshToken), next.TokenHash)
+	require.Equal(t, _now, next.CreatedAt)
+	require.Equal(t, _now.Add(time.Hour), next.ExpiresAt)
+	require.Equal(t, next.ExpiresAt, pair.RefreshExpiresAt)
+
+	// the access token is bound to the family and revocable by the successor
+	claims := accessClaims(t, pair.AccessToken)
+	require.Equal(t, session.FamilyID.String(), claims["sid"])
+	require.Equal(t, next.AccessJTI.String(), claims["jti"])
+	require.Equal(t, _now.Add(15*time.Minute), pair.AccessExpiresAt)
+}
+
+func TestRefreshReuseRevokesFamily(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+	session := refreshSession(user.ID)
+	rotatedAt := _now.Add(-5 * time.Minute)
+	session.RotatedAt = &rotatedAt
+
+	// the successor's access token is still live, the original one expired
+	successor := refreshSession(user.ID)
+	successor.CreatedAt = _now.Add(-5 * time.Minute)
+	expired := refreshSession(user.ID)
+	expired.CreatedAt = _now.Add(-30 * time.Minute)
+
+	m.sessions.EXPECT().GetByTokenHash(gomock.Any(), sha256Hex(_refreshToken)).Return(session, nil)
+	m.sessions.EXPECT().RevokeFamily(gomock.Any(), user.ID, session.FamilyID).Return([]*entity.Session{expired, successor}, nil)
+	m.denylist.EXPECT().Add(gomock.Any(), successor.AccessJTI, _now.Add(10*time.Minute)).Return(nil)
+
+	_, err := useCase.Refresh(context.Background(), _refreshToken)
+	require.ErrorIs(t, err, usecase.ErrRefreshTokenReused)
+
+	events := m.eventsOf(entity.AuditRefreshReused)
+	require.Len(t, events, 1)
+	require.Equal(t, user.ID, events[0].TargetID)
+	require.Equal(t, session.FamilyID.String(), events[0].Details["session_id"])
+}
+
+func TestRefreshRotationRace(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+	session := refreshSession(user.ID)
+
+	// another request rotated the token between the read and the update
+	m.sessions.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(session, nil)
+	m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+	m.sessions.EXPECT().Rotate(gomock.Any(), session.ID, gomock.Any()).Return(false, nil)
+	m.sessions.EXPECT().RevokeFamily(gomock.Any(), user.ID, session.FamilyID).Return(nil, nil)
+
+	_, err := useCase.Refresh(context.Background(), _refreshToken)
+	require.ErrorIs(t, err, usecase.ErrRefreshTokenReused)
+	require.Len(t, m.eventsOf(entity.AuditRefreshReused), 1)
+}
+
+func TestRefreshRejected(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	revokedAt := _now.Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		session *entity.Session
+		target  uuid.UUID
+	}{
+		{name: "unknown"},
+		{
+			name: "revoked",
+			session: func() *entity.Session {
+				s := refreshSession(userID)
+				s.RevokedAt = &revokedAt
+				return s
+			}(),
+			target: userID,
+		},
+		{
+			name: "expired",
+			session: func() *entity.Session {
+				s := refreshSession(userID)
+				s.ExpiresAt = _now
+				return s
+			}(),
+			target: userID,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, m := authUseCase(t)
+			m.sessions.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(tc.session, nil)
+
+			_, err := useCase.Refresh(context.Background(), _refreshToken)
+			require.ErrorIs(t, err, usecase.ErrInvalidToken)
+
+			events := m.eventsOf(entity.AuditTokenRejected)
+			require.Len(t, events, 1)
+			require.Equal(t, tc.target, events[0].TargetID)
+			require.Equal(t, "refresh_token", events[0].Details["kind"])
+		})
+	}
+}
+
+func TestRefreshDisabledUser(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	user := testUser(t, entity.RoleUser)
+	disabledAt := _now.Add(-time.Minute)
+	user.DisabledAt = &disabledAt
+	session := refreshSession(user.ID)
+
+	m.sessions.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(session, nil)
+	m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+	_, err := useCase.Refresh(context.Background(), _refreshToken)
+	require.ErrorIs(t, err, usecase.ErrUserDisabled)
+}
+
+func TestRevokeSession(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	userID := uuid.New()
+	session := refreshSession(userID)
+
+	m.sessions.EXPECT().RevokeFamily(gomock.Any(), userID, session.FamilyID).Return([]*entity.Session{session}, nil)
+	m.denylist.EXPECT().Add(gomock.Any(), session.AccessJTI, session.CreatedAt.Add(15*time.Minute)).Return(nil)
+
+	require.NoError(t, useCase.RevokeSession(context.Background(), userID, session.FamilyID))
+	require.Len(t, m.eventsOf(entity.AuditSessionRevoked), 1)
+
+	// families of other users are not found
+	m.sessions.EXPECT().RevokeFamily(gomock.Any(), userID, gomock.Any()).Return(nil, nil)
+
+	err := useCase.RevokeSession(context.Background(), userID, uuid.New())
+	require.ErrorIs(t, err, usecase.ErrSessionNotFound)
+}
@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+// _liveExp is the expiry of signed access tokens
+var _liveExp = time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+
+// signAccess signs an access token of the user with the claims of makeToken,
+// overrides are applied on top and nil ones removed. The token is live by the wall clock too, the
+// JWT library checks exp against it besides the use case clock
+func signAccess(t *testing.T, key *entity.SigningKey, userID, jti uuid.UUID, overrides jwt.MapClaims) string {
+	t.Helper()
+
+	claims := jwt.MapClaims{
+		"iss":   "auth-service",
+		"sub":   userID.String(),
+		"sid":   uuid.NewString(),
+		"jti":   jti.String(),
+		"role":  string(entity.RoleUser),
+		"perms": entity.RoleUser.Permissions(),
+		"exp":   _liveExp.Unix(),
+		"iat":   _now.Unix(),
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+
+	tok := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	tok.Header["kid"] = key.ID
+	signed, err := tok.SignedString(key.Private)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func TestAuthenticateDenylist(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	key, err := entity.GenerateSigningKey("EdDSA", _now.Add(-time.Hour))
+	require.NoError(t, err)
+	m.keys.EXPECT().Key(key.ID).Return(key).AnyTimes()
+
+	userID, jti := uuid.New(), uuid.New()
+	token := signAccess(t, key, userID, jti, nil)
+
+	m.denylist.EXPECT().Contains(gomock.Any(), jti).Return(false, nil)
+
+	claims, err := useCase.Authenticate(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, userID, claims.UserID)
+	require.Equal(t, jti, claims.JTI)
+	require.Equal(t, entity.RoleUser, claims.Role)
+	require.Equal(t, _liveExp, claims.ExpiresAt)
+	require.Empty(t, m.events)
+
+	// once denied the same signed token is rejected until it expires
+	m.denylist.EXPECT().Contains(gomock.Any(), jti).Return(true, nil)
+
+	_, err = useCase.Authenticate(context.Background(), token)
+	require.ErrorIs(t, err, usecase.ErrInvalidToken)
+
+	events := m.eventsOf(entity.AuditTokenRejected)
+	require.Len(t, events, 1)
+	require.Equal(t, "access_token", events[0].Details["kind"])
+}
+
+func TestAuthenticateRejected(t *testing.T) {
+	t.Parallel()
+
+	key, err := entity.GenerateSigningKey("EdDSA", _now.Add(-time.Hour))
+	require.NoError(t, err)
+	other, err := entity.GenerateSigningKey("EdDSA", _now.Add(-time.Hour))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "expired", token: signAccess(t, key, uuid.New(), uuid.New(), jwt.MapClaims{"exp": _now.Add(-time.Second).Unix()})},
+		{name: "foreign issuer", token: signAccess(t, key, uuid.New(), uuid.New(), jwt.MapClaims{"iss": "evil"})},
+		{name: "unknown key", token: signAccess(t, other, uuid.New(), uuid.New(), nil)},
+		{name: "no jti", token: signAccess(t, key, uuid.New(), uuid.New(), jwt.MapClaims{"jti": ""})},
+		{name: "unknown role", token: signAccess(t, key, uuid.New(), uuid.New(), jwt.MapClaims{"role": "root"})},
+		{name: "malformed", token: "not.a.jwt"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase, m := authUseCase(t)
+			m.keys.EXPECT().Key(key.ID).Return(key).AnyTimes()
+			m.keys.EXPECT().Key(other.ID).Return(nil).AnyTimes()
+
+			// the denylist is never consulted for tokens that fail verification
+			_, err := useCase.Authenticate(context.Background(), tc.token)
+			require.ErrorIs(t, err, usecase.ErrInvalidToken)
+			require.Len(t, m.eventsOf(entity.AuditTokenRejected), 1)
+		})
+	}
+}
+
+func TestSignOut(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+
+	claims := &entity.AccessClaims{
+		UserID:    uuid.New(),
+		SessionID: uuid.New(),
+		JTI:       uuid.New(),
+		ExpiresAt: _now.Add(12 * time.Minute),
+	}
+
+	// the current token of the family was issued with a later refresh
+	current := refreshSession(claims.UserID)
+	current.FamilyID = claims.SessionID
+	current.CreatedAt = _now.Add(-time.Minute)
+
+	m.denylist.EXPECT().Add(gomock.Any(), claims.JTI, claims.ExpiresAt).Return(nil)
+	m.sessions.EXPECT().RevokeFamily(gomock.Any(), claims.UserID, claims.SessionID).Return([]*entity.Session{current}, nil)
+	m.denylist.EXPECT().Add(gomock.Any(), current.AccessJTI, _now.Add(14*time.Minute)).Return(nil)
+
+	require.NoError(t, useCase.SignOut(context.Background(), claims))
+
+	events := m.eventsOf(entity.AuditSignOut)
+	require.Len(t, events, 1)
+	require.Equal(t, claims.UserID, events[0].ActorID)
+	require.Equal(t, claims.SessionID.String(), events[0].Details["session_id"])
+}
+
+func TestSignOutDenylistFails(t *testing.T) {
+	t.Parallel()
+
+	useCase, m := authUseCase(t)
+	claims := &entity.AccessClaims{UserID: uuid.New(), SessionID: uuid.New(), JTI: uuid.New()}
+
+	// the session stays usable if its token cannot be denied, the client can retry
+	m.denylist.EXPECT().Add(gomock.Any(), claims.JTI, gomock.Any()).Return(context.DeadlineExceeded)
+
+	err := useCase.SignOut(context.Background(), claims)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Empty(t, m.eventsOf(entity.AuditSignOut))
+}
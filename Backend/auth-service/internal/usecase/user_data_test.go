@@ -0,0 +1,319 @@
+package usecase_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/internal/usecase"
+)
+
+func TestExportUserData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("archive of every service", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+		m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+		m.identities.EXPECT().ListByUser(gomock.Any(), user.ID).Return(nil, nil)
+		m.sessions.EXPECT().ListActive(gomock.Any(), user.ID).Return(nil, nil)
+		m.apiKeys.EXPECT().ListByUser(gomock.Any(), user.ID).Return(nil, nil)
+		m.profiles.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+		m.profiles.EXPECT().ListAssessments(gomock.Any(), user.ID, gomock.Any()).Return(nil, nil)
+		m.audit.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil)
+		m.brain.EXPECT().Export(gomock.Any(), user.ID).Return([]entity.DataFile{
+			{Name: "../chats.json", ContentType: "application/json", Content: []byte(`[]`)},
+		}, nil)
+
+		export, err := useCase.ExportUserData(context.Background(), &entity.AccessClaims{UserID: user.ID})
+		require.NoError(t, err)
+		require.Equal(t, "financialadviser-export-20250606-120010.zip", export.FileName)
+
+		files := unzip(t, export.Archive)
+		require.ElementsMatch(t, []string{
+			"manifest.json",
+			"auth/account.json",
+			"auth/api_keys.json",
+			"auth/profile.json",
+			"auth/risk_assessments.json",
+			"auth/security_events.json",
+			"auth/sessions.json",
+			"brain/chats.json",
+		}, slices.Collect(maps.Keys(files)))
+		require.NotContains(t, string(files["auth/account.json"]), user.PasswordHash)
+
+		var account struct {
+			User entity.User `json:"user"`
+		}
+		require.NoError(t, json.Unmarshal(files["auth/account.json"], &account))
+		require.Equal(t, user.Email, account.User.Email)
+		require.Len(t, m.eventsOf(entity.AuditDataExported), 1)
+	})
+
+	t.Run("service unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+		m.mfa.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+		m.identities.EXPECT().ListByUser(gomock.Any(), user.ID).Return(nil, nil)
+		m.sessions.EXPECT().ListActive(gomock.Any(), user.ID).Return(nil, nil)
+		m.apiKeys.EXPECT().ListByUser(gomock.Any(), user.ID).Return(nil, nil)
+		m.profiles.EXPECT().Get(gomock.Any(), user.ID).Return(nil, nil)
+		m.profiles.EXPECT().ListAssessments(gomock.Any(), user.ID, gomock.Any()).Return(nil, nil)
+		m.audit.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil)
+		m.brain.EXPECT().Export(gomock.Any(), user.ID).Return(nil, errors.New("connection refused"))
+
+		_, err := useCase.ExportUserData(context.Background(), &entity.AccessClaims{UserID: user.ID})
+		require.ErrorIs(t, err, usecase.ErrServiceUnavailable)
+		require.Empty(t, m.eventsOf(entity.AuditDataExported))
+	})
+
+	t.Run("impersonated", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, _ := authUseCase(t)
+
+		_, err := useCase.ExportUserData(context.Background(), &entity.AccessClaims{
+			UserID:  uuid.New(),
+			ActorID: uuid.New(),
+		})
+		require.ErrorIs(t, err, usecase.ErrImpersonated)
+	})
+}
+
+func TestDeleteAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong password", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		_, err := useCase.DeleteAccount(context.Background(), &entity.AccessClaims{UserID: user.ID}, "wrong")
+		require.ErrorIs(t, err, usecase.ErrInvalidPassword)
+		require.Len(t, m.eventsOf(entity.AuditAccountDeletionRequested), 1)
+		require.Equal(t, entity.AuditFailure, m.events[0].Outcome)
+	})
+
+	t.Run("disables the account and signs it out", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+
+		var created *entity.AccountDeletion
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+		m.deletions.EXPECT().GetUnfinished(gomock.Any(), user.ID).Return(nil, nil)
+		m.deletions.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, d *entity.AccountDeletion) error {
+				created = d
+				return nil
+			})
+		m.users.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, u *entity.User) error {
+				require.True(t, u.IsDisabled())
+				return nil
+			})
+		m.sessions.EXPECT().RevokeAll(gomock.Any(), user.ID).Return(nil, nil)
+
+		deletion, err := useCase.DeleteAccount(context.Background(), &entity.AccessClaims{UserID: user.ID}, _password)
+		require.NoError(t, err)
+		require.Same(t, created, deletion)
+		require.Equal(t, entity.DeletionPending, deletion.Status)
+		require.Equal(t, []string{"brain", entity.DeletionStepAccount}, deletion.Pending)
+		require.Equal(t, _now, deletion.NextAttemptAt)
+		require.Len(t, m.eventsOf(entity.AuditAccountDeletionRequested), 1)
+	})
+
+	t.Run("returns the unfinished deletion", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		user := testUser(t, entity.RoleUser)
+		disabledAt := _now.Add(-time.Minute)
+		user.DisabledAt = &disabledAt
+		existing := &entity.AccountDeletion{ID: uuid.New(), UserID: user.ID, Status: entity.DeletionFailed}
+
+		m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+		m.deletions.EXPECT().GetUnfinished(gomock.Any(), user.ID).Return(existing, nil)
+		m.sessions.EXPECT().RevokeAll(gomock.Any(), user.ID).Return(nil, nil)
+
+		deletion, err := useCase.DeleteAccount(context.Background(), &entity.AccessClaims{UserID: user.ID}, _password)
+		require.NoError(t, err)
+		require.Same(t, existing, deletion)
+	})
+}
+
+func TestRetryAccountDeletion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("completed", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		id := uuid.New()
+
+		m.deletions.EXPECT().Get(gomock.Any(), id).Return(&entity.AccountDeletion{
+			ID:     id,
+			Status: entity.DeletionCompleted,
+		}, nil)
+
+		_, err := useCase.RetryAccountDeletion(context.Background(), uuid.New(), id)
+		require.ErrorIs(t, err, usecase.ErrDeletionCompleted)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		id := uuid.New()
+
+		m.deletions.EXPECT().Get(gomock.Any(), id).Return(&entity.AccountDeletion{
+			ID:            id,
+			Status:        entity.DeletionFailed,
+			Pending:       []string{"brain", entity.DeletionStepAccount},
+			Attempts:      3,
+			NextAttemptAt: _now.Add(time.Hour),
+		}, nil)
+		m.deletions.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		deletion, err := useCase.RetryAccountDeletion(context.Background(), uuid.New(), id)
+		require.NoError(t, err)
+		require.Equal(t, entity.DeletionPending, deletion.Status)
+		require.Zero(t, deletion.Attempts)
+		require.Equal(t, _now, deletion.NextAttemptAt)
+		require.Len(t, m.eventsOf(entity.AuditAccountDeletionRetried), 1)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		id := uuid.New()
+
+		m.deletions.EXPECT().Get(gomock.Any(), id).Return(nil, nil)
+
+		_, err := useCase.RetryAccountDeletion(context.Background(), uuid.New(), id)
+		require.ErrorIs(t, err, usecase.ErrDeletionNotFound)
+	})
+}
+
+func TestProcessDeletions(t *testing.T) {
+	t.Parallel()
+
+	pending := func(attempts int) *entity.AccountDeletion {
+		return &entity.AccountDeletion{
+			ID:       uuid.New(),
+			UserID:   uuid.New(),
+			Status:   entity.DeletionPending,
+			Pending:  []string{"brain", entity.DeletionStepAccount},
+			Attempts: attempts,
+		}
+	}
+
+	t.Run("deletes the account last", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		deletion := pending(0)
+		user := testUser(t, entity.RoleUser)
+		user.ID = deletion.UserID
+
+		m.deletions.EXPECT().Claim(gomock.Any(), _now, gomock.Any(), gomock.Any()).
+			Return([]*entity.AccountDeletion{deletion}, nil)
+		gomock.InOrder(
+			m.brain.EXPECT().Delete(gomock.Any(), deletion.UserID, deletion.ID).Return(nil),
+			m.users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil),
+			m.throttle.EXPECT().Reset(gomock.Any(), entity.AccountThrottleKey(user.Email)).Return(nil),
+			m.users.EXPECT().Delete(gomock.Any(), user.ID).Return(nil),
+			m.deletions.EXPECT().Update(gomock.Any(), deletion).Return(nil),
+		)
+
+		completed, err := useCase.ProcessDeletions(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, completed)
+		require.Equal(t, entity.DeletionCompleted, deletion.Status)
+		require.Empty(t, deletion.Pending)
+		require.Equal(t, &_now, deletion.CompletedAt)
+		require.Len(t, m.eventsOf(entity.AuditAccountDeleted), 1)
+	})
+
+	t.Run("failed service keeps the account", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		deletion := pending(1)
+
+		m.deletions.EXPECT().Claim(gomock.Any(), _now, gomock.Any(), gomock.Any()).
+			Return([]*entity.AccountDeletion{deletion}, nil)
+		m.brain.EXPECT().Delete(gomock.Any(), deletion.UserID, deletion.ID).Return(errors.New("unavailable"))
+		m.deletions.EXPECT().Update(gomock.Any(), deletion).Return(nil)
+
+		completed, err := useCase.ProcessDeletions(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, completed)
+		require.Equal(t, entity.DeletionPending, deletion.Status)
+		require.Equal(t, []string{"brain", entity.DeletionStepAccount}, deletion.Pending)
+		require.Equal(t, 2, deletion.Attempts)
+		require.Equal(t, _now.Add(2*time.Minute), deletion.NextAttemptAt)
+		require.Contains(t, deletion.LastError, "unavailable")
+	})
+
+	t.Run("attempts run out", func(t *testing.T) {
+		t.Parallel()
+
+		useCase, m := authUseCase(t)
+		deletion := pending(2)
+
+		m.deletions.EXPECT().Claim(gomock.Any(), _now, gomock.Any(), gomock.Any()).
+			Return([]*entity.AccountDeletion{deletion}, nil)
+		m.brain.EXPECT().Delete(gomock.Any(), deletion.UserID, deletion.ID).Return(errors.New("unavailable"))
+		m.deletions.EXPECT().Update(gomock.Any(), deletion).Return(nil)
+
+		_, err := useCase.ProcessDeletions(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, entity.DeletionFailed, deletion.Status)
+		require.Len(t, m.eventsOf(entity.AuditAccountDeletionFailed), 1)
+	})
+}
+
+// unzip returns the contents of the archive's files by name
+func unzip(t *testing.T, archive []byte) map[string][]byte {
+	t.Helper()
+
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte, len(r.File))
+	for _, f := range r.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		files[f.Name], err = io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+	}
+
+	return files
+}
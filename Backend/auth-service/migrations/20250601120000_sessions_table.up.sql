@@ -0,0 +1,29 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    family_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash varchar(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    rotated_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+-- COMMENTS
+COMMENT ON TABLE sessions IS 'Issued refresh tokens, one row per token';
+COMMENT ON COLUMN sessions.id IS 'Unique identifier for the refresh token';
+COMMENT ON COLUMN sessions.family_id IS 'Sign-in the token descends from, shared by all rotated tokens';
+COMMENT ON COLUMN sessions.user_id IS 'Owner of the session';
+COMMENT ON COLUMN sessions.token_hash IS 'SHA-256 hex digest of the opaque refresh token';
+COMMENT ON COLUMN sessions.created_at IS 'Timestamp when the token was issued';
+COMMENT ON COLUMN sessions.expires_at IS 'Timestamp after which the token is rejected';
+COMMENT ON COLUMN sessions.rotated_at IS 'Timestamp when the token was exchanged for a new one, reuse after it revokes the family';
+COMMENT ON COLUMN sessions.revoked_at IS 'Timestamp when the token was revoked';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_sessions_family_id ON sessions(family_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
+-- +goose StatementEnd
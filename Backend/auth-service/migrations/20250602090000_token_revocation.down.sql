@@ -0,0 +1,6 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS revoked_tokens;
+ALTER TABLE sessions DROP COLUMN IF EXISTS access_jti;
+-- +goose StatementEnd
\ No newline at end of file
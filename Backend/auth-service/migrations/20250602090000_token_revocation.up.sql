@@ -0,0 +1,19 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS access_jti UUID;
+
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti UUID PRIMARY KEY,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- COMMENTS
+COMMENT ON COLUMN sessions.access_jti IS 'jti of the access token issued together with the refresh token';
+COMMENT ON TABLE revoked_tokens IS 'Denylist of revoked access tokens, rows are purged once the token expires';
+COMMENT ON COLUMN revoked_tokens.jti IS 'jti claim of the revoked access token';
+COMMENT ON COLUMN revoked_tokens.expires_at IS 'Expiry of the revoked access token';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS signing_keys (
+    kid varchar(64) PRIMARY KEY,
+    algorithm varchar(16) NOT NULL,
+    private_key text NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- COMMENTS
+COMMENT ON TABLE signing_keys IS 'Private keys access tokens are signed with, the newest published key signs';
+COMMENT ON COLUMN signing_keys.kid IS 'Key identifier, set as the kid header of signed tokens';
+COMMENT ON COLUMN signing_keys.algorithm IS 'JWS algorithm of the key: RS256 or EdDSA';
+COMMENT ON COLUMN signing_keys.private_key IS 'PKCS #8 PEM encoded private key';
+COMMENT ON COLUMN signing_keys.created_at IS 'Timestamp when the key was generated and published in the JWKS';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_signing_keys_created_at ON signing_keys(created_at);
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT false;
+UPDATE users SET is_admin = (role = 'admin');
+
+DROP INDEX IF EXISTS idx_users_role;
+ALTER TABLE users DROP COLUMN IF EXISTS disabled_at;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+-- +goose StatementEnd
\ No newline at end of file
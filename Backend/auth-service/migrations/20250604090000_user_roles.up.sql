@@ -0,0 +1,17 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role varchar(16) NOT NULL DEFAULT 'user'
+    CHECK (role IN ('user', 'analyst', 'support', 'admin'));
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE users SET role = 'admin' WHERE is_admin;
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
+
+-- COMMENTS
+COMMENT ON COLUMN users.role IS 'Role granting the user''s permissions: user, analyst, support or admin';
+COMMENT ON COLUMN users.disabled_at IS 'Timestamp when the account was disabled, NULL while it is enabled';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_users_role ON users(role);
+-- +goose StatementEnd
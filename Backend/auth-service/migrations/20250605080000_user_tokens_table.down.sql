@@ -0,0 +1,6 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_tokens;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP WITH TIME ZONE;
+UPDATE users SET email_verified_at = created_at WHERE email_verified_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS user_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose varchar(32) NOT NULL,
+    token_hash varchar(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE
+);
+
+-- COMMENTS
+COMMENT ON COLUMN users.email_verified_at IS 'Timestamp when the user confirmed the email, NULL until then';
+COMMENT ON TABLE user_tokens IS 'Single-use tokens mailed to users to verify the email or reset the password';
+COMMENT ON COLUMN user_tokens.id IS 'Unique identifier for the token';
+COMMENT ON COLUMN user_tokens.user_id IS 'User the token was mailed to';
+COMMENT ON COLUMN user_tokens.purpose IS 'What the token is for: email_verification or password_reset';
+COMMENT ON COLUMN user_tokens.token_hash IS 'SHA-256 hex digest of the mailed token';
+COMMENT ON COLUMN user_tokens.created_at IS 'Timestamp when the token was issued';
+COMMENT ON COLUMN user_tokens.expires_at IS 'Timestamp after which the token is rejected';
+COMMENT ON COLUMN user_tokens.used_at IS 'Timestamp when the token was used or superseded by a newer one';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user_id_purpose ON user_tokens(user_id, purpose);
+CREATE INDEX IF NOT EXISTS idx_user_tokens_expires_at ON user_tokens(expires_at);
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS mfa_role_policies;
+DROP TABLE IF EXISTS mfa_challenges;
+DROP TABLE IF EXISTS mfa_recovery_codes;
+DROP TABLE IF EXISTS user_mfa;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,60 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret varchar(64) NOT NULL,
+    enabled_at TIMESTAMP WITH TIME ZONE,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES user_mfa(user_id) ON DELETE CASCADE,
+    code_hash varchar(64) NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (user_id, code_hash)
+);
+
+CREATE TABLE IF NOT EXISTS mfa_challenges (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash varchar(64) NOT NULL UNIQUE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE IF NOT EXISTS mfa_role_policies (
+    role varchar(16) PRIMARY KEY,
+    required BOOLEAN NOT NULL DEFAULT false,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- COMMENTS
+COMMENT ON TABLE user_mfa IS 'TOTP second factor of users, pending until enabled_at is set';
+COMMENT ON COLUMN user_mfa.user_id IS 'Owner of the second factor';
+COMMENT ON COLUMN user_mfa.secret IS 'Base32 encoded TOTP secret';
+COMMENT ON COLUMN user_mfa.enabled_at IS 'Timestamp when the enrollment was confirmed with a code, NULL while pending';
+COMMENT ON COLUMN user_mfa.last_used_step IS 'TOTP time step of the last accepted code, older and equal steps are rejected';
+COMMENT ON COLUMN user_mfa.created_at IS 'Timestamp when the enrollment started';
+
+COMMENT ON TABLE mfa_recovery_codes IS 'Single-use codes replacing a TOTP code when the authenticator is lost';
+COMMENT ON COLUMN mfa_recovery_codes.code_hash IS 'SHA-256 hex digest of the normalized recovery code';
+COMMENT ON COLUMN mfa_recovery_codes.used_at IS 'Timestamp when the code was used';
+
+COMMENT ON TABLE mfa_challenges IS 'Pending second steps of sign-ins with MFA';
+COMMENT ON COLUMN mfa_challenges.token_hash IS 'SHA-256 hex digest of the opaque challenge token';
+COMMENT ON COLUMN mfa_challenges.attempts IS 'Number of codes tried, the challenge is rejected once the limit is reached';
+COMMENT ON COLUMN mfa_challenges.expires_at IS 'Timestamp after which the challenge is rejected';
+COMMENT ON COLUMN mfa_challenges.used_at IS 'Timestamp when a code completed the sign-in';
+
+COMMENT ON TABLE mfa_role_policies IS 'Roles whose users must have MFA enabled to get their permissions';
+COMMENT ON COLUMN mfa_role_policies.updated_by IS 'Admin who changed the policy last';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_mfa_challenges_expires_at ON mfa_challenges(expires_at);
+-- +goose StatementEnd
@@ -0,0 +1,5 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sign_in_throttles;
+-- +goose StatementEnd
\ No newline at end of file
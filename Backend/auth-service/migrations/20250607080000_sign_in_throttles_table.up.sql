@@ -0,0 +1,20 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS sign_in_throttles (
+    key varchar(320) PRIMARY KEY,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    locked_until TIMESTAMP WITH TIME ZONE
+);
+
+-- COMMENTS
+COMMENT ON TABLE sign_in_throttles IS 'Failed sign-in counters per account and per client address';
+COMMENT ON COLUMN sign_in_throttles.key IS 'account:<email> or ip:<address>';
+COMMENT ON COLUMN sign_in_throttles.failures IS 'Failed sign-ins since the counter was last reset or forgotten';
+COMMENT ON COLUMN sign_in_throttles.last_failed_at IS 'Timestamp of the last failure, older counters start over';
+COMMENT ON COLUMN sign_in_throttles.locked_until IS 'Sign-ins are rejected until this timestamp';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_sign_in_throttles_last_failed_at ON sign_in_throttles(last_failed_at);
+-- +goose StatementEnd
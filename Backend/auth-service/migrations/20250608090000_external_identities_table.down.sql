@@ -0,0 +1,6 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS oauth_states;
+DROP TABLE IF EXISTS external_identities;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS external_identities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider varchar(32) NOT NULL,
+    subject varchar(255) NOT NULL,
+    email varchar(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (provider, subject)
+);
+
+CREATE TABLE IF NOT EXISTS oauth_states (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    provider varchar(32) NOT NULL,
+    state_hash varchar(64) NOT NULL UNIQUE,
+    code_verifier varchar(128) NOT NULL,
+    nonce varchar(128) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- COMMENTS
+COMMENT ON TABLE external_identities IS 'Accounts at identity providers (Google, GitHub) linked to users';
+COMMENT ON COLUMN external_identities.id IS 'Unique identifier for the link';
+COMMENT ON COLUMN external_identities.user_id IS 'User the identity signs in as';
+COMMENT ON COLUMN external_identities.provider IS 'Identity provider name: google or github';
+COMMENT ON COLUMN external_identities.subject IS 'Account id at the provider, the sub claim for OIDC';
+COMMENT ON COLUMN external_identities.email IS 'Email the provider reported at the last sign-in';
+COMMENT ON COLUMN external_identities.created_at IS 'Timestamp when the identity was linked';
+COMMENT ON COLUMN external_identities.last_used_at IS 'Timestamp of the last sign-in with the identity';
+COMMENT ON TABLE oauth_states IS 'Authorizations started at identity providers awaiting their callback';
+COMMENT ON COLUMN oauth_states.id IS 'Unique identifier for the authorization';
+COMMENT ON COLUMN oauth_states.provider IS 'Identity provider the user was sent to';
+COMMENT ON COLUMN oauth_states.state_hash IS 'SHA-256 hex digest of the state parameter';
+COMMENT ON COLUMN oauth_states.code_verifier IS 'PKCE code verifier sent with the code exchange';
+COMMENT ON COLUMN oauth_states.nonce IS 'OIDC nonce the ID token must carry';
+COMMENT ON COLUMN oauth_states.created_at IS 'Timestamp when the authorization was started';
+COMMENT ON COLUMN oauth_states.expires_at IS 'Timestamp after which the callback is rejected';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_external_identities_user_id ON external_identities(user_id);
+CREATE INDEX IF NOT EXISTS idx_oauth_states_expires_at ON oauth_states(expires_at);
+-- +goose StatementEnd
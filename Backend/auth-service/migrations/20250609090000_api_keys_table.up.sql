@@ -0,0 +1,32 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name varchar(64) NOT NULL,
+    prefix varchar(16) NOT NULL,
+    key_hash varchar(64) NOT NULL UNIQUE,
+    scopes text[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+-- COMMENTS
+COMMENT ON TABLE api_keys IS 'Personal API keys for programmatic access';
+COMMENT ON COLUMN api_keys.id IS 'Unique identifier for the key';
+COMMENT ON COLUMN api_keys.user_id IS 'User the key authenticates as';
+COMMENT ON COLUMN api_keys.name IS 'Name the user gave the key';
+COMMENT ON COLUMN api_keys.prefix IS 'First characters of the key shown in listings';
+COMMENT ON COLUMN api_keys.key_hash IS 'SHA-256 hex digest of the key';
+COMMENT ON COLUMN api_keys.scopes IS 'Permissions the key grants, a subset of the role permissions';
+COMMENT ON COLUMN api_keys.created_at IS 'Timestamp when the key was created';
+COMMENT ON COLUMN api_keys.expires_at IS 'Timestamp after which the key is rejected';
+COMMENT ON COLUMN api_keys.last_used_at IS 'Timestamp of the last request with the key, updated at most once a minute';
+COMMENT ON COLUMN api_keys.revoked_at IS 'Timestamp when the key was revoked, NULL while usable';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id);
+-- +goose StatementEnd
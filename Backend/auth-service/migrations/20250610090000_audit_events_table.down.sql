@@ -0,0 +1,6 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_events;
+DROP FUNCTION IF EXISTS reject_audit_event_update();
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    type varchar(64) NOT NULL,
+    outcome varchar(16) NOT NULL,
+    actor_id UUID,
+    target_id UUID,
+    ip varchar(64) NOT NULL DEFAULT '',
+    user_agent text NOT NULL DEFAULT '',
+    details jsonb NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Events are never changed once written, only the retention job deletes old ones
+CREATE OR REPLACE FUNCTION reject_audit_event_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE ON audit_events
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_audit_event_update();
+
+-- COMMENTS
+COMMENT ON TABLE audit_events IS 'Append-only security audit log of authentication and account events';
+COMMENT ON COLUMN audit_events.id IS 'Unique identifier for the event';
+COMMENT ON COLUMN audit_events.type IS 'Event type, e.g. sign_in or user.role_changed';
+COMMENT ON COLUMN audit_events.outcome IS 'success or failure';
+COMMENT ON COLUMN audit_events.actor_id IS 'User who acted, NULL for anonymous callers; no foreign key so events outlive users';
+COMMENT ON COLUMN audit_events.target_id IS 'User acted upon, NULL if unknown';
+COMMENT ON COLUMN audit_events.ip IS 'Address of the end client';
+COMMENT ON COLUMN audit_events.user_agent IS 'User agent of the end client';
+COMMENT ON COLUMN audit_events.details IS 'Event specific string attributes';
+COMMENT ON COLUMN audit_events.created_at IS 'Timestamp of the event';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events(actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_target_id ON audit_events(target_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_type ON audit_events(type, created_at DESC);
+-- +goose StatementEnd
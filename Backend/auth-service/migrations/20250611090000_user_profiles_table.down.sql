@@ -0,0 +1,6 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS risk_assessments;
+DROP TABLE IF EXISTS user_profiles;
+-- +goose StatementEnd
\ No newline at end of file
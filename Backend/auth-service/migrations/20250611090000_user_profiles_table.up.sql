@@ -0,0 +1,51 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_profiles (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    risk_tolerance varchar(16),
+    risk_score integer,
+    assessed_at TIMESTAMP WITH TIME ZONE,
+    horizon varchar(16),
+    base_currency char(3) NOT NULL DEFAULT 'USD',
+    markets text[] NOT NULL DEFAULT '{}',
+    watchlist text[] NOT NULL DEFAULT '{}',
+    locale varchar(35) NOT NULL DEFAULT 'en-US',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS risk_assessments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    version varchar(32) NOT NULL,
+    answers jsonb NOT NULL,
+    score integer NOT NULL,
+    tolerance varchar(16) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- COMMENTS
+COMMENT ON TABLE user_profiles IS 'Investment profiles advice is personalised with';
+COMMENT ON COLUMN user_profiles.user_id IS 'User the profile belongs to';
+COMMENT ON COLUMN user_profiles.risk_tolerance IS 'Tolerance of the latest risk assessment, NULL until the questionnaire is answered';
+COMMENT ON COLUMN user_profiles.risk_score IS 'Score of the latest risk assessment';
+COMMENT ON COLUMN user_profiles.assessed_at IS 'Timestamp of the latest risk assessment';
+COMMENT ON COLUMN user_profiles.horizon IS 'Investment horizon: short, medium or long, NULL until set';
+COMMENT ON COLUMN user_profiles.base_currency IS 'ISO 4217 code of the currency amounts are shown in';
+COMMENT ON COLUMN user_profiles.markets IS 'Preferred markets';
+COMMENT ON COLUMN user_profiles.watchlist IS 'Watchlisted ticker symbols in the order the user keeps them';
+COMMENT ON COLUMN user_profiles.locale IS 'BCP 47 language tag of texts and formats';
+COMMENT ON COLUMN user_profiles.updated_at IS 'Timestamp when the profile was last changed';
+
+COMMENT ON TABLE risk_assessments IS 'History of answered risk questionnaires';
+COMMENT ON COLUMN risk_assessments.id IS 'Unique identifier for the assessment';
+COMMENT ON COLUMN risk_assessments.user_id IS 'User who answered the questionnaire';
+COMMENT ON COLUMN risk_assessments.version IS 'Version of the questionnaire answered';
+COMMENT ON COLUMN risk_assessments.answers IS 'Option id by question id';
+COMMENT ON COLUMN risk_assessments.score IS 'Sum of the scores of the answers';
+COMMENT ON COLUMN risk_assessments.tolerance IS 'Risk tolerance of the score';
+COMMENT ON COLUMN risk_assessments.created_at IS 'Timestamp when the questionnaire was answered';
+
+-- INDEXES
+CREATE INDEX IF NOT EXISTS idx_risk_assessments_user_id_created_at ON risk_assessments(user_id, created_at DESC);
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS account_deletions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'pending',
+    pending text[] NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- COMMENTS
+COMMENT ON TABLE account_deletions IS 'Deletions of account data across services, rows outlive the deleted users';
+COMMENT ON COLUMN account_deletions.id IS 'Unique identifier for the deletion, sent to services with every attempt';
+COMMENT ON COLUMN account_deletions.user_id IS 'User whose data is deleted, no foreign key as the user is deleted last';
+COMMENT ON COLUMN account_deletions.status IS 'pending, completed or failed once retries ran out';
+COMMENT ON COLUMN account_deletions.pending IS 'Services left to delete the data, auth last';
+COMMENT ON COLUMN account_deletions.attempts IS 'Failed attempts since the deletion was requested or retried';
+COMMENT ON COLUMN account_deletions.last_error IS 'Errors of the services in the last failed attempt';
+COMMENT ON COLUMN account_deletions.next_attempt_at IS 'Timestamp when pending services are called next';
+COMMENT ON COLUMN account_deletions.requested_at IS 'Timestamp when the user asked for the deletion';
+COMMENT ON COLUMN account_deletions.completed_at IS 'Timestamp when every service deleted the data';
+
+-- INDEXES
+CREATE UNIQUE INDEX IF NOT EXISTS idx_account_deletions_user_id_unfinished ON account_deletions(user_id) WHERE status <> 'completed';
+CREATE INDEX IF NOT EXISTS idx_account_deletions_next_attempt_at ON account_deletions(next_attempt_at) WHERE status = 'pending';
+-- +goose StatementEnd
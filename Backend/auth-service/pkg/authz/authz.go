@@ -0,0 +1,134 @@
+// Package authz authorizes gRPC calls by the role and permissions of the
+// access token sent in the "authorization: Bearer <token>" metadata.
+//
+// Services declare a Policy naming the methods that need a token and what the
+// token must grant, methods missing from the policy are not checked
+package authz
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+// Roles of auth-service users
+const (
+	RoleUser    = "user"
+	RoleAnalyst = "analyst"
+	RoleSupport = "support"
+	RoleAdmin   = "admin"
+)
+
+// Permissions granted to roles, see the role table of auth-service
+const (
+	PermTweetsRead       = "tweets:read"
+	PermTweetsExport     = "tweets:export"
+	PermTweetsAdmin      = "tweets:admin"
+	PermUsersRead        = "users:read"
+	PermUsersWrite       = "users:write"
+	PermUsersImpersonate = "users:impersonate"
+	PermAuditRead        = "audit:read"
+	PermProfilesRead     = "profiles:read"
+	PermPlansUnlimited   = "plans:unlimited"
+)
+
+// Claims are the verified claims of an access token
+type Claims struct {
+	UserID      string
+	SessionID   string
+	Email       string
+	Username    string
+	Role        string // empty while the permissions are withheld
+	Permissions []string
+
+	EmailVerified bool
+
+	// ActorID is the user acting on behalf of UserID through impersonation,
+	// empty for tokens the user signed in for
+	ActorID string
+}
+
+// HasRole reports whether the token has one of the roles
+func (c *Claims) HasRole(roles ...string) bool {
+	return slices.Contains(roles, c.Role)
+}
+
+// HasPermission reports whether the token grants the permission
+func (c *Claims) HasPermission(perm string) bool {
+	return slices.Contains(c.Permissions, perm)
+}
+
+// Impersonated reports whether the token was issued to another user acting as its subject
+func (c *Claims) Impersonated() bool {
+	return c.ActorID != ""
+}
+
+// Verifier verifies access tokens
+type Verifier interface {
+	// Verify checks the token and returns its claims.
+	// Returns error if the token is invalid, expired or revoked
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
+
+// Rule is what a token must grant to call a method: one of Roles, if any,
+// and all of Permissions
+type Rule struct {
+	Roles       []string
+	Permissions []string
+}
+
+// RequireRoles returns a rule allowing tokens with any of the roles
+func RequireRoles(roles ...string) Rule {
+	return Rule{Roles: roles}
+}
+
+// RequirePermissions returns a rule allowing tokens granting all the permissions
+func RequirePermissions(perms ...string) Rule {
+	return Rule{Permissions: perms}
+}
+
+// allows reports whether the claims satisfy the rule
+func (r Rule) allows(c *Claims) bool {
+	if len(r.Roles) > 0 && !c.HasRole(r.Roles...) {
+		return false
+	}
+	for _, perm := range r.Permissions {
+		if !c.HasPermission(perm) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Policy maps full method names ("/pkg.Service/Method") or whole services
+// ("/pkg.Service/") to their rule, a method rule overrides its service rule
+type Policy map[string]Rule
+
+// rule returns the rule of the full method name
+func (p Policy) rule(method string) (Rule, bool) {
+	if r, ok := p[method]; ok {
+		return r, true
+	}
+
+	i := strings.LastIndex(method, "/")
+	if i <= 0 {
+		return Rule{}, false
+	}
+	r, ok := p[method[:i+1]]
+
+	return r, ok
+}
+
+type claimsKey struct{}
+
+// NewContext returns a copy of ctx carrying the claims
+func NewContext(ctx context.Context, c *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, c)
+}
+
+// FromContext returns the claims of the authorized call
+func FromContext(ctx context.Context) (*Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(*Claims)
+	return c, ok
+}
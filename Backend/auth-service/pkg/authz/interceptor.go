@@ -0,0 +1,102 @@
+package authz
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	_authorizationHeader = "authorization"
+	_bearerPrefix        = "bearer "
+)
+
+// UnaryServerInterceptor rejects unary calls to methods of the policy without a
+// token granting their rule, handlers find the claims with FromContext.
+// A nil verifier rejects every call the policy covers
+func UnaryServerInterceptor(v Verifier, p Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, v, p, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming calls
+func StreamServerInterceptor(v Verifier, p Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), v, p, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize checks the call against the policy and attaches the token claims to ctx
+func authorize(ctx context.Context, v Verifier, p Policy, method string) (context.Context, error) {
+	rule, ok := p.rule(method)
+	if !ok {
+		return ctx, nil
+	}
+	if v == nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is not configured")
+	}
+
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "access token is required")
+	}
+
+	claims, err := v.Verify(ctx, token)
+	if err != nil {
+		// verifiers may report their own failures, e.g. an unavailable storage
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	if !rule.allows(claims) {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
+	}
+
+	return NewContext(ctx, claims), nil
+}
+
+// bearerToken returns the token of the authorization metadata, empty if there is none
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(_authorizationHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	value := values[0]
+	if len(value) < len(_bearerPrefix) || !strings.EqualFold(value[:len(_bearerPrefix)], _bearerPrefix) {
+		return ""
+	}
+
+	return strings.TrimSpace(value[len(_bearerPrefix):])
+}
+
+// serverStream overrides the context of a stream with the authorized one
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the authorized context
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,184 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+)
+
+const (
+	_getTweet    = "/tweets.v1.TweetService/GetTweet"
+	_listTweets  = "/tweets.v1.TweetService/ListTweets"
+	_deleteTweet = "/admin.v1.AdminTweetService/DeleteTweet"
+	_bulkDelete  = "/admin.v1.AdminTweetService/BulkDeleteTweets"
+)
+
+// _policy lets any token call GetTweet, requires tweets:read for the rest of the
+// tweet service and tweets:admin for admin methods, bulk ones only for admins
+var _policy = authz.Policy{
+	"/tweets.v1.TweetService/":     authz.RequirePermissions(authz.PermTweetsRead),
+	_getTweet:                      {},
+	"/admin.v1.AdminTweetService/": authz.RequirePermissions(authz.PermTweetsAdmin),
+	_bulkDelete: {
+		Roles:       []string{authz.RoleAdmin},
+		Permissions: []string{authz.PermTweetsAdmin},
+	},
+}
+
+// fakeVerifier accepts the tokens it knows
+type fakeVerifier map[string]*authz.Claims
+
+func (v fakeVerifier) Verify(_ context.Context, token string) (*authz.Claims, error) {
+	if token == "unavailable" {
+		return nil, status.Error(codes.Unavailable, "denylist is unavailable")
+	}
+
+	c, ok := v[token]
+	if !ok {
+		return nil, errors.New("signature is invalid")
+	}
+
+	return c, nil
+}
+
+var _verifier = fakeVerifier{
+	"user": {UserID: "u1", Role: authz.RoleUser, Permissions: []string{authz.PermTweetsRead}},
+	"support": {
+		UserID:      "u2",
+		Role:        authz.RoleSupport,
+		Permissions: []string{authz.PermTweetsRead, authz.PermTweetsAdmin},
+	},
+	"admin": {
+		UserID:      "u3",
+		Role:        authz.RoleAdmin,
+		Permissions: []string{authz.PermTweetsRead, authz.PermTweetsAdmin},
+	},
+	// an admin who has not enrolled in MFA yet is granted nothing
+	"withheld": {UserID: "u4", Role: authz.RoleAdmin, Permissions: []string{}},
+}
+
+// call runs a unary call to the method with the authorization metadata through
+// the interceptor, returning the claims the handler saw
+func call(t *testing.T, v authz.Verifier, method, authorization string) (*authz.Claims, error) {
+	t.Helper()
+
+	ctx := context.Background()
+	if authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+
+	var claims *authz.Claims
+	_, err := authz.UnaryServerInterceptor(v, _policy)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, _ any) (any, error) {
+			claims, _ = authz.FromContext(ctx)
+			return nil, nil
+		})
+
+	return claims, err
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		code          codes.Code
+		userID        string
+	}{
+		{name: "empty rule needs a token", method: _getTweet, code: codes.Unauthenticated},
+		{name: "empty rule", method: _getTweet, authorization: "Bearer withheld", userID: "u4"},
+		{name: "method outside the policy", method: "/grpc.health.v1.Health/Check"},
+		{name: "no token", method: _listTweets, code: codes.Unauthenticated},
+		{name: "not a bearer token", method: _listTweets, authorization: "Basic dXNlcg==", code: codes.Unauthenticated},
+		{name: "invalid token", method: _listTweets, authorization: "Bearer forged", code: codes.Unauthenticated},
+		{name: "verifier status", method: _listTweets, authorization: "Bearer unavailable", code: codes.Unavailable},
+		{name: "service rule", method: _listTweets, authorization: "bearer user", userID: "u1"},
+		{name: "missing permission", method: _deleteTweet, authorization: "Bearer user", code: codes.PermissionDenied},
+		{name: "permission", method: _deleteTweet, authorization: "Bearer support", userID: "u2"},
+		{name: "method rule overrides service rule", method: _bulkDelete, authorization: "Bearer support", code: codes.PermissionDenied},
+		{name: "role and permission", method: _bulkDelete, authorization: "Bearer admin", userID: "u3"},
+		{name: "withheld permissions", method: _deleteTweet, authorization: "Bearer withheld", code: codes.PermissionDenied},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			claims, err := call(t, _verifier, tc.method, tc.authorization)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.userID == "" {
+				require.Nil(t, claims)
+				return
+			}
+			require.Equal(t, tc.userID, claims.UserID)
+		})
+	}
+}
+
+func TestUnaryServerInterceptorWithoutVerifier(t *testing.T) {
+	t.Parallel()
+
+	// methods of the policy are closed, the rest stays reachable
+	_, err := call(t, nil, _listTweets, "Bearer admin")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call(t, nil, "/grpc.health.v1.Health/Check", "")
+	require.NoError(t, err)
+}
+
+// serverStream is a stream of the incoming context
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := authz.StreamServerInterceptor(_verifier, _policy)
+	info := &grpc.StreamServerInfo{FullMethod: _listTweets}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer user"))
+	err := interceptor(nil, &serverStream{ctx: ctx}, info, func(_ any, ss grpc.ServerStream) error {
+		// handlers see the claims through the stream context
+		claims, ok := authz.FromContext(ss.Context())
+		require.True(t, ok)
+		require.Equal(t, "u1", claims.UserID)
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = interceptor(nil, &serverStream{ctx: context.Background()}, info, func(any, grpc.ServerStream) error {
+		t.Fatal("handler called without a token")
+		return nil
+	})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestClaims(t *testing.T) {
+	t.Parallel()
+
+	c := &authz.Claims{UserID: "u1", Role: authz.RoleSupport, Permissions: []string{authz.PermUsersRead}}
+	require.True(t, c.HasRole(authz.RoleAdmin, authz.RoleSupport))
+	require.False(t, c.HasRole(authz.RoleAdmin))
+	require.True(t, c.HasPermission(authz.PermUsersRead))
+	require.False(t, c.HasPermission(authz.PermUsersWrite))
+	require.False(t, c.Impersonated())
+
+	c.ActorID = "u2"
+	require.True(t, c.Impersonated())
+}
@@ -0,0 +1,77 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var _errInvalidToken = errors.New("invalid token")
+
+// accessClaims mirrors the claims auth-service puts into access tokens
+type accessClaims struct {
+	SessionID   string   `json:"sid"`
+	Email       string   `json:"email"`
+	Username    string   `json:"username"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"perms"`
+	Verified    bool     `json:"email_verified"`
+	Actor       *struct {
+		Subject string `json:"sub"`
+	} `json:"act,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// JWKSVerifier verifies access tokens offline against the public keys
+// auth-service publishes, the key set is cached and refreshed in background.
+// Revoked tokens stay valid here until they expire
+type JWKSVerifier struct {
+	keyfunc keyfunc.Keyfunc
+	issuer  string
+}
+
+// NewJWKSVerifier starts fetching the key set from url; ctx ends the refresh goroutine.
+// A failing first fetch is not an error, the set is fetched again on an unknown kid
+func NewJWKSVerifier(ctx context.Context, url, issuer string, refresh time.Duration) (*JWKSVerifier, error) {
+	kf, err := keyfunc.NewDefaultOverrideCtx(ctx, []string{url}, keyfunc.Override{
+		RefreshInterval: refresh,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("keyfunc.NewDefaultOverrideCtx: %w", err)
+	}
+
+	return &JWKSVerifier{keyfunc: kf, issuer: issuer}, nil
+}
+
+// Verify checks the signature, issuer and expiry of an access token
+func (v *JWKSVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	claims := &accessClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, v.keyfunc.KeyfuncCtx(ctx),
+		jwt.WithValidMethods([]string{"RS256", "EdDSA"}),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || claims.Subject == "" {
+		return nil, _errInvalidToken
+	}
+
+	c := &Claims{
+		UserID:      claims.Subject,
+		SessionID:   claims.SessionID,
+		Email:       claims.Email,
+		Username:    claims.Username,
+		Role:        claims.Role,
+		Permissions: claims.Permissions,
+
+		EmailVerified: claims.Verified,
+	}
+	if claims.Actor != nil {
+		c.ActorID = claims.Actor.Subject
+	}
+
+	return c, nil
+}
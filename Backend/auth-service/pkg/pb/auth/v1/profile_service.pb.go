@@ -0,0 +1,1143 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: auth/v1/profile_service.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // JWT access token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetProfileRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                         // JWT access token
+	Horizon       *string                `protobuf:"bytes,2,opt,name=horizon,proto3,oneof" json:"horizon,omitempty"`                               // short, medium or long, empty to unset
+	BaseCurrency  *string                `protobuf:"bytes,3,opt,name=base_currency,json=baseCurrency,proto3,oneof" json:"base_currency,omitempty"` // ISO 4217 code
+	Markets       *StringList            `protobuf:"bytes,4,opt,name=markets,proto3" json:"markets,omitempty"`                                     // replaces the preferred markets when set
+	Watchlist     *StringList            `protobuf:"bytes,5,opt,name=watchlist,proto3" json:"watchlist,omitempty"`                                 // replaces the watchlist when set
+	Locale        *string                `protobuf:"bytes,6,opt,name=locale,proto3,oneof" json:"locale,omitempty"`                                 // BCP 47 language tag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateProfileRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetHorizon() string {
+	if x != nil && x.Horizon != nil {
+		return *x.Horizon
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBaseCurrency() string {
+	if x != nil && x.BaseCurrency != nil {
+		return *x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetMarkets() *StringList {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetWatchlist() *StringList {
+	if x != nil {
+		return x.Watchlist
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetRiskQuestionnaireRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskQuestionnaireRequest) Reset() {
+	*x = GetRiskQuestionnaireRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskQuestionnaireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskQuestionnaireRequest) ProtoMessage() {}
+
+func (x *GetRiskQuestionnaireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskQuestionnaireRequest.ProtoReflect.Descriptor instead.
+func (*GetRiskQuestionnaireRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{4}
+}
+
+type GetRiskQuestionnaireResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Questions     []*RiskQuestion        `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskQuestionnaireResponse) Reset() {
+	*x = GetRiskQuestionnaireResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskQuestionnaireResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskQuestionnaireResponse) ProtoMessage() {}
+
+func (x *GetRiskQuestionnaireResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskQuestionnaireResponse.ProtoReflect.Descriptor instead.
+func (*GetRiskQuestionnaireResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRiskQuestionnaireResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetRiskQuestionnaireResponse) GetQuestions() []*RiskQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type SubmitRiskQuestionnaireRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                                                               // JWT access token
+	Answers       map[string]string      `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // option id by question id, every question answered
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRiskQuestionnaireRequest) Reset() {
+	*x = SubmitRiskQuestionnaireRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRiskQuestionnaireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRiskQuestionnaireRequest) ProtoMessage() {}
+
+func (x *SubmitRiskQuestionnaireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRiskQuestionnaireRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRiskQuestionnaireRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubmitRiskQuestionnaireRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SubmitRiskQuestionnaireRequest) GetAnswers() map[string]string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type SubmitRiskQuestionnaireResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assessment    *RiskAssessment        `protobuf:"bytes,1,opt,name=assessment,proto3" json:"assessment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRiskQuestionnaireResponse) Reset() {
+	*x = SubmitRiskQuestionnaireResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRiskQuestionnaireResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRiskQuestionnaireResponse) ProtoMessage() {}
+
+func (x *SubmitRiskQuestionnaireResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRiskQuestionnaireResponse.ProtoReflect.Descriptor instead.
+func (*SubmitRiskQuestionnaireResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SubmitRiskQuestionnaireResponse) GetAssessment() *RiskAssessment {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
+type ListRiskAssessmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`  // JWT access token
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 20 by default, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskAssessmentsRequest) Reset() {
+	*x = ListRiskAssessmentsRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskAssessmentsRequest) ProtoMessage() {}
+
+func (x *ListRiskAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListRiskAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListRiskAssessmentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListRiskAssessmentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRiskAssessmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assessments   []*RiskAssessment      `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskAssessmentsResponse) Reset() {
+	*x = ListRiskAssessmentsResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskAssessmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskAssessmentsResponse) ProtoMessage() {}
+
+func (x *ListRiskAssessmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskAssessmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListRiskAssessmentsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListRiskAssessmentsResponse) GetAssessments() []*RiskAssessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // user UUID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUserProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileResponse) ProtoMessage() {}
+
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUserProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RiskTolerance string                 `protobuf:"bytes,2,opt,name=risk_tolerance,json=riskTolerance,proto3" json:"risk_tolerance,omitempty"` // conservative, moderate, balanced, growth or aggressive, empty until assessed
+	RiskScore     int32                  `protobuf:"varint,3,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	AssessedAt    string                 `protobuf:"bytes,4,opt,name=assessed_at,json=assessedAt,proto3" json:"assessed_at,omitempty"`       // empty until assessed
+	Horizon       string                 `protobuf:"bytes,5,opt,name=horizon,proto3" json:"horizon,omitempty"`                               // short, medium or long, empty until set
+	BaseCurrency  string                 `protobuf:"bytes,6,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"` // ISO 4217 code
+	Markets       []string               `protobuf:"bytes,7,rep,name=markets,proto3" json:"markets,omitempty"`
+	Watchlist     []string               `protobuf:"bytes,8,rep,name=watchlist,proto3" json:"watchlist,omitempty"`                   // ticker symbols
+	Locale        string                 `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`                         // BCP 47 language tag
+	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // empty until the profile is changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Profile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Profile) GetRiskTolerance() string {
+	if x != nil {
+		return x.RiskTolerance
+	}
+	return ""
+}
+
+func (x *Profile) GetRiskScore() int32 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *Profile) GetAssessedAt() string {
+	if x != nil {
+		return x.AssessedAt
+	}
+	return ""
+}
+
+func (x *Profile) GetHorizon() string {
+	if x != nil {
+		return x.Horizon
+	}
+	return ""
+}
+
+func (x *Profile) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *Profile) GetMarkets() []string {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *Profile) GetWatchlist() []string {
+	if x != nil {
+		return x.Watchlist
+	}
+	return nil
+}
+
+func (x *Profile) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Profile) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type StringList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type RiskQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Options       []*RiskOption          `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskQuestion) Reset() {
+	*x = RiskQuestion{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskQuestion) ProtoMessage() {}
+
+func (x *RiskQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskQuestion.ProtoReflect.Descriptor instead.
+func (*RiskQuestion) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RiskQuestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RiskQuestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RiskQuestion) GetOptions() []*RiskOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type RiskOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskOption) Reset() {
+	*x = RiskOption{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskOption) ProtoMessage() {}
+
+func (x *RiskOption) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskOption.ProtoReflect.Descriptor instead.
+func (*RiskOption) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RiskOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RiskOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type RiskAssessment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // questionnaire version answered
+	Answers       map[string]string      `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Tolerance     string                 `protobuf:"bytes,5,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskAssessment) Reset() {
+	*x = RiskAssessment{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskAssessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskAssessment) ProtoMessage() {}
+
+func (x *RiskAssessment) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskAssessment.ProtoReflect.Descriptor instead.
+func (*RiskAssessment) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RiskAssessment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RiskAssessment) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RiskAssessment) GetAnswers() map[string]string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *RiskAssessment) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetTolerance() string {
+	if x != nil {
+		return x.Tolerance
+	}
+	return ""
+}
+
+func (x *RiskAssessment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_auth_v1_profile_service_proto protoreflect.FileDescriptor
+
+const file_auth_v1_profile_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dauth/v1/profile_service.proto\x12\aauth.v1\")\n" +
+	"\x11GetProfileRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"@\n" +
+	"\x12GetProfileResponse\x12*\n" +
+	"\aprofile\x18\x01 \x01(\v2\x10.auth.v1.ProfileR\aprofile\"\x9d\x02\n" +
+	"\x14UpdateProfileRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\ahorizon\x18\x02 \x01(\tH\x00R\ahorizon\x88\x01\x01\x12(\n" +
+	"\rbase_currency\x18\x03 \x01(\tH\x01R\fbaseCurrency\x88\x01\x01\x12-\n" +
+	"\amarkets\x18\x04 \x01(\v2\x13.auth.v1.StringListR\amarkets\x121\n" +
+	"\twatchlist\x18\x05 \x01(\v2\x13.auth.v1.StringListR\twatchlist\x12\x1b\n" +
+	"\x06locale\x18\x06 \x01(\tH\x02R\x06locale\x88\x01\x01B\n" +
+	"\n" +
+	"\b_horizonB\x10\n" +
+	"\x0e_base_currencyB\t\n" +
+	"\a_locale\"C\n" +
+	"\x15UpdateProfileResponse\x12*\n" +
+	"\aprofile\x18\x01 \x01(\v2\x10.auth.v1.ProfileR\aprofile\"\x1d\n" +
+	"\x1bGetRiskQuestionnaireRequest\"m\n" +
+	"\x1cGetRiskQuestionnaireResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x123\n" +
+	"\tquestions\x18\x02 \x03(\v2\x15.auth.v1.RiskQuestionR\tquestions\"\xc2\x01\n" +
+	"\x1eSubmitRiskQuestionnaireRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12N\n" +
+	"\aanswers\x18\x02 \x03(\v24.auth.v1.SubmitRiskQuestionnaireRequest.AnswersEntryR\aanswers\x1a:\n" +
+	"\fAnswersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Z\n" +
+	"\x1fSubmitRiskQuestionnaireResponse\x127\n" +
+	"\n" +
+	"assessment\x18\x01 \x01(\v2\x17.auth.v1.RiskAssessmentR\n" +
+	"assessment\"H\n" +
+	"\x1aListRiskAssessmentsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"X\n" +
+	"\x1bListRiskAssessmentsResponse\x129\n" +
+	"\vassessments\x18\x01 \x03(\v2\x17.auth.v1.RiskAssessmentR\vassessments\"0\n" +
+	"\x15GetUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"D\n" +
+	"\x16GetUserProfileResponse\x12*\n" +
+	"\aprofile\x18\x01 \x01(\v2\x10.auth.v1.ProfileR\aprofile\"\xb7\x02\n" +
+	"\aProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0erisk_tolerance\x18\x02 \x01(\tR\rriskTolerance\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\x03 \x01(\x05R\triskScore\x12\x1f\n" +
+	"\vassessed_at\x18\x04 \x01(\tR\n" +
+	"assessedAt\x12\x18\n" +
+	"\ahorizon\x18\x05 \x01(\tR\ahorizon\x12#\n" +
+	"\rbase_currency\x18\x06 \x01(\tR\fbaseCurrency\x12\x18\n" +
+	"\amarkets\x18\a \x03(\tR\amarkets\x12\x1c\n" +
+	"\twatchlist\x18\b \x03(\tR\twatchlist\x12\x16\n" +
+	"\x06locale\x18\t \x01(\tR\x06locale\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"$\n" +
+	"\n" +
+	"StringList\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"a\n" +
+	"\fRiskQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12-\n" +
+	"\aoptions\x18\x03 \x03(\v2\x13.auth.v1.RiskOptionR\aoptions\"0\n" +
+	"\n" +
+	"RiskOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x89\x02\n" +
+	"\x0eRiskAssessment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12>\n" +
+	"\aanswers\x18\x03 \x03(\v2$.auth.v1.RiskAssessment.AnswersEntryR\aanswers\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x1c\n" +
+	"\ttolerance\x18\x05 \x01(\tR\ttolerance\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x1a:\n" +
+	"\fAnswersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xaf\x04\n" +
+	"\x0eProfileService\x12E\n" +
+	"\n" +
+	"GetProfile\x12\x1a.auth.v1.GetProfileRequest\x1a\x1b.auth.v1.GetProfileResponse\x12N\n" +
+	"\rUpdateProfile\x12\x1d.auth.v1.UpdateProfileRequest\x1a\x1e.auth.v1.UpdateProfileResponse\x12c\n" +
+	"\x14GetRiskQuestionnaire\x12$.auth.v1.GetRiskQuestionnaireRequest\x1a%.auth.v1.GetRiskQuestionnaireResponse\x12l\n" +
+	"\x17SubmitRiskQuestionnaire\x12'.auth.v1.SubmitRiskQuestionnaireRequest\x1a(.auth.v1.SubmitRiskQuestionnaireResponse\x12`\n" +
+	"\x13ListRiskAssessments\x12#.auth.v1.ListRiskAssessmentsRequest\x1a$.auth.v1.ListRiskAssessmentsResponse\x12Q\n" +
+	"\x0eGetUserProfile\x12\x1e.auth.v1.GetUserProfileRequest\x1a\x1f.auth.v1.GetUserProfileResponseBQZOgithub.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_profile_service_proto_rawDescOnce sync.Once
+	file_auth_v1_profile_service_proto_rawDescData []byte
+)
+
+func file_auth_v1_profile_service_proto_rawDescGZIP() []byte {
+	file_auth_v1_profile_service_proto_rawDescOnce.Do(func() {
+		file_auth_v1_profile_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_profile_service_proto_rawDesc), len(file_auth_v1_profile_service_proto_rawDesc)))
+	})
+	return file_auth_v1_profile_service_proto_rawDescData
+}
+
+var file_auth_v1_profile_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_auth_v1_profile_service_proto_goTypes = []any{
+	(*GetProfileRequest)(nil),               // 0: auth.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),              // 1: auth.v1.GetProfileResponse
+	(*UpdateProfileRequest)(nil),            // 2: auth.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),           // 3: auth.v1.UpdateProfileResponse
+	(*GetRiskQuestionnaireRequest)(nil),     // 4: auth.v1.GetRiskQuestionnaireRequest
+	(*GetRiskQuestionnaireResponse)(nil),    // 5: auth.v1.GetRiskQuestionnaireResponse
+	(*SubmitRiskQuestionnaireRequest)(nil),  // 6: auth.v1.SubmitRiskQuestionnaireRequest
+	(*SubmitRiskQuestionnaireResponse)(nil), // 7: auth.v1.SubmitRiskQuestionnaireResponse
+	(*ListRiskAssessmentsRequest)(nil),      // 8: auth.v1.ListRiskAssessmentsRequest
+	(*ListRiskAssessmentsResponse)(nil),     // 9: auth.v1.ListRiskAssessmentsResponse
+	(*GetUserProfileRequest)(nil),           // 10: auth.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),          // 11: auth.v1.GetUserProfileResponse
+	(*Profile)(nil),                         // 12: auth.v1.Profile
+	(*StringList)(nil),                      // 13: auth.v1.StringList
+	(*RiskQuestion)(nil),                    // 14: auth.v1.RiskQuestion
+	(*RiskOption)(nil),                      // 15: auth.v1.RiskOption
+	(*RiskAssessment)(nil),                  // 16: auth.v1.RiskAssessment
+	nil,                                     // 17: auth.v1.SubmitRiskQuestionnaireRequest.AnswersEntry
+	nil,                                     // 18: auth.v1.RiskAssessment.AnswersEntry
+}
+var file_auth_v1_profile_service_proto_depIdxs = []int32{
+	12, // 0: auth.v1.GetProfileResponse.profile:type_name -> auth.v1.Profile
+	13, // 1: auth.v1.UpdateProfileRequest.markets:type_name -> auth.v1.StringList
+	13, // 2: auth.v1.UpdateProfileRequest.watchlist:type_name -> auth.v1.StringList
+	12, // 3: auth.v1.UpdateProfileResponse.profile:type_name -> auth.v1.Profile
+	14, // 4: auth.v1.GetRiskQuestionnaireResponse.questions:type_name -> auth.v1.RiskQuestion
+	17, // 5: auth.v1.SubmitRiskQuestionnaireRequest.answers:type_name -> auth.v1.SubmitRiskQuestionnaireRequest.AnswersEntry
+	16, // 6: auth.v1.SubmitRiskQuestionnaireResponse.assessment:type_name -> auth.v1.RiskAssessment
+	16, // 7: auth.v1.ListRiskAssessmentsResponse.assessments:type_name -> auth.v1.RiskAssessment
+	12, // 8: auth.v1.GetUserProfileResponse.profile:type_name -> auth.v1.Profile
+	15, // 9: auth.v1.RiskQuestion.options:type_name -> auth.v1.RiskOption
+	18, // 10: auth.v1.RiskAssessment.answers:type_name -> auth.v1.RiskAssessment.AnswersEntry
+	0,  // 11: auth.v1.ProfileService.GetProfile:input_type -> auth.v1.GetProfileRequest
+	2,  // 12: auth.v1.ProfileService.UpdateProfile:input_type -> auth.v1.UpdateProfileRequest
+	4,  // 13: auth.v1.ProfileService.GetRiskQuestionnaire:input_type -> auth.v1.GetRiskQuestionnaireRequest
+	6,  // 14: auth.v1.ProfileService.SubmitRiskQuestionnaire:input_type -> auth.v1.SubmitRiskQuestionnaireRequest
+	8,  // 15: auth.v1.ProfileService.ListRiskAssessments:input_type -> auth.v1.ListRiskAssessmentsRequest
+	10, // 16: auth.v1.ProfileService.GetUserProfile:input_type -> auth.v1.GetUserProfileRequest
+	1,  // 17: auth.v1.ProfileService.GetProfile:output_type -> auth.v1.GetProfileResponse
+	3,  // 18: auth.v1.ProfileService.UpdateProfile:output_type -> auth.v1.UpdateProfileResponse
+	5,  // 19: auth.v1.ProfileService.GetRiskQuestionnaire:output_type -> auth.v1.GetRiskQuestionnaireResponse
+	7,  // 20: auth.v1.ProfileService.SubmitRiskQuestionnaire:output_type -> auth.v1.SubmitRiskQuestionnaireResponse
+	9,  // 21: auth.v1.ProfileService.ListRiskAssessments:output_type -> auth.v1.ListRiskAssessmentsResponse
+	11, // 22: auth.v1.ProfileService.GetUserProfile:output_type -> auth.v1.GetUserProfileResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_profile_service_proto_init() }
+func file_auth_v1_profile_service_proto_init() {
+	if File_auth_v1_profile_service_proto != nil {
+		return
+	}
+	file_auth_v1_profile_service_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_profile_service_proto_rawDesc), len(file_auth_v1_profile_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_profile_service_proto_goTypes,
+		DependencyIndexes: file_auth_v1_profile_service_proto_depIdxs,
+		MessageInfos:      file_auth_v1_profile_service_proto_msgTypes,
+	}.Build()
+	File_auth_v1_profile_service_proto = out.File
+	file_auth_v1_profile_service_proto_goTypes = nil
+	file_auth_v1_profile_service_proto_depIdxs = nil
+}
@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: auth/v1/profile_service.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProfileService_GetProfile_FullMethodName              = "/auth.v1.ProfileService/GetProfile"
+	ProfileService_UpdateProfile_FullMethodName           = "/auth.v1.ProfileService/UpdateProfile"
+	ProfileService_GetRiskQuestionnaire_FullMethodName    = "/auth.v1.ProfileService/GetRiskQuestionnaire"
+	ProfileService_SubmitRiskQuestionnaire_FullMethodName = "/auth.v1.ProfileService/SubmitRiskQuestionnaire"
+	ProfileService_ListRiskAssessments_FullMethodName     = "/auth.v1.ProfileService/ListRiskAssessments"
+	ProfileService_GetUserProfile_FullMethodName          = "/auth.v1.ProfileService/GetUserProfile"
+)
+
+// ProfileServiceClient is the client API for ProfileService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ProfileService keeps the investment profiles advice is personalised with.
+// Calls on behalf of a user carry their access token, GetUserProfile is for
+// other backend services and staff
+type ProfileServiceClient interface {
+	// GetProfile returns the profile of the token owner, defaults until they set it up
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	// UpdateProfile changes the preferences set in the request, the risk
+	// tolerance is changed by SubmitRiskQuestionnaire only
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// GetRiskQuestionnaire returns the questions of the risk questionnaire
+	GetRiskQuestionnaire(ctx context.Context, in *GetRiskQuestionnaireRequest, opts ...grpc.CallOption) (*GetRiskQuestionnaireResponse, error)
+	// SubmitRiskQuestionnaire scores the answers of the token owner and sets
+	// the risk tolerance of their profile
+	SubmitRiskQuestionnaire(ctx context.Context, in *SubmitRiskQuestionnaireRequest, opts ...grpc.CallOption) (*SubmitRiskQuestionnaireResponse, error)
+	// ListRiskAssessments returns the past questionnaire answers of the token owner, newest first
+	ListRiskAssessments(ctx context.Context, in *ListRiskAssessmentsRequest, opts ...grpc.CallOption) (*ListRiskAssessmentsResponse, error)
+	// GetUserProfile returns the profile of any user for backend services
+	// personalising recommendations and notifications, the caller's access
+	// token must grant profiles:read
+	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error)
+}
+
+type profileServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfileServiceClient(cc grpc.ClientConnInterface) ProfileServiceClient {
+	return &profileServiceClient{cc}
+}
+
+func (c *profileServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, ProfileService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetRiskQuestionnaire(ctx context.Context, in *GetRiskQuestionnaireRequest, opts ...grpc.CallOption) (*GetRiskQuestionnaireResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRiskQuestionnaireResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetRiskQuestionnaire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) SubmitRiskQuestionnaire(ctx context.Context, in *SubmitRiskQuestionnaireRequest, opts ...grpc.CallOption) (*SubmitRiskQuestionnaireResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitRiskQuestionnaireResponse)
+	err := c.cc.Invoke(ctx, ProfileService_SubmitRiskQuestionnaire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListRiskAssessments(ctx context.Context, in *ListRiskAssessmentsRequest, opts ...grpc.CallOption) (*ListRiskAssessmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRiskAssessmentsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListRiskAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserProfileResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetUserProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfileServiceServer is the server API for ProfileService service.
+// All implementations must embed UnimplementedProfileServiceServer
+// for forward compatibility.
+//
+// ProfileService keeps the investment profiles advice is personalised with.
+// Calls on behalf of a user carry their access token, GetUserProfile is for
+// other backend services and staff
+type ProfileServiceServer interface {
+	// GetProfile returns the profile of the token owner, defaults until they set it up
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	// UpdateProfile changes the preferences set in the request, the risk
+	// tolerance is changed by SubmitRiskQuestionnaire only
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// GetRiskQuestionnaire returns the questions of the risk questionnaire
+	GetRiskQuestionnaire(context.Context, *GetRiskQuestionnaireRequest) (*GetRiskQuestionnaireResponse, error)
+	// SubmitRiskQuestionnaire scores the answers of the token owner and sets
+	// the risk tolerance of their profile
+	SubmitRiskQuestionnaire(context.Context, *SubmitRiskQuestionnaireRequest) (*SubmitRiskQuestionnaireResponse, error)
+	// ListRiskAssessments returns the past questionnaire answers of the token owner, newest first
+	ListRiskAssessments(context.Context, *ListRiskAssessmentsRequest) (*ListRiskAssessmentsResponse, error)
+	// GetUserProfile returns the profile of any user for backend services
+	// personalising recommendations and notifications, the caller's access
+	// token must grant profiles:read
+	GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
+	mustEmbedUnimplementedProfileServiceServer()
+}
+
+// UnimplementedProfileServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProfileServiceServer struct{}
+
+func (UnimplementedProfileServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedProfileServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedProfileServiceServer) GetRiskQuestionnaire(context.Context, *GetRiskQuestionnaireRequest) (*GetRiskQuestionnaireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRiskQuestionnaire not implemented")
+}
+func (UnimplementedProfileServiceServer) SubmitRiskQuestionnaire(context.Context, *SubmitRiskQuestionnaireRequest) (*SubmitRiskQuestionnaireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRiskQuestionnaire not implemented")
+}
+func (UnimplementedProfileServiceServer) ListRiskAssessments(context.Context, *ListRiskAssessmentsRequest) (*ListRiskAssessmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRiskAssessments not implemented")
+}
+func (UnimplementedProfileServiceServer) GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+}
+func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
+func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProfileServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfileServiceServer will
+// result in compilation errors.
+type UnsafeProfileServiceServer interface {
+	mustEmbedUnimplementedProfileServiceServer()
+}
+
+func RegisterProfileServiceServer(s grpc.ServiceRegistrar, srv ProfileServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProfileServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProfileService_ServiceDesc, srv)
+}
+
+func _ProfileService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetRiskQuestionnaire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiskQuestionnaireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetRiskQuestionnaire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetRiskQuestionnaire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetRiskQuestionnaire(ctx, req.(*GetRiskQuestionnaireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_SubmitRiskQuestionnaire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRiskQuestionnaireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).SubmitRiskQuestionnaire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_SubmitRiskQuestionnaire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).SubmitRiskQuestionnaire(ctx, req.(*SubmitRiskQuestionnaireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListRiskAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiskAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListRiskAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListRiskAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListRiskAssessments(ctx, req.(*ListRiskAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetUserProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetUserProfile(ctx, req.(*GetUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProfileService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.ProfileService",
+	HandlerType: (*ProfileServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfile",
+			Handler:    _ProfileService_GetProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _ProfileService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "GetRiskQuestionnaire",
+			Handler:    _ProfileService_GetRiskQuestionnaire_Handler,
+		},
+		{
+			MethodName: "SubmitRiskQuestionnaire",
+			Handler:    _ProfileService_SubmitRiskQuestionnaire_Handler,
+		},
+		{
+			MethodName: "ListRiskAssessments",
+			Handler:    _ProfileService_ListRiskAssessments_Handler,
+		},
+		{
+			MethodName: "GetUserProfile",
+			Handler:    _ProfileService_GetUserProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/v1/profile_service.proto",
+}
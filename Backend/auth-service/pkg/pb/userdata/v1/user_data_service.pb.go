@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: userdata/v1/user_data_service.proto
+
+package userdatav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // user UUID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_userdata_v1_user_data_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*DataFile            `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_userdata_v1_user_data_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportUserDataResponse) GetFiles() []*DataFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type DeleteUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // user UUID
+	DeletionId    string                 `protobuf:"bytes,2,opt,name=deletion_id,json=deletionId,proto3" json:"deletion_id,omitempty"` // account deletion UUID, the same on every retry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserDataRequest) Reset() {
+	*x = DeleteUserDataRequest{}
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserDataRequest) ProtoMessage() {}
+
+func (x *DeleteUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserDataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_userdata_v1_user_data_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteUserDataRequest) GetDeletionId() string {
+	if x != nil {
+		return x.DeletionId
+	}
+	return ""
+}
+
+type DeleteUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserDataResponse) Reset() {
+	*x = DeleteUserDataResponse{}
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserDataResponse) ProtoMessage() {}
+
+func (x *DeleteUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserDataResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_userdata_v1_user_data_service_proto_rawDescGZIP(), []int{3}
+}
+
+type DataFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // file name in the folder of the service, e.g. chats.json
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // e.g. application/json
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataFile) Reset() {
+	*x = DataFile{}
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataFile) ProtoMessage() {}
+
+func (x *DataFile) ProtoReflect() protoreflect.Message {
+	mi := &file_userdata_v1_user_data_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataFile.ProtoReflect.Descriptor instead.
+func (*DataFile) Descriptor() ([]byte, []int) {
+	return file_userdata_v1_user_data_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DataFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DataFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DataFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_userdata_v1_user_data_service_proto protoreflect.FileDescriptor
+
+const file_userdata_v1_user_data_service_proto_rawDesc = "" +
+	"\n" +
+	"#userdata/v1/user_data_service.proto\x12\vuserdata.v1\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"E\n" +
+	"\x16ExportUserDataResponse\x12+\n" +
+	"\x05files\x18\x01 \x03(\v2\x15.userdata.v1.DataFileR\x05files\"Q\n" +
+	"\x15DeleteUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vdeletion_id\x18\x02 \x01(\tR\n" +
+	"deletionId\"\x18\n" +
+	"\x16DeleteUserDataResponse\"[\n" +
+	"\bDataFile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent2\xc7\x01\n" +
+	"\x0fUserDataService\x12Y\n" +
+	"\x0eExportUserData\x12\".userdata.v1.ExportUserDataRequest\x1a#.userdata.v1.ExportUserDataResponse\x12Y\n" +
+	"\x0eDeleteUserData\x12\".userdata.v1.DeleteUserDataRequest\x1a#.userdata.v1.DeleteUserDataResponseBYZWgithub.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/userdata/v1;userdatav1b\x06proto3"
+
+var (
+	file_userdata_v1_user_data_service_proto_rawDescOnce sync.Once
+	file_userdata_v1_user_data_service_proto_rawDescData []byte
+)
+
+func file_userdata_v1_user_data_service_proto_rawDescGZIP() []byte {
+	file_userdata_v1_user_data_service_proto_rawDescOnce.Do(func() {
+		file_userdata_v1_user_data_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_userdata_v1_user_data_service_proto_rawDesc), len(file_userdata_v1_user_data_service_proto_rawDesc)))
+	})
+	return file_userdata_v1_user_data_service_proto_rawDescData
+}
+
+var file_userdata_v1_user_data_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_userdata_v1_user_data_service_proto_goTypes = []any{
+	(*ExportUserDataRequest)(nil),  // 0: userdata.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil), // 1: userdata.v1.ExportUserDataResponse
+	(*DeleteUserDataRequest)(nil),  // 2: userdata.v1.DeleteUserDataRequest
+	(*DeleteUserDataResponse)(nil), // 3: userdata.v1.DeleteUserDataResponse
+	(*DataFile)(nil),               // 4: userdata.v1.DataFile
+}
+var file_userdata_v1_user_data_service_proto_depIdxs = []int32{
+	4, // 0: userdata.v1.ExportUserDataResponse.files:type_name -> userdata.v1.DataFile
+	0, // 1: userdata.v1.UserDataService.ExportUserData:input_type -> userdata.v1.ExportUserDataRequest
+	2, // 2: userdata.v1.UserDataService.DeleteUserData:input_type -> userdata.v1.DeleteUserDataRequest
+	1, // 3: userdata.v1.UserDataService.ExportUserData:output_type -> userdata.v1.ExportUserDataResponse
+	3, // 4: userdata.v1.UserDataService.DeleteUserData:output_type -> userdata.v1.DeleteUserDataResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_userdata_v1_user_data_service_proto_init() }
+func file_userdata_v1_user_data_service_proto_init() {
+	if File_userdata_v1_user_data_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_userdata_v1_user_data_service_proto_rawDesc), len(file_userdata_v1_user_data_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_userdata_v1_user_data_service_proto_goTypes,
+		DependencyIndexes: file_userdata_v1_user_data_service_proto_depIdxs,
+		MessageInfos:      file_userdata_v1_user_data_service_proto_msgTypes,
+	}.Build()
+	File_userdata_v1_user_data_service_proto = out.File
+	file_userdata_v1_user_data_service_proto_goTypes = nil
+	file_userdata_v1_user_data_service_proto_depIdxs = nil
+}
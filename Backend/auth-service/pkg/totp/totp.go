@@ -0,0 +1,101 @@
+// Package totp implements time-based one-time passwords as defined by RFC 6238
+// with the parameters authenticator apps support everywhere: HMAC-SHA1,
+// 6 digits and a 30 second period
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 default, what authenticator apps implement
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the length of a code
+	Digits = 6
+	// Period is how long a code is valid
+	Period = 30 * time.Second
+
+	_secretBytes = 20
+)
+
+var _encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random secret, base32 encoded as apps expect it
+func GenerateSecret() (string, error) {
+	buf := make([]byte, _secretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+
+	return _encoding.EncodeToString(buf), nil
+}
+
+// Step returns the time step of t
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// Code returns the code of the secret for the time step
+func Code(secret string, step int64) (string, error) {
+	key, err := _encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("invalid secret: %w", err)
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step)) //nolint:gosec // steps are positive
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation, RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, bin%1_000_000), nil
+}
+
+// Validate checks the code against the time step of t and skew steps around
+// it, to allow for clock drift and typing time. Returns the matching step so
+// that callers can reject codes of steps that were used before
+func Validate(secret, code string, t time.Time, skew int) (int64, bool) {
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	now := Step(t)
+	for i := -skew; i <= skew; i++ {
+		step := now + int64(i)
+		expected, err := Code(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// URI returns the otpauth:// provisioning URI apps enroll from, usually shown as a QR code
+func URI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	params := url.Values{
+		"secret":    {secret},
+		"issuer":    {issuer},
+		"algorithm": {"SHA1"},
+		"digits":    {fmt.Sprint(Digits)},
+		"period":    {fmt.Sprint(int(Period / time.Second))},
+	}
+
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
@@ -0,0 +1,68 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/totp"
+	"github.com/stretchr/testify/require"
+)
+
+// _rfcSecret is the SHA1 seed of the RFC 6238 appendix B test vectors
+var _rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	// RFC 6238 appendix B, truncated to 6 digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tc := range tests {
+		code, err := totp.Code(_rfcSecret, totp.Step(time.Unix(tc.unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, tc.code, code, "time %d", tc.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1111111111, 0)
+	step := totp.Step(now)
+
+	previous, err := totp.Code(_rfcSecret, step-1)
+	require.NoError(t, err)
+	stale, err := totp.Code(_rfcSecret, step-2)
+	require.NoError(t, err)
+
+	matched, ok := totp.Validate(_rfcSecret, previous, now, 1)
+	require.True(t, ok)
+	require.Equal(t, step-1, matched)
+
+	_, ok = totp.Validate(_rfcSecret, stale, now, 1)
+	require.False(t, ok)
+
+	_, ok = totp.Validate(_rfcSecret, "12345", now, 1)
+	require.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	t.Parallel()
+
+	uri := totp.URI("FinancialAdviser", "jane@example.com", "JBSWY3DPEHPK3PXP")
+	require.Equal(t,
+		"otpauth://totp/FinancialAdviser:jane@example.com?algorithm=SHA1&digits=6&issuer=FinancialAdviser&period=30&secret=JBSWY3DPEHPK3PXP",
+		uri,
+	)
+}
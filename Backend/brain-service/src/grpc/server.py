@@ -11,12 +11,15 @@ from asyncpg.pool import Pool
 
 from proto import brain_pb2 as pb
 from proto import brain_pb2_grpc as pb_grpc
+from proto import user_data_service_pb2_grpc as udpb_grpc
 
 from core.db import init_pool, close_pool
 from repo.chats import ChatRepository
 from repo.messages import MessageRepository
+from repo.user_data import UserDataRepository
 from services.llm_orchestrator import LLMOrchestrator
 from services.ml_client import MLClient
+from grpc.user_data import UserDataServiceServicer
 
 
 def _chat_dict_to_pb(row: dict) -> pb.Chat:
@@ -98,11 +101,15 @@ class BrainServiceServicer(pb_grpc.BrainServiceServicer):
         self, request: pb.ListMessagesRequest,
         context: grpc.aio.ServicerContext,
     ) -> pb.ListMessagesResponse:
+        chat_id = uuid.UUID(request.chat_id)
+        if not await self.chats.get_chat(uuid.UUID(request.user_id), chat_id):
+            await context.abort(grpc.StatusCode.NOT_FOUND, "chat not found")
+
         limit = request.page_size or 50
         cursor: Optional[int] = int(request.page_token) \
             if request.page_token else None
         rows, next_cursor = await self.msgs.list_messages(
-            uuid.UUID(request.chat_id), limit, cursor
+            chat_id, limit, cursor
         )
         return pb.ListMessagesResponse(
             messages=[_msg_dict_to_pb(r) for r in rows],
@@ -118,19 +125,16 @@ class BrainServiceServicer(pb_grpc.BrainServiceServicer):
         • Получаем асинхронный генератор чанков от LLMOrchestrator.
         • Стримим их в клиента.
         """
-        # 1) cоздать чат «на лету», если отсутствует
-        chat_id = (
-            uuid.UUID(request.chat_id)
-            if request.chat_id
-            else uuid.UUID(
-                (
-                    await self.chats.create_chat(
-                        uuid.UUID(request.user_id),
-                        "New chat",
-                    )
-                )["id"]
-            )
-        )
+        # 1) cоздать чат «на лету», если отсутствует,
+        #    чужой чат — как несуществующий
+        user_id = uuid.UUID(request.user_id)
+        if request.chat_id:
+            chat_id = uuid.UUID(request.chat_id)
+            if not await self.chats.get_chat(user_id, chat_id):
+                await context.abort(grpc.StatusCode.NOT_FOUND,
+                                    "chat not found")
+        else:
+            chat_id = (await self.chats.create_chat(user_id, "New chat"))["id"]
 
         # 2) cохранить входящее сообщение
         await self.msgs.create_message(
@@ -145,7 +149,8 @@ class BrainServiceServicer(pb_grpc.BrainServiceServicer):
             chat_id, request.content
         ):
             yield pb.StreamMessageResponse(
-                content_chunk=chunk, is_final=is_final, tokens_used=tokens
+                content_chunk=chunk, is_final=is_final, tokens_used=tokens,
+                chat_id=str(chat_id),
             )
 
     async def Ping(
@@ -168,6 +173,7 @@ async def serve_grpc() -> None:
     # 2. репозитории & сервисы
     chat_repo = ChatRepository(pool)
     msg_repo = MessageRepository(pool)
+    user_data_repo = UserDataRepository(pool)
     ml_client = MLClient()
     orchestrator = LLMOrchestrator(msg_repo, ml_client)
 
@@ -177,6 +183,9 @@ async def serve_grpc() -> None:
     pb_grpc.add_BrainServiceServicer_to_server(
         BrainServiceServicer(pool, chat_repo, msg_repo, orchestrator), server
     )
+    udpb_grpc.add_UserDataServiceServicer_to_server(
+        UserDataServiceServicer(user_data_repo), server
+    )
     listen_addr = f"[::]:{_GRPC_PORT}"
     server.add_insecure_port(listen_addr)
     await server.start()
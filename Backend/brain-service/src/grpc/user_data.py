@@ -0,0 +1,69 @@
+from __future__ import annotations
+
+import json
+import logging
+import uuid
+
+import grpc
+
+from proto import user_data_service_pb2 as udpb
+from proto import user_data_service_pb2_grpc as udpb_grpc
+
+from repo.user_data import UserDataRepository
+
+_CONTENT_TYPE_JSON = "application/json"
+
+
+def _json_file(name: str, value) -> udpb.DataFile:
+    return udpb.DataFile(
+        name=name,
+        content_type=_CONTENT_TYPE_JSON,
+        content=json.dumps(
+            value, default=str, ensure_ascii=False, indent=2,
+        ).encode(),
+    )
+
+
+class UserDataServiceServicer(udpb_grpc.UserDataServiceServicer):
+    """
+    Выгрузка и удаление данных пользователя по запросу auth-service
+    (контракт — user_data_service.proto из auth-service).
+    """
+
+    def __init__(self, repo: UserDataRepository):
+        self.repo = repo
+        self.log = logging.getLogger("brain.grpc.user_data")
+
+    async def ExportUserData(
+        self, request: udpb.ExportUserDataRequest,
+        context: grpc.aio.ServicerContext,
+    ) -> udpb.ExportUserDataResponse:
+        user_id = await self._user_id(request.user_id, context)
+
+        chats = await self.repo.export_chats(user_id)
+        usage = await self.repo.export_usage(user_id)
+        return udpb.ExportUserDataResponse(files=[
+            _json_file("chats.json", chats),
+            _json_file("usage.json", usage),
+        ])
+
+    async def DeleteUserData(
+        self, request: udpb.DeleteUserDataRequest,
+        context: grpc.aio.ServicerContext,
+    ) -> udpb.DeleteUserDataResponse:
+        user_id = await self._user_id(request.user_id, context)
+
+        await self.repo.delete_user(user_id)
+        self.log.info("deleted data of user %s (deletion %s)",
+                      user_id, request.deletion_id)
+        return udpb.DeleteUserDataResponse()
+
+    @staticmethod
+    async def _user_id(
+        raw: str, context: grpc.aio.ServicerContext,
+    ) -> uuid.UUID:
+        try:
+            return uuid.UUID(raw)
+        except ValueError:
+            await context.abort(grpc.StatusCode.INVALID_ARGUMENT,
+                                "invalid user id")
@@ -24,6 +24,22 @@ class ChatRepository:
         )
         return dict(row)
 
+    async def get_chat(self, user_id: UUID, chat_id: UUID) -> Optional[Dict]:
+        """
+        Возвращает чат пользователя или None, если чата нет
+        или он принадлежит другому пользователю.
+        """
+        row = await self.pool.fetchrow(
+            """
+            SELECT id, user_id, title, created_at, updated_at
+              FROM chats
+             WHERE id = $1
+               AND user_id = $2
+            """,
+            chat_id, user_id,
+        )
+        return dict(row) if row else None
+
     async def list_chats(
         self,
         user_id: UUID,
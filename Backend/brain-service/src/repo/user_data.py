@@ -0,0 +1,79 @@
+from __future__ import annotations
+
+import asyncpg
+from typing import Dict, List, Optional
+from uuid import UUID
+
+
+class UserDataRepository:
+    """Данные пользователя целиком — для выгрузки и удаления аккаунта."""
+
+    def __init__(self, pool: asyncpg.Pool):
+        self.pool = pool
+
+    async def export_chats(self, user_id: UUID) -> List[Dict]:
+        """
+        Возвращает все чаты пользователя вместе с сообщениями
+        (старые → новые).
+        """
+        chats = await self.pool.fetch(
+            """
+            SELECT id, title, created_at, updated_at
+              FROM chats
+             WHERE user_id = $1
+          ORDER BY created_at
+            """,
+            user_id,
+        )
+        messages = await self.pool.fetch(
+            """
+            SELECT m.id, m.chat_id, m.role, m.content,
+                   m.token_count, m.created_at
+              FROM messages m
+              JOIN chats c ON c.id = m.chat_id
+             WHERE c.user_id = $1
+          ORDER BY m.id
+            """,
+            user_id,
+        )
+
+        by_chat: Dict[UUID, List[Dict]] = {}
+        for m in messages:
+            by_chat.setdefault(m["chat_id"], []).append({
+                "id": m["id"],
+                "role": m["role"],
+                "content": m["content"],
+                "token_count": m["token_count"],
+                "created_at": m["created_at"],
+            })
+
+        return [
+            {**dict(c), "messages": by_chat.get(c["id"], [])}
+            for c in chats
+        ]
+
+    async def export_usage(self, user_id: UUID) -> Optional[Dict]:
+        """Возвращает квоту токенов пользователя, если она заведена."""
+        row = await self.pool.fetchrow(
+            """
+            SELECT period_start, tokens_used, tokens_limit
+              FROM user_usage
+             WHERE user_id = $1
+            """,
+            user_id,
+        )
+        return dict(row) if row else None
+
+    async def delete_user(self, user_id: UUID) -> None:
+        """
+        Удаляет чаты пользователя (ON DELETE CASCADE уберёт сообщения
+        и llm_logs) и его квоту. Повторный вызов ничего не делает.
+        """
+        async with self.pool.acquire() as conn:
+            async with conn.transaction():
+                await conn.execute(
+                    "DELETE FROM chats WHERE user_id = $1", user_id,
+                )
+                await conn.execute(
+                    "DELETE FROM user_usage WHERE user_id = $1", user_id,
+                )
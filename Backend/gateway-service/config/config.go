@@ -19,6 +19,11 @@ type (
 		Services Services
 		Redis    Redis
 		JWT      JWT
+		WS       WS
+
+		Entitlements Entitlements
+		RateLimit    RateLimit
+		Cache        Cache
 	}
 
 	// App -.
@@ -35,12 +40,15 @@ type (
 		WriteTimeout    time.Duration `env:"HTTP_WRITE_TIMEOUT" envDefault:"5s"`
 		IdleTimeout     time.Duration `env:"HTTP_IDLE_TIMEOUT" envDefault:"60s"`
 		GIN             GIN
+
+		// TrustedProxies may set X-Forwarded-For, the client IP rate limits
+		// go by. Unset, the address of the peer is the client IP
+		TrustedProxies []string `env:"HTTP_TRUSTED_PROXIES" envSeparator:","`
 	}
 
 	// GIN -.
 	GIN struct {
-		Mode      string `env:"GIN_MODE" envDefault:"debug"`
-		RateLimit int    `env:"GIN_RATE_LIMIT" envDefault:"100"`
+		Mode string `env:"GIN_MODE" envDefault:"debug"`
 	}
 
 	// Log -.
@@ -66,10 +74,11 @@ type (
 
 	// Services -..
 	Services struct {
-		Auth  GRPCService
-		Brain GRPCService
-		ML    GRPCService
-		Sub   GRPCService
+		Auth  GRPCService `envPrefix:"AUTH_"`
+		Brain GRPCService `envPrefix:"BRAIN_"`
+		ML    GRPCService `envPrefix:"ML_"`
+		Sub   GRPCService `envPrefix:"SUB_"`
+		X     GRPCService `envPrefix:"X_"`
 	}
 
 	// GRPCService -..
@@ -88,10 +97,63 @@ type (
 
 	// JWT -..
 	JWT struct {
-		Secret          string        `env:"JWT_SECRET,required"`
-		AccessTokenTTL  time.Duration `env:"JWT_ACCESS_TOKEN_TTL,required"`
-		RefreshTokenTTL time.Duration `env:"JWT_REFRESH_TOKEN_TTL,required"`
-		VerificationKey string        `env:"JWT_VERIFICATION_KEY,required"`
+		AccessTokenTTL      time.Duration `env:"JWT_ACCESS_TOKEN_TTL,required"`
+		RefreshTokenTTL     time.Duration `env:"JWT_REFRESH_TOKEN_TTL,required"`
+		Issuer              string        `env:"JWT_ISSUER" envDefault:"auth-service"`
+		JWKSURL             string        `env:"JWT_JWKS_URL"`
+		JWKSRefreshInterval time.Duration `env:"JWT_JWKS_REFRESH_INTERVAL" envDefault:"15m"`
+	}
+
+	// WS - WebSocket hub
+	WS struct {
+		PingInterval     time.Duration `env:"WS_PING_INTERVAL" envDefault:"30s"`
+		PongTimeout      time.Duration `env:"WS_PONG_TIMEOUT" envDefault:"60s"`
+		WriteTimeout     time.Duration `env:"WS_WRITE_TIMEOUT" envDefault:"10s"`
+		MaxMessageSize   int64         `env:"WS_MAX_MESSAGE_SIZE" envDefault:"65536"`
+		SendBuffer       int           `env:"WS_SEND_BUFFER" envDefault:"256"`
+		MaxSubscriptions int           `env:"WS_MAX_SUBSCRIPTIONS" envDefault:"20"`
+		MaxChatStreams   int           `env:"WS_MAX_CHAT_STREAMS" envDefault:"2"`
+		ChatTimeout      time.Duration `env:"WS_CHAT_TIMEOUT" envDefault:"2m"`
+		FeedPollInterval time.Duration `env:"WS_FEED_POLL_INTERVAL" envDefault:"5s"`
+		FeedBatch        int           `env:"WS_FEED_BATCH" envDefault:"100"`
+
+		// AllowedOrigins may open connections from browsers besides the
+		// origin of the gateway itself, e.g. https://app.example.com
+		AllowedOrigins []string `env:"WS_ALLOWED_ORIGINS" envSeparator:","`
+	}
+
+	// Entitlements - what subscription plans allow
+	Entitlements struct {
+		CacheTTL     time.Duration `env:"ENTITLEMENTS_CACHE_TTL" envDefault:"1m"`
+		ChatMessages PlanQuota     `envPrefix:"QUOTA_CHAT_MESSAGES_"`
+	}
+
+	// RateLimit - requests per minute, public routes per client address, the
+	// others per client address before the token is checked and then per API
+	// key or user by plan type, "free" without a plan; 0 is unlimited
+	RateLimit struct {
+		Public int            `env:"RATE_LIMIT_PUBLIC" envDefault:"30"`
+		Client int            `env:"RATE_LIMIT_CLIENT" envDefault:"600"`
+		API    map[string]int `env:"RATE_LIMIT_API" envDefault:"free:60,basic:120,pro:600,enterprise:3000"`
+		Chat   map[string]int `env:"RATE_LIMIT_CHAT" envDefault:"free:5,basic:10,pro:30,enterprise:120"`
+		ML     map[string]int `env:"RATE_LIMIT_ML" envDefault:"free:10,basic:20,pro:60,enterprise:300"`
+	}
+
+	// Cache - how long cached responses of each route are fresh, stale ones
+	// are served as long again while they are refreshed; 0 disables a route
+	Cache struct {
+		Plans        time.Duration `env:"CACHE_PLANS_TTL" envDefault:"10m"`
+		Symbols      time.Duration `env:"CACHE_SYMBOLS_TTL" envDefault:"10m"`
+		Sentiment    time.Duration `env:"CACHE_SENTIMENT_TTL" envDefault:"5m"`
+		Trending     time.Duration `env:"CACHE_TRENDING_TTL" envDefault:"1m"`
+		Subscription time.Duration `env:"CACHE_SUBSCRIPTION_TTL" envDefault:"30s"`
+	}
+
+	// PlanQuota - daily limit of each plan type, 0 is unlimited
+	PlanQuota struct {
+		Basic      int64 `env:"BASIC" envDefault:"20"`
+		Pro        int64 `env:"PRO" envDefault:"200"`
+		Enterprise int64 `env:"ENTERPRISE" envDefault:"0"`
 	}
 )
 
@@ -105,6 +167,23 @@ func NewConfig() (*Config, error) {
 	return cfg, nil
 }
 
+// Of returns the limit of a plan type, 0 for unknown types
+func (q PlanQuota) Of(planType string) int64 {
+	switch planType {
+	case "basic":
+		return q.Basic
+	case "pro":
+		return q.Pro
+	case "enterprise":
+		return q.Enterprise
+	}
+	return 0
+}
+
+func (r Redis) Addr() string {
+	return net.JoinHostPort(r.Host, r.Port)
+}
+
 func (s GRPCService) Addr() string {
 	return net.JoinHostPort(s.Host, s.Port)
 }
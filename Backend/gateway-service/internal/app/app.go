@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
@@ -13,6 +14,7 @@ import (
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/handler"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/ws"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/logger"
 
 	"github.com/gin-gonic/gin"
@@ -35,21 +37,38 @@ func Run(cfg *config.Config) {
 	}
 
 	router := gin.New()
+	if err = router.SetTrustedProxies(cfg.HTTP.TrustedProxies); err != nil {
+		l.Fatal(fmt.Errorf("gateway – router.SetTrustedProxies: %w", err))
+	}
 	router.Use(
 		middleware.Logger(l),
 		middleware.Recovery(),
-		middleware.RateLimit(cfg),
 	)
 
-	handlers := handler.NewHandlers(svcs)
+	// WebSocket hub
+	hub := ws.NewHub(cfg.WS, svcs.Chat, svcs.Feed, svcs.Entitlements, l)
+	feedCtx, stopFeed := context.WithCancel(context.Background())
+	go hub.Run(feedCtx)
+
+	handlers := handler.NewHandlers(svcs, hub, cfg.WS.AllowedOrigins)
 
 	// register routes
 	api := router.Group("/api")
-	controllerhttp.RegisterPublic(api, handlers)
+	public := api.Group("/", middleware.RateLimit(svcs.RateLimiter, service.RoutePublic))
+	controllerhttp.RegisterPublic(public, handlers, svcs)
 
 	protected := api.Group("/")
-	protected.Use(middleware.Auth(svcs.Auth))
-	controllerhttp.RegisterProtected(protected, handlers)
+	protected.Use(
+		// ahead of Auth, so floods of forged tokens and guessed API keys stop
+		// before they reach auth-service
+		middleware.RateLimit(svcs.RateLimiter, service.RouteClient),
+		middleware.Auth(svcs.Tokens, svcs.Auth),
+		middleware.RateLimit(svcs.RateLimiter, service.RouteAPI),
+	)
+	controllerhttp.RegisterProtected(protected, handlers, svcs)
+
+	// stale cached answers are refreshed through the routes
+	svcs.Cache.SetHandler(router)
 
 	// HTTP server
 	srv := &http.Server{
@@ -77,9 +96,22 @@ func Run(cfg *config.Config) {
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.HTTP.ShutdownTimeout)
 	defer cancel()
 
+	stopFeed()
+
+	// hijacked WebSocket connections drain alongside the HTTP requests
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := hub.Shutdown(ctx); err != nil {
+			l.Error("app - Run - gateway - hub.Shutdown: %v", err)
+		}
+	}()
+
 	if err := srv.Shutdown(ctx); err != nil {
 		l.Error("app - Run - gateway - server.Shutdown: %v", err)
 	}
+	wg.Wait()
 
 	l.Info("app - Run - gateway stopped gracefully")
 }
@@ -1,17 +1,68 @@
 package http
 
 import (
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/handler"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
 	"github.com/gin-gonic/gin"
 )
 
-// RegisterProtected - mounts authenticated routes under /api
-func RegisterProtected(r *gin.RouterGroup, h *handler.Handlers) {
-	// chat := r.Group("/chat")
-	// {
-	// 	chat.GET("/", h.Chat.ListChats)
-	// 	chat.POST("/", h.Chat.CreateChat)
-	// 	chat.GET("/:id/messages", h.Chat.GetMessages)
-	// 	chat.POST("/:id/messages", h.Chat.SendMessage)
-	// }
+// RegisterProtected - mounts authenticated routes under /api, chat and ML
+// need plans that include them and have rate limits of their own. Read-heavy
+// routes are cached, and the changes going through them drop their answers.
+func RegisterProtected(r *gin.RouterGroup, h *handler.Handlers, svcs *service.Services) {
+	ents, rl, rc := svcs.Entitlements, svcs.RateLimiter, svcs.Cache
+
+	subs := r.Group("/subscriptions")
+	{
+		subs.GET("", h.Subscription.List)
+		subs.GET("/current", middleware.Cache(rc, service.CacheSubscription), h.Subscription.GetStatus)
+		subs.GET("/entitlements", h.Subscription.GetEntitlements)
+		subs.GET("/:id", h.Subscription.Get)
+
+		changes := subs.Group("", middleware.InvalidateCache(rc, service.CacheSubscription))
+		changes.POST("", h.Subscription.Subscribe)
+		changes.PATCH("/:id", h.Subscription.Update)
+		changes.POST("/:id/cancel", h.Subscription.Cancel)
+		changes.POST("/:id/payments", h.Subscription.Pay)
+	}
+
+	chat := r.Group("/chat", middleware.RequireFeature(ents, entity.FeatureChat))
+	{
+		chat.GET("", h.Chat.ListChats)
+		chat.POST("", h.Chat.CreateChat)
+		chat.DELETE("/:id", h.Chat.DeleteChat)
+		chat.GET("/:id/messages", h.Chat.GetMessages)
+		chat.POST("/:id/messages", middleware.RateLimit(rl, service.RouteChat),
+			middleware.ConsumeQuota(ents, entity.QuotaChatMessages), h.Chat.SendMessage)
+	}
+
+	feed := r.Group("/feed")
+	{
+		feed.GET("/tweets", h.Feed.ListTweets)
+		feed.GET("/tweets/:id", h.Feed.GetTweet)
+		feed.GET("/symbols/:symbol/tweets", h.Feed.ListSymbolTweets)
+		feed.GET("/symbols/:symbol/sentiment", middleware.Cache(rc, service.CacheSentiment), h.Feed.GetSentimentSeries)
+		feed.GET("/trending", middleware.Cache(rc, service.CacheTrending), h.Feed.GetTrending)
+
+		admin := feed.Group("", middleware.RequirePermission(authz.PermTweetsAdmin),
+			middleware.InvalidateCache(rc, service.CacheSentiment, service.CacheTrending))
+		admin.POST("/tweets", h.Feed.CreateTweet)
+		admin.PATCH("/tweets/:id", h.Feed.UpdateTweet)
+		admin.DELETE("/tweets/:id", h.Feed.DeleteTweet)
+		admin.POST("/tweets/:id/release", h.Feed.ReleaseTweet)
+	}
+
+	ml := r.Group("/ml", middleware.RequireFeature(ents, entity.FeatureML), middleware.RateLimit(rl, service.RouteML))
+	{
+		ml.POST("/sentiment", h.ML.AnalyzeSentiment)
+		ml.POST("/sentiment/batch", h.ML.BatchAnalyzeSentiment)
+		ml.POST("/trend", h.ML.PredictTrend)
+		ml.GET("/symbols", middleware.Cache(rc, service.CacheSymbols), h.ML.GetSymbols)
+	}
+
+	// frames of the connection check the features they use
+	r.GET("/ws", h.WS.Connect)
 }
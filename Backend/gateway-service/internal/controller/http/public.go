@@ -2,15 +2,38 @@ package http
 
 import (
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/handler"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
 	"github.com/gin-gonic/gin"
 )
 
-// RegisterPublic - mounts unauthenticated routes: /api/auth/…
-func RegisterPublic(r *gin.RouterGroup, h *handler.Handlers) {
+// RegisterPublic - mounts unauthenticated routes: /api/auth/…, /api/subscriptions/plans
+func RegisterPublic(r *gin.RouterGroup, h *handler.Handlers, svcs *service.Services) {
 	auth := r.Group("/auth")
 	{
 		auth.POST("/signup", h.Auth.Register)
 		auth.POST("/signin", h.Auth.Login)
-		// auth.POST("/signout", h.Auth.Logout)
+		auth.POST("/refresh", h.Auth.RefreshToken)
+		auth.POST("/signout", h.Auth.Logout)
+		auth.POST("/verify-email", h.Auth.VerifyEmail)
+		auth.POST("/verify-email/resend", h.Auth.ResendVerificationEmail)
+		auth.POST("/password/forgot", h.Auth.ForgotPassword)
+		auth.POST("/password/reset", h.Auth.ResetPassword)
+		auth.POST("/password/change", h.Auth.ChangePassword)
+		auth.POST("/mfa/verify", h.Auth.VerifyMFA)
+		auth.POST("/mfa/enroll", h.Auth.EnrollMFA)
+		auth.POST("/mfa/confirm", h.Auth.ConfirmMFA)
+		auth.POST("/mfa/disable", h.Auth.DisableMFA)
+		auth.POST("/mfa/recovery-codes", h.Auth.RegenerateRecoveryCodes)
+		auth.GET("/oauth/:provider", h.Auth.StartOAuth)
+		auth.GET("/oauth/:provider/callback", h.Auth.OAuthCallback)
+		auth.GET("/api-keys", h.Auth.ListAPIKeys)
+		auth.POST("/api-keys", h.Auth.CreateAPIKey)
+		auth.DELETE("/api-keys/:id", h.Auth.RevokeAPIKey)
+		auth.GET("/account/export", h.Auth.ExportAccountData)
+		auth.DELETE("/account", h.Auth.DeleteAccount)
+		auth.GET("/account/deletions/:id", h.Auth.GetAccountDeletion)
 	}
+
+	r.GET("/subscriptions/plans", middleware.Cache(svcs.Cache, service.CachePlans), h.Subscription.GetPlans)
 }
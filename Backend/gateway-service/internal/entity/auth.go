@@ -1,9 +1,22 @@
 package entity
 
-// Claims is what our middleware pulls out of a validated JWT
+import "slices"
+
+// Claims is what our middleware pulls out of a validated JWT or API key
 type Claims struct {
-	UserID   string
-	Email    string
-	Username string
-	IsAdmin  bool
+	UserID      string
+	Email       string
+	Username    string
+	Role        string   // user, analyst, support or admin, empty while Permissions are withheld and for API keys
+	Permissions []string // granted by Role, limited to the scopes of an API key
+	ActorID     string   // staff member impersonating UserID, empty otherwise
+	APIKeyID    string   // API key the request was made with, empty for JWTs
+
+	EmailVerified bool
+}
+
+// HasPermission reports whether the token or API key grants the permission,
+// requests are authorized by permission since the role may grant more
+func (c *Claims) HasPermission(perm string) bool {
+	return slices.Contains(c.Permissions, perm)
 }
@@ -0,0 +1,19 @@
+package entity
+
+// Chat is a conversation of the signed-in user with the assistant
+type Chat struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// Message is a message of a chat
+type Message struct {
+	ID         int64  `json:"id"`
+	ChatID     string `json:"chat_id"`
+	Role       string `json:"role"` // user, assistant or system
+	Content    string `json:"content"`
+	TokenCount int    `json:"token_count"`
+	CreatedAt  string `json:"created_at"`
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// Features a plan grants, plans list them in their features
+const (
+	FeatureChat         = "chat"
+	FeatureRealtimeFeed = "realtime_feed"
+	FeatureML           = "ml"
+)
+
+// Quotas limit the use of a feature per day
+const (
+	QuotaChatMessages = "chat_messages"
+)
+
+// Entitlements is what the active subscription of the user allows
+type Entitlements struct {
+	PlanID   string           `json:"plan_id,omitempty"`
+	Plan     string           `json:"plan"` // basic, pro or enterprise, empty without an active subscription
+	Features []string         `json:"features"`
+	Quotas   map[string]int64 `json:"quotas"`         // daily limits, unlimited quotas are absent
+	Used     map[string]int64 `json:"used,omitempty"` // use of the quotas today
+}
+
+func (e *Entitlements) Has(feature string) bool {
+	return slices.Contains(e.Features, feature)
+}
+
+// Denial codes
+const (
+	DenialSubscriptionRequired = "subscription_required" // 402, no active subscription
+	DenialFeatureNotInPlan     = "feature_not_in_plan"   // 403, the plan lacks the feature
+	DenialQuotaExceeded        = "quota_exceeded"        // 403, the quota of the plan is used up for the day
+)
+
+// Denial explains why the plan of the user refuses a request and which plans
+// would allow it
+type Denial struct {
+	Status      int        `json:"-"` // 402 or 403
+	Code        string     `json:"code"`
+	Message     string     `json:"error"`
+	Entitlement string     `json:"entitlement"` // the feature or quota
+	Plan        string     `json:"plan,omitempty"`
+	Limit       int64      `json:"limit,omitempty"`
+	ResetAt     *time.Time `json:"reset_at,omitempty"`
+	Upgrade     Upgrade    `json:"upgrade"`
+}
+
+func (d *Denial) Error() string {
+	return d.Message
+}
+
+// Upgrade is where a denied user goes next
+type Upgrade struct {
+	Plans     []*Plan `json:"plans"`     // plans that allow the request
+	Subscribe string  `json:"subscribe"` // route that subscribes to one of them
+}
@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// Tweet is a post of the market feed with its financial enrichment
+type Tweet struct {
+	ID        string    `json:"id"`
+	AuthorID  string    `json:"author_id"`
+	Username  string    `json:"username"`
+	Text      string    `json:"text"`
+	Lang      string    `json:"lang"`
+	CreatedAt time.Time `json:"created_at"`
+	FetchedAt time.Time `json:"fetched_at"`
+
+	Likes    int `json:"likes"`
+	Replies  int `json:"replies"`
+	Retweets int `json:"retweets"`
+	Views    int `json:"views"`
+
+	URLs   []string `json:"urls,omitempty"`
+	Photos []string `json:"photos,omitempty"`
+	Videos []string `json:"videos,omitempty"`
+
+	IsFinancial    bool     `json:"is_financial"`
+	Symbols        []string `json:"symbols"`
+	SentimentScore float64  `json:"sentiment_score"` // range -1 .. 1
+	SentimentLabel string   `json:"sentiment_label"` // positive, neutral or negative, empty until scored
+}
+
+// SentimentDay is the sentiment of a symbol over a day
+type SentimentDay struct {
+	Day              string   `json:"day"` // YYYY-MM-DD
+	AvgScore         float64  `json:"avg_score"`
+	WeightedAvgScore *float64 `json:"weighted_avg_score"` // null until author scores are computed
+	Positive         int      `json:"positive"`
+	Negative         int      `json:"negative"`
+	Neutral          int      `json:"neutral"`
+}
+
+// SymbolTrend is how much a symbol is talked about in a time window
+type SymbolTrend struct {
+	Symbol       string  `json:"symbol"`
+	Mentions     int     `json:"mentions"`
+	AvgSentiment float64 `json:"avg_sentiment"` // range -1 .. 1
+}
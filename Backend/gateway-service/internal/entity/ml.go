@@ -1,13 +1,29 @@
 package entity
 
-type Analysis struct {
-	Score    float64  `json:"score"`
-	Insights []string `json:"insights"`
-	Risk     string   `json:"risk"`
+// Sentiment of a text as scored by ml-service, the probabilities sum to one
+type Sentiment struct {
+	Positive float32 `json:"positive"`
+	Neutral  float32 `json:"neutral"`
+	Negative float32 `json:"negative"`
+	Label    string  `json:"label"` // the most probable of positive, neutral and negative
 }
 
-type Recommendation struct {
-	Type        string `json:"type"`
-	Description string `json:"description"`
-	Priority    int    `json:"priority"`
+// PricePoint is a price of a symbol at a date (YYYY-MM-DD)
+type PricePoint struct {
+	Date  string  `json:"date"`
+	Price float64 `json:"price"`
+}
+
+// TrendPoint is a predicted price with its confidence interval
+type TrendPoint struct {
+	Date       string  `json:"date"`
+	Prediction float64 `json:"prediction"`
+	LowerBound float64 `json:"lower_bound"`
+	UpperBound float64 `json:"upper_bound"`
+}
+
+// Trend is a price forecast of a symbol
+type Trend struct {
+	Symbol string        `json:"symbol"`
+	Points []*TrendPoint `json:"points"`
 }
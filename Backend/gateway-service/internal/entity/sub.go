@@ -1,17 +1,46 @@
 package entity
 
+import "time"
+
 type Plan struct {
-	ID          string   `json:"id"`
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	Price       float64  `json:"price"`
-	Features    []string `json:"features"`
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Type         string   `json:"type"` // basic, pro or enterprise
+	Price        float64  `json:"price"`
+	Currency     string   `json:"currency"`
+	DurationDays int      `json:"duration_days"`
+	Features     []string `json:"features"`
+}
+
+// Subscription is a plan subscribed to by the signed-in user
+type Subscription struct {
+	ID              string    `json:"id"`
+	PlanID          string    `json:"plan_id"`
+	Status          string    `json:"status"` // active, cancelled, expired or pending
+	StartDate       time.Time `json:"start_date"`
+	EndDate         time.Time `json:"end_date"`
+	AutoRenew       bool      `json:"auto_renew"`
+	AmountPaid      float64   `json:"amount_paid"`
+	Currency        string    `json:"currency"`
+	PaymentMethod   string    `json:"payment_method"`
+	LastPaymentDate time.Time `json:"last_payment_date"`
+	NextPaymentDate time.Time `json:"next_payment_date"`
+}
+
+// Payment is the outcome of paying for a subscription
+type Payment struct {
+	ID            string    `json:"id"`
+	Status        string    `json:"status"` // pending, completed, failed or refunded
+	TransactionID string    `json:"transaction_id"`
+	Timestamp     time.Time `json:"timestamp"`
 }
 
 type Status struct {
-	Active    bool   `json:"active"`
-	PlanID    string `json:"plan_id"`
-	StartDate string `json:"start_date"`
-	EndDate   string `json:"end_date"`
-	AutoRenew bool   `json:"auto_renew"`
+	Active         bool   `json:"active"`
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	PlanID         string `json:"plan_id"`
+	StartDate      string `json:"start_date"`
+	EndDate        string `json:"end_date"`
+	AutoRenew      bool   `json:"auto_renew"`
 }
@@ -2,12 +2,26 @@ package handler
 
 import (
 	"context"
+	"mime"
 	"net/http"
+	"strings"
 	"time"
 
 	authpb "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// _oauthStateCookie binds an identity provider sign-in to the browser that started it
+	_oauthStateCookie = "oauth_state"
+
+	// _maxExportSize is the largest data export accepted from auth-service
+	_maxExportSize = 64 << 20
+	_exportTimeout = time.Minute
 )
 
 type AuthHandler struct {
@@ -42,7 +56,10 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"token": resp.Token,
+		"token":              resp.Token,
+		"expires_at":         resp.ExpiresAt,
+		"refresh_token":      resp.RefreshToken,
+		"refresh_expires_at": resp.RefreshExpiresAt,
 		"user": gin.H{
 			"id":       resp.UserId,
 			"email":    req.Email,
@@ -62,7 +79,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(clientContext(c), 3*time.Second)
 	defer cancel()
 
 	resp, err := h.svc.Client.SignIn(ctx, &authpb.SignInRequest{
@@ -70,18 +87,187 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		Password: req.Password,
 	})
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	respondSignIn(c, resp)
+}
+
+// GET /api/auth/oauth/:provider
+func (h *AuthHandler) StartOAuth(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.svc.Client.StartOAuth(ctx, &authpb.StartOAuthRequest{
+		Provider: c.Param("provider"),
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	// the callback must come back to the browser that started the sign-in
+	maxAge := int(time.Until(time.Unix(resp.ExpiresAt, 0)).Seconds())
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(_oauthStateCookie, resp.State, maxAge, "/api/auth/oauth", "", c.Request.TLS != nil, true)
+	c.Redirect(http.StatusFound, resp.AuthorizationUrl)
+}
+
+// GET /api/auth/oauth/:provider/callback
+func (h *AuthHandler) OAuthCallback(c *gin.Context) {
+	state, _ := c.Cookie(_oauthStateCookie)
+	c.SetCookie(_oauthStateCookie, "", -1, "/api/auth/oauth", "", c.Request.TLS != nil, true)
+
+	if reason := c.Query("error"); reason != "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "sign-in cancelled: " + reason})
+		return
+	}
+	if state == "" || c.Query("state") != state {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "state mismatch"})
+		return
+	}
+	if c.Query("code") == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "code is required"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(clientContext(c), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.svc.Client.CompleteOAuth(ctx, &authpb.CompleteOAuthRequest{
+		Provider: c.Param("provider"),
+		Code:     c.Query("code"),
+		State:    state,
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	respondSignIn(c, resp)
+}
+
+// POST /api/auth/mfa/verify
+func (h *AuthHandler) VerifyMFA(c *gin.Context) {
+	var req struct {
+		MFAToken string `json:"mfa_token" binding:"required"`
+		Code     string `json:"code"      binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(clientContext(c), 3*time.Second)
+	defer cancel()
+
+	resp, err := h.svc.Client.VerifyMFA(ctx, &authpb.VerifyMFARequest{
+		MfaToken: req.MFAToken,
+		Code:     req.Code,
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"token": resp.Token,
+		"token":              resp.Token,
+		"expires_at":         resp.ExpiresAt,
+		"refresh_token":      resp.RefreshToken,
+		"refresh_expires_at": resp.RefreshExpiresAt,
 		"user": gin.H{
 			"id": resp.UserId,
 		},
 	})
 }
 
+// POST /api/auth/mfa/enroll
+func (h *AuthHandler) EnrollMFA(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 3*time.Second)
+	defer cancel()
+
+	resp, err := h.svc.Client.EnrollMFA(ctx, &authpb.EnrollMFARequest{Token: token})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"secret": resp.Secret,
+		"uri":    resp.Uri,
+	})
+}
+
+// POST /api/auth/mfa/confirm
+func (h *AuthHandler) ConfirmMFA(c *gin.Context) {
+	h.mfaCodeAction(c, func(ctx context.Context, token, code string) (gin.H, error) {
+		resp, err := h.svc.Client.ConfirmMFA(ctx, &authpb.ConfirmMFARequest{Token: token, Code: code})
+		if err != nil {
+			return nil, err
+		}
+		return gin.H{"recovery_codes": resp.RecoveryCodes}, nil
+	})
+}
+
+// POST /api/auth/mfa/disable
+func (h *AuthHandler) DisableMFA(c *gin.Context) {
+	h.mfaCodeAction(c, func(ctx context.Context, token, code string) (gin.H, error) {
+		_, err := h.svc.Client.DisableMFA(ctx, &authpb.DisableMFARequest{Token: token, Code: code})
+		return nil, err
+	})
+}
+
+// POST /api/auth/mfa/recovery-codes
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	h.mfaCodeAction(c, func(ctx context.Context, token, code string) (gin.H, error) {
+		resp, err := h.svc.Client.RegenerateRecoveryCodes(ctx, &authpb.RegenerateRecoveryCodesRequest{Token: token, Code: code})
+		if err != nil {
+			return nil, err
+		}
+		return gin.H{"recovery_codes": resp.RecoveryCodes}, nil
+	})
+}
+
+// mfaCodeAction runs an MFA call of the signed-in user that needs a code,
+// a nil body answers with 204
+func (h *AuthHandler) mfaCodeAction(c *gin.Context, call func(ctx context.Context, token, code string) (gin.H, error)) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	var req struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 3*time.Second)
+	defer cancel()
+
+	body, err := call(ctx, token, req.Code)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	if body == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, body)
+}
+
 // POST /api/auth/refresh
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	var req struct {
@@ -95,15 +281,422 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 3*time.Second)
 	defer cancel()
 
-	valid, newToken, err := h.svc.Refresh(ctx, req.RefreshToken)
+	resp, ok, err := h.svc.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"token":              resp.Token,
+		"expires_at":         resp.ExpiresAt,
+		"refresh_token":      resp.RefreshToken,
+		"refresh_expires_at": resp.RefreshExpiresAt,
+		"user": gin.H{
+			"id": resp.UserId,
+		},
+	})
+}
+
+// POST /api/auth/signout
+func (h *AuthHandler) Logout(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	ok, err := h.svc.SignOut(c.Request.Context(), token)
 	if err != nil {
 		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
 		return
 	}
-	if !valid {
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"token": newToken})
+	c.Status(http.StatusNoContent)
+}
+
+// POST /api/auth/verify-email
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req struct {
+		Token string `json:"token" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 3*time.Second)
+	defer cancel()
+
+	if _, err := h.svc.Client.VerifyEmail(ctx, &authpb.VerifyEmailRequest{Token: req.Token}); err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// POST /api/auth/verify-email/resend
+func (h *AuthHandler) ResendVerificationEmail(c *gin.Context) {
+	var req struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	_, err := h.svc.Client.ResendVerificationEmail(ctx, &authpb.ResendVerificationEmailRequest{Email: req.Email})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	// accepted for unknown emails too, so that registered ones cannot be probed
+	c.Status(http.StatusAccepted)
+}
+
+// POST /api/auth/password/forgot
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	_, err := h.svc.Client.RequestPasswordReset(ctx, &authpb.RequestPasswordResetRequest{Email: req.Email})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	// accepted for unknown emails too, so that registered ones cannot be probed
+	c.Status(http.StatusAccepted)
+}
+
+// POST /api/auth/password/reset
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req struct {
+		Token       string `json:"token"        binding:"required"`
+		NewPassword string `json:"new_password" binding:"required,min=8"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 3*time.Second)
+	defer cancel()
+
+	_, err := h.svc.Client.ResetPassword(ctx, &authpb.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// POST /api/auth/password/change
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	var req struct {
+		CurrentPassword string `json:"current_password" binding:"required"`
+		NewPassword     string `json:"new_password"     binding:"required,min=8"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 3*time.Second)
+	defer cancel()
+
+	_, err := h.svc.Client.ChangePassword(ctx, &authpb.ChangePasswordRequest{
+		Token:           token,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GET /api/auth/api-keys
+func (h *AuthHandler) ListAPIKeys(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 3*time.Second)
+	defer cancel()
+
+	resp, err := h.svc.Client.ListAPIKeys(ctx, &authpb.ListAPIKeysRequest{Token: token})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	keys := make([]gin.H, len(resp.ApiKeys))
+	for i, key := range resp.ApiKeys {
+		keys[i] = apiKeyJSON(key)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"api_keys": keys})
+}
+
+// POST /api/auth/api-keys
+func (h *AuthHandler) CreateAPIKey(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	var req struct {
+		Name       string   `json:"name"        binding:"required"`
+		Scopes     []string `json:"scopes"      binding:"required,min=1"`
+		TTLSeconds int64    `json:"ttl_seconds" binding:"min=0"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(clientContext(c), 3*time.Second)
+	defer cancel()
+
+	resp, err := h.svc.Client.CreateAPIKey(ctx, &authpb.CreateAPIKeyRequest{
+		Token:      token,
+		Name:       req.Name,
+		Scopes:     req.Scopes,
+		TtlSeconds: req.TTLSeconds,
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	body := apiKeyJSON(resp.ApiKey)
+	body["key"] = resp.Key
+	c.JSON(http.StatusCreated, body)
+}
+
+// DELETE /api/auth/api-keys/:id
+func (h *AuthHandler) RevokeAPIKey(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(clientContext(c), 3*time.Second)
+	defer cancel()
+
+	_, err := h.svc.Client.RevokeAPIKey(ctx, &authpb.RevokeAPIKeyRequest{
+		Token: token,
+		KeyId: c.Param("id"),
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GET /api/auth/account/export
+func (h *AuthHandler) ExportAccountData(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	// the export gathers the data of every service, it may outlast the server write timeout
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Now().Add(_exportTimeout + 5*time.Second))
+	ctx, cancel := context.WithTimeout(clientContext(c), _exportTimeout)
+	defer cancel()
+
+	resp, err := h.svc.Client.ExportMyData(ctx, &authpb.ExportMyDataRequest{Token: token},
+		grpc.MaxCallRecvMsgSize(_maxExportSize))
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": resp.GetFileName(),
+	}))
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/zip", resp.GetArchive())
+}
+
+// DELETE /api/auth/account
+func (h *AuthHandler) DeleteAccount(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing Authorization header"})
+		return
+	}
+
+	var req struct {
+		Password string `json:"password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(clientContext(c), 3*time.Second)
+	defer cancel()
+
+	resp, err := h.svc.Client.DeleteMyAccount(ctx, &authpb.DeleteMyAccountRequest{
+		Token:    token,
+		Password: req.Password,
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, accountDeletionJSON(resp.GetDeletion()))
+}
+
+// GET /api/auth/account/deletions/:id
+func (h *AuthHandler) GetAccountDeletion(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(clientContext(c), 3*time.Second)
+	defer cancel()
+
+	resp, err := h.svc.Client.GetAccountDeletion(ctx, &authpb.GetAccountDeletionRequest{
+		DeletionId: c.Param("id"),
+	})
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, accountDeletionJSON(resp.GetDeletion()))
+}
+
+// accountDeletionJSON is the response body of an account deletion
+func accountDeletionJSON(d *authpb.AccountDeletion) gin.H {
+	return gin.H{
+		"id":               d.GetId(),
+		"status":           d.GetStatus(),
+		"pending_services": d.GetPendingServices(),
+		"attempts":         d.GetAttempts(),
+		"requested_at":     d.GetRequestedAt(),
+		"completed_at":     d.GetCompletedAt(),
+	}
+}
+
+// apiKeyJSON is the response body of an API key, the key itself is never listed
+func apiKeyJSON(key *authpb.APIKey) gin.H {
+	return gin.H{
+		"id":           key.GetId(),
+		"name":         key.GetName(),
+		"prefix":       key.GetPrefix(),
+		"scopes":       key.GetScopes(),
+		"created_at":   key.GetCreatedAt(),
+		"expires_at":   key.GetExpiresAt(),
+		"last_used_at": key.GetLastUsedAt(),
+	}
+}
+
+// abortWithGRPCError answers with the HTTP status matching the gRPC status of a backend service
+func abortWithGRPCError(c *gin.Context, err error) {
+	code := http.StatusBadGateway
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		code = http.StatusConflict
+	case codes.ResourceExhausted:
+		code = http.StatusTooManyRequests
+	case codes.Unavailable:
+		code = http.StatusServiceUnavailable
+	}
+
+	c.JSON(code, gin.H{"error": status.Convert(err).Message()})
+}
+
+// signInResponse is the response of the RPCs that sign a user in
+type signInResponse interface {
+	GetToken() string
+	GetUserId() string
+	GetRefreshToken() string
+	GetExpiresAt() int64
+	GetRefreshExpiresAt() int64
+	GetMfaRequired() bool
+	GetMfaToken() string
+	GetMfaExpiresAt() int64
+	GetMfaEnrollmentRequired() bool
+}
+
+// respondSignIn answers with the tokens, or with the MFA challenge when the
+// user has MFA enabled and the tokens come from /api/auth/mfa/verify
+func respondSignIn(c *gin.Context, resp signInResponse) {
+	if resp.GetMfaRequired() {
+		c.JSON(http.StatusOK, gin.H{
+			"mfa_required":   true,
+			"mfa_token":      resp.GetMfaToken(),
+			"mfa_expires_at": resp.GetMfaExpiresAt(),
+			"user": gin.H{
+				"id": resp.GetUserId(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"token":                   resp.GetToken(),
+		"expires_at":              resp.GetExpiresAt(),
+		"refresh_token":           resp.GetRefreshToken(),
+		"refresh_expires_at":      resp.GetRefreshExpiresAt(),
+		"mfa_enrollment_required": resp.GetMfaEnrollmentRequired(),
+		"user": gin.H{
+			"id": resp.GetUserId(),
+		},
+	})
+}
+
+// clientContext is the request context carrying the client for auth-service
+func clientContext(c *gin.Context) context.Context {
+	return service.WithClient(c.Request.Context(), c.ClientIP(), c.Request.UserAgent())
 }
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// _chatStreamTimeout bounds a single answer, it overrides the server
+	// write timeout for the SSE response
+	_chatStreamTimeout = 2 * time.Minute
+)
+
+type ChatHandler struct{ svc *service.ChatService }
+
+func NewChatHandler(s *service.ChatService) *ChatHandler {
+	return &ChatHandler{svc: s}
+}
+
+// GET /api/chat?limit=&cursor=
+func (h *ChatHandler) ListChats(c *gin.Context) {
+	limit, ok := pageLimit(c)
+	if !ok {
+		return
+	}
+
+	chats, next, err := h.svc.ListChats(c.Request.Context(), requestClaims(c).UserID, limit, c.Query("cursor"))
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"chats":       chats,
+		"next_cursor": next,
+	})
+}
+
+// POST /api/chat
+func (h *ChatHandler) CreateChat(c *gin.Context) {
+	claims, ok := ownClaims(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Title string `json:"title"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	chat, err := h.svc.CreateChat(c.Request.Context(), claims.UserID, body.Title)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, chat)
+}
+
+// DELETE /api/chat/:id
+func (h *ChatHandler) DeleteChat(c *gin.Context) {
+	claims, ok := ownClaims(c)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.DeleteChat(c.Request.Context(), claims.UserID, c.Param("id")); err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// GET /api/chat/:id/messages?limit=&cursor=
+func (h *ChatHandler) GetMessages(c *gin.Context) {
+	limit, ok := pageLimit(c)
+	if !ok {
+		return
+	}
+
+	messages, next, err := h.svc.ListMessages(c.Request.Context(), requestClaims(c).UserID,
+		c.Param("id"), limit, c.Query("cursor"))
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"messages":    messages,
+		"next_cursor": next,
+	})
+}
+
+// POST /api/chat/:id/messages
+//
+// The answer is relayed as Server-Sent Events: "chunk" events carry parts of
+// the answer, "done" closes a successful answer with the token usage and
+// "error" reports a failure after the stream has started. Errors before the
+// first chunk are plain JSON with the usual status codes. Closing the
+// connection cancels generation in brain-service.
+func (h *ChatHandler) SendMessage(c *gin.Context) {
+	claims, ok := ownClaims(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Content string `json:"content" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// request context is cancelled when the client disconnects, which
+	// cancels the gRPC stream with it
+	ctx, cancel := context.WithTimeout(c.Request.Context(), _chatStreamTimeout)
+	defer cancel()
+
+	stream, err := h.svc.StreamMessage(ctx, claims.UserID, c.Param("id"), body.Content)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "empty answer"})
+			return
+		}
+		abortWithGRPCError(c, err)
+		return
+	}
+
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Now().Add(_chatStreamTimeout))
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	for {
+		if chunk := resp.GetContentChunk(); chunk != "" {
+			c.SSEvent("chunk", gin.H{"content": chunk})
+		}
+		if resp.GetIsFinal() {
+			c.SSEvent("done", gin.H{
+				"chat_id":     resp.GetChatId(),
+				"tokens_used": resp.GetTokensUsed(),
+			})
+		}
+		c.Writer.Flush()
+
+		resp, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				c.SSEvent("error", gin.H{"error": status.Convert(err).Message()})
+				c.Writer.Flush()
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package handler_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/handler"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	brainpb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/brain"
+)
+
+// fakeBrain is an in-process brain-service, StreamMessage sends the answer
+// and then fails with err when set. With hang it waits for the caller to go
+// away after the answer and closes cancelled
+type fakeBrain struct {
+	brainpb.UnimplementedBrainServiceServer
+
+	answer    []*brainpb.StreamMessageResponse
+	err       error
+	hang      bool
+	cancelled chan struct{}
+	req       *brainpb.StreamMessageRequest
+}
+
+func (f *fakeBrain) StreamMessage(req *brainpb.StreamMessageRequest, stream grpc.ServerStreamingServer[brainpb.StreamMessageResponse]) error {
+	f.req = req
+	for _, resp := range f.answer {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	if f.hang {
+		<-stream.Context().Done()
+		close(f.cancelled)
+		return stream.Context().Err()
+	}
+
+	return f.err
+}
+
+func chatRouter(t *testing.T, fake *fakeBrain, claims *entity.Claims) *gin.Engine {
+	t.Helper()
+
+	conn := dialBufconn(t, func(s *grpc.Server) { brainpb.RegisterBrainServiceServer(s, fake) })
+	h := handler.NewChatHandler(service.NewChatService(conn))
+
+	r := gin.New()
+	r.POST("/api/chat/:id/messages", func(c *gin.Context) {
+		c.Set("claims", claims)
+	}, h.SendMessage)
+
+	return r
+}
+
+func TestChatSendMessage(t *testing.T) {
+	t.Parallel()
+
+	user := &entity.Claims{UserID: "u1"}
+
+	t.Run("server-sent events", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeBrain{answer: []*brainpb.StreamMessageResponse{
+			{ContentChunk: "Buy "},
+			{ContentChunk: "low", IsFinal: true, TokensUsed: 42, ChatId: "c1"},
+		}}
+		rec := serve(chatRouter(t, fake, user), http.MethodPost, "/api/chat/c1/messages", `{"content":"TSLA?"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream;charset=utf-8", rec.Header().Get("Content-Type"))
+		require.Equal(t, "no", rec.Header().Get("X-Accel-Buffering"))
+		require.Equal(t, "event:chunk\ndata:{\"content\":\"Buy \"}\n\n"+
+			"event:chunk\ndata:{\"content\":\"low\"}\n\n"+
+			"event:done\ndata:{\"chat_id\":\"c1\",\"tokens_used\":42}\n\n", rec.Body.String())
+
+		require.Equal(t, "u1", fake.req.GetUserId())
+		require.Equal(t, "c1", fake.req.GetChatId())
+		require.Equal(t, "TSLA?", fake.req.GetContent())
+	})
+
+	t.Run("failure after the first chunk", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeBrain{
+			answer: []*brainpb.StreamMessageResponse{{ContentChunk: "Buy "}},
+			err:    status.Error(codes.Internal, "model crashed"),
+		}
+		rec := serve(chatRouter(t, fake, user), http.MethodPost, "/api/chat/c1/messages", `{"content":"TSLA?"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "event:chunk\ndata:{\"content\":\"Buy \"}\n\n"+
+			"event:error\ndata:{\"error\":\"model crashed\"}\n\n", rec.Body.String())
+	})
+
+	t.Run("failure before the first chunk", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeBrain{err: status.Error(codes.NotFound, "chat not found")}
+		rec := serve(chatRouter(t, fake, user), http.MethodPost, "/api/chat/c1/messages", `{"content":"TSLA?"}`)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.JSONEq(t, `{"error":"chat not found"}`, rec.Body.String())
+	})
+
+	t.Run("empty stream", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(chatRouter(t, &fakeBrain{}, user), http.MethodPost, "/api/chat/c1/messages", `{"content":"TSLA?"}`)
+		require.Equal(t, http.StatusBadGateway, rec.Code)
+		require.JSONEq(t, `{"error":"empty answer"}`, rec.Body.String())
+	})
+
+	t.Run("impersonating", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeBrain{}
+		staff := &entity.Claims{UserID: "u1", ActorID: "support"}
+		rec := serve(chatRouter(t, fake, staff), http.MethodPost, "/api/chat/c1/messages", `{"content":"TSLA?"}`)
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.Nil(t, fake.req)
+	})
+
+	t.Run("client disconnects", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeBrain{
+			answer:    []*brainpb.StreamMessageResponse{{ContentChunk: "Buy "}},
+			hang:      true,
+			cancelled: make(chan struct{}),
+		}
+		srv := httptest.NewServer(chatRouter(t, fake, user))
+		t.Cleanup(srv.Close)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/api/chat/c1/messages",
+			strings.NewReader(`{"content":"TSLA?"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// the first chunk is flushed while brain-service is still answering
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "event:chunk\n", line)
+
+		cancel()
+		select {
+		case <-fake.cancelled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("brain-service stream was not cancelled")
+		}
+	})
+}
@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	_defaultPageLimit = 20
+	_maxPageLimit     = 100
+
+	_defaultSentimentDays = 30
+	_maxSentimentDays     = 366
+
+	_defaultTrendingWindow = 24 * time.Hour
+	_maxTrendingWindow     = 7 * 24 * time.Hour
+	_defaultTrendingLimit  = 10
+	_maxTrendingLimit      = 50
+)
+
+type FeedHandler struct{ svc *service.FeedService }
+
+func NewFeedHandler(s *service.FeedService) *FeedHandler {
+	return &FeedHandler{svc: s}
+}
+
+// GET /api/feed/tweets?sentiment=&limit=&cursor=
+func (h *FeedHandler) ListTweets(c *gin.Context) {
+	h.listTweets(c, "")
+}
+
+// GET /api/feed/symbols/:symbol/tweets?sentiment=&limit=&cursor=
+func (h *FeedHandler) ListSymbolTweets(c *gin.Context) {
+	symbol, ok := symbolParam(c)
+	if !ok {
+		return
+	}
+	h.listTweets(c, symbol)
+}
+
+func (h *FeedHandler) listTweets(c *gin.Context, symbol string) {
+	limit, ok := pageLimit(c)
+	if !ok {
+		return
+	}
+
+	sentiment := c.Query("sentiment")
+	switch sentiment {
+	case "", "positive", "neutral", "negative":
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sentiment must be positive, neutral or negative"})
+		return
+	}
+
+	tweets, next, err := h.svc.List(c.Request.Context(), symbol, sentiment, limit, c.Query("cursor"))
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	jsonWithETag(c, gin.H{
+		"tweets":      tweets,
+		"next_cursor": next,
+	})
+}
+
+// GET /api/feed/tweets/:id
+func (h *FeedHandler) GetTweet(c *gin.Context) {
+	tweet, err := h.svc.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	jsonWithETag(c, tweet)
+}
+
+// GET /api/feed/symbols/:symbol/sentiment?from=&to=
+func (h *FeedHandler) GetSentimentSeries(c *gin.Context) {
+	symbol, ok := symbolParam(c)
+	if !ok {
+		return
+	}
+
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if v := c.Query("to"); v != "" {
+		day, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to must be a date (YYYY-MM-DD)"})
+			return
+		}
+		to = day
+	}
+	from := to.AddDate(0, 0, 1-_defaultSentimentDays)
+	if v := c.Query("from"); v != "" {
+		day, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be a date (YYYY-MM-DD)"})
+			return
+		}
+		from = day
+	}
+	if to.Before(from) || to.Sub(from) >= _maxSentimentDays*24*time.Hour {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from must be within 366 days before to"})
+		return
+	}
+
+	days, err := h.svc.SentimentSeries(c.Request.Context(), symbol, from, to)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	jsonWithETag(c, gin.H{
+		"symbol": symbol,
+		"from":   from.Format(time.DateOnly),
+		"to":     to.Format(time.DateOnly),
+		"days":   days,
+	})
+}
+
+// GET /api/feed/trending?window=&limit=
+func (h *FeedHandler) GetTrending(c *gin.Context) {
+	window := _defaultTrendingWindow
+	if v := c.Query("window"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < time.Hour || d > _maxTrendingWindow {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "window must be a duration from 1h to 168h"})
+			return
+		}
+		window = d
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(_defaultTrendingLimit)))
+	if err != nil || limit <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+		return
+	}
+
+	trends, err := h.svc.Trending(c.Request.Context(), window, min(limit, _maxTrendingLimit))
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	jsonWithETag(c, gin.H{
+		"window":  window.String(),
+		"symbols": trends,
+	})
+}
+
+// POST /api/feed/tweets
+func (h *FeedHandler) CreateTweet(c *gin.Context) {
+	var body struct {
+		Text     string `json:"text"      binding:"required,max=4096"`
+		AuthorID string `json:"author_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	tweet, err := h.svc.CreateTweet(c.Request.Context(), token, body.Text, body.AuthorID)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, tweet)
+}
+
+// PATCH /api/feed/tweets/:id
+func (h *FeedHandler) UpdateTweet(c *gin.Context) {
+	var body struct {
+		Text      string `json:"text" binding:"max=4096"`
+		Sentiment *struct {
+			Label string  `json:"label" binding:"required,oneof=positive neutral negative"`
+			Score float64 `json:"score" binding:"min=-1,max=1"`
+		} `json:"sentiment"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if body.Text == "" && body.Sentiment == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "text or sentiment is required"})
+		return
+	}
+
+	var (
+		label string
+		score float64
+	)
+	if body.Sentiment != nil {
+		label, score = body.Sentiment.Label, body.Sentiment.Score
+	}
+
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	tweet, err := h.svc.UpdateTweet(c.Request.Context(), token, c.Param("id"), body.Text, label, score)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, tweet)
+}
+
+// DELETE /api/feed/tweets/:id
+func (h *FeedHandler) DeleteTweet(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if err := h.svc.DeleteTweet(c.Request.Context(), token, c.Param("id")); err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// POST /api/feed/tweets/:id/release
+func (h *FeedHandler) ReleaseTweet(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if err := h.svc.ReleaseTweet(c.Request.Context(), token, c.Param("id")); err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// pageLimit reads the limit of cursor paginated lists, capped at _maxPageLimit
+func pageLimit(c *gin.Context) (int, bool) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(_defaultPageLimit)))
+	if err != nil || limit <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+		return 0, false
+	}
+	return min(limit, _maxPageLimit), true
+}
+
+func symbolParam(c *gin.Context) (string, bool) {
+	symbol := strings.ToUpper(strings.TrimPrefix(c.Param("symbol"), "$"))
+	if !_symbolPattern.MatchString(symbol) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid symbol"})
+		return "", false
+	}
+	return symbol, true
+}
+
+// jsonWithETag answers obj with a validator of its content, a request whose
+// If-None-Match already has it gets 304 without the body
+func jsonWithETag(c *gin.Context, obj any) {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "encode response"})
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `W/"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "private, no-cache")
+	if middleware.ETagMatch(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
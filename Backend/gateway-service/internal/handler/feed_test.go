@@ -0,0 +1,245 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/handler"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	adminpb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/admin/v1"
+	tweetspb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/tweets/v1"
+)
+
+// fakeX is an in-process x-service, it records the requests it gets
+type fakeX struct {
+	tweetspb.UnimplementedTweetServiceServer
+
+	mu        sync.Mutex
+	list      *tweetspb.ListTweetsRequest
+	series    *tweetspb.GetSentimentSeriesRequest
+	authz     []string
+	adminCall bool
+}
+
+func (f *fakeX) ListTweets(_ context.Context, req *tweetspb.ListTweetsRequest) (*tweetspb.ListTweetsResponse, error) {
+	f.mu.Lock()
+	f.list = req
+	f.mu.Unlock()
+
+	return &tweetspb.ListTweetsResponse{
+		Tweets: []*tweetspb.Tweet{{
+			Id:             "6f1c1c1e-0000-4000-8000-000000000001",
+			Text:           "$TSLA beats deliveries",
+			CreatedAt:      1735689600,
+			Symbols:        []string{"TSLA"},
+			SentimentScore: 0.8,
+			SentimentLabel: "POS",
+		}},
+		NextCursor: "next",
+	}, nil
+}
+
+func (f *fakeX) GetSentimentSeries(_ context.Context, req *tweetspb.GetSentimentSeriesRequest) (*tweetspb.GetSentimentSeriesResponse, error) {
+	f.mu.Lock()
+	f.series = req
+	f.mu.Unlock()
+
+	return &tweetspb.GetSentimentSeriesResponse{
+		Days: []*tweetspb.SentimentDay{{Day: "2025-01-01", AvgScore: 0.5, Positive: 3, Neutral: 1}},
+	}, nil
+}
+
+// fakeXAdmin is the admin API of fakeX, both define ListTweets
+type fakeXAdmin struct {
+	adminpb.UnimplementedAdminTweetServiceServer
+
+	x *fakeX
+}
+
+func (f fakeXAdmin) DeleteTweet(ctx context.Context, _ *adminpb.DeleteTweetRequest) (*adminpb.DeleteTweetResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	f.x.mu.Lock()
+	f.x.adminCall = true
+	f.x.authz = md.Get("authorization")
+	f.x.mu.Unlock()
+
+	return &adminpb.DeleteTweetResponse{}, nil
+}
+
+func (f fakeXAdmin) UpdateTweet(context.Context, *adminpb.UpdateTweetRequest) (*adminpb.UpdateTweetResponse, error) {
+	f.x.mu.Lock()
+	f.x.adminCall = true
+	f.x.mu.Unlock()
+
+	return &adminpb.UpdateTweetResponse{}, nil
+}
+
+// Claims of the feed requests
+var (
+	_reader = &entity.Claims{UserID: "u1", Role: "user", Permissions: []string{authz.PermTweetsRead}}
+	_editor = &entity.Claims{UserID: "u2", Role: "admin", Permissions: []string{authz.PermTweetsRead, authz.PermTweetsAdmin}}
+)
+
+// feedRouter mounts the feed routes the way the protected group does, signed
+// in with the claims
+func feedRouter(t *testing.T, fake *fakeX, claims *entity.Claims) *gin.Engine {
+	t.Helper()
+
+	conn := dialBufconn(t, func(s *grpc.Server) {
+		tweetspb.RegisterTweetServiceServer(s, fake)
+		adminpb.RegisterAdminTweetServiceServer(s, fakeXAdmin{x: fake})
+	})
+	h := handler.NewFeedHandler(service.NewFeedService(conn))
+
+	r := gin.New()
+	feed := r.Group("/api/feed", func(c *gin.Context) {
+		c.Set("claims", claims)
+	})
+	feed.GET("/tweets", h.ListTweets)
+	feed.GET("/symbols/:symbol/tweets", h.ListSymbolTweets)
+	feed.GET("/symbols/:symbol/sentiment", h.GetSentimentSeries)
+
+	mut := feed.Group("", middleware.RequirePermission(authz.PermTweetsAdmin))
+	mut.PATCH("/tweets/:id", h.UpdateTweet)
+	mut.DELETE("/tweets/:id", h.DeleteTweet)
+
+	return r
+}
+
+func TestFeedListTweets(t *testing.T) {
+	t.Parallel()
+
+	t.Run("page with mapped labels", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeX{}
+		rec := serve(feedRouter(t, fake, _reader), http.MethodGet,
+			"/api/feed/symbols/$tsla/tweets?sentiment=neutral&limit=500&cursor=abc", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var body struct {
+			Tweets     []entity.Tweet `json:"tweets"`
+			NextCursor string         `json:"next_cursor"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Tweets, 1)
+		require.Equal(t, "positive", body.Tweets[0].SentimentLabel)
+		require.Equal(t, "next", body.NextCursor)
+
+		require.Equal(t, "TSLA", fake.list.GetSymbol())
+		require.Equal(t, "NEU", fake.list.GetSentimentLabel())
+		require.EqualValues(t, 100, fake.list.GetLimit())
+		require.Equal(t, "abc", fake.list.GetCursor())
+	})
+
+	t.Run("not modified", func(t *testing.T) {
+		t.Parallel()
+
+		r := feedRouter(t, &fakeX{}, _reader)
+		first := serve(r, http.MethodGet, "/api/feed/tweets", "")
+		require.Equal(t, http.StatusOK, first.Code)
+		etag := first.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		again := serve(r, http.MethodGet, "/api/feed/tweets", "", "If-None-Match", etag)
+		require.Equal(t, http.StatusNotModified, again.Code)
+		require.Empty(t, again.Body.String())
+		require.Equal(t, etag, again.Header().Get("ETag"))
+
+		stale := serve(r, http.MethodGet, "/api/feed/tweets", "", "If-None-Match", `W/"stale"`)
+		require.Equal(t, http.StatusOK, stale.Code)
+	})
+
+	t.Run("invalid sentiment", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeX{}
+		rec := serve(feedRouter(t, fake, _reader), http.MethodGet, "/api/feed/tweets?sentiment=POS", "")
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Nil(t, fake.list)
+	})
+}
+
+func TestFeedSentimentSeries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("range", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeX{}
+		rec := serve(feedRouter(t, fake, _reader), http.MethodGet,
+			"/api/feed/symbols/AAPL/sentiment?from=2025-01-01&to=2025-01-07", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{
+			"symbol": "AAPL", "from": "2025-01-01", "to": "2025-01-07",
+			"days": [{"day": "2025-01-01", "avg_score": 0.5, "weighted_avg_score": null,
+				"positive": 3, "negative": 0, "neutral": 1}]
+		}`, rec.Body.String())
+		require.EqualValues(t, 1735689600, fake.series.GetFrom())
+		require.EqualValues(t, 1736208000, fake.series.GetTo())
+	})
+
+	for name, path := range map[string]string{
+		"bad date":   "/api/feed/symbols/AAPL/sentiment?from=01-01-2025",
+		"reversed":   "/api/feed/symbols/AAPL/sentiment?from=2025-02-01&to=2025-01-01",
+		"too long":   "/api/feed/symbols/AAPL/sentiment?from=2024-01-01&to=2025-01-07",
+		"bad symbol": "/api/feed/symbols/not-a-symbol/sentiment",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeX{}
+			rec := serve(feedRouter(t, fake, _reader), http.MethodGet, path, "")
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			require.Nil(t, fake.series)
+		})
+	}
+}
+
+func TestFeedAdmin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not an admin", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeX{}
+		rec := serve(feedRouter(t, fake, _reader), http.MethodPatch,
+			"/api/feed/tweets/t1", `{"text":"edited"}`)
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.False(t, fake.adminCall)
+	})
+
+	t.Run("withheld permissions", func(t *testing.T) {
+		t.Parallel()
+
+		// an admin who has not enrolled in MFA yet is granted nothing
+		withheld := &entity.Claims{UserID: "u3", Role: "admin", Permissions: []string{}}
+
+		fake := &fakeX{}
+		rec := serve(feedRouter(t, fake, withheld), http.MethodDelete, "/api/feed/tweets/t1", "")
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.False(t, fake.adminCall)
+	})
+
+	t.Run("token forwarded", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeX{}
+		rec := serve(feedRouter(t, fake, _editor), http.MethodDelete,
+			"/api/feed/tweets/t1", "", "Authorization", "Bearer admin-token")
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		require.Equal(t, []string{"Bearer admin-token"}, fake.authz)
+	})
+}
@@ -2,18 +2,25 @@ package handler
 
 import (
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/ws"
 )
 
 type Handlers struct {
 	Auth         *AuthHandler
 	Subscription *SubscriptionHandler
+	Chat         *ChatHandler
+	Feed         *FeedHandler
+	WS           *WSHandler
 	ML           *MLHandler
 }
 
-func NewHandlers(services *service.Services) *Handlers {
+func NewHandlers(services *service.Services, hub *ws.Hub, wsOrigins []string) *Handlers {
 	return &Handlers{
 		Auth:         NewAuthHandler(services.Auth),
-		Subscription: NewSubscriptionHandler(services.Subscription),
+		Subscription: NewSubscriptionHandler(services.Subscription, services.Entitlements),
+		Chat:         NewChatHandler(services.Chat),
+		Feed:         NewFeedHandler(services.Feed),
+		WS:           NewWSHandler(hub, wsOrigins),
 		ML:           NewMLHandler(services.ML),
 	}
 }
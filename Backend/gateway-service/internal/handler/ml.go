@@ -2,36 +2,101 @@ package handler
 
 import (
 	"net/http"
+	"regexp"
+	"strings"
 
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
 	"github.com/gin-gonic/gin"
 )
 
+const _defaultTrendPeriods = 30
+
+var _symbolPattern = regexp.MustCompile(`^[A-Z][A-Z0-9.]{0,9}$`)
+
 type MLHandler struct{ svc *service.MLService }
 
 func NewMLHandler(s *service.MLService) *MLHandler { return &MLHandler{svc: s} }
 
-func (h *MLHandler) Analyze(c *gin.Context) {
-	var req struct {
-		Data []byte `json:"data" binding:"required"`
+// POST /api/ml/sentiment
+func (h *MLHandler) AnalyzeSentiment(c *gin.Context) {
+	var body struct {
+		Text string `json:"text" binding:"required,max=5000"`
 	}
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := c.ShouldBindJSON(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	res, err := h.svc.Analyze(c, req.Data)
+
+	sentiment, err := h.svc.AnalyzeSentiment(c.Request.Context(), body.Text)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, sentiment)
+}
+
+// POST /api/ml/sentiment/batch
+func (h *MLHandler) BatchAnalyzeSentiment(c *gin.Context) {
+	var body struct {
+		Texts []string `json:"texts" binding:"required,min=1,max=100,dive,required,max=5000"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := h.svc.BatchAnalyzeSentiment(c.Request.Context(), body.Texts)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// POST /api/ml/trend
+func (h *MLHandler) PredictTrend(c *gin.Context) {
+	var body struct {
+		Symbol string `json:"symbol" binding:"required"`
+		Data   []struct {
+			Date  string  `json:"date"  binding:"required,datetime=2006-01-02"`
+			Price float64 `json:"price" binding:"gt=0"`
+		} `json:"data" binding:"required,min=2,max=5000,dive"`
+		Periods int `json:"periods" binding:"omitempty,min=1,max=365"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	symbol := strings.ToUpper(strings.TrimPrefix(body.Symbol, "$"))
+	if !_symbolPattern.MatchString(symbol) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid symbol"})
+		return
+	}
+	if body.Periods == 0 {
+		body.Periods = _defaultTrendPeriods
+	}
+
+	history := make([]*entity.PricePoint, len(body.Data))
+	for i, p := range body.Data {
+		history[i] = &entity.PricePoint{Date: p.Date, Price: p.Price}
+	}
+
+	trend, err := h.svc.PredictTrend(c.Request.Context(), symbol, history, body.Periods)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		abortWithGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, res)
+	c.JSON(http.StatusOK, trend)
 }
 
-func (h *MLHandler) GetRecommendations(c *gin.Context) {
-	recs, err := h.svc.Recommend(c)
+// GET /api/ml/symbols
+func (h *MLHandler) GetSymbols(c *gin.Context) {
+	symbols, err := h.svc.Symbols(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		abortWithGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, recs)
+	c.JSON(http.StatusOK, gin.H{"symbols": symbols})
 }
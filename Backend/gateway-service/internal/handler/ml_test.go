@@ -0,0 +1,195 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/handler"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	mlpb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/ml"
+)
+
+// fakeML is an in-process ml-service, it records the last request and
+// answers with err when set
+type fakeML struct {
+	mlpb.UnimplementedMLServiceServer
+
+	err      error
+	deadline time.Duration
+	trend    *mlpb.TrendRequest
+	batch    int // results of BatchAnalyzeSentiment, one per text when zero
+}
+
+func (f *fakeML) AnalyzeSentiment(ctx context.Context, req *mlpb.SentimentRequest) (*mlpb.SentimentResponse, error) {
+	if d, ok := ctx.Deadline(); ok {
+		f.deadline = time.Until(d)
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &mlpb.SentimentResponse{Positive: 0.1, Neutral: 0.2, Negative: 0.7}, nil
+}
+
+func (f *fakeML) BatchAnalyzeSentiment(_ context.Context, req *mlpb.BatchSentimentRequest) (*mlpb.BatchSentimentResponse, error) {
+	n := len(req.GetTexts())
+	if f.batch != 0 {
+		n = f.batch
+	}
+
+	resp := &mlpb.BatchSentimentResponse{}
+	for range n {
+		resp.Results = append(resp.Results, &mlpb.SentimentResponse{Positive: 0.8, Neutral: 0.1, Negative: 0.1})
+	}
+
+	return resp, nil
+}
+
+func (f *fakeML) PredictTrend(_ context.Context, req *mlpb.TrendRequest) (*mlpb.TrendResponse, error) {
+	f.trend = req
+
+	return &mlpb.TrendResponse{
+		Dates:       []string{"2025-01-03"},
+		Predictions: []float64{102},
+		LowerBound:  []float64{99},
+		UpperBound:  []float64{105},
+	}, nil
+}
+
+func (f *fakeML) GetSymbols(context.Context, *mlpb.GetSymbolsRequest) (*mlpb.GetSymbolsResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &mlpb.GetSymbolsResponse{Symbols: []string{"AAPL", "TSLA"}}, nil
+}
+
+func mlRouter(t *testing.T, fake *fakeML) *gin.Engine {
+	t.Helper()
+
+	conn := dialBufconn(t, func(s *grpc.Server) { mlpb.RegisterMLServiceServer(s, fake) })
+	h := handler.NewMLHandler(service.NewMLService(conn))
+
+	r := gin.New()
+	r.POST("/api/ml/sentiment", h.AnalyzeSentiment)
+	r.POST("/api/ml/sentiment/batch", h.BatchAnalyzeSentiment)
+	r.POST("/api/ml/trend", h.PredictTrend)
+	r.GET("/api/ml/symbols", h.GetSymbols)
+
+	return r
+}
+
+func TestMLSentiment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scored with a timeout", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeML{}
+		rec := serve(mlRouter(t, fake), http.MethodPost, "/api/ml/sentiment", `{"text":"TSLA misses deliveries"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"positive":0.1,"neutral":0.2,"negative":0.7,"label":"negative"}`, rec.Body.String())
+		require.Positive(t, fake.deadline)
+		require.LessOrEqual(t, fake.deadline, 5*time.Second)
+	})
+
+	t.Run("text required", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(mlRouter(t, &fakeML{}), http.MethodPost, "/api/ml/sentiment", `{"text":""}`)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("ml-service unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeML{err: status.Error(codes.Unavailable, "model loading")}
+		rec := serve(mlRouter(t, fake), http.MethodPost, "/api/ml/sentiment", `{"text":"hi"}`)
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(mlRouter(t, &fakeML{}), http.MethodPost, "/api/ml/sentiment/batch", `{"texts":["a","b"]}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var body struct {
+			Results []struct {
+				Label string `json:"label"`
+			} `json:"results"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Results, 2)
+		require.Equal(t, "positive", body.Results[0].Label)
+	})
+
+	t.Run("batch with empty text", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(mlRouter(t, &fakeML{}), http.MethodPost, "/api/ml/sentiment/batch", `{"texts":["a",""]}`)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("batch with missing results", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(mlRouter(t, &fakeML{batch: 1}), http.MethodPost, "/api/ml/sentiment/batch", `{"texts":["a","b"]}`)
+		require.Equal(t, http.StatusBadGateway, rec.Code)
+	})
+}
+
+func TestMLTrend(t *testing.T) {
+	t.Parallel()
+
+	const history = `[{"date":"2025-01-01","price":100},{"date":"2025-01-02","price":101}]`
+
+	t.Run("forecast", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &fakeML{}
+		rec := serve(mlRouter(t, fake), http.MethodPost, "/api/ml/trend", `{"symbol":"$tsla","data":`+history+`}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"symbol":"TSLA","points":[
+			{"date":"2025-01-03","prediction":102,"lower_bound":99,"upper_bound":105}
+		]}`, rec.Body.String())
+
+		require.Equal(t, "TSLA", fake.trend.GetSymbol())
+		require.Equal(t, int32(30), fake.trend.GetPeriods())
+		require.Len(t, fake.trend.GetData(), 2)
+	})
+
+	for name, body := range map[string]string{
+		"invalid symbol":   `{"symbol":"tesla motors","data":` + history + `}`,
+		"single point":     `{"symbol":"TSLA","data":[{"date":"2025-01-01","price":100}]}`,
+		"malformed date":   `{"symbol":"TSLA","data":[{"date":"01/01/2025","price":100},{"date":"2025-01-02","price":101}]}`,
+		"negative price":   `{"symbol":"TSLA","data":[{"date":"2025-01-01","price":-1},{"date":"2025-01-02","price":101}]}`,
+		"too many periods": `{"symbol":"TSLA","periods":1000,"data":` + history + `}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeML{}
+			rec := serve(mlRouter(t, fake), http.MethodPost, "/api/ml/trend", body)
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			require.Nil(t, fake.trend)
+		})
+	}
+}
+
+func TestMLSymbols(t *testing.T) {
+	t.Parallel()
+
+	rec := serve(mlRouter(t, &fakeML{}), http.MethodGet, "/api/ml/symbols", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"symbols":["AAPL","TSLA"]}`, rec.Body.String())
+}
@@ -2,54 +2,215 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
 	"github.com/gin-gonic/gin"
 )
 
-type SubscriptionHandler struct{ svc *service.SubscriptionService }
+const (
+	_defaultSubscriptionLimit = 20
+	_maxSubscriptionLimit     = 100
+)
+
+type SubscriptionHandler struct {
+	svc  *service.SubscriptionService
+	ents *service.EntitlementService
+}
 
-func NewSubscriptionHandler(s *service.SubscriptionService) *SubscriptionHandler {
-	return &SubscriptionHandler{svc: s}
+func NewSubscriptionHandler(s *service.SubscriptionService, ents *service.EntitlementService) *SubscriptionHandler {
+	return &SubscriptionHandler{svc: s, ents: ents}
 }
 
+// GET /api/subscriptions/plans?type=
 func (h *SubscriptionHandler) GetPlans(c *gin.Context) {
-	plans, err := h.svc.GetPlans(c)
+	plans, err := h.svc.GetPlans(c.Request.Context(), c.Query("type"))
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		abortWithGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, plans)
 }
 
+// POST /api/subscriptions
 func (h *SubscriptionHandler) Subscribe(c *gin.Context) {
+	claims, ok := ownClaims(c)
+	if !ok {
+		return
+	}
+
 	var body struct {
-		PlanID string `json:"plan_id" binding:"required"`
+		PlanID        string `json:"plan_id"        binding:"required"`
+		AutoRenew     bool   `json:"auto_renew"`
+		PaymentMethod string `json:"payment_method" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	if err := h.svc.Subscribe(c, body.PlanID); err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+
+	sub, err := h.svc.Subscribe(c.Request.Context(), claims.UserID, body.PlanID, body.AutoRenew, body.PaymentMethod)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, sub)
+}
+
+// GET /api/subscriptions?status=&limit=&offset=
+func (h *SubscriptionHandler) List(c *gin.Context) {
+	claims := requestClaims(c)
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(_defaultSubscriptionLimit)))
+	if err != nil || limit <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset"})
+		return
+	}
+
+	subs, total, err := h.svc.List(c.Request.Context(), claims.UserID, c.Query("status"),
+		min(limit, _maxSubscriptionLimit), offset)
+	if err != nil {
+		abortWithGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	c.JSON(http.StatusOK, gin.H{
+		"subscriptions": subs,
+		"total":         total,
+	})
 }
 
+// GET /api/subscriptions/current
 func (h *SubscriptionHandler) GetStatus(c *gin.Context) {
-	status, err := h.svc.Status(c)
+	status, err := h.svc.Status(c.Request.Context(), requestClaims(c).UserID)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		abortWithGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, status)
 }
 
+// GET /api/subscriptions/entitlements
+func (h *SubscriptionHandler) GetEntitlements(c *gin.Context) {
+	ents, err := h.ents.Entitlements(c.Request.Context(), requestClaims(c).UserID)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ents)
+}
+
+// GET /api/subscriptions/:id
+func (h *SubscriptionHandler) Get(c *gin.Context) {
+	sub, err := h.svc.Get(c.Request.Context(), requestClaims(c).UserID, c.Param("id"))
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, sub)
+}
+
+// PATCH /api/subscriptions/:id
+func (h *SubscriptionHandler) Update(c *gin.Context) {
+	claims, ok := ownClaims(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		AutoRenew     *bool  `json:"auto_renew"     binding:"required"`
+		PaymentMethod string `json:"payment_method"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sub, err := h.svc.Update(c.Request.Context(), claims.UserID, c.Param("id"), *body.AutoRenew, body.PaymentMethod)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, sub)
+}
+
+// POST /api/subscriptions/:id/cancel
 func (h *SubscriptionHandler) Cancel(c *gin.Context) {
-	if err := h.svc.Cancel(c); err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+	claims, ok := ownClaims(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	// the body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	sub, err := h.svc.Cancel(c.Request.Context(), claims.UserID, c.Param("id"), body.Reason)
+	if err != nil {
+		abortWithGRPCError(c, err)
+		return
+	}
+	if err := h.ents.Invalidate(c.Request.Context(), claims.UserID); err != nil {
+		_ = c.Error(err)
+	}
+	c.JSON(http.StatusOK, sub)
+}
+
+// POST /api/subscriptions/:id/payments
+func (h *SubscriptionHandler) Pay(c *gin.Context) {
+	claims, ok := ownClaims(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		PaymentMethod string `json:"payment_method"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	payment, err := h.svc.Pay(c.Request.Context(), claims.UserID, c.Param("id"), body.PaymentMethod)
+	if err != nil {
+		abortWithGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"status": "cancelled"})
+	// a paid subscription turns active
+	if err := h.ents.Invalidate(c.Request.Context(), claims.UserID); err != nil {
+		_ = c.Error(err)
+	}
+	c.JSON(http.StatusCreated, payment)
+}
+
+// requestClaims returns the claims the auth middleware stored for the request
+func requestClaims(c *gin.Context) *entity.Claims {
+	claims, _ := c.MustGet("claims").(*entity.Claims)
+	return claims
+}
+
+// ownClaims returns the claims of requests the user makes in person, staff
+// impersonating the user must not change what the user pays for
+func ownClaims(c *gin.Context) (*entity.Claims, bool) {
+	claims := requestClaims(c)
+	if claims.ActorID != "" {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not allowed while impersonating"})
+		return nil, false
+	}
+
+	return claims, true
 }
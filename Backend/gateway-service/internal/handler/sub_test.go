@@ -0,0 +1,187 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/handler"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/subtest"
+	subpb "github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/subscription/v1"
+)
+
+// subRouter mounts the subscription routes the way the protected group does,
+// signed in with the claims
+func subRouter(t *testing.T, fake *subtest.Server, claims *entity.Claims) *gin.Engine {
+	t.Helper()
+
+	conn := dialBufconn(t, func(s *grpc.Server) { subpb.RegisterSubscriptionServiceServer(s, fake) })
+	svc := service.NewSubscriptionService(conn)
+	h := handler.NewSubscriptionHandler(svc, service.NewEntitlementService(redisClient(t), svc, config.Entitlements{
+		CacheTTL:     time.Minute,
+		ChatMessages: config.PlanQuota{Basic: 2, Pro: 100},
+	}))
+
+	r := gin.New()
+	subs := r.Group("/api/subscriptions", func(c *gin.Context) {
+		c.Set("claims", claims)
+	})
+	subs.GET("", h.List)
+	subs.GET("/:id", h.Get)
+	subs.POST("", h.Subscribe)
+	subs.PATCH("/:id", h.Update)
+	subs.POST("/:id/cancel", h.Cancel)
+	subs.POST("/:id/payments", h.Pay)
+
+	return r
+}
+
+func TestSubscriptionOwnership(t *testing.T) {
+	t.Parallel()
+
+	owner := &entity.Claims{UserID: "owner"}
+	other := &entity.Claims{UserID: "other"}
+	path := "/api/subscriptions/" + subtest.SubscriptionID(owner.UserID)
+
+	changes := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		code   int
+	}{
+		{name: "get", method: http.MethodGet, path: path, code: http.StatusOK},
+		{name: "update", method: http.MethodPatch, path: path, body: `{"auto_renew": false}`, code: http.StatusOK},
+		{name: "cancel", method: http.MethodPost, path: path + "/cancel", code: http.StatusOK},
+		{name: "pay", method: http.MethodPost, path: path + "/payments", code: http.StatusCreated},
+	}
+
+	for _, tc := range changes {
+		t.Run(tc.name+" of another user", func(t *testing.T) {
+			t.Parallel()
+
+			fake := &subtest.Server{Active: map[string]string{owner.UserID: subtest.BasicPlanID}}
+			rec := serve(subRouter(t, fake, other), tc.method, tc.path, tc.body)
+			require.Equal(t, http.StatusNotFound, rec.Code)
+			require.JSONEq(t, `{"error": "subscription not found"}`, rec.Body.String())
+			require.Empty(t, fake.Cancelled())
+			require.Empty(t, fake.Paid())
+		})
+
+		t.Run(tc.name+" of the owner", func(t *testing.T) {
+			t.Parallel()
+
+			fake := &subtest.Server{Active: map[string]string{owner.UserID: subtest.BasicPlanID}}
+			rec := serve(subRouter(t, fake, owner), tc.method, tc.path, tc.body)
+			require.Equal(t, tc.code, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("cancel and pay", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &subtest.Server{Active: map[string]string{owner.UserID: subtest.BasicPlanID}}
+		r := subRouter(t, fake, owner)
+
+		rec := serve(r, http.MethodPost, path+"/cancel", `{"reason": "too expensive"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var sub entity.Subscription
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sub))
+		require.Equal(t, "cancelled", sub.Status)
+
+		rec = serve(r, http.MethodPost, path+"/payments", "")
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var payment entity.Payment
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &payment))
+		require.Equal(t, "completed", payment.Status)
+
+		require.Equal(t, []string{subtest.SubscriptionID(owner.UserID)}, fake.Cancelled())
+		require.Equal(t, []string{subtest.SubscriptionID(owner.UserID)}, fake.Paid())
+	})
+
+	t.Run("impersonating", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &subtest.Server{Active: map[string]string{owner.UserID: subtest.BasicPlanID}}
+		staff := &entity.Claims{UserID: owner.UserID, ActorID: "support"}
+		r := subRouter(t, fake, staff)
+
+		// staff may look at the subscription but not change it
+		require.Equal(t, http.StatusOK, serve(r, http.MethodGet, path, "").Code)
+		for _, tc := range changes[1:] {
+			rec := serve(r, tc.method, tc.path, tc.body)
+			require.Equal(t, http.StatusForbidden, rec.Code, tc.name)
+		}
+		require.Empty(t, fake.Cancelled())
+		require.Empty(t, fake.Paid())
+	})
+}
+
+func TestSubscriptionErrors(t *testing.T) {
+	t.Parallel()
+
+	user := &entity.Claims{UserID: "u1"}
+
+	tests := []struct {
+		name   string
+		err    error
+		active map[string]string
+		method string
+		path   string
+		body   string
+		code   int
+		msg    string
+	}{
+		{
+			name: "unknown plan", method: http.MethodPost, path: "/api/subscriptions",
+			body: `{"plan_id": "p0", "payment_method": "card"}`,
+			code: http.StatusBadRequest, msg: "unknown plan",
+		},
+		{
+			name: "already subscribed", active: map[string]string{user.UserID: subtest.BasicPlanID},
+			method: http.MethodPost, path: "/api/subscriptions",
+			body: `{"plan_id": "` + subtest.ProPlanID + `", "payment_method": "card"}`,
+			code: http.StatusConflict, msg: "user already has an active subscription",
+		},
+		{
+			name: "invalid status", method: http.MethodGet, path: "/api/subscriptions?status=paused",
+			code: http.StatusBadRequest, msg: "invalid subscription status",
+		},
+		{
+			name: "unavailable", err: status.Error(codes.Unavailable, "connection refused"),
+			method: http.MethodGet, path: "/api/subscriptions",
+			code: http.StatusServiceUnavailable, msg: "connection refused",
+		},
+		{
+			name: "internal", err: status.Error(codes.Internal, "database is down"),
+			method: http.MethodGet, path: "/api/subscriptions/" + subtest.SubscriptionID(user.UserID),
+			code: http.StatusBadGateway, msg: "database is down",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &subtest.Server{Active: tc.active, Err: tc.err}
+			rec := serve(subRouter(t, fake, user), tc.method, tc.path, tc.body)
+			require.Equal(t, tc.code, rec.Code)
+
+			var body struct {
+				Error string `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tc.msg, body.Error)
+		})
+	}
+}
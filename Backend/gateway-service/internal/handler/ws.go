@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/ws"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+type WSHandler struct {
+	hub      *ws.Hub
+	upgrader websocket.Upgrader
+}
+
+// NewWSHandler upgrades connections from clients without an Origin, from the
+// origin of the gateway and from the allowed origins
+func NewWSHandler(hub *ws.Hub, allowedOrigins []string) *WSHandler {
+	return &WSHandler{
+		hub: hub,
+		upgrader: websocket.Upgrader{
+			// other sites must not open connections as the user
+			CheckOrigin: checkOrigin(allowedOrigins),
+			// browsers must get back the protocol they offered their token with
+			Subprotocols: []string{middleware.WSTokenProtocol},
+		},
+	}
+}
+
+// GET /api/ws
+func (h *WSHandler) Connect(c *gin.Context) {
+	claims := requestClaims(c)
+
+	if !h.hub.Accepting() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "server shutting down"})
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader has answered with an HTTP error already
+		return
+	}
+
+	h.hub.Serve(conn, claims)
+}
+
+// checkOrigin lets through handshakes without an Origin, which browsers always
+// send, handshakes of pages served by the gateway and of the allowed origins
+func checkOrigin(allowed []string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		if slices.ContainsFunc(allowed, func(o string) bool { return strings.EqualFold(o, origin) }) {
+			return true
+		}
+
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+}
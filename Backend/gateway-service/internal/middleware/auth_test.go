@@ -0,0 +1,102 @@
+package middleware_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	authpb "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/subtest"
+)
+
+// dial serves the services registered by register in process
+func dial(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	register(srv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+// fakeAuth is an in-process auth-service knowing one API key, an admin's key
+// scoped to reading tweets. It answers like auth-service did before keys
+// stopped reporting the role of their user
+type fakeAuth struct {
+	authpb.UnimplementedAuthServiceServer
+}
+
+func (fakeAuth) ValidateAPIKey(context.Context, *authpb.ValidateAPIKeyRequest) (*authpb.ValidateAPIKeyResponse, error) {
+	return &authpb.ValidateAPIKeyResponse{
+		IsValid:     true,
+		UserId:      "admin-1",
+		IsAdmin:     true,
+		Role:        authz.RoleAdmin,
+		Permissions: []string{authz.PermTweetsRead},
+		KeyId:       "k1",
+	}, nil
+}
+
+func TestAuthScopedAPIKey(t *testing.T) {
+	t.Parallel()
+
+	auth := service.NewAuthService(dial(t, func(s *grpc.Server) { authpb.RegisterAuthServiceServer(s, fakeAuth{}) }))
+	rdb, _ := redisClient(t)
+	ents := entitlements(t, rdb, &subtest.Server{})
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.Auth(auth, auth))
+	ok := func(c *gin.Context) { c.Status(http.StatusNoContent) }
+	r.GET("/tweets", middleware.RequirePermission(authz.PermTweetsRead), ok)
+	r.DELETE("/tweets", middleware.RequirePermission(authz.PermTweetsAdmin), ok)
+	r.GET("/feature", middleware.RequireFeature(ents, entity.FeatureML), ok)
+
+	tests := []struct {
+		method string
+		path   string
+		code   int
+	}{
+		{method: http.MethodGet, path: "/tweets", code: http.StatusNoContent},
+		// the key grants what it is scoped to, not what its admin user could do
+		{method: http.MethodDelete, path: "/tweets", code: http.StatusForbidden},
+		{method: http.MethodGet, path: "/feature", code: http.StatusPaymentRequired},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			req.Header.Set(middleware.APIKeyHeader, "fa_key")
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// _cachedHeaders are the headers stored with cached answers
+var _cachedHeaders = []string{"Content-Type", "Cache-Control", "ETag"}
+
+// Cache answers GET requests of a route from the response cache. Fresh
+// answers are served as they are, stale ones while the route refreshes them
+// in the background, and 200 answers of misses are stored. Routes cached per
+// user go after Auth. Answers carry X-Cache: HIT, STALE or MISS.
+func Cache(rc *service.ResponseCache, route string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policy := rc.Policy(route)
+		if policy.TTL <= 0 {
+			c.Next()
+			return
+		}
+
+		scope := ""
+		if policy.PerUser {
+			claims, _ := c.MustGet("claims").(*entity.Claims)
+			scope = "user:" + claims.UserID
+		}
+		ctx := c.Request.Context()
+		request := c.Request.URL.Path + "?" + c.Request.URL.Query().Encode()
+
+		entry, key, err := rc.Lookup(ctx, route, scope, request)
+		if err != nil {
+			_ = c.Error(err)
+			c.Next()
+			return
+		}
+
+		if entry != nil && !service.Revalidating(ctx) {
+			age := time.Since(entry.StoredAt)
+			state := "HIT"
+			if age >= policy.TTL {
+				state = "STALE"
+				rc.Revalidate(c.Request, key)
+			}
+			serveCached(c, entry, state, age)
+			return
+		}
+
+		w := &captureWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Header("X-Cache", "MISS")
+		c.Next()
+
+		if w.Status() != http.StatusOK {
+			return
+		}
+		resp := &service.CachedResponse{
+			Status:   http.StatusOK,
+			Header:   http.Header{},
+			Body:     w.body.Bytes(),
+			StoredAt: time.Now(),
+		}
+		for _, h := range _cachedHeaders {
+			if v := w.Header().Get(h); v != "" {
+				resp.Header.Set(h, v)
+			}
+		}
+		if err := rc.Store(ctx, key, policy.TTL, resp); err != nil {
+			_ = c.Error(err)
+		}
+	}
+}
+
+// InvalidateCache drops the cached answers of routes once the request
+// succeeds, of the user for routes cached per user; it goes after Auth
+func InvalidateCache(rc *service.ResponseCache, routes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusMultipleChoices {
+			return
+		}
+		claims, _ := c.MustGet("claims").(*entity.Claims)
+		for _, route := range routes {
+			scope := ""
+			if rc.Policy(route).PerUser {
+				scope = "user:" + claims.UserID
+			}
+			if err := rc.Invalidate(c.Request.Context(), route, scope); err != nil {
+				_ = c.Error(err)
+			}
+		}
+	}
+}
+
+// ETagMatch compares If-None-Match weakly, as RFC 9110 asks for GET
+func ETagMatch(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+func serveCached(c *gin.Context, entry *service.CachedResponse, state string, age time.Duration) {
+	for h, v := range entry.Header {
+		c.Writer.Header()[h] = v
+	}
+	c.Header("X-Cache", state)
+	c.Header("Age", strconv.Itoa(int(age/time.Second)))
+
+	if etag := entry.Header.Get("ETag"); etag != "" && ETagMatch(c.GetHeader("If-None-Match"), etag) {
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	c.Data(entry.Status, entry.Header.Get("Content-Type"), entry.Body)
+	c.Abort()
+}
+
+// captureWriter keeps a copy of the answer it writes
+type captureWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *captureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
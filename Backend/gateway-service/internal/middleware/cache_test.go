@@ -0,0 +1,150 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+)
+
+// cacheRouter serves GET /plans cached for everyone and GET /current cached
+// per user, both answering with the number of calls so far, POST /current
+// drops the answers of the user and POST /plans fails. The plans stay fresh
+// for ttl.
+func cacheRouter(t *testing.T, ttl time.Duration) (*gin.Engine, *atomic.Int32, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	rc := service.NewResponseCache(rdb, config.Cache{Plans: ttl, Subscription: time.Minute})
+
+	var calls atomic.Int32
+	answer := func(c *gin.Context) {
+		n := calls.Add(1)
+		c.Header("ETag", `"`+strconv.Itoa(int(n))+`"`)
+		c.String(http.StatusOK, "%d", n)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(withClaims)
+	r.GET("/plans", middleware.Cache(rc, service.CachePlans), answer)
+	r.POST("/plans", middleware.InvalidateCache(rc, service.CachePlans), func(c *gin.Context) {
+		c.Status(http.StatusBadRequest)
+	})
+	r.GET("/current", middleware.Cache(rc, service.CacheSubscription), answer)
+	r.POST("/current", middleware.InvalidateCache(rc, service.CacheSubscription), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	rc.SetHandler(r)
+
+	return r, &calls, mr
+}
+
+func cached(r *gin.Engine, method, path, user string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("X-User", user)
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestCacheHit(t *testing.T) {
+	t.Parallel()
+
+	r, calls, _ := cacheRouter(t, time.Minute)
+
+	rec := cached(r, http.MethodGet, "/plans", "u1")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "MISS", rec.Header().Get("X-Cache"))
+	require.Equal(t, "1", rec.Body.String())
+
+	// other users share the answer, other queries do not
+	rec = cached(r, http.MethodGet, "/plans", "u2")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "HIT", rec.Header().Get("X-Cache"))
+	require.Equal(t, "1", rec.Body.String())
+	require.Equal(t, `"1"`, rec.Header().Get("ETag"))
+	require.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "0", rec.Header().Get("Age"))
+
+	require.Equal(t, "2", cached(r, http.MethodGet, "/plans?type=pro", "u1").Body.String())
+	require.EqualValues(t, 2, calls.Load())
+
+	rec = cached(r, http.MethodGet, "/plans", "u1", "If-None-Match", `W/"1"`)
+	require.Equal(t, http.StatusNotModified, rec.Code)
+	require.Empty(t, rec.Body.String())
+
+	// failed changes keep the answers
+	require.Equal(t, http.StatusBadRequest, cached(r, http.MethodPost, "/plans", "u1").Code)
+	require.Equal(t, "HIT", cached(r, http.MethodGet, "/plans", "u1").Header().Get("X-Cache"))
+	require.EqualValues(t, 2, calls.Load())
+}
+
+func TestCachePerUser(t *testing.T) {
+	t.Parallel()
+
+	r, calls, _ := cacheRouter(t, time.Minute)
+
+	require.Equal(t, "1", cached(r, http.MethodGet, "/current", "u1").Body.String())
+	require.Equal(t, "2", cached(r, http.MethodGet, "/current", "u2").Body.String())
+	require.Equal(t, "1", cached(r, http.MethodGet, "/current", "u1").Body.String())
+
+	// changes drop the answers of their user only
+	require.Equal(t, http.StatusNoContent, cached(r, http.MethodPost, "/current", "u1").Code)
+
+	rec := cached(r, http.MethodGet, "/current", "u1")
+	require.Equal(t, "MISS", rec.Header().Get("X-Cache"))
+	require.Equal(t, "3", rec.Body.String())
+	require.Equal(t, "2", cached(r, http.MethodGet, "/current", "u2").Body.String())
+	require.EqualValues(t, 3, calls.Load())
+}
+
+func TestCacheStaleWhileRevalidate(t *testing.T) {
+	t.Parallel()
+
+	r, calls, _ := cacheRouter(t, 50*time.Millisecond)
+
+	require.Equal(t, "1", cached(r, http.MethodGet, "/plans", "u1").Body.String())
+	time.Sleep(60 * time.Millisecond)
+
+	// the stale answer is served while it is refreshed in the background
+	rec := cached(r, http.MethodGet, "/plans", "u1")
+	require.Equal(t, "STALE", rec.Header().Get("X-Cache"))
+	require.Equal(t, "1", rec.Body.String())
+
+	require.Eventually(t, func() bool {
+		rec := cached(r, http.MethodGet, "/plans", "u1")
+		return rec.Header().Get("X-Cache") == "HIT" && rec.Body.String() == "2"
+	}, time.Second, 5*time.Millisecond)
+	require.EqualValues(t, 2, calls.Load())
+}
+
+func TestCacheRedisDown(t *testing.T) {
+	t.Parallel()
+
+	r, calls, mr := cacheRouter(t, time.Minute)
+	mr.Close()
+
+	require.Equal(t, "1", cached(r, http.MethodGet, "/plans", "u1").Body.String())
+	require.Equal(t, "2", cached(r, http.MethodGet, "/plans", "u1").Body.String())
+	require.EqualValues(t, 2, calls.Load())
+}
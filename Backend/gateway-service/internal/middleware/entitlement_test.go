@@ -0,0 +1,299 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/subtest"
+	subpb "github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/subscription/v1"
+)
+
+// redisClient connects to an in-process Redis
+func redisClient(t *testing.T) (*redis.Client, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	return rdb, mr
+}
+
+// entitlements serves the plans of fake counting quotas in rdb, basic plans
+// have two chat messages a day
+func entitlements(t *testing.T, rdb redis.Cmdable, fake *subtest.Server) *service.EntitlementService {
+	t.Helper()
+
+	conn := dial(t, func(s *grpc.Server) { subpb.RegisterSubscriptionServiceServer(s, fake) })
+
+	return service.NewEntitlementService(rdb, service.NewSubscriptionService(conn), config.Entitlements{
+		CacheTTL:     time.Minute,
+		ChatMessages: config.PlanQuota{Basic: 2, Pro: 100},
+	})
+}
+
+// withClaims signs requests in as the user of the X-User header, an admin
+// with X-Admin and with the API key of X-Key. X-Admin "withheld" is an admin
+// whose permissions are withheld until they enroll in MFA
+func withClaims(c *gin.Context) {
+	claims := &entity.Claims{UserID: c.GetHeader("X-User"), APIKeyID: c.GetHeader("X-Key")}
+	switch c.GetHeader("X-Admin") {
+	case "":
+	case "withheld":
+		claims.Role, claims.Permissions = "admin", []string{}
+	default:
+		claims.Role, claims.Permissions = "admin", []string{authz.PermPlansUnlimited}
+	}
+	c.Set("claims", claims)
+}
+
+// entitlementRouter serves GET /feature gated by the ml feature and
+// POST /quota counted against the chat message quota
+func entitlementRouter(t *testing.T, fake *subtest.Server) *gin.Engine {
+	t.Helper()
+
+	rdb, _ := redisClient(t)
+	ents := entitlements(t, rdb, fake)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(withClaims)
+	ok := func(c *gin.Context) { c.Status(http.StatusNoContent) }
+	r.GET("/feature", middleware.RequireFeature(ents, entity.FeatureML), ok)
+	r.POST("/quota", middleware.ConsumeQuota(ents, entity.QuotaChatMessages), ok)
+
+	return r
+}
+
+func request(r *gin.Engine, method, path, user string, admin bool) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("X-User", user)
+	if admin {
+		req.Header.Set("X-Admin", "1")
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func denialOf(t *testing.T, rec *httptest.ResponseRecorder) entity.Denial {
+	t.Helper()
+
+	var d entity.Denial
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &d))
+
+	return d
+}
+
+func TestRequireFeature(t *testing.T) {
+	t.Parallel()
+
+	fake := &subtest.Server{Active: map[string]string{"basic": subtest.BasicPlanID, "pro": subtest.ProPlanID}}
+	r := entitlementRouter(t, fake)
+
+	t.Run("included", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, http.StatusNoContent, request(r, http.MethodGet, "/feature", "pro", false).Code)
+	})
+
+	t.Run("not in plan", func(t *testing.T) {
+		t.Parallel()
+
+		rec := request(r, http.MethodGet, "/feature", "basic", false)
+		require.Equal(t, http.StatusForbidden, rec.Code)
+
+		d := denialOf(t, rec)
+		require.Equal(t, entity.DenialFeatureNotInPlan, d.Code)
+		require.Equal(t, entity.FeatureML, d.Entitlement)
+		require.Equal(t, "basic", d.Plan)
+		require.Len(t, d.Upgrade.Plans, 1)
+		require.Equal(t, subtest.ProPlanID, d.Upgrade.Plans[0].ID)
+		require.Equal(t, "POST /api/subscriptions", d.Upgrade.Subscribe)
+	})
+
+	t.Run("no subscription", func(t *testing.T) {
+		t.Parallel()
+
+		rec := request(r, http.MethodGet, "/feature", "nobody", false)
+		require.Equal(t, http.StatusPaymentRequired, rec.Code)
+		require.Equal(t, entity.DenialSubscriptionRequired, denialOf(t, rec).Code)
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, http.StatusNoContent, request(r, http.MethodGet, "/feature", "nobody-admin", true).Code)
+	})
+
+	t.Run("admin with withheld permissions", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodGet, "/feature", nil)
+		req.Header.Set("X-User", "nobody-withheld")
+		req.Header.Set("X-Admin", "withheld")
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusPaymentRequired, rec.Code)
+	})
+}
+
+func TestConsumeQuota(t *testing.T) {
+	t.Parallel()
+
+	fake := &subtest.Server{Active: map[string]string{"basic": subtest.BasicPlanID, "pro": subtest.ProPlanID}}
+	r := entitlementRouter(t, fake)
+
+	for range 2 {
+		require.Equal(t, http.StatusNoContent, request(r, http.MethodPost, "/quota", "basic", false).Code)
+	}
+
+	rec := request(r, http.MethodPost, "/quota", "basic", false)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	d := denialOf(t, rec)
+	require.Equal(t, entity.DenialQuotaExceeded, d.Code)
+	require.Equal(t, entity.QuotaChatMessages, d.Entitlement)
+	require.EqualValues(t, 2, d.Limit)
+	require.NotNil(t, d.ResetAt)
+	require.True(t, d.ResetAt.After(time.Now()))
+	require.Len(t, d.Upgrade.Plans, 1)
+	require.Equal(t, subtest.ProPlanID, d.Upgrade.Plans[0].ID)
+
+	// other users have quotas of their own, the plan is read once per user
+	require.Equal(t, http.StatusNoContent, request(r, http.MethodPost, "/quota", "pro", false).Code)
+	require.EqualValues(t, 2, fake.Lookups.Load())
+}
+
+func TestConsumeQuotaInRedis(t *testing.T) {
+	t.Parallel()
+
+	basic := &entity.Claims{UserID: "basic"}
+	fake := &subtest.Server{Active: map[string]string{"basic": subtest.BasicPlanID}}
+	ctx := context.Background()
+
+	t.Run("shared by gateway instances until the end of the UTC day", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, mr := redisClient(t)
+		first, second := entitlements(t, rdb, fake), entitlements(t, rdb, fake)
+
+		require.NoError(t, first.Consume(ctx, basic, entity.QuotaChatMessages))
+		require.NoError(t, second.Consume(ctx, basic, entity.QuotaChatMessages))
+
+		var denial *entity.Denial
+		require.ErrorAs(t, first.Consume(ctx, basic, entity.QuotaChatMessages), &denial)
+		require.Equal(t, entity.DenialQuotaExceeded, denial.Code)
+
+		e, err := second.Entitlements(ctx, basic.UserID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, e.Used[entity.QuotaChatMessages])
+
+		// refused uses are not counted
+		key := "quota:" + entity.QuotaChatMessages + ":basic:" + time.Now().UTC().Format(time.DateOnly)
+		used, err := mr.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, "2", used)
+
+		ttl := mr.TTL(key)
+		require.WithinDuration(t, *denial.ResetAt, time.Now().Add(ttl), 5*time.Second)
+		require.True(t, denial.ResetAt.Equal(time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)))
+
+		mr.FastForward(ttl)
+		require.NoError(t, second.Consume(ctx, basic, entity.QuotaChatMessages))
+	})
+
+	t.Run("Redis unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, mr := redisClient(t)
+		mr.SetError("LOADING Redis is loading the dataset in memory")
+
+		r := gin.New()
+		r.Use(withClaims)
+		r.POST("/quota", middleware.ConsumeQuota(entitlements(t, rdb, fake), entity.QuotaChatMessages),
+			func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+		rec := request(r, http.MethodPost, "/quota", "basic", false)
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+}
+
+func TestEntitlementsCachedInRedis(t *testing.T) {
+	t.Parallel()
+
+	user := &entity.Claims{UserID: "u1"}
+	ctx := context.Background()
+
+	t.Run("invalidated on every gateway instance", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &subtest.Server{}
+		rdb, _ := redisClient(t)
+		first, second := entitlements(t, rdb, fake), entitlements(t, rdb, fake)
+
+		var denial *entity.Denial
+		require.ErrorAs(t, first.Require(ctx, user, entity.FeatureChat), &denial)
+		require.Equal(t, entity.DenialSubscriptionRequired, denial.Code)
+
+		// the other instance reads the cached plan
+		require.ErrorAs(t, second.Require(ctx, user, entity.FeatureChat), &denial)
+		require.EqualValues(t, 1, fake.Lookups.Load())
+
+		// a payment made through one instance applies on both
+		fake.Activate(user.UserID, subtest.BasicPlanID)
+		require.NoError(t, first.Invalidate(ctx, user.UserID))
+		require.NoError(t, second.Require(ctx, user, entity.FeatureChat))
+		require.NoError(t, first.Require(ctx, user, entity.FeatureChat))
+		require.EqualValues(t, 2, fake.Lookups.Load())
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &subtest.Server{}
+		rdb, mr := redisClient(t)
+		ents := entitlements(t, rdb, fake)
+
+		require.Error(t, ents.Require(ctx, user, entity.FeatureChat))
+
+		// changes made outside the gateway apply within the TTL
+		fake.Activate(user.UserID, subtest.BasicPlanID)
+		mr.FastForward(time.Minute)
+		require.NoError(t, ents.Require(ctx, user, entity.FeatureChat))
+	})
+
+	t.Run("Redis unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &subtest.Server{Active: map[string]string{user.UserID: subtest.BasicPlanID}}
+		rdb, mr := redisClient(t)
+		mr.SetError("LOADING Redis is loading the dataset in memory")
+		ents := entitlements(t, rdb, fake)
+
+		// every check asks sub-service
+		for range 2 {
+			require.NoError(t, ents.Require(ctx, user, entity.FeatureChat))
+		}
+		require.EqualValues(t, 2, fake.Lookups.Load())
+		require.Error(t, ents.Invalidate(ctx, user.UserID))
+	})
+}
@@ -1,17 +1,18 @@
 package middleware
 
 import (
-	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/logger"
 	"github.com/gin-gonic/gin"
-	"golang.org/x/time/rate"
+	"github.com/gorilla/websocket"
 )
 
 // Logger middleware for request logging
@@ -53,7 +54,7 @@ func CORS() gin.HandlerFunc {
 		if reqHeaders := c.GetHeader("Access-Control-Request-Headers"); reqHeaders != "" {
 			h.Set("Access-Control-Allow-Headers", reqHeaders)
 		} else {
-			h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+			h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-API-Key")
 		}
 
 		if reqMethods := c.GetHeader("Access-Control-Request-Methods"); reqMethods != "" {
@@ -70,34 +71,42 @@ func CORS() gin.HandlerFunc {
 	}
 }
 
-// RateLimit middleware for request rate limiting
-func RateLimit(cfg *config.Config) gin.HandlerFunc {
-	rps := rate.Limit(cfg.HTTP.GIN.RateLimit) // tokens / second
-	burst := int(cfg.HTTP.GIN.RateLimit)      // same as rps for now
-
-	limiters := make(map[string]*rate.Limiter)
-	var lastPurge time.Time
-
+// RateLimit limits the requests of a route class with the limiter shared by
+// every gateway instance. After Auth requests are counted per API key or user,
+// before it per client address. Answers carry the RateLimit-* headers of the
+// limit, requests pass when Redis cannot be reached.
+func RateLimit(rl *service.RateLimiter, route string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		ip := c.ClientIP()
-
-		// purge old entries once a minute
-		if time.Since(lastPurge) > time.Minute {
-			for k, l := range limiters {
-				if l.Allow() { // rough heuristic
-					delete(limiters, k)
-				}
-			}
-			lastPurge = time.Now()
+		// background refreshes of the response cache are not the user's
+		if service.Revalidating(c.Request.Context()) {
+			c.Next()
+			return
 		}
 
-		l, ok := limiters[ip]
-		if !ok {
-			l = rate.NewLimiter(rps, burst)
-			limiters[ip] = l
+		var claims *entity.Claims
+		if v, ok := c.Get("claims"); ok {
+			claims, _ = v.(*entity.Claims)
 		}
 
-		if !l.Allow() {
+		res, err := rl.Allow(c.Request.Context(), route, claims, c.ClientIP())
+		if err != nil {
+			_ = c.Error(err)
+			c.Next()
+			return
+		}
+		if res.Limit == 0 {
+			c.Next()
+			return
+		}
+
+		h := c.Writer.Header()
+		h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=60", res.Limit))
+		h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+		h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		h.Set("RateLimit-Reset", strconv.Itoa(seconds(res.Reset)))
+
+		if !res.Allowed {
+			h.Set("Retry-After", strconv.Itoa(seconds(res.RetryAfter)))
 			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
 				"error": "rate limit exceeded",
 			})
@@ -108,12 +117,41 @@ func RateLimit(cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
-// Auth middleware for JWT authentication
-func Auth(authService *service.AuthService) gin.HandlerFunc {
+// seconds rounds up, so clients never come back too early
+func seconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
+
+// APIKeyHeader carries personal API keys for programmatic access
+const APIKeyHeader = "X-API-Key"
+
+// WSTokenProtocol is the WebSocket subprotocol browsers offer right before
+// their access token, since they cannot set headers on the handshake. The
+// token stays out of the URL and so out of access logs
+const WSTokenProtocol = "bearer"
+
+// Auth middleware for JWT and API key authentication, both resolve to the
+// same claims
+func Auth(tokens service.TokenValidator, keys service.APIKeyValidator) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if key := c.GetHeader(APIKeyHeader); key != "" {
+			claims, err := keys.ValidateAPIKey(c.Request.Context(), key)
+			if err != nil {
+				unauth(c, "invalid API key")
+				return
+			}
+
+			c.Set("claims", claims)
+			c.Next()
+			return
+		}
+
 		raw := c.GetHeader("Authorization")
+		if raw == "" && c.IsWebsocket() {
+			raw = wsToken(c.Request)
+		}
 		if raw == "" {
-			unauth(c, "missing Authorization header")
+			unauth(c, "missing Authorization or X-API-Key header")
 			return
 		}
 
@@ -122,7 +160,7 @@ func Auth(authService *service.AuthService) gin.HandlerFunc {
 			raw = strings.TrimPrefix(raw, bearer)
 		}
 
-		claims, err := authService.ValidateToken(context.Background(), raw)
+		claims, err := tokens.ValidateToken(c.Request.Context(), raw)
 		if err != nil {
 			unauth(c, err.Error())
 			return
@@ -134,6 +172,67 @@ func Auth(authService *service.AuthService) gin.HandlerFunc {
 	}
 }
 
+// wsToken returns the subprotocol offered after WSTokenProtocol
+func wsToken(r *http.Request) string {
+	protocols := websocket.Subprotocols(r)
+	for i, p := range protocols {
+		if p == WSTokenProtocol && i+1 < len(protocols) {
+			return protocols[i+1]
+		}
+	}
+	return ""
+}
+
+// RequirePermission lets through requests whose token or API key grants the
+// permission, it goes after Auth
+func RequirePermission(perm string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, _ := c.MustGet("claims").(*entity.Claims)
+		if !claims.HasPermission(perm) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing permission " + perm})
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireFeature lets through users whose plan includes the feature, it goes
+// after Auth. Refusals name the missing entitlement and the plans that have it
+func RequireFeature(ents *service.EntitlementService, feature string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, _ := c.MustGet("claims").(*entity.Claims)
+		if err := ents.Require(c.Request.Context(), claims, feature); err != nil {
+			abortEntitlement(c, err)
+			return
+		}
+		c.Next()
+	}
+}
+
+// ConsumeQuota counts the request against a daily quota of the plan of the
+// user, it goes after Auth
+func ConsumeQuota(ents *service.EntitlementService, quota string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, _ := c.MustGet("claims").(*entity.Claims)
+		if err := ents.Consume(c.Request.Context(), claims, quota); err != nil {
+			abortEntitlement(c, err)
+			return
+		}
+		c.Next()
+	}
+}
+
+// abortEntitlement answers a denial with its own status, requests are
+// refused as well when the plan of the user cannot be read
+func abortEntitlement(c *gin.Context, err error) {
+	var denial *entity.Denial
+	if errors.As(err, &denial) {
+		c.AbortWithStatusJSON(denial.Status, denial)
+		return
+	}
+	c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "entitlements unavailable"})
+}
+
 func unauth(c *gin.Context, msg string) {
 	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": msg})
 }
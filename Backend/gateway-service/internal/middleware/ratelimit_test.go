@@ -0,0 +1,173 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/subtest"
+)
+
+// rateLimitRouter serves GET /public limited to 3 requests a minute per
+// client address, and GET /api limited to 1 a minute without a plan and 3
+// with the pro plan, per API key or user
+func rateLimitRouter(t *testing.T) (*gin.Engine, *miniredis.Miniredis) {
+	t.Helper()
+
+	rdb, mr := redisClient(t)
+	mr.SetTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	fake := &subtest.Server{Active: map[string]string{"pro": subtest.ProPlanID}}
+	rl := service.NewRateLimiter(rdb, entitlements(t, rdb, fake), config.RateLimit{
+		Public: 3,
+		API:    map[string]int{"free": 1, "pro": 3},
+	})
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ok := func(c *gin.Context) { c.Status(http.StatusNoContent) }
+	r.GET("/public", middleware.RateLimit(rl, service.RoutePublic), ok)
+	r.GET("/api", withClaims, middleware.RateLimit(rl, service.RouteAPI), ok)
+
+	return r, mr
+}
+
+func limited(r *gin.Engine, path, addr string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = addr
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestRateLimitPublic(t *testing.T) {
+	t.Parallel()
+
+	r, mr := rateLimitRouter(t)
+
+	for remaining := 2; remaining >= 0; remaining-- {
+		rec := limited(r, "/public", "192.0.2.1:1000")
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		require.Equal(t, "3", rec.Header().Get("RateLimit-Limit"))
+		require.Equal(t, strconv.Itoa(remaining), rec.Header().Get("RateLimit-Remaining"))
+		require.Equal(t, "3;w=60", rec.Header().Get("RateLimit-Policy"))
+	}
+
+	rec := limited(r, "/public", "192.0.2.1:1001")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+	require.Equal(t, "20", rec.Header().Get("Retry-After"))
+	require.Equal(t, "60", rec.Header().Get("RateLimit-Reset"))
+
+	// other addresses have limits of their own
+	require.Equal(t, http.StatusNoContent, limited(r, "/public", "192.0.2.2:1000").Code)
+
+	// a request is allowed again every 20 seconds
+	mr.SetTime(time.Date(2025, 1, 1, 12, 0, 20, 0, time.UTC))
+	rec = limited(r, "/public", "192.0.2.1:1000")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+}
+
+func TestRateLimitPlans(t *testing.T) {
+	t.Parallel()
+
+	r, _ := rateLimitRouter(t)
+
+	t.Run("free", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, http.StatusNoContent, limited(r, "/api", "192.0.2.1:1000", "X-User", "free").Code)
+		require.Equal(t, http.StatusTooManyRequests, limited(r, "/api", "192.0.2.1:1000", "X-User", "free").Code)
+
+		// the same address signed in as another user is not limited with it
+		require.Equal(t, http.StatusNoContent, limited(r, "/api", "192.0.2.1:1000", "X-User", "free-2").Code)
+	})
+
+	t.Run("pro", func(t *testing.T) {
+		t.Parallel()
+
+		for range 3 {
+			rec := limited(r, "/api", "192.0.2.3:1000", "X-User", "pro")
+			require.Equal(t, http.StatusNoContent, rec.Code)
+			require.Equal(t, "3", rec.Header().Get("RateLimit-Limit"))
+		}
+		require.Equal(t, http.StatusTooManyRequests, limited(r, "/api", "192.0.2.3:1000", "X-User", "pro").Code)
+
+		// API keys of the user have limits of their own
+		rec := limited(r, "/api", "192.0.2.3:1000", "X-User", "pro", "X-Key", "k1")
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		require.Equal(t, "2", rec.Header().Get("RateLimit-Remaining"))
+	})
+}
+
+func TestRateLimitRedisDown(t *testing.T) {
+	t.Parallel()
+
+	r, mr := rateLimitRouter(t)
+	mr.Close()
+
+	rec := limited(r, "/public", "192.0.2.1:1000")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Empty(t, rec.Header().Get("RateLimit-Limit"))
+}
+
+// rejectedTokens counts the tokens it rejects
+type rejectedTokens struct {
+	checked atomic.Int32
+}
+
+func (r *rejectedTokens) ValidateToken(context.Context, string) (*entity.Claims, error) {
+	r.checked.Add(1)
+	return nil, errors.New("invalid token")
+}
+
+func (r *rejectedTokens) ValidateAPIKey(context.Context, string) (*entity.Claims, error) {
+	r.checked.Add(1)
+	return nil, errors.New("invalid API key")
+}
+
+func TestRateLimitBeforeAuth(t *testing.T) {
+	t.Parallel()
+
+	rdb, _ := redisClient(t)
+	rl := service.NewRateLimiter(rdb, entitlements(t, rdb, &subtest.Server{}), config.RateLimit{
+		Client: 2,
+		API:    map[string]int{"free": 100},
+	})
+	tokens := &rejectedTokens{}
+
+	r := gin.New()
+	r.GET("/api", middleware.RateLimit(rl, service.RouteClient), middleware.Auth(tokens, tokens),
+		middleware.RateLimit(rl, service.RouteAPI), func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	for range 2 {
+		require.Equal(t, http.StatusUnauthorized, limited(r, "/api", "192.0.2.1:1000", "Authorization", "Bearer forged").Code)
+	}
+
+	// guessing on stops before auth-service is asked
+	rec := limited(r, "/api", "192.0.2.1:1000", "X-API-Key", "guessed")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "2", rec.Header().Get("RateLimit-Limit"))
+	require.EqualValues(t, 2, tokens.checked.Load())
+
+	require.Equal(t, http.StatusUnauthorized, limited(r, "/api", "192.0.2.2:1000", "X-API-Key", "guessed").Code)
+}
@@ -8,9 +8,18 @@ import (
 	authpb "github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/pb/auth/v1"
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// Metadata keys auth-service reads the end client from, its peer address is
+// the gateway itself.
+const (
+	ClientIPKey        = "x-client-ip"
+	ClientUserAgentKey = "x-client-user-agent"
+)
+
 type AuthService struct {
 	Client authpb.AuthServiceClient
 }
@@ -33,39 +42,81 @@ func (a *AuthService) ValidateToken(ctx context.Context, token string) (*entity.
 	}
 
 	return &entity.Claims{
-		UserID:   resp.UserId,
-		Email:    resp.Email,
-		Username: resp.Username,
-		IsAdmin:  resp.IsAdmin,
+		UserID:      resp.UserId,
+		Email:       resp.Email,
+		Username:    resp.Username,
+		Role:        resp.Role,
+		Permissions: resp.Permissions,
+
+		EmailVerified: resp.EmailVerified,
 	}, nil
 }
 
-// Refresh takes a *refresh* token, validates it, then issues a new access
-// token by simply calling SignIn again (one could design a dedicated RPC).
-func (a *AuthService) Refresh(ctx context.Context, refresh string) (bool, string, error) {
+// ValidateAPIKey checks a personal API key and translates proto → entity,
+// the permissions are the scopes of the key and there is no role.
+func (a *AuthService) ValidateAPIKey(ctx context.Context, key string) (*entity.Claims, error) {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
 
-	vr, err := a.Client.ValidateToken(ctx, &authpb.ValidateTokenRequest{Token: refresh})
+	resp, err := a.Client.ValidateAPIKey(ctx, &authpb.ValidateAPIKeyRequest{Key: key})
 	if err != nil {
-		return false, "", err
+		return nil, err
+	}
+	if !resp.IsValid {
+		return nil, errors.New("api key invalid")
 	}
-	if !vr.IsValid {
-		return false, "", nil
+
+	return &entity.Claims{
+		UserID:      resp.UserId,
+		Email:       resp.Email,
+		Username:    resp.Username,
+		Permissions: resp.Permissions,
+		APIKeyID:    resp.KeyId,
+
+		EmailVerified: resp.EmailVerified,
+	}, nil
+}
+
+// Refresh exchanges a refresh token for a new access/refresh pair. The old
+// refresh token is rotated by auth-service and must be discarded; ok is false
+// when the token is invalid, expired or was already used.
+func (a *AuthService) Refresh(ctx context.Context, refresh string) (*authpb.RefreshResponse, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := a.Client.Refresh(ctx, &authpb.RefreshRequest{RefreshToken: refresh})
+	if err != nil {
+		if isUnauth(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
 	}
+	return resp, true, nil
+}
 
-	// In a real world flow we'd have a Refresh RPC.  For demo we re-sign-in.
-	sr, err := a.Client.SignIn(ctx, &authpb.SignInRequest{
-		Email:    vr.Email,
-		Password: "", // passwordless refresh – the server trusts the old token
-	})
+// SignOut revokes the access token and the session it belongs to; ok is false
+// when the token is already invalid.
+func (a *AuthService) SignOut(ctx context.Context, token string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	_, err := a.Client.SignOut(ctx, &authpb.SignOutRequest{Token: token})
 	if err != nil {
-		return true, "", err
+		if isUnauth(err) {
+			return false, nil
+		}
+		return false, err
 	}
-	return true, sr.Token, nil
+	return true, nil
+}
+
+// WithClient forwards the address and user agent of the end client to
+// auth-service, which throttles sign-in attempts per address.
+func WithClient(ctx context.Context, ip, userAgent string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ClientIPKey, ip, ClientUserAgentKey, userAgent)
 }
 
 // Helper to translate gRPC error → bool unauthorised.
 func isUnauth(err error) bool {
-	return status.Code(err).String() == "Unauthenticated"
+	return status.Code(err) == codes.Unauthenticated
 }
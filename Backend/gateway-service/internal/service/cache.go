@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// Cached routes
+const (
+	CachePlans        = "plans"
+	CacheSymbols      = "symbols"
+	CacheSentiment    = "sentiment"
+	CacheTrending     = "trending"
+	CacheSubscription = "subscription" // per user
+)
+
+// _revalidateTimeout bounds background refreshes, only one runs per entry
+// meanwhile
+const _revalidateTimeout = 30 * time.Second
+
+// CachePolicy is how a route is cached
+type CachePolicy struct {
+	TTL     time.Duration // fresh for, then stale for as long again
+	PerUser bool          // answers differ by user
+}
+
+// CachedResponse is a stored answer of a route
+type CachedResponse struct {
+	Status   int         `json:"status"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+	StoredAt time.Time   `json:"stored_at"`
+}
+
+// ResponseCache keeps answers of read-heavy routes in Redis. Entries are
+// keyed by a generation of their route, or of the route and user, so
+// invalidating one bumps it and leaves the old entries to expire.
+type ResponseCache struct {
+	rdb      redis.Cmdable
+	policies map[string]CachePolicy
+	handler  http.Handler
+}
+
+type revalidatingKey struct{}
+
+func NewResponseCache(rdb redis.Cmdable, cfg config.Cache) *ResponseCache {
+	return &ResponseCache{
+		rdb: rdb,
+		policies: map[string]CachePolicy{
+			CachePlans:        {TTL: cfg.Plans},
+			CacheSymbols:      {TTL: cfg.Symbols},
+			CacheSentiment:    {TTL: cfg.Sentiment},
+			CacheTrending:     {TTL: cfg.Trending},
+			CacheSubscription: {TTL: cfg.Subscription, PerUser: true},
+		},
+	}
+}
+
+// SetHandler sets the handler stale entries are refreshed through, without
+// one they are served until they expire
+func (c *ResponseCache) SetHandler(h http.Handler) {
+	c.handler = h
+}
+
+// Policy returns how a route is cached
+func (c *ResponseCache) Policy(route string) CachePolicy {
+	return c.policies[route]
+}
+
+// Lookup returns the entry of a request to a route, nil on a miss, and the
+// key a new answer is stored under. scope is empty for answers shared by
+// every user.
+func (c *ResponseCache) Lookup(ctx context.Context, route, scope, request string) (*CachedResponse, string, error) {
+	gen, err := c.rdb.Get(ctx, generationKey(route, scope)).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, "", fmt.Errorf("cache generation: %w", err)
+	}
+	key := fmt.Sprintf("cache:%s:%s:%d:%s", route, scope, gen, request)
+
+	raw, err := c.rdb.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, key, nil
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("cache get: %w", err)
+	}
+
+	var resp CachedResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		// unreadable entries are replaced like misses
+		return nil, key, nil
+	}
+
+	return &resp, key, nil
+}
+
+// Store keeps an answer under the key of its Lookup until it is stale for
+// as long as it was fresh
+func (c *ResponseCache) Store(ctx context.Context, key string, ttl time.Duration, resp *CachedResponse) error {
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("cache encode: %w", err)
+	}
+	if err := c.rdb.Set(ctx, key, raw, 2*ttl).Err(); err != nil {
+		return fmt.Errorf("cache set: %w", err)
+	}
+
+	return nil
+}
+
+// Invalidate drops the entries of a route, of one user for routes cached
+// per user
+func (c *ResponseCache) Invalidate(ctx context.Context, route, scope string) error {
+	if err := c.rdb.Incr(ctx, generationKey(route, scope)).Err(); err != nil {
+		return fmt.Errorf("cache invalidate %s: %w", route, err)
+	}
+
+	return nil
+}
+
+// Revalidate refreshes the stale entry under key by serving a copy of r in
+// the background, the route stores the answer again
+func (c *ResponseCache) Revalidate(r *http.Request, key string) {
+	if c.handler == nil {
+		return
+	}
+
+	// one refresh per entry across gateway instances
+	ok, err := c.rdb.SetNX(r.Context(), key+":refresh", 1, _revalidateTimeout).Result()
+	if err != nil || !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), revalidatingKey{}, true), _revalidateTimeout)
+	req := r.Clone(ctx)
+	req.Header.Del("If-None-Match")
+
+	go func() {
+		defer cancel()
+		c.handler.ServeHTTP(&discardWriter{header: http.Header{}}, req)
+	}()
+}
+
+// Revalidating reports whether ctx is of a background refresh, which skips
+// cached answers and rate limits
+func Revalidating(ctx context.Context) bool {
+	v, _ := ctx.Value(revalidatingKey{}).(bool)
+	return v
+}
+
+func generationKey(route, scope string) string {
+	return "cache:gen:" + route + ":" + scope
+}
+
+// discardWriter takes the answer of a background refresh, the route stores it
+type discardWriter struct {
+	header http.Header
+}
+
+func (w *discardWriter) Header() http.Header         { return w.header }
+func (w *discardWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardWriter) WriteHeader(int)             {}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	brainpb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/brain"
+	"google.golang.org/grpc"
+)
+
+// ChatService talks to brain-service on behalf of the user of the request,
+// brain-service answers NotFound for chats of other users.
+type ChatService struct {
+	Client brainpb.BrainServiceClient
+}
+
+func NewChatService(conn *grpc.ClientConn) *ChatService {
+	return &ChatService{Client: brainpb.NewBrainServiceClient(conn)}
+}
+
+// CreateChat creates an empty chat of the user
+func (s *ChatService) CreateChat(ctx context.Context, userID, title string) (*entity.Chat, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.CreateChat(ctx, &brainpb.CreateChatRequest{UserId: userID, Title: title})
+	if err != nil {
+		return nil, err
+	}
+
+	return chatFromProto(resp.GetChat()), nil
+}
+
+// ListChats returns a page of the user's chats, newest first, and the cursor
+// of the next page, empty on the last one
+func (s *ChatService) ListChats(ctx context.Context, userID string, limit int, cursor string) ([]*entity.Chat, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.ListChats(ctx, &brainpb.ListChatsRequest{
+		UserId:    userID,
+		PageSize:  int32(limit),
+		PageToken: cursor,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	chats := make([]*entity.Chat, len(resp.GetChats()))
+	for i, chat := range resp.GetChats() {
+		chats[i] = chatFromProto(chat)
+	}
+
+	return chats, resp.GetNextPageToken(), nil
+}
+
+// DeleteChat deletes a chat of the user with its messages
+func (s *ChatService) DeleteChat(ctx context.Context, userID, chatID string) error {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	_, err := s.Client.DeleteChat(ctx, &brainpb.DeleteChatRequest{UserId: userID, ChatId: chatID})
+	return err
+}
+
+// ListMessages returns a page of the messages of a chat of the user and the
+// cursor of the next page, empty on the last one
+func (s *ChatService) ListMessages(
+	ctx context.Context,
+	userID, chatID string,
+	limit int,
+	cursor string,
+) ([]*entity.Message, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.ListMessages(ctx, &brainpb.ListMessagesRequest{
+		UserId:    userID,
+		ChatId:    chatID,
+		PageSize:  int32(limit),
+		PageToken: cursor,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	messages := make([]*entity.Message, len(resp.GetMessages()))
+	for i, m := range resp.GetMessages() {
+		messages[i] = &entity.Message{
+			ID:         m.GetId(),
+			ChatID:     m.GetChatId(),
+			Role:       strings.ToLower(strings.TrimPrefix(m.GetRole().String(), "ROLE_")),
+			Content:    m.GetContent(),
+			TokenCount: int(m.GetTokenCount()),
+			CreatedAt:  m.GetCreatedAt(),
+		}
+	}
+
+	return messages, resp.GetNextPageToken(), nil
+}
+
+// StreamMessage sends a message of the user to a chat and returns the stream
+// of the assistant's answer. The stream ends with ctx, so callers cancel it
+// when the client goes away
+func (s *ChatService) StreamMessage(
+	ctx context.Context,
+	userID, chatID, content string,
+) (grpc.ServerStreamingClient[brainpb.StreamMessageResponse], error) {
+	return s.Client.StreamMessage(ctx, &brainpb.StreamMessageRequest{
+		UserId:  userID,
+		ChatId:  chatID,
+		Content: content,
+	})
+}
+
+func chatFromProto(c *brainpb.Chat) *entity.Chat {
+	return &entity.Chat{
+		ID:        c.GetId(),
+		Title:     c.GetTitle(),
+		CreatedAt: c.GetCreatedAt(),
+		UpdatedAt: c.GetUpdatedAt(),
+	}
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+// _subscribeRoute is where denied users pick a plan
+const _subscribeRoute = "POST /api/subscriptions"
+
+// _quotaFeatures are the features each quota limits
+var _quotaFeatures = map[string]string{
+	entity.QuotaChatMessages: entity.FeatureChat,
+}
+
+// _consume counts a use of the daily quota KEYS[1] unless ARGV[1] uses are
+// counted already. The count expires at ARGV[2], the end of its UTC day in
+// unix seconds. It returns whether the use is counted and the uses so far.
+var _consume = redis.NewScript(`
+local used = tonumber(redis.call("GET", KEYS[1])) or 0
+if used >= tonumber(ARGV[1]) then
+	return {0, used}
+end
+
+used = redis.call("INCR", KEYS[1])
+redis.call("EXPIREAT", KEYS[1], ARGV[2])
+return {1, used}
+`)
+
+// EntitlementService decides what the plan of a user allows. The
+// entitlements of users are cached in Redis for a TTL, shared by every gateway
+// instance, so plan changes made outside the gateway apply within it. Plans
+// are cached in process for the same TTL. Quota use is counted in Redis per
+// UTC day.
+type EntitlementService struct {
+	rdb    redis.Cmdable
+	subs   *SubscriptionService
+	ttl    time.Duration
+	quotas map[string]config.PlanQuota
+
+	mu       sync.Mutex
+	plans    []*entity.Plan
+	plansExp time.Time
+}
+
+func NewEntitlementService(rdb redis.Cmdable, subs *SubscriptionService, cfg config.Entitlements) *EntitlementService {
+	return &EntitlementService{
+		rdb:  rdb,
+		subs: subs,
+		ttl:  cfg.CacheTTL,
+		quotas: map[string]config.PlanQuota{
+			entity.QuotaChatMessages: cfg.ChatMessages,
+		},
+	}
+}
+
+// Entitlements returns what the active subscription of the user allows and
+// how much of its quotas is used today
+func (s *EntitlementService) Entitlements(ctx context.Context, userID string) (*entity.Entitlements, error) {
+	e, err := s.resolve(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := *e
+	out.Used = make(map[string]int64, len(e.Quotas))
+
+	day := utcDay(time.Now())
+	for quota := range e.Quotas {
+		used, err := s.rdb.Get(ctx, quotaKey(userID, quota, day)).Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, fmt.Errorf("quota: %w", err)
+		}
+		out.Used[quota] = used
+	}
+
+	return &out, nil
+}
+
+// Require returns a *entity.Denial unless the plan of the user includes the
+// feature, tokens granting plans:unlimited are never denied
+func (s *EntitlementService) Require(ctx context.Context, claims *entity.Claims, feature string) error {
+	if claims.HasPermission(authz.PermPlansUnlimited) {
+		return nil
+	}
+
+	e, err := s.resolve(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+
+	return s.check(ctx, e, feature)
+}
+
+// Consume counts one use of a daily quota of the user, it returns a
+// *entity.Denial when the plan lacks the feature of the quota or it is used up
+func (s *EntitlementService) Consume(ctx context.Context, claims *entity.Claims, quota string) error {
+	if claims.HasPermission(authz.PermPlansUnlimited) {
+		return nil
+	}
+
+	e, err := s.resolve(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+	if err := s.check(ctx, e, _quotaFeatures[quota]); err != nil {
+		return err
+	}
+
+	limit, ok := e.Quotas[quota]
+	if !ok {
+		return nil
+	}
+
+	day := utcDay(time.Now())
+	resetAt := day.AddDate(0, 0, 1)
+	res, err := _consume.Run(ctx, s.rdb, []string{quotaKey(claims.UserID, quota, day)}, limit, resetAt.Unix()).Int64Slice()
+	if err != nil {
+		return fmt.Errorf("quota: %w", err)
+	}
+	if res[0] == 1 {
+		return nil
+	}
+
+	return &entity.Denial{
+		Status:      http.StatusForbidden,
+		Code:        entity.DenialQuotaExceeded,
+		Message:     fmt.Sprintf("daily %s quota of the %s plan is used up", quota, e.Plan),
+		Entitlement: quota,
+		Plan:        e.Plan,
+		Limit:       limit,
+		ResetAt:     &resetAt,
+		Upgrade: s.upgrade(ctx, func(p *entity.Plan) bool {
+			l := s.quotas[quota].Of(p.Type)
+			return slices.Contains(p.Features, _quotaFeatures[quota]) && (l == 0 || l > limit)
+		}),
+	}
+}
+
+// Invalidate forgets the cached entitlements of the user on every gateway
+// instance, after a change to their subscriptions
+func (s *EntitlementService) Invalidate(ctx context.Context, userID string) error {
+	if err := s.rdb.Del(ctx, entitlementsKey(userID)).Err(); err != nil {
+		return fmt.Errorf("entitlements invalidate: %w", err)
+	}
+
+	return nil
+}
+
+func (s *EntitlementService) check(ctx context.Context, e *entity.Entitlements, feature string) error {
+	if e.Has(feature) {
+		return nil
+	}
+
+	d := &entity.Denial{
+		Status:      http.StatusForbidden,
+		Code:        entity.DenialFeatureNotInPlan,
+		Message:     fmt.Sprintf("the %s plan does not include %s", e.Plan, feature),
+		Entitlement: feature,
+		Plan:        e.Plan,
+		Upgrade: s.upgrade(ctx, func(p *entity.Plan) bool {
+			return slices.Contains(p.Features, feature)
+		}),
+	}
+	if e.Plan == "" {
+		d.Status = http.StatusPaymentRequired
+		d.Code = entity.DenialSubscriptionRequired
+		d.Message = fmt.Sprintf("an active subscription including %s is required", feature)
+	}
+
+	return d
+}
+
+// upgrade lists the plans that allow what was denied, a denial is still
+// answered when the plans cannot be read
+func (s *EntitlementService) upgrade(ctx context.Context, allows func(*entity.Plan) bool) entity.Upgrade {
+	up := entity.Upgrade{Plans: []*entity.Plan{}, Subscribe: _subscribeRoute}
+
+	plans, err := s.planList(ctx)
+	if err != nil {
+		return up
+	}
+	for _, p := range plans {
+		if allows(p) {
+			up.Plans = append(up.Plans, p)
+		}
+	}
+
+	return up
+}
+
+// resolve returns the cached entitlements of the user, or reads them from
+// the active subscription. Without Redis every call asks sub-service
+func (s *EntitlementService) resolve(ctx context.Context, userID string) (*entity.Entitlements, error) {
+	key := entitlementsKey(userID)
+	if raw, err := s.rdb.Get(ctx, key).Bytes(); err == nil {
+		var e entity.Entitlements
+		if err := json.Unmarshal(raw, &e); err == nil {
+			return &e, nil
+		}
+	}
+
+	status, err := s.subs.Status(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &entity.Entitlements{Features: []string{}, Quotas: map[string]int64{}}
+	if status.Active {
+		plans, err := s.planList(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		e.PlanID = status.PlanID
+		for _, p := range plans {
+			if p.ID != status.PlanID {
+				continue
+			}
+			e.Plan = p.Type
+			e.Features = p.Features
+			for quota, limits := range s.quotas {
+				if l := limits.Of(p.Type); l > 0 {
+					e.Quotas[quota] = l
+				}
+			}
+		}
+	}
+
+	if raw, err := json.Marshal(e); err == nil {
+		_ = s.rdb.Set(ctx, key, raw, s.ttl).Err()
+	}
+
+	return e, nil
+}
+
+func (s *EntitlementService) planList(ctx context.Context) ([]*entity.Plan, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	plans, expires := s.plans, s.plansExp
+	s.mu.Unlock()
+	if plans != nil && now.Before(expires) {
+		return plans, nil
+	}
+
+	plans, err := s.subs.GetPlans(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.plans, s.plansExp = plans, now.Add(s.ttl)
+	s.mu.Unlock()
+
+	return plans, nil
+}
+
+// entitlementsKey is the key caching the entitlements of the user
+func entitlementsKey(userID string) string {
+	return "entitlements:" + userID
+}
+
+// quotaKey is the key counting the use of a quota by the user on a UTC day
+func quotaKey(userID, quota string, day time.Time) string {
+	return "quota:" + quota + ":" + userID + ":" + day.Format(time.DateOnly)
+}
+
+// utcDay returns the start of the UTC day of t
+func utcDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	adminpb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/admin/v1"
+	tweetspb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/tweets/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// sentiment labels of x-service and their names in the API
+var _sentimentLabels = map[string]string{
+	"POS": "positive",
+	"NEG": "negative",
+	"NEU": "neutral",
+}
+
+// FeedService reads the market feed of x-service, changes go through its
+// admin API on behalf of the admin making the request
+type FeedService struct {
+	Client tweetspb.TweetServiceClient
+	Admin  adminpb.AdminTweetServiceClient
+}
+
+func NewFeedService(conn *grpc.ClientConn) *FeedService {
+	return &FeedService{
+		Client: tweetspb.NewTweetServiceClient(conn),
+		Admin:  adminpb.NewAdminTweetServiceClient(conn),
+	}
+}
+
+// Latest returns up to limit newest tweets, newest first
+func (s *FeedService) Latest(ctx context.Context, limit int) ([]*entity.Tweet, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.ListLatestTweets(ctx, &tweetspb.ListLatestTweetsRequest{Limit: int32(limit)})
+	if err != nil {
+		return nil, err
+	}
+
+	tweets := make([]*entity.Tweet, len(resp.GetTweets()))
+	for i, t := range resp.GetTweets() {
+		tweets[i] = tweetFromProto(t)
+	}
+
+	return tweets, nil
+}
+
+// List returns a page of tweets, newest first, optionally of a symbol or a
+// sentiment (positive, neutral or negative), and the cursor of the next page,
+// empty on the last one
+func (s *FeedService) List(
+	ctx context.Context,
+	symbol, sentiment string,
+	limit int,
+	cursor string,
+) ([]*entity.Tweet, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.ListTweets(ctx, &tweetspb.ListTweetsRequest{
+		Symbol:         symbol,
+		SentimentLabel: sentimentLabelToProto(sentiment),
+		Limit:          int32(limit),
+		Cursor:         cursor,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	tweets := make([]*entity.Tweet, len(resp.GetTweets()))
+	for i, t := range resp.GetTweets() {
+		tweets[i] = tweetFromProto(t)
+	}
+
+	return tweets, resp.GetNextCursor(), nil
+}
+
+// Get returns a tweet
+func (s *FeedService) Get(ctx context.Context, id string) (*entity.Tweet, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.GetTweetByID(ctx, &tweetspb.GetTweetByIDRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return tweetFromProto(resp.GetTweet()), nil
+}
+
+// SentimentSeries returns the daily sentiment of a symbol between the days of
+// from and to, ordered by day
+func (s *FeedService) SentimentSeries(ctx context.Context, symbol string, from, to time.Time) ([]*entity.SentimentDay, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.GetSentimentSeries(ctx, &tweetspb.GetSentimentSeriesRequest{
+		Symbol: symbol,
+		From:   from.Unix(),
+		To:     to.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*entity.SentimentDay, len(resp.GetDays()))
+	for i, d := range resp.GetDays() {
+		days[i] = &entity.SentimentDay{
+			Day:              d.GetDay(),
+			AvgScore:         d.GetAvgScore(),
+			WeightedAvgScore: d.WeightedAvgScore,
+			Positive:         int(d.GetPositive()),
+			Negative:         int(d.GetNegative()),
+			Neutral:          int(d.GetNeutral()),
+		}
+	}
+
+	return days, nil
+}
+
+// Trending returns the symbols mentioned most within window, most mentioned first
+func (s *FeedService) Trending(ctx context.Context, window time.Duration, limit int) ([]*entity.SymbolTrend, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.ListTrendingSymbols(ctx, &tweetspb.ListTrendingSymbolsRequest{
+		Since: time.Now().Add(-window).Unix(),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trends := make([]*entity.SymbolTrend, len(resp.GetSymbols()))
+	for i, t := range resp.GetSymbols() {
+		trends[i] = &entity.SymbolTrend{
+			Symbol:       t.GetSymbol(),
+			Mentions:     int(t.GetMentions()),
+			AvgSentiment: t.GetAvgSentiment(),
+		}
+	}
+
+	return trends, nil
+}
+
+// CreateTweet adds a tweet to the feed by hand
+func (s *FeedService) CreateTweet(ctx context.Context, token, text, authorID string) (*entity.Tweet, error) {
+	ctx, cancel := adminContext(ctx, token)
+	defer cancel()
+
+	resp, err := s.Admin.CreateTweet(ctx, &adminpb.CreateTweetRequest{Text: text, AuthorId: authorID})
+	if err != nil {
+		return nil, err
+	}
+
+	return tweetFromAdminProto(resp.GetTweet()), nil
+}
+
+// UpdateTweet changes the text and, when sentiment is not empty, the
+// sentiment of a tweet, empty text is left as is
+func (s *FeedService) UpdateTweet(
+	ctx context.Context,
+	token, id, text, sentiment string,
+	score float64,
+) (*entity.Tweet, error) {
+	ctx, cancel := adminContext(ctx, token)
+	defer cancel()
+
+	req := &adminpb.UpdateTweetRequest{Id: id, Text: text}
+	if sentiment != "" {
+		req.Sentiment = &adminpb.Sentiment{Score: score, Label: sentimentLabelToProto(sentiment)}
+	}
+
+	resp, err := s.Admin.UpdateTweet(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return tweetFromAdminProto(resp.GetTweet()), nil
+}
+
+// DeleteTweet removes a tweet from the feed
+func (s *FeedService) DeleteTweet(ctx context.Context, token, id string) error {
+	ctx, cancel := adminContext(ctx, token)
+	defer cancel()
+
+	_, err := s.Admin.DeleteTweet(ctx, &adminpb.DeleteTweetRequest{Id: id})
+	return err
+}
+
+// ReleaseTweet returns a tweet quarantined as spam to the feed
+func (s *FeedService) ReleaseTweet(ctx context.Context, token, id string) error {
+	ctx, cancel := adminContext(ctx, token)
+	defer cancel()
+
+	_, err := s.Admin.ReleaseTweet(ctx, &adminpb.ReleaseTweetRequest{Id: id})
+	return err
+}
+
+// adminContext forwards the access token of the admin, x-service checks the
+// role on its own
+func adminContext(ctx context.Context, token string) (context.Context, context.CancelFunc) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	return context.WithTimeout(ctx, 3*time.Second)
+}
+
+func sentimentLabelFromProto(label string) string {
+	return _sentimentLabels[label]
+}
+
+func sentimentLabelToProto(label string) string {
+	for code, name := range _sentimentLabels {
+		if name == label {
+			return code
+		}
+	}
+
+	return ""
+}
+
+func tweetFromProto(t *tweetspb.Tweet) *entity.Tweet {
+	return &entity.Tweet{
+		ID:             t.GetId(),
+		AuthorID:       t.GetAuthorId(),
+		Username:       t.GetUsername(),
+		Text:           t.GetText(),
+		Lang:           t.GetLang(),
+		CreatedAt:      time.Unix(t.GetCreatedAt(), 0).UTC(),
+		FetchedAt:      time.Unix(t.GetFetchedAt(), 0).UTC(),
+		Likes:          int(t.GetLikes()),
+		Replies:        int(t.GetReplies()),
+		Retweets:       int(t.GetRetweets()),
+		Views:          int(t.GetViews()),
+		URLs:           t.GetUrls(),
+		Photos:         t.GetPhotos(),
+		Videos:         t.GetVideos(),
+		IsFinancial:    t.GetIsFinancial(),
+		Symbols:        t.GetSymbols(),
+		SentimentScore: t.GetSentimentScore(),
+		SentimentLabel: sentimentLabelFromProto(t.GetSentimentLabel()),
+	}
+}
+
+// tweetFromAdminProto maps the admin view of a tweet, it lacks the author
+// name, views and media
+func tweetFromAdminProto(t *adminpb.Tweet) *entity.Tweet {
+	return &entity.Tweet{
+		ID:             t.GetId(),
+		AuthorID:       t.GetAuthorId(),
+		Text:           t.GetText(),
+		CreatedAt:      time.Unix(t.GetCreatedAt(), 0).UTC(),
+		Likes:          int(t.GetEngagement().GetFavoriteCount()),
+		Replies:        int(t.GetEngagement().GetReplyCount()),
+		Retweets:       int(t.GetEngagement().GetRetweetCount()),
+		IsFinancial:    t.GetIsFinancial(),
+		Symbols:        t.GetSymbols(),
+		SentimentScore: t.GetSentiment().GetScore(),
+		SentimentLabel: sentimentLabelFromProto(t.GetSentiment().GetLabel()),
+	}
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+)
+
+// TokenValidator turns an access token into the claims of its owner
+type TokenValidator interface {
+	ValidateToken(ctx context.Context, token string) (*entity.Claims, error)
+}
+
+// APIKeyValidator turns a personal API key into the claims of its owner
+type APIKeyValidator interface {
+	ValidateAPIKey(ctx context.Context, key string) (*entity.Claims, error)
+}
+
+// JWKSVerifier validates access tokens offline with the authz verifier of
+// auth-service, against the public keys it publishes. Revoked tokens stay
+// valid here until they expire, so access tokens should be short-lived.
+type JWKSVerifier struct {
+	verifier authz.Verifier
+}
+
+// NewJWKSVerifier starts fetching the key set from url; ctx ends the refresh goroutine.
+// A failing first fetch is not an error, the set is fetched again on an unknown kid.
+func NewJWKSVerifier(ctx context.Context, url, issuer string, refresh time.Duration) (*JWKSVerifier, error) {
+	v, err := authz.NewJWKSVerifier(ctx, url, issuer, refresh)
+	if err != nil {
+		return nil, fmt.Errorf("authz.NewJWKSVerifier: %w", err)
+	}
+
+	return &JWKSVerifier{verifier: v}, nil
+}
+
+// ValidateToken checks the signature, issuer and expiry of an access token.
+func (v *JWKSVerifier) ValidateToken(ctx context.Context, token string) (*entity.Claims, error) {
+	claims, err := v.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, errors.New("token invalid")
+	}
+
+	return &entity.Claims{
+		UserID:      claims.UserID,
+		Email:       claims.Email,
+		Username:    claims.Username,
+		Role:        claims.Role,
+		Permissions: claims.Permissions,
+		ActorID:     claims.ActorID,
+
+		EmailVerified: claims.EmailVerified,
+	}, nil
+}
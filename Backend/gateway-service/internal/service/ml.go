@@ -2,34 +2,137 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	mlpb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/ml"
 	"google.golang.org/grpc"
 )
 
-type MLService struct{ conn *grpc.ClientConn }
+// Models of ml-service are slow, trend prediction fits a model per request
+const (
+	_sentimentTimeout      = 5 * time.Second
+	_batchSentimentTimeout = 15 * time.Second
+	_trendTimeout          = 30 * time.Second
+	_symbolsTimeout        = 3 * time.Second
+)
+
+// MLService proxies the models of ml-service
+type MLService struct {
+	Client mlpb.MLServiceClient
+}
+
+func NewMLService(conn *grpc.ClientConn) *MLService {
+	return &MLService{Client: mlpb.NewMLServiceClient(conn)}
+}
+
+// AnalyzeSentiment scores the sentiment of a text
+func (s *MLService) AnalyzeSentiment(ctx context.Context, text string) (*entity.Sentiment, error) {
+	ctx, cancel := context.WithTimeout(ctx, _sentimentTimeout)
+	defer cancel()
+
+	resp, err := s.Client.AnalyzeSentiment(ctx, &mlpb.SentimentRequest{Text: text})
+	if err != nil {
+		return nil, err
+	}
+
+	return sentimentFromProto(resp), nil
+}
+
+// BatchAnalyzeSentiment scores the sentiment of texts, results follow the
+// order of texts
+func (s *MLService) BatchAnalyzeSentiment(ctx context.Context, texts []string) ([]*entity.Sentiment, error) {
+	ctx, cancel := context.WithTimeout(ctx, _batchSentimentTimeout)
+	defer cancel()
 
-func NewMLService(conn *grpc.ClientConn) *MLService { return &MLService{conn: conn} }
+	resp, err := s.Client.BatchAnalyzeSentiment(ctx, &mlpb.BatchSentimentRequest{Texts: texts})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetResults()) != len(texts) {
+		return nil, fmt.Errorf("ml-service returned %d results for %d texts", len(resp.GetResults()), len(texts))
+	}
 
-func (m *MLService) Analyze(ctx context.Context, payload []byte) (*entity.Analysis, error) {
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	results := make([]*entity.Sentiment, len(resp.GetResults()))
+	for i, r := range resp.GetResults() {
+		results[i] = sentimentFromProto(r)
+	}
+
+	return results, nil
+}
+
+// PredictTrend forecasts the price of a symbol periods days past its history
+func (s *MLService) PredictTrend(
+	ctx context.Context,
+	symbol string,
+	history []*entity.PricePoint,
+	periods int,
+) (*entity.Trend, error) {
+	ctx, cancel := context.WithTimeout(ctx, _trendTimeout)
 	defer cancel()
 
-	// TODO: call brain / ml proto
-	return &entity.Analysis{
-		Score:    0.8,
-		Insights: []string{"stub insight"},
-		Risk:     "medium",
-	}, nil
+	data := make([]*mlpb.PriceData, len(history))
+	for i, p := range history {
+		data[i] = &mlpb.PriceData{Date: p.Date, Price: p.Price}
+	}
+
+	resp, err := s.Client.PredictTrend(ctx, &mlpb.TrendRequest{
+		Symbol:  symbol,
+		Data:    data,
+		Periods: int32(periods),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dates := resp.GetDates()
+	if len(resp.GetPredictions()) != len(dates) ||
+		len(resp.GetLowerBound()) != len(dates) ||
+		len(resp.GetUpperBound()) != len(dates) {
+		return nil, fmt.Errorf("ml-service returned a malformed trend of %d dates", len(dates))
+	}
+
+	points := make([]*entity.TrendPoint, len(dates))
+	for i, date := range dates {
+		points[i] = &entity.TrendPoint{
+			Date:       date,
+			Prediction: resp.GetPredictions()[i],
+			LowerBound: resp.GetLowerBound()[i],
+			UpperBound: resp.GetUpperBound()[i],
+		}
+	}
+
+	return &entity.Trend{Symbol: symbol, Points: points}, nil
 }
 
-func (m *MLService) Recommend(ctx context.Context) ([]*entity.Recommendation, error) {
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+// Symbols returns the symbols ml-service has models for
+func (s *MLService) Symbols(ctx context.Context) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, _symbolsTimeout)
 	defer cancel()
 
-	// TODO
-	return []*entity.Recommendation{
-		{Type: "BUY", Description: "Consider TSLA", Priority: 1},
-	}, nil
+	resp, err := s.Client.GetSymbols(ctx, &mlpb.GetSymbolsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetSymbols(), nil
+}
+
+func sentimentFromProto(r *mlpb.SentimentResponse) *entity.Sentiment {
+	s := &entity.Sentiment{
+		Positive: r.GetPositive(),
+		Neutral:  r.GetNeutral(),
+		Negative: r.GetNegative(),
+		Label:    "neutral",
+	}
+
+	switch {
+	case s.Positive > s.Neutral && s.Positive >= s.Negative:
+		s.Label = "positive"
+	case s.Negative > s.Neutral && s.Negative > s.Positive:
+		s.Label = "negative"
+	}
+
+	return s
 }
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+// Route classes with limits of their own
+const (
+	RoutePublic = "public" // by client address
+	RouteClient = "client" // by client address, every authenticated route before Auth
+	RouteAPI    = "api"    // every authenticated route
+	RouteChat   = "chat"   // chat messages
+	RouteML     = "ml"
+)
+
+// _freeTier are users without an active subscription
+const _freeTier = "free"
+
+// _gcra is the generic cell rate algorithm over the theoretical arrival time
+// of KEYS[1] in milliseconds of the Redis clock, shared by every gateway
+// instance. ARGV are the emission interval in milliseconds and the burst. It
+// returns whether the request is allowed, the requests remaining, and the
+// milliseconds until a retry can succeed and until the limit is full again.
+var _gcra = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local tat = tonumber(redis.call("GET", KEYS[1])) or now
+if tat < now then
+	tat = now
+end
+
+local new_tat = tat + interval
+local diff = now - (new_tat - interval * burst)
+if diff < 0 then
+	return {0, 0, math.ceil(-diff), math.ceil(tat - now)}
+end
+
+redis.call("SET", KEYS[1], string.format("%.3f", new_tat), "PX", math.ceil(new_tat - now))
+return {1, math.floor(diff / interval), 0, math.ceil(new_tat - now)}
+`)
+
+// RateLimit is the outcome of counting a request
+type RateLimit struct {
+	Allowed    bool
+	Limit      int // requests per minute, 0 is unlimited
+	Remaining  int
+	RetryAfter time.Duration // until a denied request can be retried
+	Reset      time.Duration // until the full limit is available again
+}
+
+// RateLimiter limits requests per minute in Redis. Public routes are limited
+// per client address, the others per client address until the token is
+// checked and then per API key or user by the plan type of the user, so
+// upgrading raises them
+type RateLimiter struct {
+	rdb       redis.Scripter
+	ents      *EntitlementService
+	addresses map[string]int            // limits of requests without claims by route class
+	routes    map[string]map[string]int // limits by route class and plan type
+}
+
+func NewRateLimiter(rdb redis.Scripter, ents *EntitlementService, cfg config.RateLimit) *RateLimiter {
+	return &RateLimiter{
+		rdb:  rdb,
+		ents: ents,
+		addresses: map[string]int{
+			RoutePublic: cfg.Public,
+			RouteClient: cfg.Client,
+		},
+		routes: map[string]map[string]int{
+			RouteAPI:  cfg.API,
+			RouteChat: cfg.Chat,
+			RouteML:   cfg.ML,
+		},
+	}
+}
+
+// Allow counts a request to a route class, claims are nil on public routes
+// and before Auth
+func (r *RateLimiter) Allow(ctx context.Context, route string, claims *entity.Claims, clientIP string) (*RateLimit, error) {
+	limit, subject := r.addresses[route], "ip:"+clientIP
+	if claims != nil {
+		limit, subject = r.limit(ctx, route, claims), "user:"+claims.UserID
+		if claims.APIKeyID != "" {
+			subject = "key:" + claims.APIKeyID
+		}
+	}
+	if limit <= 0 {
+		return &RateLimit{Allowed: true}, nil
+	}
+
+	interval := float64(time.Minute.Milliseconds()) / float64(limit)
+	res, err := _gcra.Run(ctx, r.rdb, []string{"ratelimit:" + route + ":" + subject}, interval, limit).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("rate limit: %w", err)
+	}
+
+	return &RateLimit{
+		Allowed:    res[0] == 1,
+		Limit:      limit,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+		Reset:      time.Duration(res[3]) * time.Millisecond,
+	}, nil
+}
+
+// limit returns the limit of the plan type of the user, the free one when
+// the plan cannot be read or has no limit of its own
+func (r *RateLimiter) limit(ctx context.Context, route string, claims *entity.Claims) int {
+	limits := r.routes[route]
+
+	e, err := r.ents.resolve(ctx, claims.UserID)
+	if err == nil && e.Plan != "" {
+		if l, ok := limits[e.Plan]; ok {
+			return l
+		}
+	}
+
+	return limits[_freeTier]
+}
@@ -1,9 +1,11 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -11,7 +13,13 @@ import (
 // Services is a DI-container exposed to handlers
 type Services struct {
 	Auth         *AuthService
+	Tokens       TokenValidator
 	Subscription *SubscriptionService
+	Entitlements *EntitlementService
+	RateLimiter  *RateLimiter
+	Cache        *ResponseCache
+	Chat         *ChatService
+	Feed         *FeedService
 	ML           *MLService
 }
 
@@ -35,14 +43,51 @@ func NewServices(cfg *config.Config) (*Services, error) {
 		return nil, fmt.Errorf("sub dial: %w", err)
 	}
 
+	brainConn, err := dial(cfg.Services.Brain)
+	if err != nil {
+		return nil, fmt.Errorf("brain dial: %w", err)
+	}
+
+	xConn, err := dial(cfg.Services.X)
+	if err != nil {
+		return nil, fmt.Errorf("x dial: %w", err)
+	}
+
 	mlConn, err := dial(cfg.Services.ML)
 	if err != nil {
 		return nil, fmt.Errorf("ml dial: %w", err)
 	}
 
+	auth := NewAuthService(authConn)
+
+	// verify tokens locally when auth-service publishes its keys,
+	// otherwise every request asks auth-service over gRPC
+	var tokens TokenValidator = auth
+	if cfg.JWT.JWKSURL != "" {
+		tokens, err = NewJWKSVerifier(context.Background(), cfg.JWT.JWKSURL, cfg.JWT.Issuer, cfg.JWT.JWKSRefreshInterval)
+		if err != nil {
+			return nil, fmt.Errorf("jwks verifier: %w", err)
+		}
+	}
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr(),
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+
+	subs := NewSubscriptionService(subConn)
+	ents := NewEntitlementService(rdb, subs, cfg.Entitlements)
+
 	return &Services{
-		Auth:         NewAuthService(authConn),
-		Subscription: NewSubscriptionService(subConn),
+		Auth:         auth,
+		Tokens:       tokens,
+		Subscription: subs,
+		Entitlements: ents,
+		RateLimiter:  NewRateLimiter(rdb, ents, cfg.RateLimit),
+		Cache:        NewResponseCache(rdb, cfg.Cache),
+		Chat:         NewChatService(brainConn),
+		Feed:         NewFeedService(xConn),
 		ML:           NewMLService(mlConn),
 	}, nil
 }
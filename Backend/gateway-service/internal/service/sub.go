@@ -2,52 +2,295 @@ package service
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	subpb "github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/subscription/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// sub-service trusts its callers with any user id, so every call for a
+// subscription checks it belongs to the user of the request first. Errors are
+// gRPC statuses, the handlers translate them into HTTP statuses.
 type SubscriptionService struct {
-	// TODO: once proto is defined, add pb client
-	conn *grpc.ClientConn
+	Client subpb.SubscriptionServiceClient
 }
 
 func NewSubscriptionService(conn *grpc.ClientConn) *SubscriptionService {
-	return &SubscriptionService{conn: conn}
+	return &SubscriptionService{Client: subpb.NewSubscriptionServiceClient(conn)}
 }
 
-func (s *SubscriptionService) GetPlans(ctx context.Context) ([]*entity.Plan, error) {
+// errSubscriptionNotFound hides the subscriptions of other users
+var errSubscriptionNotFound = status.Error(codes.NotFound, "subscription not found")
+
+// GetPlans returns the plans of the type, or all plans when planType is empty
+func (s *SubscriptionService) GetPlans(ctx context.Context, planType string) ([]*entity.Plan, error) {
+	pt, err := planTypeToProto(planType)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
-	// TODO: call real gRPC.  Placeholder:
-	return []*entity.Plan{
-		{ID: "free", Name: "Free", Description: "Basic", Price: 0},
-		{ID: "pro", Name: "Pro", Description: "Everything", Price: 19.99},
+	resp, err := s.Client.GetPlans(ctx, &subpb.GetPlansRequest{Type: pt})
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*entity.Plan, len(resp.GetPlans()))
+	for i, p := range resp.GetPlans() {
+		plans[i] = planFromProto(p)
+	}
+
+	return plans, nil
+}
+
+// Subscribe creates a pending subscription of the user to the plan, it is
+// activated by paying for it
+func (s *SubscriptionService) Subscribe(
+	ctx context.Context,
+	userID, planID string,
+	autoRenew bool,
+	paymentMethod string,
+) (*entity.Subscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.CreateSubscription(ctx, &subpb.CreateSubscriptionRequest{
+		UserId:        userID,
+		PlanId:        planID,
+		AutoRenew:     autoRenew,
+		PaymentMethod: paymentMethod,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptionFromProto(resp.GetSubscription()), nil
+}
+
+// List returns a page of the user's subscriptions with the given status, or
+// of all of them when status is empty, and the number of matching subscriptions
+func (s *SubscriptionService) List(
+	ctx context.Context,
+	userID, status string,
+	limit, offset int,
+) ([]*entity.Subscription, int, error) {
+	st, err := subscriptionStatusToProto(status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	resp, err := s.Client.ListSubscriptions(ctx, &subpb.ListSubscriptionsRequest{
+		UserId: userID,
+		Status: st,
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	subs := make([]*entity.Subscription, len(resp.GetSubscriptions()))
+	for i, sub := range resp.GetSubscriptions() {
+		subs[i] = subscriptionFromProto(sub)
+	}
+
+	return subs, int(resp.GetTotal()), nil
+}
+
+// Get returns a subscription of the user
+func (s *SubscriptionService) Get(ctx context.Context, userID, id string) (*entity.Subscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	sub, err := s.owned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptionFromProto(sub), nil
+}
+
+// Status returns the active subscription of the user, if any
+func (s *SubscriptionService) Status(ctx context.Context, userID string) (*entity.Status, error) {
+	subs, _, err := s.List(ctx, userID, "active", 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) == 0 {
+		return &entity.Status{Active: false}, nil
+	}
+
+	sub := subs[0]
+	return &entity.Status{
+		Active:         true,
+		SubscriptionID: sub.ID,
+		PlanID:         sub.PlanID,
+		StartDate:      sub.StartDate.Format(time.RFC3339),
+		EndDate:        sub.EndDate.Format(time.RFC3339),
+		AutoRenew:      sub.AutoRenew,
 	}, nil
 }
 
-func (s *SubscriptionService) Subscribe(ctx context.Context, planID string) error {
-	_, cancel := context.WithTimeout(ctx, 2*time.Second)
+// Update changes the renewal and payment method of a subscription of the user
+func (s *SubscriptionService) Update(
+	ctx context.Context,
+	userID, id string,
+	autoRenew bool,
+	paymentMethod string,
+) (*entity.Subscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
-	// TODO
-	return nil
+	current, err := s.owned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if paymentMethod == "" {
+		paymentMethod = current.GetPaymentMethod()
+	}
+
+	resp, err := s.Client.UpdateSubscription(ctx, &subpb.UpdateSubscriptionRequest{
+		SubscriptionId: id,
+		AutoRenew:      autoRenew,
+		PaymentMethod:  paymentMethod,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptionFromProto(resp.GetSubscription()), nil
 }
 
-func (s *SubscriptionService) Status(ctx context.Context) (*entity.Status, error) {
+// Cancel cancels a subscription of the user
+func (s *SubscriptionService) Cancel(ctx context.Context, userID, id, reason string) (*entity.Subscription, error) {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
-	// TODO
-	return &entity.Status{Active: false}, nil
+	if _, err := s.owned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Client.CancelSubscription(ctx, &subpb.CancelSubscriptionRequest{
+		SubscriptionId: id,
+		Reason:         reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptionFromProto(resp.GetSubscription()), nil
 }
 
-func (s *SubscriptionService) Cancel(ctx context.Context) error {
-	_, cancel := context.WithTimeout(ctx, 2*time.Second)
+// Pay pays for a subscription of the user with the price of its plan
+func (s *SubscriptionService) Pay(ctx context.Context, userID, id, paymentMethod string) (*entity.Payment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	// TODO
-	return nil
+	sub, err := s.owned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if paymentMethod == "" {
+		paymentMethod = sub.GetPaymentMethod()
+	}
+
+	resp, err := s.Client.ProcessPayment(ctx, &subpb.ProcessPaymentRequest{
+		SubscriptionId: id,
+		Amount:         sub.GetAmountPaid(),
+		Currency:       sub.GetCurrency(),
+		PaymentMethod:  paymentMethod,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Payment{
+		ID:            resp.GetPaymentId(),
+		Status:        enumName(resp.GetStatus().String(), "PAYMENT_STATUS_"),
+		TransactionID: resp.GetTransactionId(),
+		Timestamp:     time.Unix(resp.GetTimestamp(), 0).UTC(),
+	}, nil
+}
+
+// owned returns the subscription if it belongs to the user
+func (s *SubscriptionService) owned(ctx context.Context, userID, id string) (*subpb.Subscription, error) {
+	resp, err := s.Client.GetSubscription(ctx, &subpb.GetSubscriptionRequest{SubscriptionId: id})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetSubscription().GetUserId() != userID {
+		return nil, errSubscriptionNotFound
+	}
+
+	return resp.GetSubscription(), nil
+}
+
+func planFromProto(p *subpb.Plan) *entity.Plan {
+	return &entity.Plan{
+		ID:           p.GetId(),
+		Name:         p.GetName(),
+		Description:  p.GetDescription(),
+		Type:         enumName(p.GetType().String(), "PLAN_TYPE_"),
+		Price:        p.GetPrice(),
+		Currency:     p.GetCurrency(),
+		DurationDays: int(p.GetDurationDays()),
+		Features:     p.GetFeatures(),
+	}
+}
+
+func subscriptionFromProto(s *subpb.Subscription) *entity.Subscription {
+	return &entity.Subscription{
+		ID:              s.GetId(),
+		PlanID:          s.GetPlanId(),
+		Status:          enumName(s.GetStatus().String(), "SUBSCRIPTION_STATUS_"),
+		StartDate:       time.Unix(s.GetStartDate(), 0).UTC(),
+		EndDate:         time.Unix(s.GetEndDate(), 0).UTC(),
+		AutoRenew:       s.GetAutoRenew(),
+		AmountPaid:      s.GetAmountPaid(),
+		Currency:        s.GetCurrency(),
+		PaymentMethod:   s.GetPaymentMethod(),
+		LastPaymentDate: time.Unix(s.GetLastPaymentDate(), 0).UTC(),
+		NextPaymentDate: time.Unix(s.GetNextPaymentDate(), 0).UTC(),
+	}
+}
+
+// planTypeToProto parses basic, pro or enterprise, empty is unspecified
+func planTypeToProto(name string) (subpb.PlanType, error) {
+	if name == "" {
+		return subpb.PlanType_PLAN_TYPE_UNSPECIFIED, nil
+	}
+	v, ok := subpb.PlanType_value["PLAN_TYPE_"+strings.ToUpper(name)]
+	if !ok {
+		return 0, status.Error(codes.InvalidArgument, "invalid plan type")
+	}
+
+	return subpb.PlanType(v), nil
+}
+
+// subscriptionStatusToProto parses active, cancelled, expired or pending, empty is unspecified
+func subscriptionStatusToProto(name string) (subpb.SubscriptionStatus, error) {
+	if name == "" {
+		return subpb.SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED, nil
+	}
+	v, ok := subpb.SubscriptionStatus_value["SUBSCRIPTION_STATUS_"+strings.ToUpper(name)]
+	if !ok {
+		return 0, status.Error(codes.InvalidArgument, "invalid subscription status")
+	}
+
+	return subpb.SubscriptionStatus(v), nil
+}
+
+// enumName returns the lower-case name of an enum value without its prefix,
+// e.g. SUBSCRIPTION_STATUS_ACTIVE is active
+func enumName(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
 }
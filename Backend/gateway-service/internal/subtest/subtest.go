@@ -0,0 +1,214 @@
+// Package subtest provides an in-process sub-service for tests of the
+// gateway
+package subtest
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	subpb "github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/subscription/v1"
+)
+
+// Plans of the server
+const (
+	BasicPlanID = "11111111-1111-4111-8111-111111111111"
+	ProPlanID   = "22222222-2222-4222-8222-222222222222"
+)
+
+// Server is an in-process sub-service, users subscribe to the plan in Active,
+// the others have no active subscription. The subscription of a user has the
+// id SubscriptionID(user). Like sub-service it trusts callers with any id
+type Server struct {
+	subpb.UnimplementedSubscriptionServiceServer
+
+	Active map[string]string // plan id by user id, changed by Activate once served
+
+	// Err fails every call with the error when set
+	Err error
+
+	// Lookups counts the listings of subscriptions
+	Lookups atomic.Int32
+
+	mu        sync.Mutex
+	cancelled []string
+	paid      []string
+}
+
+// SubscriptionID returns the id of the subscription of the user
+func SubscriptionID(userID string) string {
+	return "s-" + userID
+}
+
+// Cancelled returns the ids of the cancelled subscriptions
+func (s *Server) Cancelled() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.cancelled...)
+}
+
+// Paid returns the ids of the subscriptions paid for
+func (s *Server) Paid() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.paid...)
+}
+
+func (s *Server) GetPlans(context.Context, *subpb.GetPlansRequest) (*subpb.GetPlansResponse, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	return &subpb.GetPlansResponse{Plans: []*subpb.Plan{
+		{Id: BasicPlanID, Name: "Basic Plan", Type: subpb.PlanType_PLAN_TYPE_BASIC, Features: []string{entity.FeatureChat}},
+		{Id: ProPlanID, Name: "Pro Plan", Type: subpb.PlanType_PLAN_TYPE_PRO, Features: []string{
+			entity.FeatureChat, entity.FeatureRealtimeFeed, entity.FeatureML,
+		}},
+	}}, nil
+}
+
+func (s *Server) ListSubscriptions(_ context.Context, req *subpb.ListSubscriptionsRequest) (*subpb.ListSubscriptionsResponse, error) {
+	s.Lookups.Add(1)
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	sub, ok := s.subscription(req.GetUserId())
+	if !ok || req.GetStatus() != subpb.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE {
+		return &subpb.ListSubscriptionsResponse{}, nil
+	}
+
+	return &subpb.ListSubscriptionsResponse{Subscriptions: []*subpb.Subscription{sub}, Total: 1}, nil
+}
+
+func (s *Server) CreateSubscription(_ context.Context, req *subpb.CreateSubscriptionRequest) (*subpb.CreateSubscriptionResponse, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	if req.GetPlanId() != BasicPlanID && req.GetPlanId() != ProPlanID {
+		return nil, status.Error(codes.InvalidArgument, "unknown plan")
+	}
+	if _, ok := s.subscription(req.GetUserId()); ok {
+		return nil, status.Error(codes.AlreadyExists, "user already has an active subscription")
+	}
+
+	return &subpb.CreateSubscriptionResponse{Subscription: &subpb.Subscription{
+		Id:            SubscriptionID(req.GetUserId()),
+		UserId:        req.GetUserId(),
+		PlanId:        req.GetPlanId(),
+		Status:        subpb.SubscriptionStatus_SUBSCRIPTION_STATUS_PENDING,
+		AutoRenew:     req.GetAutoRenew(),
+		PaymentMethod: req.GetPaymentMethod(),
+	}}, nil
+}
+
+func (s *Server) GetSubscription(_ context.Context, req *subpb.GetSubscriptionRequest) (*subpb.GetSubscriptionResponse, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	sub, err := s.byID(req.GetSubscriptionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &subpb.GetSubscriptionResponse{Subscription: sub}, nil
+}
+
+func (s *Server) UpdateSubscription(_ context.Context, req *subpb.UpdateSubscriptionRequest) (*subpb.UpdateSubscriptionResponse, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	sub, err := s.byID(req.GetSubscriptionId())
+	if err != nil {
+		return nil, err
+	}
+	sub.AutoRenew = req.GetAutoRenew()
+	sub.PaymentMethod = req.GetPaymentMethod()
+
+	return &subpb.UpdateSubscriptionResponse{Subscription: sub}, nil
+}
+
+func (s *Server) CancelSubscription(_ context.Context, req *subpb.CancelSubscriptionRequest) (*subpb.CancelSubscriptionResponse, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	sub, err := s.byID(req.GetSubscriptionId())
+	if err != nil {
+		return nil, err
+	}
+	sub.Status = subpb.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELLED
+
+	s.mu.Lock()
+	s.cancelled = append(s.cancelled, sub.GetId())
+	s.mu.Unlock()
+
+	return &subpb.CancelSubscriptionResponse{Subscription: sub}, nil
+}
+
+func (s *Server) ProcessPayment(_ context.Context, req *subpb.ProcessPaymentRequest) (*subpb.ProcessPaymentResponse, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	if _, err := s.byID(req.GetSubscriptionId()); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.paid = append(s.paid, req.GetSubscriptionId())
+	s.mu.Unlock()
+
+	return &subpb.ProcessPaymentResponse{
+		PaymentId: "p-" + req.GetSubscriptionId(),
+		Status:    subpb.PaymentStatus_PAYMENT_STATUS_COMPLETED,
+	}, nil
+}
+
+// Activate subscribes the user to the plan, the way a payment does
+func (s *Server) Activate(userID, planID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Active == nil {
+		s.Active = make(map[string]string)
+	}
+	s.Active[userID] = planID
+}
+
+// subscription returns the active subscription of the user
+func (s *Server) subscription(userID string) (*subpb.Subscription, bool) {
+	s.mu.Lock()
+	planID, ok := s.Active[userID]
+	s.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	return &subpb.Subscription{
+		Id:            SubscriptionID(userID),
+		UserId:        userID,
+		PlanId:        planID,
+		Status:        subpb.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE,
+		AmountPaid:    9.99,
+		Currency:      "USD",
+		PaymentMethod: "card",
+	}, true
+}
+
+// byID returns the subscription with the id, whoever its user is
+func (s *Server) byID(id string) (*subpb.Subscription, error) {
+	sub, ok := s.subscription(strings.TrimPrefix(id, SubscriptionID("")))
+	if !ok {
+		return nil, status.Error(codes.NotFound, "subscription not found")
+	}
+
+	return sub, nil
+}
@@ -0,0 +1,388 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/status"
+)
+
+// Client is a WebSocket connection of a signed-in user. Every write goes
+// through the send queue and the write pump, the read pump handles requests
+type Client struct {
+	hub    *Hub
+	conn   *websocket.Conn
+	claims *entity.Claims
+	send   chan outbound
+
+	// ctx ends when the connection closes, closeCode and closeText are sent
+	// in the close frame
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+	closeCode int
+	closeText string
+
+	mu       sync.Mutex
+	topics   map[string]struct{}
+	streams  map[string]context.CancelFunc // chat answers by request id
+	draining bool
+	wg       sync.WaitGroup // one per chat answer
+}
+
+func newClient(h *Hub, conn *websocket.Conn, claims *entity.Claims) *Client {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Client{
+		hub:       h,
+		conn:      conn,
+		claims:    claims,
+		send:      make(chan outbound, h.cfg.SendBuffer),
+		ctx:       ctx,
+		cancel:    cancel,
+		closeCode: websocket.CloseNormalClosure,
+		topics:    make(map[string]struct{}),
+		streams:   make(map[string]context.CancelFunc),
+	}
+}
+
+// run serves the connection until it closes and its chat answers stop
+func (c *Client) run() {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.writePump()
+	}()
+
+	c.readPump()
+	c.close(websocket.CloseNormalClosure, "")
+
+	<-done
+	c.wg.Wait()
+}
+
+// close ends the connection, only the first code and text are sent
+func (c *Client) close(code int, text string) {
+	c.closeOnce.Do(func() {
+		c.closeCode, c.closeText = code, text
+		c.cancel()
+	})
+}
+
+// drain takes no more chat messages and closes the connection once the
+// answers in flight are complete
+func (c *Client) drain() {
+	c.mu.Lock()
+	c.draining = true
+	idle := len(c.streams) == 0
+	c.mu.Unlock()
+
+	if idle {
+		c.close(websocket.CloseGoingAway, "server shutting down")
+	}
+}
+
+func (c *Client) readPump() {
+	cfg := c.hub.cfg
+
+	c.conn.SetReadLimit(cfg.MaxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(cfg.PongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(cfg.PongTimeout))
+	})
+
+	for {
+		// fails once the peer goes away, misses pongs, exceeds the size
+		// limit or the write pump closes the connection
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var in inbound
+		if err := json.Unmarshal(data, &in); err != nil {
+			c.fail(in.ID, "malformed message")
+			continue
+		}
+		c.handle(in)
+	}
+}
+
+func (c *Client) writePump() {
+	cfg := c.hub.cfg
+
+	ticker := time.NewTicker(cfg.PingInterval)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+	}()
+
+	for {
+		select {
+		case msg := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(cfg.WriteTimeout))
+			if err := c.conn.WriteJSON(msg); err != nil {
+				c.cancel()
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(cfg.WriteTimeout)); err != nil {
+				c.cancel()
+				return
+			}
+		case <-c.ctx.Done():
+			// flush what is queued, e.g. the end of answers completed
+			// during drain, then say goodbye
+			deadline := time.Now().Add(cfg.WriteTimeout)
+			_ = c.conn.SetWriteDeadline(deadline)
+			for len(c.send) > 0 {
+				if err := c.conn.WriteJSON(<-c.send); err != nil {
+					return
+				}
+			}
+			_ = c.conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(c.closeCode, c.closeText), deadline)
+			return
+		}
+	}
+}
+
+// enqueue queues msg, waiting for room in the send queue until ctx ends.
+// Replies and chat answers slow down to the pace of the client this way
+func (c *Client) enqueue(ctx context.Context, msg outbound) bool {
+	select {
+	case c.send <- msg:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// offer queues a feed event without waiting, a client that cannot keep up
+// with the feed is disconnected rather than holding up everyone else
+func (c *Client) offer(msg outbound) {
+	select {
+	case c.send <- msg:
+	default:
+		c.close(websocket.CloseTryAgainLater, "too slow")
+	}
+}
+
+func (c *Client) fail(id, msg string) {
+	c.enqueue(c.ctx, outbound{ID: id, Type: typeError, Error: msg})
+}
+
+func (c *Client) handle(in inbound) {
+	switch in.Type {
+	case typePing:
+		c.enqueue(c.ctx, outbound{ID: in.ID, Type: typePong})
+	case typeSubscribe:
+		c.subscribe(in)
+	case typeUnsubscribe:
+		c.unsubscribe(in)
+	case typeChatSend:
+		c.startChat(in)
+	case typeChatCancel:
+		c.cancelChat(in)
+	default:
+		c.fail(in.ID, "unknown message type")
+	}
+}
+
+func (c *Client) subscribe(in inbound) {
+	topic, err := parseTopic(in.Topic)
+	if err != nil {
+		c.fail(in.ID, err.Error())
+		return
+	}
+	if err := c.hub.ents.Require(c.ctx, c.claims, entity.FeatureRealtimeFeed); err != nil {
+		c.deny(in.ID, err)
+		return
+	}
+
+	c.mu.Lock()
+	_, ok := c.topics[topic]
+	if !ok && len(c.topics) >= c.hub.cfg.MaxSubscriptions {
+		c.mu.Unlock()
+		c.fail(in.ID, "too many subscriptions")
+		return
+	}
+	c.topics[topic] = struct{}{}
+	c.mu.Unlock()
+
+	c.enqueue(c.ctx, outbound{ID: in.ID, Type: typeSubscribed, Topic: topic})
+}
+
+func (c *Client) unsubscribe(in inbound) {
+	topic, err := parseTopic(in.Topic)
+	if err != nil {
+		c.fail(in.ID, err.Error())
+		return
+	}
+
+	c.mu.Lock()
+	delete(c.topics, topic)
+	c.mu.Unlock()
+
+	c.enqueue(c.ctx, outbound{ID: in.ID, Type: typeUnsubscribed, Topic: topic})
+}
+
+// subscribed returns the first of topics the client is subscribed to
+func (c *Client) subscribed(topics []string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, topic := range topics {
+		if _, ok := c.topics[topic]; ok {
+			return topic, true
+		}
+	}
+
+	return "", false
+}
+
+func (c *Client) hasTopics() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.topics) > 0
+}
+
+// startChat sends a chat message to brain-service and relays the answer,
+// answers run concurrently with the read pump and are told apart by the id
+// of their request
+func (c *Client) startChat(in inbound) {
+	switch {
+	case c.claims.ActorID != "":
+		c.fail(in.ID, "not allowed while impersonating")
+		return
+	case in.ID == "":
+		c.fail(in.ID, "id is required")
+		return
+	case in.Content == "":
+		c.fail(in.ID, "content is required")
+		return
+	}
+
+	c.mu.Lock()
+	var reason string
+	switch _, dup := c.streams[in.ID]; {
+	case c.draining:
+		reason = "server shutting down"
+	case dup:
+		reason = "id is already in use"
+	case len(c.streams) >= c.hub.cfg.MaxChatStreams:
+		reason = "too many chat messages in flight"
+	}
+	if reason != "" {
+		c.mu.Unlock()
+		c.fail(in.ID, reason)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, c.hub.cfg.ChatTimeout)
+	c.streams[in.ID] = cancel
+	c.wg.Add(1)
+	c.mu.Unlock()
+
+	go func() {
+		defer c.wg.Done()
+		defer c.finishChat(in.ID)
+
+		c.relayChat(ctx, in)
+	}()
+}
+
+func (c *Client) relayChat(ctx context.Context, in inbound) {
+	if err := c.hub.ents.Consume(ctx, c.claims, entity.QuotaChatMessages); err != nil {
+		c.deny(in.ID, err)
+		return
+	}
+
+	stream, err := c.hub.chat.StreamMessage(ctx, c.claims.UserID, in.ChatID, in.Content)
+	if err != nil {
+		c.failChat(ctx, in.ID, err)
+		return
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			c.failChat(ctx, in.ID, err)
+			return
+		}
+
+		if chunk := resp.GetContentChunk(); chunk != "" {
+			if !c.enqueue(ctx, outbound{ID: in.ID, Type: typeChatChunk, ChatID: resp.GetChatId(), Content: chunk}) {
+				return
+			}
+		}
+		if resp.GetIsFinal() {
+			c.enqueue(ctx, outbound{
+				ID:         in.ID,
+				Type:       typeChatDone,
+				ChatID:     resp.GetChatId(),
+				TokensUsed: resp.GetTokensUsed(),
+			})
+		}
+	}
+}
+
+// failChat reports a failed answer unless the connection is gone
+func (c *Client) failChat(ctx context.Context, id string, err error) {
+	if c.ctx.Err() != nil {
+		return
+	}
+
+	msg := status.Convert(err).Message()
+	if errors.Is(ctx.Err(), context.Canceled) {
+		msg = "cancelled"
+	}
+	c.enqueue(c.ctx, outbound{ID: id, Type: typeError, Code: status.Code(err).String(), Error: msg})
+}
+
+// deny reports a request the plan of the user refuses, with the denial the
+// HTTP API would answer
+func (c *Client) deny(id string, err error) {
+	var denial *entity.Denial
+	if !errors.As(err, &denial) {
+		c.fail(id, "entitlements unavailable")
+		return
+	}
+	c.enqueue(c.ctx, outbound{ID: id, Type: typeError, Code: denial.Code, Error: denial.Message, Data: denial})
+}
+
+func (c *Client) cancelChat(in inbound) {
+	c.mu.Lock()
+	cancel, ok := c.streams[in.ID]
+	c.mu.Unlock()
+
+	if !ok {
+		c.fail(in.ID, "no chat message in flight with this id")
+		return
+	}
+	cancel()
+}
+
+func (c *Client) finishChat(id string) {
+	c.mu.Lock()
+	if cancel, ok := c.streams[id]; ok {
+		cancel()
+		delete(c.streams, id)
+	}
+	idle := c.draining && len(c.streams) == 0
+	c.mu.Unlock()
+
+	if idle {
+		c.close(websocket.CloseGoingAway, "server shutting down")
+	}
+}
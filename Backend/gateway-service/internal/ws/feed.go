@@ -0,0 +1,81 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+)
+
+// sentiment of a tweet as of the last poll
+type sentiment struct {
+	score float64
+	label string
+}
+
+// Run polls x-service for the newest tweets while anyone is subscribed and
+// publishes new tweets and changed sentiment, it returns when ctx ends
+func (h *Hub) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.cfg.FeedPollInterval)
+	defer ticker.Stop()
+
+	// tweets of the last poll, nil until the first one
+	var window map[string]sentiment
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !h.hasSubscribers() {
+			window = nil
+			continue
+		}
+
+		tweets, err := h.feed.Latest(ctx, h.cfg.FeedBatch)
+		if err != nil {
+			h.l.Error(fmt.Errorf("ws - Hub - Run - h.feed.Latest: %w", err))
+			continue
+		}
+
+		window = h.publish(tweets, window)
+	}
+}
+
+// publish sends tweets missing from window to tweet subscribers and sentiment
+// that differs from window to sentiment subscribers, the first poll only
+// fills the window. It returns the window of the next poll
+func (h *Hub) publish(tweets []*entity.Tweet, window map[string]sentiment) map[string]sentiment {
+	next := make(map[string]sentiment, len(tweets))
+
+	// oldest first, clients get the feed in order
+	for i := len(tweets) - 1; i >= 0; i-- {
+		t := tweets[i]
+		s := sentiment{score: t.SentimentScore, label: t.SentimentLabel}
+		next[t.ID] = s
+
+		if window == nil {
+			continue
+		}
+
+		prev, seen := window[t.ID]
+		if !seen {
+			h.broadcast(topicsOf(topicTweets, t), outbound{Type: typeTweet, Data: t})
+		}
+		if (!seen || prev != s) && s.label != "" {
+			h.broadcast(topicsOf(topicSentiment, t), outbound{
+				Type: typeSentiment,
+				Data: sentimentUpdate{
+					TweetID: t.ID,
+					Symbols: t.Symbols,
+					Score:   s.score,
+					Label:   s.label,
+				},
+			})
+		}
+	}
+
+	return next
+}
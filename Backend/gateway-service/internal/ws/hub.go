@@ -0,0 +1,154 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/logger"
+	"github.com/gorilla/websocket"
+)
+
+// Hub keeps the WebSocket connections of the gateway, relays chat answers of
+// brain-service to them and fans the market feed of x-service out to their
+// subscriptions
+type Hub struct {
+	cfg  config.WS
+	chat *service.ChatService
+	feed *service.FeedService
+	ents *service.EntitlementService
+	l    logger.Interface
+
+	mu      sync.RWMutex
+	clients map[*Client]struct{}
+	closed  bool
+	wg      sync.WaitGroup // one per served connection
+}
+
+func NewHub(
+	cfg config.WS,
+	chat *service.ChatService,
+	feed *service.FeedService,
+	ents *service.EntitlementService,
+	l logger.Interface,
+) *Hub {
+	return &Hub{
+		cfg:     cfg,
+		chat:    chat,
+		feed:    feed,
+		ents:    ents,
+		l:       l,
+		clients: make(map[*Client]struct{}),
+	}
+}
+
+// Accepting reports whether the hub takes new connections, it stops on Shutdown
+func (h *Hub) Accepting() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return !h.closed
+}
+
+// Serve runs an upgraded connection of the user until it closes
+func (h *Hub) Serve(conn *websocket.Conn, claims *entity.Claims) {
+	c := newClient(h, conn, claims)
+	if !h.register(c) {
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
+			time.Now().Add(h.cfg.WriteTimeout))
+		_ = conn.Close()
+		return
+	}
+	defer h.unregister(c)
+
+	c.run()
+}
+
+// Shutdown stops taking connections and closes the open ones with 1001 Going
+// Away once their chat answers are complete. Connections still answering when
+// ctx ends are closed right away.
+//
+// Connections are hijacked from net/http, so http.Server.Shutdown does not
+// wait for them.
+func (h *Hub) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = true
+	clients := slices.Collect(maps.Keys(h.clients))
+	h.mu.Unlock()
+
+	h.l.Info("ws - Hub - Shutdown: draining %d connections", len(clients))
+	for _, c := range clients {
+		c.drain()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		for _, c := range clients {
+			c.close(websocket.CloseGoingAway, "server shutting down")
+		}
+		return fmt.Errorf("ws - Hub - Shutdown: %w", ctx.Err())
+	}
+}
+
+func (h *Hub) register(c *Client) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return false
+	}
+	h.clients[c] = struct{}{}
+	h.wg.Add(1)
+
+	return true
+}
+
+func (h *Hub) unregister(c *Client) {
+	h.mu.Lock()
+	delete(h.clients, c)
+	h.mu.Unlock()
+
+	h.wg.Done()
+}
+
+// broadcast offers msg to every client subscribed to one of topics
+func (h *Hub) broadcast(topics []string, msg outbound) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for c := range h.clients {
+		if topic, ok := c.subscribed(topics); ok {
+			m := msg
+			m.Topic = topic
+			c.offer(m)
+		}
+	}
+}
+
+func (h *Hub) hasSubscribers() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for c := range h.clients {
+		if c.hasTopics() {
+			return true
+		}
+	}
+
+	return false
+}
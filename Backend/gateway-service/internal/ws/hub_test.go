@@ -0,0 +1,583 @@
+package ws_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/handler"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/middleware"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/service"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/subtest"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/ws"
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/logger"
+	brainpb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/brain"
+	tweetspb "github.com/Denterry/FinancialAdviser/Backend/gateway-service/pkg/pb/tweets/v1"
+	subpb "github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/subscription/v1"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// fakeTokens knows the access tokens of the users, it knows no API keys
+type fakeTokens map[string]*entity.Claims
+
+func (f fakeTokens) ValidateToken(_ context.Context, token string) (*entity.Claims, error) {
+	claims, ok := f[token]
+	if !ok {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+func (fakeTokens) ValidateAPIKey(context.Context, string) (*entity.Claims, error) {
+	return nil, errors.New("invalid API key")
+}
+
+// Users of the hub, the token of a user is their id
+var _tokens = fakeTokens{
+	"pro":   {UserID: "pro"},
+	"basic": {UserID: "basic"},
+	"free":  {UserID: "free"},
+}
+
+// fakeFeed is an in-process x-service, the latest tweets are the ones added
+// so far. With flood every poll also returns eight new tweets of flood bytes
+type fakeFeed struct {
+	tweetspb.UnimplementedTweetServiceServer
+
+	polls atomic.Int32
+	flood int
+
+	mu     sync.Mutex
+	tweets []*tweetspb.Tweet // newest first
+}
+
+func (f *fakeFeed) ListLatestTweets(context.Context, *tweetspb.ListLatestTweetsRequest) (*tweetspb.ListLatestTweetsResponse, error) {
+	n := f.polls.Add(1)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tweets := f.tweets
+	if f.flood > 0 {
+		for i := range 8 {
+			tweets = append([]*tweetspb.Tweet{{
+				Id:   strconv.Itoa(int(n)) + "-" + strconv.Itoa(i),
+				Text: strings.Repeat("x", f.flood),
+			}}, tweets...)
+		}
+	}
+
+	return &tweetspb.ListLatestTweetsResponse{Tweets: tweets}, nil
+}
+
+func (f *fakeFeed) add(t *tweetspb.Tweet) {
+	f.mu.Lock()
+	f.tweets = append([]*tweetspb.Tweet{t}, f.tweets...)
+	f.mu.Unlock()
+}
+
+// fakeBrain is an in-process brain-service, it answers "Buy " at once and
+// "low" once release closes
+type fakeBrain struct {
+	brainpb.UnimplementedBrainServiceServer
+
+	release chan struct{}
+}
+
+func (f *fakeBrain) StreamMessage(req *brainpb.StreamMessageRequest, stream grpc.ServerStreamingServer[brainpb.StreamMessageResponse]) error {
+	if err := stream.Send(&brainpb.StreamMessageResponse{ContentChunk: "Buy ", ChatId: req.GetChatId()}); err != nil {
+		return err
+	}
+
+	select {
+	case <-f.release:
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	}
+
+	return stream.Send(&brainpb.StreamMessageResponse{
+		ContentChunk: "low",
+		IsFinal:      true,
+		TokensUsed:   42,
+		ChatId:       req.GetChatId(),
+	})
+}
+
+// hubTest is a hub served in process the way the protected routes serve it
+type hubTest struct {
+	hub   *ws.Hub
+	srv   *httptest.Server
+	feed  *fakeFeed
+	brain *fakeBrain
+}
+
+func testConfig() config.WS {
+	return config.WS{
+		PingInterval:     time.Minute,
+		PongTimeout:      time.Minute,
+		WriteTimeout:     5 * time.Second,
+		MaxMessageSize:   1 << 16,
+		SendBuffer:       16,
+		MaxSubscriptions: 2,
+		MaxChatStreams:   1,
+		ChatTimeout:      10 * time.Second,
+		FeedPollInterval: 10 * time.Millisecond,
+		FeedBatch:        100,
+		AllowedOrigins:   []string{"https://app.example.com"},
+	}
+}
+
+func newHubTest(t *testing.T, cfg config.WS, feed *fakeFeed) *hubTest {
+	t.Helper()
+
+	brain := &fakeBrain{release: make(chan struct{})}
+	subs := &subtest.Server{Active: map[string]string{"pro": subtest.ProPlanID, "basic": subtest.BasicPlanID}}
+	conn := dial(t, func(s *grpc.Server) {
+		tweetspb.RegisterTweetServiceServer(s, feed)
+		brainpb.RegisterBrainServiceServer(s, brain)
+		subpb.RegisterSubscriptionServiceServer(s, subs)
+	})
+
+	subService := service.NewSubscriptionService(conn)
+	hub := ws.NewHub(cfg, service.NewChatService(conn), service.NewFeedService(conn),
+		service.NewEntitlementService(redisClient(t), subService, config.Entitlements{
+			CacheTTL:     time.Minute,
+			ChatMessages: config.PlanQuota{Basic: 2, Pro: 100},
+		}),
+		logger.New("error"))
+
+	r := gin.New()
+	api := r.Group("/api", middleware.Auth(_tokens, _tokens))
+	api.GET("/ws", handler.NewWSHandler(hub, cfg.AllowedOrigins).Connect)
+
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go hub.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = hub.Shutdown(ctx)
+	})
+
+	return &hubTest{hub: hub, srv: srv, feed: feed, brain: brain}
+}
+
+// connect opens a connection of the user with the token offered as a
+// subprotocol, the way browsers do
+func (h *hubTest) connect(t *testing.T, token string) *websocket.Conn {
+	t.Helper()
+
+	conn, resp, err := h.dial("", tokenProtocol(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	require.Equal(t, middleware.WSTokenProtocol, conn.Subprotocol())
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func (h *hubTest) dial(query string, header http.Header) (*websocket.Conn, *http.Response, error) {
+	return websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(h.srv.URL, "http")+"/api/ws"+query, header)
+}
+
+// tokenProtocol offers the token the way browsers do
+func tokenProtocol(token string) http.Header {
+	return http.Header{"Sec-WebSocket-Protocol": {middleware.WSTokenProtocol + ", " + token}}
+}
+
+// dial serves the services registered by register in process
+func dial(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	register(srv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+// redisClient connects to an in-process Redis
+func redisClient(t *testing.T) *redis.Client {
+	t.Helper()
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	return rdb
+}
+
+// frame is a frame of the server
+type frame struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Topic      string          `json:"topic"`
+	ChatID     string          `json:"chat_id"`
+	Content    string          `json:"content"`
+	TokensUsed int32           `json:"tokens_used"`
+	Data       json.RawMessage `json:"data"`
+	Code       string          `json:"code"`
+	Error      string          `json:"error"`
+}
+
+func send(t *testing.T, conn *websocket.Conn, msg map[string]string) {
+	t.Helper()
+	require.NoError(t, conn.WriteJSON(msg))
+}
+
+func next(t *testing.T, conn *websocket.Conn) frame {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var f frame
+	require.NoError(t, conn.ReadJSON(&f))
+
+	return f
+}
+
+// closed reads until the server closes the connection and returns the close
+// frame
+func closed(t *testing.T, conn *websocket.Conn) *websocket.CloseError {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	for {
+		_, _, err := conn.ReadMessage()
+		if err == nil {
+			continue
+		}
+
+		var closeErr *websocket.CloseError
+		require.ErrorAs(t, err, &closeErr)
+		return closeErr
+	}
+}
+
+func TestHubUpgradeAuth(t *testing.T) {
+	t.Parallel()
+
+	h := newHubTest(t, testConfig(), &fakeFeed{})
+
+	tests := []struct {
+		name   string
+		query  string
+		header http.Header
+		code   int
+	}{
+		{name: "no token", code: http.StatusUnauthorized},
+		{name: "forged token", header: tokenProtocol("forged"), code: http.StatusUnauthorized},
+		{name: "token in the subprotocol", header: tokenProtocol("pro"), code: http.StatusSwitchingProtocols},
+		{name: "token in the header", header: http.Header{"Authorization": {"Bearer pro"}}, code: http.StatusSwitchingProtocols},
+		// query strings end up in access logs
+		{name: "token in the query", query: "?access_token=pro", code: http.StatusUnauthorized},
+		{name: "token without the protocol", header: http.Header{"Sec-WebSocket-Protocol": {"pro"}}, code: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, resp, err := h.dial(tc.query, tc.header)
+			require.Equal(t, tc.code, resp.StatusCode)
+			if tc.code != http.StatusSwitchingProtocols {
+				require.ErrorIs(t, err, websocket.ErrBadHandshake)
+				return
+			}
+			require.NoError(t, err)
+			defer conn.Close()
+
+			send(t, conn, map[string]string{"id": "1", "type": "ping"})
+			require.Equal(t, frame{ID: "1", Type: "pong"}, next(t, conn))
+		})
+	}
+}
+
+func TestHubUpgradeOrigin(t *testing.T) {
+	t.Parallel()
+
+	h := newHubTest(t, testConfig(), &fakeFeed{})
+
+	tests := []struct {
+		name   string
+		origin string
+		code   int
+	}{
+		{name: "no origin", code: http.StatusSwitchingProtocols},
+		{name: "gateway", origin: h.srv.URL, code: http.StatusSwitchingProtocols},
+		{name: "allowed", origin: "https://APP.example.com", code: http.StatusSwitchingProtocols},
+		{name: "other site", origin: "https://evil.example.com", code: http.StatusForbidden},
+		{name: "subdomain of an allowed origin", origin: "https://evil.app.example.com", code: http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			header := tokenProtocol("pro")
+			if tc.origin != "" {
+				header.Set("Origin", tc.origin)
+			}
+
+			conn, resp, err := h.dial("", header)
+			require.Equal(t, tc.code, resp.StatusCode)
+			if tc.code != http.StatusSwitchingProtocols {
+				require.ErrorIs(t, err, websocket.ErrBadHandshake)
+				return
+			}
+			require.NoError(t, err)
+			_ = conn.Close()
+		})
+	}
+}
+
+func TestHubSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("feed of the topics", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHubTest(t, testConfig(), &fakeFeed{})
+		conn := h.connect(t, "pro")
+
+		send(t, conn, map[string]string{"id": "1", "type": "subscribe", "topic": "tweets:$tsla"})
+		require.Equal(t, frame{ID: "1", Type: "subscribed", Topic: "tweets:TSLA"}, next(t, conn))
+		send(t, conn, map[string]string{"id": "2", "type": "subscribe", "topic": "sentiment"})
+		require.Equal(t, frame{ID: "2", Type: "subscribed", Topic: "sentiment"}, next(t, conn))
+
+		send(t, conn, map[string]string{"id": "3", "type": "subscribe", "topic": "tweets"})
+		require.Equal(t, frame{ID: "3", Type: "error", Error: "too many subscriptions"}, next(t, conn))
+		send(t, conn, map[string]string{"id": "4", "type": "subscribe", "topic": "quotes"})
+		require.Equal(t, frame{ID: "4", Type: "error", Error: "unknown topic"}, next(t, conn))
+
+		// the first poll only learns what is in the feed already
+		require.Eventually(t, func() bool { return h.feed.polls.Load() > 0 }, 5*time.Second, 5*time.Millisecond)
+
+		// oldest first, the AAPL tweet goes to nobody
+		h.feed.add(&tweetspb.Tweet{Id: "t1", Symbols: []string{"AAPL"}})
+		h.feed.add(&tweetspb.Tweet{Id: "t2", Symbols: []string{"TSLA"}, SentimentScore: 0.8, SentimentLabel: "POS"})
+
+		tweet := next(t, conn)
+		require.Equal(t, "tweet", tweet.Type)
+		require.Equal(t, "tweets:TSLA", tweet.Topic)
+		require.Equal(t, "t2", gjson(t, tweet.Data)["id"])
+
+		sentiment := next(t, conn)
+		require.Equal(t, "sentiment", sentiment.Type)
+		require.Equal(t, "sentiment", sentiment.Topic)
+		require.JSONEq(t, `{"tweet_id":"t2","symbols":["TSLA"],"score":0.8,"label":"positive"}`, string(sentiment.Data))
+
+		send(t, conn, map[string]string{"id": "5", "type": "unsubscribe", "topic": "tweets:tsla"})
+		require.Equal(t, frame{ID: "5", Type: "unsubscribed", Topic: "tweets:TSLA"}, next(t, conn))
+
+		h.feed.add(&tweetspb.Tweet{Id: "t3", Symbols: []string{"TSLA"}, SentimentScore: -0.5, SentimentLabel: "NEG"})
+		sentiment = next(t, conn)
+		require.Equal(t, "sentiment", sentiment.Type)
+		require.Equal(t, "t3", gjson(t, sentiment.Data)["tweet_id"])
+	})
+
+	for token, code := range map[string]string{
+		"basic": entity.DenialFeatureNotInPlan,
+		"free":  entity.DenialSubscriptionRequired,
+	} {
+		t.Run(token, func(t *testing.T) {
+			t.Parallel()
+
+			h := newHubTest(t, testConfig(), &fakeFeed{})
+			conn := h.connect(t, token)
+
+			send(t, conn, map[string]string{"id": "1", "type": "subscribe", "topic": "tweets"})
+			f := next(t, conn)
+			require.Equal(t, "error", f.Type)
+			require.Equal(t, code, f.Code)
+			require.Equal(t, entity.FeatureRealtimeFeed, gjson(t, f.Data)["entitlement"])
+			require.Zero(t, h.feed.polls.Load())
+		})
+	}
+}
+
+func TestHubHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	cfg := testConfig()
+	cfg.PingInterval = 20 * time.Millisecond
+	cfg.PongTimeout = 100 * time.Millisecond
+	h := newHubTest(t, cfg, &fakeFeed{})
+
+	// pings counts the pings of the server, answering them when pong is set
+	heartbeat := func(conn *websocket.Conn, pong bool) (*atomic.Int32, <-chan error) {
+		var pings atomic.Int32
+		conn.SetPingHandler(func(data string) error {
+			pings.Add(1)
+			if !pong {
+				return nil
+			}
+			return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+		})
+
+		done := make(chan error, 1)
+		go func() {
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					done <- err
+					return
+				}
+			}
+		}()
+
+		return &pings, done
+	}
+
+	t.Run("answered", func(t *testing.T) {
+		t.Parallel()
+
+		pings, done := heartbeat(h.connect(t, "pro"), true)
+
+		// outlives the pong timeout many times over
+		require.Eventually(t, func() bool { return pings.Load() >= 20 }, 5*time.Second, 5*time.Millisecond)
+		require.Empty(t, done)
+	})
+
+	t.Run("unanswered", func(t *testing.T) {
+		t.Parallel()
+
+		pings, done := heartbeat(h.connect(t, "pro"), false)
+
+		select {
+		case err := <-done:
+			require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+			require.Positive(t, pings.Load())
+		case <-time.After(5 * time.Second):
+			t.Fatal("connection without pongs was kept open")
+		}
+	})
+}
+
+func TestHubBackpressure(t *testing.T) {
+	t.Parallel()
+
+	cfg := testConfig()
+	cfg.SendBuffer = 1
+	// every poll publishes eight tweets at once, the hub offers the next one
+	// long before the write pump has sent the one before
+	h := newHubTest(t, cfg, &fakeFeed{flood: 128 << 10})
+	conn := h.connect(t, "pro")
+
+	send(t, conn, map[string]string{"id": "1", "type": "subscribe", "topic": "tweets"})
+	require.Equal(t, frame{ID: "1", Type: "subscribed", Topic: "tweets"}, next(t, conn))
+
+	closeErr := closed(t, conn)
+	require.Equal(t, websocket.CloseTryAgainLater, closeErr.Code)
+	require.Equal(t, "too slow", closeErr.Text)
+}
+
+func TestHubShutdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("drains chat answers", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHubTest(t, testConfig(), &fakeFeed{})
+		answering := h.connect(t, "pro")
+		idle := h.connect(t, "pro")
+
+		send(t, answering, map[string]string{"id": "m1", "type": "chat.send", "chat_id": "c1", "content": "TSLA?"})
+		require.Equal(t, frame{ID: "m1", Type: "chat.chunk", ChatID: "c1", Content: "Buy "}, next(t, answering))
+
+		shutdown := make(chan error, 1)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			shutdown <- h.hub.Shutdown(ctx)
+		}()
+
+		closeErr := closed(t, idle)
+		require.Equal(t, websocket.CloseGoingAway, closeErr.Code)
+		require.Equal(t, "server shutting down", closeErr.Text)
+
+		_, resp, err := h.dial("", tokenProtocol("pro"))
+		require.ErrorIs(t, err, websocket.ErrBadHandshake)
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+		// the answer in flight takes the only chat slot until the
+		// connection drains
+		refused := frame{Type: "error", Error: "too many chat messages in flight"}
+		for refused.Error == "too many chat messages in flight" {
+			send(t, answering, map[string]string{"id": "m2", "type": "chat.send", "chat_id": "c1", "content": "AAPL?"})
+			refused = next(t, answering)
+		}
+		require.Equal(t, frame{ID: "m2", Type: "error", Error: "server shutting down"}, refused)
+		require.Empty(t, shutdown)
+
+		close(h.brain.release)
+		require.Equal(t, frame{ID: "m1", Type: "chat.chunk", ChatID: "c1", Content: "low"}, next(t, answering))
+		require.Equal(t, frame{ID: "m1", Type: "chat.done", ChatID: "c1", TokensUsed: 42}, next(t, answering))
+
+		closeErr = closed(t, answering)
+		require.Equal(t, websocket.CloseGoingAway, closeErr.Code)
+		require.NoError(t, <-shutdown)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHubTest(t, testConfig(), &fakeFeed{})
+		answering := h.connect(t, "pro")
+
+		send(t, answering, map[string]string{"id": "m1", "type": "chat.send", "chat_id": "c1", "content": "TSLA?"})
+		require.Equal(t, "chat.chunk", next(t, answering).Type)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, h.hub.Shutdown(ctx), context.DeadlineExceeded)
+
+		closeErr := closed(t, answering)
+		require.Equal(t, websocket.CloseGoingAway, closeErr.Code)
+		require.Equal(t, "server shutting down", closeErr.Text)
+	})
+}
+
+// gjson decodes a JSON object
+func gjson(t *testing.T, data json.RawMessage) map[string]any {
+	t.Helper()
+
+	var v map[string]any
+	require.NoError(t, json.Unmarshal(data, &v))
+
+	return v
+}
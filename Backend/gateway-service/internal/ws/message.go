@@ -0,0 +1,102 @@
+package ws
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/Denterry/FinancialAdviser/Backend/gateway-service/internal/entity"
+)
+
+// Frames are JSON text messages, the "id" of a client request is echoed in
+// every reply to it
+const (
+	// client -> server
+	typeSubscribe   = "subscribe"
+	typeUnsubscribe = "unsubscribe"
+	typeChatSend    = "chat.send"
+	typeChatCancel  = "chat.cancel"
+	typePing        = "ping"
+
+	// server -> client
+	typeSubscribed   = "subscribed"
+	typeUnsubscribed = "unsubscribed"
+	typeChatChunk    = "chat.chunk"
+	typeChatDone     = "chat.done"
+	typeTweet        = "tweet"
+	typeSentiment    = "sentiment"
+	typePong         = "pong"
+	typeError        = "error"
+)
+
+// Topics of the market feed, "tweets" and "sentiment" cover the whole feed,
+// "tweets:TSLA" and "sentiment:TSLA" a single symbol
+const (
+	topicTweets    = "tweets"
+	topicSentiment = "sentiment"
+)
+
+var (
+	errUnknownTopic = errors.New("unknown topic")
+
+	symbolPattern = regexp.MustCompile(`^[A-Z][A-Z0-9.]{0,9}$`)
+)
+
+// inbound is a frame of the client
+type inbound struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Topic   string `json:"topic"`
+	ChatID  string `json:"chat_id"`
+	Content string `json:"content"`
+}
+
+// outbound is a frame of the server
+type outbound struct {
+	ID         string `json:"id,omitempty"`
+	Type       string `json:"type"`
+	Topic      string `json:"topic,omitempty"`
+	ChatID     string `json:"chat_id,omitempty"`
+	Content    string `json:"content,omitempty"`
+	TokensUsed int32  `json:"tokens_used,omitempty"`
+	Data       any    `json:"data,omitempty"`
+	Code       string `json:"code,omitempty"` // gRPC code of failed chat answers, denial code of refused requests
+	Error      string `json:"error,omitempty"`
+}
+
+// sentimentUpdate is the payload of sentiment events
+type sentimentUpdate struct {
+	TweetID string   `json:"tweet_id"`
+	Symbols []string `json:"symbols"`
+	Score   float64  `json:"score"`
+	Label   string   `json:"label"`
+}
+
+// parseTopic validates a topic and returns its canonical form
+func parseTopic(topic string) (string, error) {
+	kind, symbol, found := strings.Cut(topic, ":")
+	if kind != topicTweets && kind != topicSentiment {
+		return "", errUnknownTopic
+	}
+	if !found {
+		return kind, nil
+	}
+
+	symbol = strings.ToUpper(strings.TrimPrefix(symbol, "$"))
+	if !symbolPattern.MatchString(symbol) {
+		return "", errUnknownTopic
+	}
+
+	return kind + ":" + symbol, nil
+}
+
+// topicsOf returns the topics a feed event of kind about t is published to
+func topicsOf(kind string, t *entity.Tweet) []string {
+	topics := make([]string, 0, len(t.Symbols)+1)
+	topics = append(topics, kind)
+	for _, symbol := range t.Symbols {
+		topics = append(topics, kind+":"+strings.ToUpper(symbol))
+	}
+
+	return topics
+}
@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: brain/brain.proto
+
+package brainpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BrainService_CreateChat_FullMethodName    = "/brain_service.BrainService/CreateChat"
+	BrainService_ListChats_FullMethodName     = "/brain_service.BrainService/ListChats"
+	BrainService_DeleteChat_FullMethodName    = "/brain_service.BrainService/DeleteChat"
+	BrainService_ListMessages_FullMethodName  = "/brain_service.BrainService/ListMessages"
+	BrainService_StreamMessage_FullMethodName = "/brain_service.BrainService/StreamMessage"
+	BrainService_Ping_FullMethodName          = "/brain_service.BrainService/Ping"
+)
+
+// BrainServiceClient is the client API for BrainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+//	Цели API:
+//	• Управлять чатами (CRUD)
+//	• Принимать сообщения пользователя и в реальном времени отдавать LLM-ответ
+//	• Давать доступ фронту к истории сообщений
+//	• Обеспечить health-check
+type BrainServiceClient interface {
+	// CreateChat is used to create a new chat
+	CreateChat(ctx context.Context, in *CreateChatRequest, opts ...grpc.CallOption) (*CreateChatResponse, error)
+	// ListChats is used to list the chats
+	ListChats(ctx context.Context, in *ListChatsRequest, opts ...grpc.CallOption) (*ListChatsResponse, error)
+	// DeleteChat is used to delete the chat
+	DeleteChat(ctx context.Context, in *DeleteChatRequest, opts ...grpc.CallOption) (*DeleteChatResponse, error)
+	// ListMessages is used to list the messages in the chat
+	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error)
+	// StreamMessage is used to stream the response from the LLM
+	StreamMessage(ctx context.Context, in *StreamMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamMessageResponse], error)
+	// Ping is used to check the health of the service
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+}
+
+type brainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBrainServiceClient(cc grpc.ClientConnInterface) BrainServiceClient {
+	return &brainServiceClient{cc}
+}
+
+func (c *brainServiceClient) CreateChat(ctx context.Context, in *CreateChatRequest, opts ...grpc.CallOption) (*CreateChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChatResponse)
+	err := c.cc.Invoke(ctx, BrainService_CreateChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brainServiceClient) ListChats(ctx context.Context, in *ListChatsRequest, opts ...grpc.CallOption) (*ListChatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChatsResponse)
+	err := c.cc.Invoke(ctx, BrainService_ListChats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brainServiceClient) DeleteChat(ctx context.Context, in *DeleteChatRequest, opts ...grpc.CallOption) (*DeleteChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChatResponse)
+	err := c.cc.Invoke(ctx, BrainService_DeleteChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brainServiceClient) ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessagesResponse)
+	err := c.cc.Invoke(ctx, BrainService_ListMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brainServiceClient) StreamMessage(ctx context.Context, in *StreamMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamMessageResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BrainService_ServiceDesc.Streams[0], BrainService_StreamMessage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamMessageRequest, StreamMessageResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrainService_StreamMessageClient = grpc.ServerStreamingClient[StreamMessageResponse]
+
+func (c *brainServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, BrainService_Ping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BrainServiceServer is the server API for BrainService service.
+// All implementations must embed UnimplementedBrainServiceServer
+// for forward compatibility.
+//
+//	Цели API:
+//	• Управлять чатами (CRUD)
+//	• Принимать сообщения пользователя и в реальном времени отдавать LLM-ответ
+//	• Давать доступ фронту к истории сообщений
+//	• Обеспечить health-check
+type BrainServiceServer interface {
+	// CreateChat is used to create a new chat
+	CreateChat(context.Context, *CreateChatRequest) (*CreateChatResponse, error)
+	// ListChats is used to list the chats
+	ListChats(context.Context, *ListChatsRequest) (*ListChatsResponse, error)
+	// DeleteChat is used to delete the chat
+	DeleteChat(context.Context, *DeleteChatRequest) (*DeleteChatResponse, error)
+	// ListMessages is used to list the messages in the chat
+	ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error)
+	// StreamMessage is used to stream the response from the LLM
+	StreamMessage(*StreamMessageRequest, grpc.ServerStreamingServer[StreamMessageResponse]) error
+	// Ping is used to check the health of the service
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	mustEmbedUnimplementedBrainServiceServer()
+}
+
+// UnimplementedBrainServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBrainServiceServer struct{}
+
+func (UnimplementedBrainServiceServer) CreateChat(context.Context, *CreateChatRequest) (*CreateChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChat not implemented")
+}
+func (UnimplementedBrainServiceServer) ListChats(context.Context, *ListChatsRequest) (*ListChatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChats not implemented")
+}
+func (UnimplementedBrainServiceServer) DeleteChat(context.Context, *DeleteChatRequest) (*DeleteChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChat not implemented")
+}
+func (UnimplementedBrainServiceServer) ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
+}
+func (UnimplementedBrainServiceServer) StreamMessage(*StreamMessageRequest, grpc.ServerStreamingServer[StreamMessageResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMessage not implemented")
+}
+func (UnimplementedBrainServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedBrainServiceServer) mustEmbedUnimplementedBrainServiceServer() {}
+func (UnimplementedBrainServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeBrainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BrainServiceServer will
+// result in compilation errors.
+type UnsafeBrainServiceServer interface {
+	mustEmbedUnimplementedBrainServiceServer()
+}
+
+func RegisterBrainServiceServer(s grpc.ServiceRegistrar, srv BrainServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBrainServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BrainService_ServiceDesc, srv)
+}
+
+func _BrainService_CreateChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrainServiceServer).CreateChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrainService_CreateChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrainServiceServer).CreateChat(ctx, req.(*CreateChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrainService_ListChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrainServiceServer).ListChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrainService_ListChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrainServiceServer).ListChats(ctx, req.(*ListChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrainService_DeleteChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrainServiceServer).DeleteChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrainService_DeleteChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrainServiceServer).DeleteChat(ctx, req.(*DeleteChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrainService_ListMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrainServiceServer).ListMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrainService_ListMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrainServiceServer).ListMessages(ctx, req.(*ListMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrainService_StreamMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMessageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BrainServiceServer).StreamMessage(m, &grpc.GenericServerStream[StreamMessageRequest, StreamMessageResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrainService_StreamMessageServer = grpc.ServerStreamingServer[StreamMessageResponse]
+
+func _BrainService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrainServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrainService_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrainServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BrainService_ServiceDesc is the grpc.ServiceDesc for BrainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BrainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "brain_service.BrainService",
+	HandlerType: (*BrainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateChat",
+			Handler:    _BrainService_CreateChat_Handler,
+		},
+		{
+			MethodName: "ListChats",
+			Handler:    _BrainService_ListChats_Handler,
+		},
+		{
+			MethodName: "DeleteChat",
+			Handler:    _BrainService_DeleteChat_Handler,
+		},
+		{
+			MethodName: "ListMessages",
+			Handler:    _BrainService_ListMessages_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _BrainService_Ping_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMessage",
+			Handler:       _BrainService_StreamMessage_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "brain/brain.proto",
+}
@@ -9,10 +9,11 @@ import (
 	"syscall"
 
 	"github.com/Denterry/FinancialAdviser/Backend/sub-service/config"
-	"github.com/Denterry/FinancialAdviser/Backend/sub-service/internal/controller/grpc"
+	grpcController "github.com/Denterry/FinancialAdviser/Backend/sub-service/internal/controller/grpc"
 	"github.com/Denterry/FinancialAdviser/Backend/sub-service/internal/repo/postgres"
 	"github.com/Denterry/FinancialAdviser/Backend/sub-service/internal/usecase"
-	"github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/subscription/v1"
+	subscription "github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/subscription/v1"
+	userdatav1 "github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/userdata/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -39,8 +40,10 @@ func main() {
 
 	// Initialize gRPC server
 	server := grpc.NewServer()
-	subscriptionService := grpc.NewSubscriptionService(subscriptionUseCase)
+	subscriptionService := grpcController.NewSubscriptionService(subscriptionUseCase)
 	subscription.RegisterSubscriptionServiceServer(server, subscriptionService)
+	// auth-service exports and deletes the data of an account through it
+	userdatav1.RegisterUserDataServiceServer(server, grpcController.NewUserDataService(subscriptionUseCase))
 
 	// Enable reflection for development tools
 	reflection.Register(server)
@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/sub-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/sub-service/internal/usecase"
+	userdatav1 "github.com/Denterry/FinancialAdviser/Backend/sub-service/pkg/pb/userdata/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserDataService implements the gRPC user data service auth-service calls
+// to export and delete the data of an account
+type UserDataService struct {
+	userdatav1.UnimplementedUserDataServiceServer
+	subscriptionUseCase *usecase.SubscriptionUseCase
+}
+
+// NewUserDataService creates a new instance of UserDataService
+func NewUserDataService(subscriptionUseCase *usecase.SubscriptionUseCase) *UserDataService {
+	return &UserDataService{
+		subscriptionUseCase: subscriptionUseCase,
+	}
+}
+
+// ExportUserData implements the ExportUserData RPC method
+func (s *UserDataService) ExportUserData(ctx context.Context, req *userdatav1.ExportUserDataRequest) (*userdatav1.ExportUserDataResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+
+	subscriptions, payments, err := s.subscriptionUseCase.ExportUserData(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+
+	exportedSubscriptions := make([]subscriptionExport, len(subscriptions))
+	for i, sub := range subscriptions {
+		exportedSubscriptions[i] = subscriptionExport{
+			ID:              sub.ID,
+			PlanID:          sub.PlanID,
+			Status:          sub.Status,
+			StartDate:       sub.StartDate,
+			EndDate:         sub.EndDate,
+			AutoRenew:       sub.AutoRenew,
+			AmountPaid:      sub.AmountPaid,
+			Currency:        sub.Currency,
+			PaymentMethod:   sub.PaymentMethod,
+			LastPaymentDate: sub.LastPaymentDate,
+			NextPaymentDate: sub.NextPaymentDate,
+			CreatedAt:       sub.CreatedAt,
+		}
+	}
+	exportedPayments := make([]paymentExport, len(payments))
+	for i, p := range payments {
+		exportedPayments[i] = paymentExport{
+			ID:             p.ID,
+			SubscriptionID: p.SubscriptionID,
+			Amount:         p.Amount,
+			Currency:       p.Currency,
+			Status:         p.Status,
+			PaymentMethod:  p.PaymentMethod,
+			TransactionID:  p.TransactionID,
+			CreatedAt:      p.CreatedAt,
+		}
+	}
+
+	subscriptionsFile, err := jsonFile("subscriptions.json", exportedSubscriptions)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+	paymentsFile, err := jsonFile("payments.json", exportedPayments)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+
+	return &userdatav1.ExportUserDataResponse{
+		Files: []*userdatav1.DataFile{subscriptionsFile, paymentsFile},
+	}, nil
+}
+
+// DeleteUserData implements the DeleteUserData RPC method, subscriptions and
+// payments are anonymised rather than deleted
+func (s *UserDataService) DeleteUserData(ctx context.Context, req *userdatav1.DeleteUserDataRequest) (*userdatav1.DeleteUserDataResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+
+	if err := s.subscriptionUseCase.DeleteUserData(ctx, userID); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete user data")
+	}
+
+	return &userdatav1.DeleteUserDataResponse{}, nil
+}
+
+// subscriptionExport is a subscription as written to the export archive
+type subscriptionExport struct {
+	ID              uuid.UUID                 `json:"id"`
+	PlanID          uuid.UUID                 `json:"plan_id"`
+	Status          entity.SubscriptionStatus `json:"status"`
+	StartDate       time.Time                 `json:"start_date"`
+	EndDate         time.Time                 `json:"end_date"`
+	AutoRenew       bool                      `json:"auto_renew"`
+	AmountPaid      float64                   `json:"amount_paid"`
+	Currency        string                    `json:"currency"`
+	PaymentMethod   string                    `json:"payment_method"`
+	LastPaymentDate time.Time                 `json:"last_payment_date"`
+	NextPaymentDate time.Time                 `json:"next_payment_date"`
+	CreatedAt       time.Time                 `json:"created_at"`
+}
+
+// paymentExport is a payment as written to the export archive
+type paymentExport struct {
+	ID             uuid.UUID            `json:"id"`
+	SubscriptionID uuid.UUID            `json:"subscription_id"`
+	Amount         float64              `json:"amount"`
+	Currency       string               `json:"currency"`
+	Status         entity.PaymentStatus `json:"status"`
+	PaymentMethod  string               `json:"payment_method"`
+	TransactionID  string               `json:"transaction_id"`
+	CreatedAt      time.Time            `json:"created_at"`
+}
+
+// jsonFile encodes the value into an indented JSON file of the export
+func jsonFile(name string, v any) (*userdatav1.DataFile, error) {
+	content, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdatav1.DataFile{
+		Name:        name,
+		ContentType: "application/json",
+		Content:     content,
+	}, nil
+}
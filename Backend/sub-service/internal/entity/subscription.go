@@ -35,6 +35,9 @@ const (
 	PaymentStatusRefunded  PaymentStatus = "refunded"
 )
 
+// RedactedPaymentMethod replaces the payment method of anonymised subscriptions and payments
+const RedactedPaymentMethod = "redacted"
+
 // Plan represents a subscription plan
 type Plan struct {
 	ID           uuid.UUID
@@ -357,3 +357,120 @@ func (r *subscriptionRepo) UpdatePayment(ctx context.Context, payment *entity.Pa
 	)
 	return err
 }
+
+// User data operations
+func (r *subscriptionRepo) ListUserSubscriptions(ctx context.Context, userID uuid.UUID) ([]*entity.Subscription, error) {
+	query := `
+		SELECT id, user_id, plan_id, status, start_date, end_date,
+			auto_renew, amount_paid, currency, payment_method,
+			last_payment_date, next_payment_date, created_at, updated_at
+		FROM subscriptions
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []*entity.Subscription
+	for rows.Next() {
+		sub := &entity.Subscription{}
+		err := rows.Scan(
+			&sub.ID,
+			&sub.UserID,
+			&sub.PlanID,
+			&sub.Status,
+			&sub.StartDate,
+			&sub.EndDate,
+			&sub.AutoRenew,
+			&sub.AmountPaid,
+			&sub.Currency,
+			&sub.PaymentMethod,
+			&sub.LastPaymentDate,
+			&sub.NextPaymentDate,
+			&sub.CreatedAt,
+			&sub.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+	return subscriptions, rows.Err()
+}
+
+func (r *subscriptionRepo) ListUserPayments(ctx context.Context, userID uuid.UUID) ([]*entity.Payment, error) {
+	query := `
+		SELECT p.id, p.subscription_id, p.amount, p.currency,
+			p.status, p.payment_method, p.transaction_id,
+			p.created_at, p.updated_at
+		FROM payments p
+		JOIN subscriptions s ON s.id = p.subscription_id
+		WHERE s.user_id = $1
+		ORDER BY p.created_at
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []*entity.Payment
+	for rows.Next() {
+		payment := &entity.Payment{}
+		err := rows.Scan(
+			&payment.ID,
+			&payment.SubscriptionID,
+			&payment.Amount,
+			&payment.Currency,
+			&payment.Status,
+			&payment.PaymentMethod,
+			&payment.TransactionID,
+			&payment.CreatedAt,
+			&payment.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+	return payments, rows.Err()
+}
+
+// AnonymizeUser keeps the rows for accounting but cuts every link to the
+// user: subscriptions move to the nil user and payment methods are redacted
+func (r *subscriptionRepo) AnonymizeUser(ctx context.Context, userID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	now := time.Now()
+	paymentsQuery := `
+		UPDATE payments
+		SET payment_method = $1,
+			updated_at = $2
+		WHERE subscription_id IN (SELECT id FROM subscriptions WHERE user_id = $3)
+	`
+	if _, err := tx.ExecContext(ctx, paymentsQuery, entity.RedactedPaymentMethod, now, userID); err != nil {
+		return err
+	}
+
+	subscriptionsQuery := `
+		UPDATE subscriptions
+		SET user_id = $1,
+			status = CASE WHEN status IN ('active', 'pending') THEN 'cancelled' ELSE status END,
+			auto_renew = false,
+			payment_method = $2,
+			updated_at = $3
+		WHERE user_id = $4
+	`
+	if _, err := tx.ExecContext(ctx, subscriptionsQuery, uuid.Nil, entity.RedactedPaymentMethod, now, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -28,6 +28,13 @@ type SubscriptionRepository interface {
 	GetPayment(ctx context.Context, id uuid.UUID) (*entity.Payment, error)
 	ListPayments(ctx context.Context, subscriptionID uuid.UUID) ([]*entity.Payment, error)
 	UpdatePayment(ctx context.Context, payment *entity.Payment) error
+
+	// User data operations
+	ListUserSubscriptions(ctx context.Context, userID uuid.UUID) ([]*entity.Subscription, error)
+	ListUserPayments(ctx context.Context, userID uuid.UUID) ([]*entity.Payment, error)
+	// AnonymizeUser cancels the user's subscriptions and detaches them and their
+	// payments from the user, amounts and transactions are kept for accounting
+	AnonymizeUser(ctx context.Context, userID uuid.UUID) error
 }
 
 // SubscriptionFilter represents the filter criteria for listing subscriptions
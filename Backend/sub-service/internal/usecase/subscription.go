@@ -148,3 +148,24 @@ func (uc *SubscriptionUseCase) ProcessPayment(ctx context.Context, subscriptionI
 func (uc *SubscriptionUseCase) GetPlans(ctx context.Context, planType entity.PlanType) ([]*entity.Plan, error) {
 	return uc.repo.ListPlans(ctx, planType)
 }
+
+// ExportUserData retrieves the subscriptions and payments of a user
+func (uc *SubscriptionUseCase) ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.Subscription, []*entity.Payment, error) {
+	subscriptions, err := uc.repo.ListUserSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payments, err := uc.repo.ListUserPayments(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return subscriptions, payments, nil
+}
+
+// DeleteUserData anonymises the subscriptions and payments of a deleted
+// account, they are kept for accounting. Users without data are a no-op
+func (uc *SubscriptionUseCase) DeleteUserData(ctx context.Context, userID uuid.UUID) error {
+	return uc.repo.AnonymizeUser(ctx, userID)
+}
@@ -0,0 +1,3 @@
+UPDATE plans SET features = ARRAY['Basic analysis', 'Limited API access', 'Email support'] WHERE type = 'basic';
+UPDATE plans SET features = ARRAY['Advanced analysis', 'Full API access', 'Priority support', 'Custom alerts'] WHERE type = 'pro';
+UPDATE plans SET features = ARRAY['Enterprise analysis', 'Unlimited API access', '24/7 support', 'Custom integration', 'Dedicated account manager'] WHERE type = 'enterprise';
@@ -0,0 +1,4 @@
+-- Plan features are the entitlement keys the gateway enforces:
+-- chat, realtime_feed and ml
+UPDATE plans SET features = ARRAY['chat'] WHERE type = 'basic';
+UPDATE plans SET features = ARRAY['chat', 'realtime_feed', 'ml'] WHERE type IN ('pro', 'enterprise');
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: userdata/v1/user_data_service.proto
+
+package userdatav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserDataService_ExportUserData_FullMethodName = "/userdata.v1.UserDataService/ExportUserData"
+	UserDataService_DeleteUserData_FullMethodName = "/userdata.v1.UserDataService/DeleteUserData"
+)
+
+// UserDataServiceClient is the client API for UserDataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UserDataService is served by every service that keeps data of users
+type UserDataServiceClient interface {
+	// ExportUserData returns the data the service keeps about the user as the
+	// files of its folder in the export archive
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// DeleteUserData deletes the data of the user, or anonymises what must be
+	// kept. Calls for a user without data succeed, so failed calls can be retried
+	DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error)
+}
+
+type userDataServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserDataServiceClient(cc grpc.ClientConnInterface) UserDataServiceClient {
+	return &userDataServiceClient{cc}
+}
+
+func (c *userDataServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, UserDataService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userDataServiceClient) DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserDataResponse)
+	err := c.cc.Invoke(ctx, UserDataService_DeleteUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserDataServiceServer is the server API for UserDataService service.
+// All implementations must embed UnimplementedUserDataServiceServer
+// for forward compatibility.
+//
+// UserDataService is served by every service that keeps data of users
+type UserDataServiceServer interface {
+	// ExportUserData returns the data the service keeps about the user as the
+	// files of its folder in the export archive
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// DeleteUserData deletes the data of the user, or anonymises what must be
+	// kept. Calls for a user without data succeed, so failed calls can be retried
+	DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error)
+	mustEmbedUnimplementedUserDataServiceServer()
+}
+
+// UnimplementedUserDataServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserDataServiceServer struct{}
+
+func (UnimplementedUserDataServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedUserDataServiceServer) DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (UnimplementedUserDataServiceServer) mustEmbedUnimplementedUserDataServiceServer() {}
+func (UnimplementedUserDataServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeUserDataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserDataServiceServer will
+// result in compilation errors.
+type UnsafeUserDataServiceServer interface {
+	mustEmbedUnimplementedUserDataServiceServer()
+}
+
+func RegisterUserDataServiceServer(s grpc.ServiceRegistrar, srv UserDataServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserDataServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserDataService_ServiceDesc, srv)
+}
+
+func _UserDataService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserDataServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserDataService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserDataServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserDataService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserDataServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserDataService_DeleteUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserDataServiceServer).DeleteUserData(ctx, req.(*DeleteUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserDataService_ServiceDesc is the grpc.ServiceDesc for UserDataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserDataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "userdata.v1.UserDataService",
+	HandlerType: (*UserDataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportUserData",
+			Handler:    _UserDataService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _UserDataService_DeleteUserData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "userdata/v1/user_data_service.proto",
+}
@@ -10,15 +10,21 @@ import (
 type (
 	// Config -.
 	Config struct {
-		App       App
-		HTTP      HTTP
-		GRPC      GRPC
-		Log       Log
-		PG        PG
-		Metrics   Metrics
-		Swagger   Swagger
-		XProvider XProvider
-		TLS       TLS
+		App         App
+		HTTP        HTTP
+		GRPC        GRPC
+		Log         Log
+		PG          PG
+		Metrics     Metrics
+		Swagger     Swagger
+		XProvider   XProvider
+		TLS         TLS
+		AuthorScore AuthorScore
+		Spam        Spam
+		ML          ML
+		Bulk        Bulk
+		Export      Export
+		Auth        Auth
 	}
 
 	// App -.
@@ -106,6 +112,56 @@ type (
 		CertFile string `env:"TLS_CERT_FILE"`
 		KeyFile  string `env:"TLS_KEY_FILE"`
 	}
+
+	// AuthorScore -.
+	AuthorScore struct {
+		Enabled   bool          `env:"AUTHOR_SCORE_ENABLED"    envDefault:"true"`
+		Interval  time.Duration `env:"AUTHOR_SCORE_INTERVAL"   envDefault:"1h"`
+		Window    time.Duration `env:"AUTHOR_SCORE_WINDOW"     envDefault:"720h"`
+		MinTweets int           `env:"AUTHOR_SCORE_MIN_TWEETS" envDefault:"5"`
+		HitRate   bool          `env:"AUTHOR_SCORE_HIT_RATE"   envDefault:"false"`
+	}
+
+	// Spam -.
+	Spam struct {
+		Enabled          bool          `env:"SPAM_FILTER_ENABLED"    envDefault:"true"`
+		DuplicateWindow  time.Duration `env:"SPAM_DUPLICATE_WINDOW"  envDefault:"24h"`
+		DuplicateAuthors int           `env:"SPAM_DUPLICATE_AUTHORS" envDefault:"3"`
+		MaxCashtags      int           `env:"SPAM_MAX_CASHTAGS"      envDefault:"5"`
+		LinkFarmDomains  []string      `env:"SPAM_LINK_FARM_DOMAINS" envSeparator:","`
+		NewAccountAge    time.Duration `env:"SPAM_NEW_ACCOUNT_AGE"   envDefault:"720h"`
+		MinFollowers     int           `env:"SPAM_MIN_FOLLOWERS"     envDefault:"50"`
+	}
+
+	// ML -.
+	ML struct {
+		Addr    string        `env:"ML_GRPC_ADDR"`
+		Timeout time.Duration `env:"ML_TIMEOUT" envDefault:"10s"`
+	}
+
+	// Bulk -.
+	Bulk struct {
+		BatchSize int `env:"BULK_BATCH_SIZE" envDefault:"100"`
+	}
+
+	// Auth -.
+	// Admin and export calls need an access token verified against the JWKS
+	// of auth-service, they are all rejected while JWKSURL is empty
+	Auth struct {
+		JWKSURL             string        `env:"AUTH_JWKS_URL"`
+		Issuer              string        `env:"AUTH_JWT_ISSUER"            envDefault:"auth-service"`
+		JWKSRefreshInterval time.Duration `env:"AUTH_JWKS_REFRESH_INTERVAL" envDefault:"15m"`
+	}
+
+	// Export -.
+	Export struct {
+		Dir string `env:"EXPORT_DIR" envDefault:"/tmp/x-service/exports"`
+
+		// scheduled dumps of both datasets covering the last interval
+		DumpEnabled  bool          `env:"EXPORT_DUMP_ENABLED"  envDefault:"false"`
+		DumpInterval time.Duration `env:"EXPORT_DUMP_INTERVAL" envDefault:"24h"`
+		DumpFormat   string        `env:"EXPORT_DUMP_FORMAT"   envDefault:"parquet"`
+	}
 )
 
 // NewConfig returns app config
@@ -1,19 +1,26 @@
 package app
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/config"
 	grpcController "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/controller/grpc"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo/persistent"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo/webapi"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/admin"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/author"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/export"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/spam"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/tweet"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/grpcserver"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/logger"
 	adminpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1"
+	exportpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/export/v1"
 	tweetspb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/tweets/v1"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/postgres"
 	"google.golang.org/grpc"
@@ -40,6 +47,9 @@ func Run(cfg *config.Config) {
 
 	// initialize repositories
 	tweetRepo := persistent.NewTweetPostgres(pg)
+	authorScoreRepo := persistent.NewAuthorScorePostgres(pg)
+	bulkRepo := persistent.NewBulkOperationPostgres(pg)
+	sentimentAggRepo := persistent.NewSentimentAggPostgres(pg)
 
 	// scraper / parser
 	fetcher, err := webapi.NewSocialFetcher(cfg.XProvider)
@@ -47,22 +57,101 @@ func Run(cfg *config.Config) {
 		l.Fatal("Failed to initialize social fetcher: %v", err)
 	}
 
+	// sentiment analyzer, bulk re-scoring is unavailable without it
+	var analyzer repo.SentimentAnalyzer
+	if cfg.ML.Addr != "" {
+		mlSentiment, err := webapi.NewMLSentiment(cfg.ML)
+		if err != nil {
+			l.Fatal("Failed to initialize ml-service client: %v", err)
+		}
+		defer mlSentiment.Close()
+		analyzer = mlSentiment
+	}
+
+	// spam filter chain
+	spamFilter := spam.NewChain()
+	if cfg.Spam.Enabled {
+		spamFilter = spam.NewChain(
+			spam.NewDuplicateCluster(tweetRepo, cfg.Spam.DuplicateWindow, cfg.Spam.DuplicateAuthors),
+			spam.NewCashtagStuffing(cfg.Spam.MaxCashtags),
+			spam.NewLinkFarm(cfg.Spam.LinkFarmDomains),
+			spam.NewNewLowFollower(cfg.Spam.NewAccountAge, cfg.Spam.MinFollowers),
+		)
+	}
+
 	// use cases
-	tweetUseCase := tweet.New(tweetRepo, fetcher)
+	tweetUseCase := tweet.New(tweetRepo, sentimentAggRepo, fetcher, spamFilter)
 	adminUseCase := admin.New(tweetRepo)
+	bulkUseCase := admin.NewBulk(tweetRepo, bulkRepo, analyzer, cfg.Bulk.BatchSize, cfg.Export.Dir)
+	exportUseCase := export.New(tweetRepo, sentimentAggRepo, cfg.Export.Dir)
+	authorScoreUseCase := author.New(
+		authorScoreRepo,
+		cfg.AuthorScore.Window,
+		cfg.AuthorScore.MinTweets,
+		cfg.AuthorScore.HitRate,
+	)
+
+	// background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	if cfg.AuthorScore.Enabled {
+		go runPeriodically(jobsCtx, cfg.AuthorScore.Interval, func(ctx context.Context) {
+			n, err := authorScoreUseCase.Recompute(ctx)
+			if err != nil {
+				l.Error("app - Run - authorScoreUseCase.Recompute: %v", err)
+				return
+			}
+			l.Info("app - Run - author scores recomputed: %d", n)
+		})
+	}
+
+	if cfg.Export.DumpEnabled {
+		go runPeriodically(jobsCtx, cfg.Export.DumpInterval, func(ctx context.Context) {
+			paths, err := exportUseCase.Dump(ctx, export.Format(cfg.Export.DumpFormat), cfg.Export.DumpInterval)
+			if err != nil {
+				l.Error("app - Run - exportUseCase.Dump: %v", err)
+				return
+			}
+			l.Info("app - Run - export dumps written: %v", paths)
+		})
+	}
+
+	// access tokens of auth-service, without a key set guarded calls are rejected
+	var verifier authz.Verifier
+	if cfg.Auth.JWKSURL != "" {
+		jwks, err := authz.NewJWKSVerifier(jobsCtx, cfg.Auth.JWKSURL, cfg.Auth.Issuer, cfg.Auth.JWKSRefreshInterval)
+		if err != nil {
+			l.Fatal("Failed to initialize JWKS verifier: %v", err)
+		}
+		verifier = jwks
+	} else {
+		l.Warn("app - Run - AUTH_JWKS_URL is not set, admin and export calls are rejected")
+	}
 
 	// GRPC server
 	gs := grpcserver.New(
 		grpcserver.Port("0.0.0.0:"+cfg.GRPC.Port),
 		grpcserver.MaxStreams(cfg.GRPC.MaxConcurrentStreams),
 		grpcserver.TLS(cfg.TLS.CertFile, cfg.TLS.KeyFile),
+		grpcserver.UnaryInterceptors(
+			grpcController.RecoveryUnaryInterceptor(l),
+			authz.UnaryServerInterceptor(verifier, grpcController.AuthPolicy),
+			grpcController.ErrorUnaryInterceptor(l),
+		),
+		grpcserver.StreamInterceptors(
+			grpcController.RecoveryStreamInterceptor(l),
+			authz.StreamServerInterceptor(verifier, grpcController.AuthPolicy),
+			grpcController.ErrorStreamInterceptor(l),
+		),
 	)
 
 	// register services
 	gs.Serve(func(s *grpc.Server) {
 		// register all services with the same server instance
 		tweetspb.RegisterTweetServiceServer(s, grpcController.NewTweetService(tweetUseCase))
-		adminpb.RegisterAdminTweetServiceServer(s, grpcController.NewAdminTweetService(adminUseCase))
+		adminpb.RegisterAdminTweetServiceServer(s, grpcController.NewAdminTweetService(adminUseCase, authorScoreUseCase, bulkUseCase))
+		exportpb.RegisterExportServiceServer(s, grpcController.NewExportService(exportUseCase))
 	})
 	l.Info("gRPC server listening on " + cfg.GRPC.Port)
 
@@ -77,6 +166,10 @@ func Run(cfg *config.Config) {
 	}
 
 	// graceful shutdown
+	stopJobs()
 	l.Info("app - Run - shutting down gRPC server")
 	gs.GracefulStop(cfg.GRPC.ShutdownTimeout)
+
+	l.Info("app - Run - stopping bulk operations")
+	bulkUseCase.Shutdown()
 }
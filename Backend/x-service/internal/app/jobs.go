@@ -0,0 +1,23 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// runPeriodically calls job every interval until ctx is canceled,
+// the first run happens right away
+func runPeriodically(ctx context.Context, interval time.Duration, job func(context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
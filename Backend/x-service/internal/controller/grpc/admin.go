@@ -5,39 +5,47 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/admin"
 	adminpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // AdminTweetService is a gRPC service for admin operations on tweets
 type AdminTweetService struct {
 	adminpb.UnimplementedAdminTweetServiceServer
-	adminTweetUseCase *admin.UseCase
+	adminTweetUseCase  *admin.UseCase
+	authorScoreUseCase usecase.AuthorScoreUseCase
+	bulkUseCase        *admin.Bulk
 }
 
 // NewAdminTweetService creates a new AdminTweetService
-func NewAdminTweetService(adminTweetUseCase *admin.UseCase) *AdminTweetService {
+func NewAdminTweetService(
+	adminTweetUseCase *admin.UseCase,
+	authorScoreUseCase usecase.AuthorScoreUseCase,
+	bulkUseCase *admin.Bulk,
+) *AdminTweetService {
 	return &AdminTweetService{
-		adminTweetUseCase: adminTweetUseCase,
+		adminTweetUseCase:  adminTweetUseCase,
+		authorScoreUseCase: authorScoreUseCase,
+		bulkUseCase:        bulkUseCase,
 	}
 }
 
 // CreateTweet creates a new tweet in the database
 func (s *AdminTweetService) CreateTweet(ctx context.Context, req *adminpb.CreateTweetRequest) (*adminpb.CreateTweetResponse, error) {
 	if req.GetText() == "" {
-		return nil, status.Error(codes.InvalidArgument, "text is required")
+		return nil, &entity.FieldError{Field: "text", Description: "text is required"}
 	}
 	if req.GetAuthorId() == "" {
-		return nil, status.Error(codes.InvalidArgument, "author_id is required")
+		return nil, &entity.FieldError{Field: "author_id", Description: "author_id is required"}
 	}
 
 	tweet, err := s.adminTweetUseCase.Create(ctx, req.Text, req.AuthorId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("s.adminTweetUseCase.Create(): %v", err))
+		return nil, fmt.Errorf("s.adminTweetUseCase.Create(): %w", err)
 	}
 
 	return &adminpb.CreateTweetResponse{
@@ -48,17 +56,17 @@ func (s *AdminTweetService) CreateTweet(ctx context.Context, req *adminpb.Create
 // GetTweet retrieves a tweet from the database
 func (s *AdminTweetService) GetTweet(ctx context.Context, req *adminpb.GetTweetRequest) (*adminpb.GetTweetResponse, error) {
 	if req.GetId() == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+		return nil, &entity.FieldError{Field: "id", Description: "id is required"}
 	}
 
 	id, err := uuid.Parse(req.GetId())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid id format")
+		return nil, &entity.FieldError{Field: "id", Description: "invalid id format"}
 	}
 
 	tweet, err := s.adminTweetUseCase.Get(ctx, id)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("s.adminTweetUseCase.Get(): %v", err))
+		return nil, fmt.Errorf("s.adminTweetUseCase.Get(): %w", err)
 	}
 
 	return &adminpb.GetTweetResponse{
@@ -68,9 +76,29 @@ func (s *AdminTweetService) GetTweet(ctx context.Context, req *adminpb.GetTweetR
 
 // ListTweets lists tweets from the database
 func (s *AdminTweetService) ListTweets(ctx context.Context, req *adminpb.ListTweetsRequest) (*adminpb.ListTweetsResponse, error) {
+	filter := toTweetFilter(req)
+
+	tweets, err := s.adminTweetUseCase.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("s.adminTweetUseCase.List(): %w", err)
+	}
+
+	response := &adminpb.ListTweetsResponse{
+		Tweets: make([]*adminpb.Tweet, len(tweets)),
+	}
+
+	for i, t := range tweets {
+		response.Tweets[i] = toProtoAdminTweet(t)
+	}
+
+	return response, nil
+}
+
+// toTweetFilter converts list request fields into a repository filter
+func toTweetFilter(req *adminpb.ListTweetsRequest) repo.TweetFilter {
 	filter := repo.TweetFilter{
 		AuthorID:       req.AuthorId,
-		IsFinancial:    &req.IsFinancial,
+		IsFinancial:    req.IsFinancial,
 		SentimentLabel: req.SentimentLabel,
 		Symbols:        req.Symbols,
 		Limit:          int32(req.Limit),
@@ -86,36 +114,23 @@ func (s *AdminTweetService) ListTweets(ctx context.Context, req *adminpb.ListTwe
 		filter.EndTime = &endTime
 	}
 
-	tweets, err := s.adminTweetUseCase.List(ctx, filter)
-	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("s.adminTweetUseCase.List(): %v", err))
-	}
-
-	response := &adminpb.ListTweetsResponse{
-		Tweets: make([]*adminpb.Tweet, len(tweets)),
-	}
-
-	for i, t := range tweets {
-		response.Tweets[i] = toProtoAdminTweet(t)
-	}
-
-	return response, nil
+	return filter
 }
 
 // UpdateTweet updates a tweet in the database
 func (s *AdminTweetService) UpdateTweet(ctx context.Context, req *adminpb.UpdateTweetRequest) (*adminpb.UpdateTweetResponse, error) {
 	if req.GetId() == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+		return nil, &entity.FieldError{Field: "id", Description: "id is required"}
 	}
 
 	id, err := uuid.Parse(req.GetId())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid id format")
+		return nil, &entity.FieldError{Field: "id", Description: "invalid id format"}
 	}
 
 	tweet, err := s.adminTweetUseCase.Get(ctx, id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("s.adminTweetUseCase.Get(): %v", err))
+		return nil, fmt.Errorf("s.adminTweetUseCase.Get(): %w", err)
 	}
 
 	if req.GetText() != "" {
@@ -132,7 +147,7 @@ func (s *AdminTweetService) UpdateTweet(ctx context.Context, req *adminpb.Update
 	}
 
 	if err := s.adminTweetUseCase.Update(ctx, tweet); err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("s.adminTweetUseCase.Update(): %v", err))
+		return nil, fmt.Errorf("s.adminTweetUseCase.Update(): %w", err)
 	}
 
 	return &adminpb.UpdateTweetResponse{Tweet: toProtoAdminTweet(tweet)}, nil
@@ -141,16 +156,16 @@ func (s *AdminTweetService) UpdateTweet(ctx context.Context, req *adminpb.Update
 // DeleteTweet deletes a tweet from the database
 func (s *AdminTweetService) DeleteTweet(ctx context.Context, req *adminpb.DeleteTweetRequest) (*adminpb.DeleteTweetResponse, error) {
 	if req.GetId() == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+		return nil, &entity.FieldError{Field: "id", Description: "id is required"}
 	}
 
 	id, err := uuid.Parse(req.GetId())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid id format")
+		return nil, &entity.FieldError{Field: "id", Description: "invalid id format"}
 	}
 
 	if err := s.adminTweetUseCase.Delete(ctx, id); err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("s.adminTweetUseCase.Delete(): %v", err))
+		return nil, fmt.Errorf("s.adminTweetUseCase.Delete(): %w", err)
 	}
 
 	return &adminpb.DeleteTweetResponse{}, nil
@@ -158,12 +173,12 @@ func (s *AdminTweetService) DeleteTweet(ctx context.Context, req *adminpb.Delete
 
 func (s *AdminTweetService) GetTweetsBySymbol(ctx context.Context, req *adminpb.GetTweetsBySymbolRequest) (*adminpb.GetTweetsBySymbolResponse, error) {
 	if req.GetSymbol() == "" {
-		return nil, status.Error(codes.InvalidArgument, "symbol is required")
+		return nil, &entity.FieldError{Field: "symbol", Description: "symbol is required"}
 	}
 
 	tweets, err := s.adminTweetUseCase.GetTweetsBySymbol(ctx, req.GetSymbol(), req.GetLimit(), req.GetOffset())
 	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("s.adminTweetUseCase.GetTweetsBySymbol(): %v", err))
+		return nil, fmt.Errorf("s.adminTweetUseCase.GetTweetsBySymbol(): %w", err)
 	}
 
 	response := &adminpb.GetTweetsBySymbolResponse{
@@ -180,12 +195,12 @@ func (s *AdminTweetService) GetTweetsBySymbol(ctx context.Context, req *adminpb.
 // GetTweetsBySentiment retrieves tweets by sentiment from the database
 func (s *AdminTweetService) GetTweetsBySentiment(ctx context.Context, req *adminpb.GetTweetsBySentimentRequest) (*adminpb.GetTweetsBySentimentResponse, error) {
 	if req.GetLabel() == "" {
-		return nil, status.Error(codes.InvalidArgument, "label is required")
+		return nil, &entity.FieldError{Field: "label", Description: "label is required"}
 	}
 
 	tweets, err := s.adminTweetUseCase.GetTweetsBySentiment(ctx, req.GetLabel(), req.GetLimit(), req.GetOffset())
 	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("s.adminTweetUseCase.GetTweetsBySentiment(): %v", err))
+		return nil, fmt.Errorf("s.adminTweetUseCase.GetTweetsBySentiment(): %w", err)
 	}
 
 	response := &adminpb.GetTweetsBySentimentResponse{
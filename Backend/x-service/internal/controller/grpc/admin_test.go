@@ -0,0 +1,176 @@
+package grpc_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	grpcController "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/controller/grpc"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/admin"
+	adminpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1"
+)
+
+// fakeTweets records the filters tweets are selected by, listIDs replaces
+// ListIDs when set
+type fakeTweets struct {
+	repo.TweetRepository
+
+	mu      sync.Mutex
+	filters []repo.TweetFilter
+	listIDs func(repo.TweetFilter) []uuid.UUID
+}
+
+func (f *fakeTweets) List(_ context.Context, filter repo.TweetFilter) ([]*entity.Tweet, error) {
+	f.record(filter)
+	return nil, nil
+}
+
+func (f *fakeTweets) ListIDs(_ context.Context, filter repo.TweetFilter) ([]uuid.UUID, error) {
+	f.record(filter)
+	if f.listIDs != nil {
+		return f.listIDs(filter), nil
+	}
+	return []uuid.UUID{uuid.New()}, nil
+}
+
+func (f *fakeTweets) Iterate(_ context.Context, filter repo.TweetFilter, fn func(*entity.Tweet) error) error {
+	f.record(filter)
+	return fn(&entity.Tweet{ID: uuid.New(), Text: "hi"})
+}
+
+func (f *fakeTweets) DeleteMany(_ context.Context, ids []uuid.UUID) (int64, error) {
+	return int64(len(ids)), nil
+}
+
+func (f *fakeTweets) record(filter repo.TweetFilter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.filters = append(f.filters, filter)
+}
+
+func (f *fakeTweets) lastFilter() repo.TweetFilter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.filters[len(f.filters)-1]
+}
+
+// fakeOps keeps bulk operations in memory
+type fakeOps struct {
+	repo.BulkOperationRepository
+
+	mu  sync.Mutex
+	ops map[uuid.UUID]entity.BulkOperation
+}
+
+func (f *fakeOps) Create(_ context.Context, op *entity.BulkOperation) error {
+	return f.Save(context.Background(), op)
+}
+
+func (f *fakeOps) Save(_ context.Context, op *entity.BulkOperation) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.ops == nil {
+		f.ops = make(map[uuid.UUID]entity.BulkOperation)
+	}
+	f.ops[op.ID] = *op
+	return nil
+}
+
+func (f *fakeOps) get(id uuid.UUID) entity.BulkOperation {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.ops[id]
+}
+
+func adminService(t *testing.T, tweets *fakeTweets) (*grpcController.AdminTweetService, *fakeOps) {
+	t.Helper()
+
+	ops := &fakeOps{}
+	bulk := admin.NewBulk(tweets, ops, nil, 10, t.TempDir())
+	t.Cleanup(bulk.Shutdown)
+
+	return grpcController.NewAdminTweetService(admin.New(tweets), nil, bulk), ops
+}
+
+// finished waits for the bulk operation to reach a final status
+func finished(t *testing.T, ops *fakeOps, id string) entity.BulkOperation {
+	t.Helper()
+
+	var op entity.BulkOperation
+	require.Eventually(t, func() bool {
+		op = ops.get(uuid.MustParse(id))
+		return op.IsDone()
+	}, time.Second, 5*time.Millisecond)
+
+	return op
+}
+
+func TestBulkSelectorIsFinancial(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		isFinancial *bool
+	}{
+		{name: "unset matches both"},
+		{name: "financial", isFinancial: proto.Bool(true)},
+		{name: "not financial", isFinancial: proto.Bool(false)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tweets := &fakeTweets{}
+			svc, ops := adminService(t, tweets)
+
+			resp, err := svc.BulkDeleteTweets(context.Background(), &adminpb.BulkDeleteTweetsRequest{
+				Selector: &adminpb.BulkSelector{Target: &adminpb.BulkSelector_Filter{
+					Filter: &adminpb.ListTweetsRequest{AuthorId: "a1", IsFinancial: tc.isFinancial, Limit: 5},
+				}},
+			})
+			require.NoError(t, err)
+
+			op := finished(t, ops, resp.GetOperation().GetId())
+			require.Equal(t, entity.BulkStatusSucceeded, op.Status)
+
+			// the whole matching set is selected, without a time range
+			require.Equal(t, repo.TweetFilter{AuthorID: "a1", IsFinancial: tc.isFinancial}, tweets.lastFilter())
+		})
+	}
+}
+
+func TestListTweetsIsFinancialUnset(t *testing.T) {
+	t.Parallel()
+
+	tweets := &fakeTweets{}
+	svc, _ := adminService(t, tweets)
+
+	_, err := svc.ListTweets(context.Background(), &adminpb.ListTweetsRequest{SentimentLabel: entity.SentimentPositive, Limit: 20})
+	require.NoError(t, err)
+	require.Equal(t, repo.TweetFilter{SentimentLabel: entity.SentimentPositive, Limit: 20}, tweets.lastFilter())
+}
+
+func TestBulkOperationPanics(t *testing.T) {
+	t.Parallel()
+
+	tweets := &fakeTweets{listIDs: func(repo.TweetFilter) []uuid.UUID { panic("boom") }}
+	svc, ops := adminService(t, tweets)
+
+	resp, err := svc.BulkDeleteTweets(context.Background(), &adminpb.BulkDeleteTweetsRequest{
+		Selector: &adminpb.BulkSelector{Target: &adminpb.BulkSelector_Filter{Filter: &adminpb.ListTweetsRequest{}}},
+	})
+	require.NoError(t, err)
+
+	// the operation fails instead of the service
+	op := finished(t, ops, resp.GetOperation().GetId())
+	require.Equal(t, entity.BulkStatusFailed, op.Status)
+	require.Contains(t, op.Error, "panicked: boom")
+}
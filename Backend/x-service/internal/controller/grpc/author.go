@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	adminpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1"
+)
+
+const _defaultAuthorScoresLimit = 50
+
+// GetAuthorScore retrieves the score of a single author
+func (s *AdminTweetService) GetAuthorScore(ctx context.Context, req *adminpb.GetAuthorScoreRequest) (*adminpb.GetAuthorScoreResponse, error) {
+	if req.GetAuthorId() == "" {
+		return nil, &entity.FieldError{Field: "author_id", Description: "author_id is required"}
+	}
+
+	score, err := s.authorScoreUseCase.Get(ctx, req.GetAuthorId())
+	if err != nil {
+		return nil, fmt.Errorf("s.authorScoreUseCase.Get(): %w", err)
+	}
+
+	return &adminpb.GetAuthorScoreResponse{
+		Score: toProtoAuthorScore(score),
+	}, nil
+}
+
+// ListAuthorScores retrieves authors ranked by score
+func (s *AdminTweetService) ListAuthorScores(ctx context.Context, req *adminpb.ListAuthorScoresRequest) (*adminpb.ListAuthorScoresResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = _defaultAuthorScoresLimit
+	}
+
+	scores, err := s.authorScoreUseCase.List(ctx, limit, req.GetOffset())
+	if err != nil {
+		return nil, fmt.Errorf("s.authorScoreUseCase.List(): %w", err)
+	}
+
+	response := &adminpb.ListAuthorScoresResponse{
+		Scores: make([]*adminpb.AuthorScore, len(scores)),
+	}
+
+	for i, sc := range scores {
+		response.Scores[i] = toProtoAuthorScore(sc)
+	}
+
+	return response, nil
+}
+
+// RecomputeAuthorScores runs the author scoring job immediately
+func (s *AdminTweetService) RecomputeAuthorScores(ctx context.Context, _ *adminpb.RecomputeAuthorScoresRequest) (*adminpb.RecomputeAuthorScoresResponse, error) {
+	n, err := s.authorScoreUseCase.Recompute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("s.authorScoreUseCase.Recompute(): %w", err)
+	}
+
+	return &adminpb.RecomputeAuthorScoresResponse{
+		Scored: int32(n),
+	}, nil
+}
@@ -0,0 +1,14 @@
+package grpc
+
+import (
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	adminpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1"
+	exportpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/export/v1"
+)
+
+// AuthPolicy lists the services that need an access token of auth-service,
+// TweetService stays open to other backend services
+var AuthPolicy = authz.Policy{
+	"/" + adminpb.AdminTweetService_ServiceDesc.ServiceName + "/": authz.RequirePermissions(authz.PermTweetsAdmin),
+	"/" + exportpb.ExportService_ServiceDesc.ServiceName + "/":    authz.RequirePermissions(authz.PermTweetsExport),
+}
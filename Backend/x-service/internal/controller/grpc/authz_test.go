@@ -0,0 +1,65 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denterry/FinancialAdviser/Backend/auth-service/pkg/authz"
+	grpcController "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/controller/grpc"
+	adminpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1"
+)
+
+// fakeVerifier accepts the tokens it knows
+type fakeVerifier map[string]*authz.Claims
+
+func (v fakeVerifier) Verify(_ context.Context, token string) (*authz.Claims, error) {
+	c, ok := v[token]
+	if !ok {
+		return nil, errors.New("signature is invalid")
+	}
+	return c, nil
+}
+
+func TestAuthPolicyAdmin(t *testing.T) {
+	t.Parallel()
+
+	verifier := fakeVerifier{
+		"admin": {UserID: "u1", Role: authz.RoleAdmin, Permissions: []string{authz.PermTweetsAdmin}},
+		// an admin who has not enrolled in MFA yet is granted nothing, older
+		// tokens still carried the role
+		"withheld": {UserID: "u2", Role: authz.RoleAdmin, Permissions: []string{}},
+		"analyst":  {UserID: "u3", Role: authz.RoleAnalyst, Permissions: []string{authz.PermTweetsRead, authz.PermTweetsExport}},
+	}
+
+	svc, _ := adminService(t, &fakeTweets{})
+	conn := dial(t, func(s *grpc.Server) { adminpb.RegisterAdminTweetServiceServer(s, svc) },
+		grpc.UnaryInterceptor(authz.UnaryServerInterceptor(verifier, grpcController.AuthPolicy)))
+	client := adminpb.NewAdminTweetServiceClient(conn)
+
+	tests := []struct {
+		token string
+		code  codes.Code
+	}{
+		{token: "admin", code: codes.OK},
+		{token: "withheld", code: codes.PermissionDenied},
+		{token: "analyst", code: codes.PermissionDenied},
+		{token: "forged", code: codes.Unauthenticated},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.token, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+tc.token)
+			_, err := client.ListTweets(ctx, &adminpb.ListTweetsRequest{Limit: 10})
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/admin"
+	adminpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1"
+	"github.com/google/uuid"
+)
+
+const _defaultBulkOperationsLimit = 50
+
+// BulkRetagSymbols starts adding and removing symbols on selected tweets
+func (s *AdminTweetService) BulkRetagSymbols(ctx context.Context, req *adminpb.BulkRetagSymbolsRequest) (*adminpb.BulkOperationResponse, error) {
+	if len(req.GetAdd()) == 0 && len(req.GetRemove()) == 0 {
+		return nil, &entity.FieldError{Field: "add", Description: "add or remove is required"}
+	}
+
+	sel, err := toBulkSelector(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := s.bulkUseCase.RetagSymbols(ctx, sel, req.GetAdd(), req.GetRemove())
+	if err != nil {
+		return nil, fmt.Errorf("s.bulkUseCase.RetagSymbols(): %w", err)
+	}
+
+	return &adminpb.BulkOperationResponse{Operation: toProtoBulkOperation(op)}, nil
+}
+
+// BulkRescoreSentiment starts re-scoring the sentiment of selected tweets with ml-service
+func (s *AdminTweetService) BulkRescoreSentiment(ctx context.Context, req *adminpb.BulkRescoreSentimentRequest) (*adminpb.BulkOperationResponse, error) {
+	sel, err := toBulkSelector(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := s.bulkUseCase.RescoreSentiment(ctx, sel)
+	if err != nil {
+		return nil, fmt.Errorf("s.bulkUseCase.RescoreSentiment(): %w", err)
+	}
+
+	return &adminpb.BulkOperationResponse{Operation: toProtoBulkOperation(op)}, nil
+}
+
+// BulkDeleteTweets starts deleting selected tweets
+func (s *AdminTweetService) BulkDeleteTweets(ctx context.Context, req *adminpb.BulkDeleteTweetsRequest) (*adminpb.BulkOperationResponse, error) {
+	sel, err := toBulkSelector(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := s.bulkUseCase.Delete(ctx, sel)
+	if err != nil {
+		return nil, fmt.Errorf("s.bulkUseCase.Delete(): %w", err)
+	}
+
+	return &adminpb.BulkOperationResponse{Operation: toProtoBulkOperation(op)}, nil
+}
+
+// BulkExportTweets starts exporting selected tweets into a JSONL file
+func (s *AdminTweetService) BulkExportTweets(ctx context.Context, req *adminpb.BulkExportTweetsRequest) (*adminpb.BulkOperationResponse, error) {
+	sel, err := toBulkSelector(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := s.bulkUseCase.Export(ctx, sel)
+	if err != nil {
+		return nil, fmt.Errorf("s.bulkUseCase.Export(): %w", err)
+	}
+
+	return &adminpb.BulkOperationResponse{Operation: toProtoBulkOperation(op)}, nil
+}
+
+// GetBulkOperation retrieves a bulk operation with its progress
+func (s *AdminTweetService) GetBulkOperation(ctx context.Context, req *adminpb.GetBulkOperationRequest) (*adminpb.BulkOperationResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, &entity.FieldError{Field: "id", Description: "invalid id format"}
+	}
+
+	op, err := s.bulkUseCase.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("s.bulkUseCase.Get(): %w", err)
+	}
+
+	return &adminpb.BulkOperationResponse{Operation: toProtoBulkOperation(op)}, nil
+}
+
+// ListBulkOperations retrieves bulk operations, newest first
+func (s *AdminTweetService) ListBulkOperations(ctx context.Context, req *adminpb.ListBulkOperationsRequest) (*adminpb.ListBulkOperationsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = _defaultBulkOperationsLimit
+	}
+
+	ops, err := s.bulkUseCase.List(ctx, limit, req.GetOffset())
+	if err != nil {
+		return nil, fmt.Errorf("s.bulkUseCase.List(): %w", err)
+	}
+
+	response := &adminpb.ListBulkOperationsResponse{
+		Operations: make([]*adminpb.BulkOperation, len(ops)),
+	}
+
+	for i, op := range ops {
+		response.Operations[i] = toProtoBulkOperation(op)
+	}
+
+	return response, nil
+}
+
+// CancelBulkOperation stops a running bulk operation
+func (s *AdminTweetService) CancelBulkOperation(ctx context.Context, req *adminpb.CancelBulkOperationRequest) (*adminpb.CancelBulkOperationResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, &entity.FieldError{Field: "id", Description: "invalid id format"}
+	}
+
+	if err := s.bulkUseCase.Cancel(ctx, id); err != nil {
+		return nil, fmt.Errorf("s.bulkUseCase.Cancel(): %w", err)
+	}
+
+	return &adminpb.CancelBulkOperationResponse{}, nil
+}
+
+// toBulkSelector converts a proto selector, it returns a gRPC status error
+func toBulkSelector(sel *adminpb.BulkSelector) (admin.Selector, error) {
+	switch {
+	case sel.GetIds() != nil:
+		if len(sel.GetIds().GetIds()) == 0 {
+			return admin.Selector{}, &entity.FieldError{Field: "selector.ids", Description: "selector ids are empty"}
+		}
+
+		ids := make([]uuid.UUID, len(sel.GetIds().GetIds()))
+		for i, raw := range sel.GetIds().GetIds() {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return admin.Selector{}, &entity.FieldError{Field: "selector.ids", Description: fmt.Sprintf("invalid id format: %q", raw)}
+			}
+			ids[i] = id
+		}
+		return admin.Selector{IDs: ids}, nil
+
+	case sel.GetFilter() != nil:
+		return admin.Selector{Filter: toTweetFilter(sel.GetFilter())}, nil
+
+	default:
+		return admin.Selector{}, &entity.FieldError{Field: "selector", Description: "selector is required"}
+	}
+}
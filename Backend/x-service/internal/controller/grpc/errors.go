@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// _internalMessage replaces messages of internal errors, they may carry SQL
+// statements, driver errors and other details clients must not see
+const _internalMessage = "internal error"
+
+// ErrorUnaryInterceptor converts errors returned by unary handlers into gRPC statuses
+func ErrorUnaryInterceptor(l logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(l, info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+// ErrorStreamInterceptor converts errors returned by stream handlers into gRPC statuses
+func ErrorStreamInterceptor(l logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return toStatusError(l, info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+// RecoveryUnaryInterceptor turns panics of unary handlers into internal
+// errors, the panic and its stack are logged. It goes first, so panics of the
+// other interceptors are recovered too
+func RecoveryUnaryInterceptor(l logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(l, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamInterceptor turns panics of stream handlers into internal
+// errors, the panic and its stack are logged
+func RecoveryStreamInterceptor(l logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(l, info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recovered(l logger.Logger, method string, r any) error {
+	l.Error("grpc - %s: panic: %v\n%s", method, r, debug.Stack())
+	return status.Error(codes.Internal, _internalMessage)
+}
+
+// toStatusError maps a domain error kind to its status code and details,
+// internal errors are logged in full and returned with a generic message
+func toStatusError(l logger.Logger, method string, err error) error {
+	var (
+		code    codes.Code
+		details []protoadapt.MessageV1
+	)
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, entity.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, entity.ErrInvalid):
+		code = codes.InvalidArgument
+		if br := badRequest(err); br != nil {
+			details = append(details, br)
+		}
+	case errors.Is(err, entity.ErrAlreadyExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, entity.ErrConflict):
+		code = codes.Aborted
+	case errors.Is(err, entity.ErrPrecondition):
+		code = codes.FailedPrecondition
+	case errors.Is(err, entity.ErrUnavailable):
+		code = codes.Unavailable
+		var te *entity.ThrottledError
+		if errors.As(err, &te) && te.RetryAfter > 0 {
+			details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(te.RetryAfter)})
+		}
+	default:
+		// statuses built by handlers themselves pass through unless they are
+		// internal, statuses of downstream services wrapped in errors do not
+		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			if c := se.GRPCStatus().Code(); c != codes.Internal && c != codes.Unknown {
+				return err
+			}
+		}
+
+		l.Error("grpc - %s: %v", method, err)
+		return status.Error(codes.Internal, _internalMessage)
+	}
+
+	st := status.New(code, publicMessage(err))
+	if len(details) > 0 {
+		if withDetails, derr := st.WithDetails(details...); derr == nil {
+			st = withDetails
+		}
+	}
+
+	return st.Err()
+}
+
+// publicMessage returns the message of the domain error in the chain,
+// not the whole chain which names internal calls and wrapped causes
+func publicMessage(err error) string {
+	var (
+		de *entity.Error
+		fe *entity.FieldError
+		te *entity.ThrottledError
+	)
+
+	switch {
+	case errors.As(err, &fe):
+		return fe.Description
+	case errors.As(err, &te):
+		return te.Provider + " is rate limited"
+	case errors.As(err, &de):
+		return de.Msg
+	default:
+		return _internalMessage
+	}
+}
+
+// badRequest collects field violations of all field errors in the chain
+func badRequest(err error) *errdetails.BadRequest {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	var walk func(error)
+	walk = func(err error) {
+		if fe, ok := err.(*entity.FieldError); ok {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fe.Field,
+				Description: fe.Description,
+			})
+		}
+
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			if inner := x.Unwrap(); inner != nil {
+				walk(inner)
+			}
+		case interface{ Unwrap() []error }:
+			for _, inner := range x.Unwrap() {
+				walk(inner)
+			}
+		}
+	}
+	walk(err)
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return &errdetails.BadRequest{FieldViolations: violations}
+}
@@ -0,0 +1,260 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcController "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/controller/grpc"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/logger"
+)
+
+// fakeLogger records error messages
+type fakeLogger struct {
+	logger.Logger
+
+	mu     sync.Mutex
+	errors []string
+}
+
+func (l *fakeLogger) Error(msg string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errors = append(l.errors, fmt.Sprintf(msg, args...))
+}
+
+func (l *fakeLogger) logged() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.errors
+}
+
+// unaryError runs err through ErrorUnaryInterceptor as the error of a handler
+func unaryError(l logger.Logger, err error) error {
+	_, err = grpcController.ErrorUnaryInterceptor(l)(context.Background(), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/tweets.v1.TweetService/GetTweet"},
+		func(context.Context, any) (any, error) { return nil, err },
+	)
+	return err
+}
+
+func TestErrorUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := entity.NewError(entity.ErrNotFound, "tweet not found")
+
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		msg    string
+		logged bool
+	}{
+		{
+			name: "not found",
+			err:  fmt.Errorf("uc.tweets.Get(): %w", errNotFound.Wrap(errors.New("no rows in result set"))),
+			code: codes.NotFound,
+			msg:  "tweet not found",
+		},
+		{
+			name: "invalid",
+			err:  fmt.Errorf("entity.NewTweet(): %w", entity.ErrEmptyText),
+			code: codes.InvalidArgument,
+			msg:  "tweet text must not be empty",
+		},
+		{
+			name: "already exists",
+			err:  entity.NewError(entity.ErrAlreadyExists, "tweet already exists"),
+			code: codes.AlreadyExists,
+			msg:  "tweet already exists",
+		},
+		{
+			name: "conflict",
+			err:  entity.NewError(entity.ErrConflict, "tweet was modified"),
+			code: codes.Aborted,
+			msg:  "tweet was modified",
+		},
+		{
+			name: "precondition",
+			err:  entity.NewError(entity.ErrPrecondition, "bulk operation is already finished"),
+			code: codes.FailedPrecondition,
+			msg:  "bulk operation is already finished",
+		},
+		{
+			name: "throttled",
+			err:  fmt.Errorf("uc.fetcher.SearchTweets(): %w", &entity.ThrottledError{Provider: "twitter", Err: errors.New("429")}),
+			code: codes.Unavailable,
+			msg:  "twitter is rate limited",
+		},
+		{
+			name: "canceled",
+			err:  fmt.Errorf("uc.tweets.List(): %w", context.Canceled),
+			code: codes.Canceled,
+		},
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("uc.tweets.List(): %w", context.DeadlineExceeded),
+			code: codes.DeadlineExceeded,
+		},
+		{
+			name: "handler status",
+			err:  status.Error(codes.PermissionDenied, "admin only"),
+			code: codes.PermissionDenied,
+			msg:  "admin only",
+		},
+		{
+			name:   "internal",
+			err:    fmt.Errorf("r.Pool.Query(): %w", errors.New(`syntax error at or near "FROM"`)),
+			code:   codes.Internal,
+			msg:    "internal error",
+			logged: true,
+		},
+		{
+			// a downstream status must not leak its code to our clients
+			name:   "wrapped internal status",
+			err:    fmt.Errorf("r.client.Analyze(): %w", status.Error(codes.Internal, "model crashed")),
+			code:   codes.Internal,
+			msg:    "internal error",
+			logged: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := &fakeLogger{}
+			st, ok := status.FromError(unaryError(l, tc.err))
+			require.True(t, ok)
+			require.Equal(t, tc.code, st.Code())
+			if tc.msg != "" {
+				require.Equal(t, tc.msg, st.Message())
+			}
+
+			// internal errors keep their details in the log only
+			if tc.logged {
+				require.Len(t, l.logged(), 1)
+				require.Contains(t, l.logged()[0], tc.err.Error())
+				require.Contains(t, l.logged()[0], "/tweets.v1.TweetService/GetTweet")
+			} else {
+				require.Empty(t, l.logged())
+			}
+		})
+	}
+}
+
+func TestErrorUnaryInterceptorDetails(t *testing.T) {
+	t.Parallel()
+
+	t.Run("field violations", func(t *testing.T) {
+		t.Parallel()
+
+		err := unaryError(&fakeLogger{}, fmt.Errorf("validate: %w", errors.Join(
+			entity.ErrEmptyText,
+			&entity.FieldError{Field: "symbols", Description: "too many symbols"},
+		)))
+
+		st := status.Convert(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+		require.Len(t, st.Details(), 1)
+
+		br, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, br.GetFieldViolations(), 2)
+		require.Equal(t, "text", br.GetFieldViolations()[0].GetField())
+		require.Equal(t, "symbols", br.GetFieldViolations()[1].GetField())
+	})
+
+	t.Run("retry info", func(t *testing.T) {
+		t.Parallel()
+
+		err := unaryError(&fakeLogger{}, &entity.ThrottledError{Provider: "twitter", RetryAfter: 15 * time.Second})
+
+		st := status.Convert(err)
+		require.Equal(t, codes.Unavailable, st.Code())
+		require.Len(t, st.Details(), 1)
+
+		ri, ok := st.Details()[0].(*errdetails.RetryInfo)
+		require.True(t, ok)
+		require.Equal(t, 15*time.Second, ri.GetRetryDelay().AsDuration())
+	})
+
+	t.Run("no retry info without a delay", func(t *testing.T) {
+		t.Parallel()
+
+		err := unaryError(&fakeLogger{}, &entity.ThrottledError{Provider: "twitter"})
+		require.Empty(t, status.Convert(err).Details())
+	})
+}
+
+func TestErrorStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	l := &fakeLogger{}
+	interceptor := grpcController.ErrorStreamInterceptor(l)
+	info := &grpc.StreamServerInfo{FullMethod: "/export.v1.ExportService/ExportTweets"}
+
+	err := interceptor(nil, nil, info, func(any, grpc.ServerStream) error {
+		return fmt.Errorf("s.exportUseCase.Write(): %w", entity.NewError(entity.ErrInvalid, "unknown export format"))
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "unknown export format", status.Convert(err).Message())
+
+	require.NoError(t, interceptor(nil, nil, info, func(any, grpc.ServerStream) error { return nil }))
+	require.Empty(t, l.logged())
+}
+
+func TestRecoveryInterceptors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unary", func(t *testing.T) {
+		t.Parallel()
+
+		l := &fakeLogger{}
+		_, err := grpcController.RecoveryUnaryInterceptor(l)(context.Background(), nil,
+			&grpc.UnaryServerInfo{FullMethod: "/tweets.v1.TweetService/ListTweets"},
+			func(context.Context, any) (any, error) { panic("boom") },
+		)
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Equal(t, "internal error", status.Convert(err).Message())
+
+		require.Len(t, l.logged(), 1)
+		require.Contains(t, l.logged()[0], "/tweets.v1.TweetService/ListTweets: panic: boom")
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		t.Parallel()
+
+		l := &fakeLogger{}
+		err := grpcController.RecoveryStreamInterceptor(l)(nil, nil,
+			&grpc.StreamServerInfo{FullMethod: "/export.v1.ExportService/ExportTweets"},
+			func(any, grpc.ServerStream) error { panic("boom") },
+		)
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Len(t, l.logged(), 1)
+		require.Contains(t, l.logged()[0], "/export.v1.ExportService/ExportTweets: panic: boom")
+	})
+
+	t.Run("no panic", func(t *testing.T) {
+		t.Parallel()
+
+		l := &fakeLogger{}
+		errNotFound := status.Error(codes.NotFound, "tweet not found")
+		_, err := grpcController.RecoveryUnaryInterceptor(l)(context.Background(), nil,
+			&grpc.UnaryServerInfo{FullMethod: "/tweets.v1.TweetService/GetTweet"},
+			func(context.Context, any) (any, error) { return nil, errNotFound },
+		)
+		require.ErrorIs(t, err, errNotFound)
+		require.Empty(t, l.logged())
+	})
+}
@@ -0,0 +1,219 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/export"
+	exportpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/export/v1"
+	"google.golang.org/grpc"
+)
+
+// _exportChunkSize is the data size of a single streamed export chunk
+const _exportChunkSize = 64 * 1024
+
+// ExportService implements the export.v1.ExportService gRPC service
+type ExportService struct {
+	exportpb.UnimplementedExportServiceServer
+	exportUseCase *export.UseCase
+}
+
+// NewExportService creates a new ExportService
+func NewExportService(exportUseCase *export.UseCase) *ExportService {
+	return &ExportService{exportUseCase: exportUseCase}
+}
+
+// ExportTweets streams tweets matching the filter as a file in the requested format
+func (s *ExportService) ExportTweets(req *exportpb.ExportTweetsRequest, stream grpc.ServerStreamingServer[exportpb.ExportChunk]) error {
+	format, err := toExportFormat(req.GetFormat())
+	if err != nil {
+		return err
+	}
+
+	return s.stream(stream, export.Request{
+		Dataset: export.DatasetTweets,
+		Format:  format,
+		Tweets:  toExportTweetFilter(req.GetFilter()),
+	})
+}
+
+// ExportSentimentDaily streams daily sentiment aggregates as a file in the requested format
+func (s *ExportService) ExportSentimentDaily(req *exportpb.ExportSentimentDailyRequest, stream grpc.ServerStreamingServer[exportpb.ExportChunk]) error {
+	format, err := toExportFormat(req.GetFormat())
+	if err != nil {
+		return err
+	}
+
+	return s.stream(stream, export.Request{
+		Dataset:   export.DatasetSentimentDaily,
+		Format:    format,
+		Sentiment: toSentimentAggFilter(req.GetFilter()),
+	})
+}
+
+// ExportToFile writes a dataset into a file on the server export volume
+func (s *ExportService) ExportToFile(ctx context.Context, req *exportpb.ExportToFileRequest) (*exportpb.ExportToFileResponse, error) {
+	format, err := toExportFormat(req.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	r := export.Request{Format: format}
+
+	switch {
+	case req.GetTweets() != nil:
+		r.Dataset = export.DatasetTweets
+		r.Tweets = toExportTweetFilter(req.GetTweets())
+	case req.GetSentimentDaily() != nil:
+		r.Dataset = export.DatasetSentimentDaily
+		r.Sentiment = toSentimentAggFilter(req.GetSentimentDaily())
+	default:
+		return nil, &entity.FieldError{Field: "dataset", Description: "dataset is required"}
+	}
+
+	path, n, err := s.exportUseCase.WriteFile(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("s.exportUseCase.WriteFile(): %w", err)
+	}
+
+	schema, _ := export.SchemaOf(r.Dataset)
+
+	return &exportpb.ExportToFileResponse{
+		Path:   path,
+		Rows:   int64(n),
+		Header: toProtoExportHeader(schema, toProtoExportFormat(r.Format)),
+	}, nil
+}
+
+// stream sends the header and then the encoded dataset in chunks
+func (s *ExportService) stream(stream grpc.ServerStreamingServer[exportpb.ExportChunk], r export.Request) error {
+	schema, err := export.SchemaOf(r.Dataset)
+	if err != nil {
+		return err
+	}
+
+	header := &exportpb.ExportChunk{
+		Payload: &exportpb.ExportChunk_Header{Header: toProtoExportHeader(schema, toProtoExportFormat(r.Format))},
+	}
+	if err := stream.Send(header); err != nil {
+		return err
+	}
+
+	w := &chunkWriter{stream: stream, buf: make([]byte, 0, _exportChunkSize)}
+	if _, err := s.exportUseCase.Write(stream.Context(), r, w); err != nil {
+		return fmt.Errorf("s.exportUseCase.Write(): %w", err)
+	}
+
+	return w.flush()
+}
+
+// chunkWriter buffers encoded output and sends it as data chunks of _exportChunkSize
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[exportpb.ExportChunk]
+	buf    []byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		free := cap(w.buf) - len(w.buf)
+		if free > len(p) {
+			free = len(p)
+		}
+		w.buf = append(w.buf, p[:free]...)
+		p = p[free:]
+
+		if len(w.buf) == cap(w.buf) {
+			if err := w.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (w *chunkWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	// the message is serialized on Send, so the buffer can be reused right after
+	err := w.stream.Send(&exportpb.ExportChunk{
+		Payload: &exportpb.ExportChunk_Data{Data: w.buf},
+	})
+	w.buf = w.buf[:0]
+	return err
+}
+
+// toExportFormat maps the requested format, an unspecified one is CSV
+func toExportFormat(f exportpb.Format) (export.Format, error) {
+	switch f {
+	case exportpb.Format_FORMAT_UNSPECIFIED, exportpb.Format_FORMAT_CSV:
+		return export.FormatCSV, nil
+	case exportpb.Format_FORMAT_JSONL:
+		return export.FormatJSONL, nil
+	case exportpb.Format_FORMAT_PARQUET:
+		return export.FormatParquet, nil
+	default:
+		return "", fmt.Errorf("%w: %s", export.ErrUnknownFormat, f)
+	}
+}
+
+func toProtoExportFormat(f export.Format) exportpb.Format {
+	switch f {
+	case export.FormatJSONL:
+		return exportpb.Format_FORMAT_JSONL
+	case export.FormatParquet:
+		return exportpb.Format_FORMAT_PARQUET
+	default:
+		return exportpb.Format_FORMAT_CSV
+	}
+}
+
+func toProtoExportHeader(s export.Schema, f exportpb.Format) *exportpb.ExportHeader {
+	return &exportpb.ExportHeader{
+		Schema:  s.ID(),
+		Columns: s.ColumnNames(),
+		Format:  f,
+	}
+}
+
+func toExportTweetFilter(f *exportpb.TweetFilter) repo.TweetFilter {
+	filter := repo.TweetFilter{
+		AuthorID:       f.GetAuthorId(),
+		SentimentLabel: f.GetSentimentLabel(),
+		Symbols:        f.GetSymbols(),
+		Limit:          f.GetLimit(),
+	}
+
+	if f != nil && f.IsFinancial != nil {
+		isFinancial := f.GetIsFinancial()
+		filter.IsFinancial = &isFinancial
+	}
+	if f.GetStartTime() != 0 {
+		startTime := time.Unix(f.GetStartTime(), 0)
+		filter.StartTime = &startTime
+	}
+	if f.GetEndTime() != 0 {
+		endTime := time.Unix(f.GetEndTime(), 0)
+		filter.EndTime = &endTime
+	}
+
+	return filter
+}
+
+func toSentimentAggFilter(f *exportpb.SentimentDailyFilter) repo.SentimentAggFilter {
+	filter := repo.SentimentAggFilter{Symbols: f.GetSymbols()}
+
+	if f.GetFrom() != 0 {
+		filter.From = time.Unix(f.GetFrom(), 0).UTC()
+	}
+	if f.GetTo() != 0 {
+		filter.To = time.Unix(f.GetTo(), 0).UTC()
+	}
+
+	return filter
+}
@@ -0,0 +1,148 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	grpcController "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/controller/grpc"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/export"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/logger"
+	exportpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/export/v1"
+)
+
+// dial serves the services registered by register in-process and connects to them
+func dial(t *testing.T, register func(*grpc.Server), opts ...grpc.ServerOption) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(opts...)
+	register(server)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func TestExportTweetsWithoutTimeRange(t *testing.T) {
+	t.Parallel()
+
+	tweets := &fakeTweets{}
+	svc := grpcController.NewExportService(export.New(tweets, nil, t.TempDir()))
+	conn := dial(t, func(s *grpc.Server) { exportpb.RegisterExportServiceServer(s, svc) })
+
+	stream, err := exportpb.NewExportServiceClient(conn).ExportTweets(context.Background(), &exportpb.ExportTweetsRequest{
+		Filter: &exportpb.TweetFilter{Symbols: []string{"TSLA"}},
+		Format: exportpb.Format_FORMAT_CSV,
+	})
+	require.NoError(t, err)
+
+	chunk, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "tweets/v1", chunk.GetHeader().GetSchema())
+
+	var data strings.Builder
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		data.Write(chunk.GetData())
+	}
+	require.Contains(t, data.String(), ",hi,")
+
+	require.Equal(t, repo.TweetFilter{Symbols: []string{"TSLA"}}, tweets.lastFilter())
+}
+
+func TestExportToFileWithoutTimeRange(t *testing.T) {
+	t.Parallel()
+
+	tweets := &fakeTweets{}
+	svc := grpcController.NewExportService(export.New(tweets, nil, t.TempDir()))
+
+	resp, err := svc.ExportToFile(context.Background(), &exportpb.ExportToFileRequest{
+		Format:  exportpb.Format_FORMAT_JSONL,
+		Dataset: &exportpb.ExportToFileRequest_Tweets{Tweets: &exportpb.TweetFilter{}},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.GetRows())
+	require.FileExists(t, resp.GetPath())
+
+	f := tweets.lastFilter()
+	require.Nil(t, f.StartTime)
+	require.Nil(t, f.EndTime)
+
+	b, err := os.ReadFile(resp.GetPath())
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"text":"hi"`)
+}
+
+func TestExportFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unspecified", func(t *testing.T) {
+		t.Parallel()
+
+		svc := grpcController.NewExportService(export.New(&fakeTweets{}, nil, t.TempDir()))
+		conn := dial(t, func(s *grpc.Server) { exportpb.RegisterExportServiceServer(s, svc) })
+
+		stream, err := exportpb.NewExportServiceClient(conn).ExportTweets(context.Background(), &exportpb.ExportTweetsRequest{})
+		require.NoError(t, err)
+
+		chunk, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, exportpb.Format_FORMAT_CSV, chunk.GetHeader().GetFormat())
+
+		chunk, err = stream.Recv()
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(chunk.GetData()), "#schema=tweets/v1\n"))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		svc := grpcController.NewExportService(export.New(&fakeTweets{}, nil, dir))
+		conn := dial(t, func(s *grpc.Server) { exportpb.RegisterExportServiceServer(s, svc) },
+			grpc.ChainUnaryInterceptor(grpcController.ErrorUnaryInterceptor(logger.New("error"))),
+			grpc.ChainStreamInterceptor(grpcController.ErrorStreamInterceptor(logger.New("error"))),
+		)
+		client := exportpb.NewExportServiceClient(conn)
+
+		stream, err := client.ExportSentimentDaily(context.Background(), &exportpb.ExportSentimentDailyRequest{Format: 7})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, "unknown export format", status.Convert(err).Message())
+
+		_, err = client.ExportToFile(context.Background(), &exportpb.ExportToFileRequest{
+			Format:  7,
+			Dataset: &exportpb.ExportToFileRequest_Tweets{Tweets: &exportpb.TweetFilter{}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, files)
+	})
+}
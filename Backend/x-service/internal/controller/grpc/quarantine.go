@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	adminpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1"
+	"github.com/google/uuid"
+)
+
+const _defaultQuarantineLimit = 50
+
+// ListQuarantinedTweets retrieves tweets quarantined by the spam filter
+func (s *AdminTweetService) ListQuarantinedTweets(ctx context.Context, req *adminpb.ListQuarantinedTweetsRequest) (*adminpb.ListQuarantinedTweetsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = _defaultQuarantineLimit
+	}
+
+	tweets, err := s.adminTweetUseCase.ListQuarantined(ctx, entity.SpamReason(req.GetReason()), limit, req.GetOffset())
+	if err != nil {
+		return nil, fmt.Errorf("s.adminTweetUseCase.ListQuarantined(): %w", err)
+	}
+
+	response := &adminpb.ListQuarantinedTweetsResponse{
+		Tweets: make([]*adminpb.Tweet, len(tweets)),
+	}
+
+	for i, t := range tweets {
+		response.Tweets[i] = toProtoAdminTweet(t)
+	}
+
+	return response, nil
+}
+
+// ReleaseTweet releases a quarantined tweet after review
+func (s *AdminTweetService) ReleaseTweet(ctx context.Context, req *adminpb.ReleaseTweetRequest) (*adminpb.ReleaseTweetResponse, error) {
+	if req.GetId() == "" {
+		return nil, &entity.FieldError{Field: "id", Description: "id is required"}
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, &entity.FieldError{Field: "id", Description: "invalid id format"}
+	}
+
+	if err := s.adminTweetUseCase.Release(ctx, id); err != nil {
+		return nil, fmt.Errorf("s.adminTweetUseCase.Release(): %w", err)
+	}
+
+	return &adminpb.ReleaseTweetResponse{}, nil
+}
@@ -2,12 +2,15 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase"
 	tweetspb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/tweets/v1"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // TweetService implements the tweets.v1.TweetService gRPC service
@@ -24,20 +27,28 @@ func NewTweetService(tweetUseCase usecase.TweetUseCase) *TweetService {
 // Ingest pulls fresh tweets matching the query, persists them, and returns how many were ingested
 func (s *TweetService) Ingest(ctx context.Context, req *tweetspb.IngestRequest) (*tweetspb.IngestResponse, error) {
 	if req.GetQuery() == "" {
-		return nil, status.Error(codes.InvalidArgument, "query is required")
+		return nil, &entity.FieldError{Field: "query", Description: "query is required"}
 	}
 	max := int(req.GetMax())
 	if max <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "max must be > 0")
+		return nil, &entity.FieldError{Field: "max", Description: "max must be > 0"}
 	}
 
 	tweets, err := s.tweetUseCase.Ingest(ctx, req.GetQuery(), max)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.tweetUseCase.Ingest(): %v", err)
+		return nil, fmt.Errorf("s.tweetUseCase.Ingest(): %w", err)
+	}
+
+	var quarantined int32
+	for _, t := range tweets {
+		if t.IsQuarantined() {
+			quarantined++
+		}
 	}
 
 	return &tweetspb.IngestResponse{
-		Ingested: int32(len(tweets)),
+		Ingested:    int32(len(tweets)) - quarantined,
+		Quarantined: quarantined,
 	}, nil
 }
 
@@ -45,12 +56,12 @@ func (s *TweetService) Ingest(ctx context.Context, req *tweetspb.IngestRequest)
 func (s *TweetService) ListLatestTweets(ctx context.Context, req *tweetspb.ListLatestTweetsRequest) (*tweetspb.ListLatestTweetsResponse, error) {
 	limit := req.GetLimit()
 	if limit <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "limit must be > 0")
+		return nil, &entity.FieldError{Field: "limit", Description: "limit must be > 0"}
 	}
 
 	tweets, err := s.tweetUseCase.GetListLatest(ctx, limit)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.tweetUseCase.GetListLatest(): %v", err)
+		return nil, fmt.Errorf("s.tweetUseCase.GetListLatest(): %w", err)
 	}
 
 	resp := &tweetspb.ListLatestTweetsResponse{
@@ -67,22 +78,136 @@ func (s *TweetService) ListLatestTweets(ctx context.Context, req *tweetspb.ListL
 // GetTweetByID returns one stored post by its UUID.
 func (s *TweetService) GetTweetByID(ctx context.Context, req *tweetspb.GetTweetByIDRequest) (*tweetspb.GetTweetByIDResponse, error) {
 	if req.GetId() == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+		return nil, &entity.FieldError{Field: "id", Description: "id is required"}
 	}
 	id, err := uuid.Parse(req.GetId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+		return nil, &entity.FieldError{Field: "id", Description: "invalid id format"}
 	}
 
 	tweet, err := s.tweetUseCase.GetByID(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.tweetUseCase.GetByID: %v", err)
+		return nil, fmt.Errorf("s.tweetUseCase.GetByID: %w", err)
 	}
 	if tweet == nil {
-		return nil, status.Error(codes.NotFound, "post not found")
+		return nil, repo.ErrTweetNotFound
 	}
 
 	return &tweetspb.GetTweetByIDResponse{
 		Tweet: toProtoTweet(tweet),
 	}, nil
 }
+
+// _maxPageSize bounds pages of ListTweets and ListTrendingSymbols
+const _maxPageSize = 100
+
+// ListTweets returns a page of stored tweets, newest first
+func (s *TweetService) ListTweets(ctx context.Context, req *tweetspb.ListTweetsRequest) (*tweetspb.ListTweetsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 || limit > _maxPageSize {
+		return nil, &entity.FieldError{Field: "limit", Description: "limit must be in 1..100"}
+	}
+
+	f := repo.TweetFilter{Limit: limit}
+	if symbol := req.GetSymbol(); symbol != "" {
+		f.Symbols = []string{strings.ToUpper(symbol)}
+	}
+	switch label := req.GetSentimentLabel(); label {
+	case "", entity.SentimentPositive, entity.SentimentNegative, entity.SentimentNeutral:
+		f.SentimentLabel = label
+	default:
+		return nil, &entity.FieldError{Field: "sentiment_label", Description: "sentiment_label must be POS, NEG or NEU"}
+	}
+	if req.GetCursor() != "" {
+		cursor, err := entity.DecodeTweetCursor(req.GetCursor())
+		if err != nil {
+			return nil, err
+		}
+		f.After = cursor
+	}
+
+	tweets, next, err := s.tweetUseCase.ListFeed(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("s.tweetUseCase.ListFeed(): %w", err)
+	}
+
+	resp := &tweetspb.ListTweetsResponse{
+		Tweets: make([]*tweetspb.Tweet, len(tweets)),
+	}
+	for i, t := range tweets {
+		resp.Tweets[i] = toProtoTweet(t)
+	}
+	if next != nil {
+		resp.NextCursor = next.Encode()
+	}
+
+	return resp, nil
+}
+
+// GetSentimentSeries returns the daily sentiment aggregates of a symbol
+func (s *TweetService) GetSentimentSeries(ctx context.Context, req *tweetspb.GetSentimentSeriesRequest) (*tweetspb.GetSentimentSeriesResponse, error) {
+	if req.GetSymbol() == "" {
+		return nil, &entity.FieldError{Field: "symbol", Description: "symbol is required"}
+	}
+
+	var from, to time.Time
+	if req.GetFrom() > 0 {
+		from = time.Unix(req.GetFrom(), 0).UTC()
+	}
+	if req.GetTo() > 0 {
+		to = time.Unix(req.GetTo(), 0).UTC()
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, &entity.FieldError{Field: "to", Description: "to must not be before from"}
+	}
+
+	days, err := s.tweetUseCase.SentimentSeries(ctx, req.GetSymbol(), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("s.tweetUseCase.SentimentSeries(): %w", err)
+	}
+
+	resp := &tweetspb.GetSentimentSeriesResponse{
+		Days: make([]*tweetspb.SentimentDay, len(days)),
+	}
+	for i, d := range days {
+		resp.Days[i] = &tweetspb.SentimentDay{
+			Day:              d.Day.Format(time.DateOnly),
+			AvgScore:         d.AvgScore,
+			WeightedAvgScore: d.WeightedAvgScore,
+			Positive:         int32(d.Positive),
+			Negative:         int32(d.Negative),
+			Neutral:          int32(d.Neutral),
+		}
+	}
+
+	return resp, nil
+}
+
+// ListTrendingSymbols returns the symbols mentioned most since the given time
+func (s *TweetService) ListTrendingSymbols(ctx context.Context, req *tweetspb.ListTrendingSymbolsRequest) (*tweetspb.ListTrendingSymbolsResponse, error) {
+	if req.GetSince() <= 0 {
+		return nil, &entity.FieldError{Field: "since", Description: "since is required"}
+	}
+	limit := req.GetLimit()
+	if limit <= 0 || limit > _maxPageSize {
+		return nil, &entity.FieldError{Field: "limit", Description: "limit must be in 1..100"}
+	}
+
+	trends, err := s.tweetUseCase.Trending(ctx, time.Unix(req.GetSince(), 0).UTC(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("s.tweetUseCase.Trending(): %w", err)
+	}
+
+	resp := &tweetspb.ListTrendingSymbolsResponse{
+		Symbols: make([]*tweetspb.SymbolTrend, len(trends)),
+	}
+	for i, t := range trends {
+		resp.Symbols[i] = &tweetspb.SymbolTrend{
+			Symbol:       t.Symbol,
+			Mentions:     int32(t.Mentions),
+			AvgSentiment: t.AvgSentiment,
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcController "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/controller/grpc"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/tweet"
+	tweetspb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/tweets/v1"
+)
+
+// panickingTweets fails listings the way a nil time range once did
+type panickingTweets struct {
+	fakeTweets
+}
+
+func (*panickingTweets) List(context.Context, repo.TweetFilter) ([]*entity.Tweet, error) {
+	panic("runtime error: invalid memory address or nil pointer dereference")
+}
+
+// tweetClient serves TweetService over tweets behind the interceptors of app.Run
+func tweetClient(t *testing.T, tweets repo.TweetRepository, l *fakeLogger) tweetspb.TweetServiceClient {
+	t.Helper()
+
+	svc := grpcController.NewTweetService(tweet.New(tweets, nil, nil, nil))
+	conn := dial(t, func(s *grpc.Server) { tweetspb.RegisterTweetServiceServer(s, svc) },
+		grpc.ChainUnaryInterceptor(
+			grpcController.RecoveryUnaryInterceptor(l),
+			grpcController.ErrorUnaryInterceptor(l),
+		))
+
+	return tweetspb.NewTweetServiceClient(conn)
+}
+
+func TestListTweetsWithoutTimeRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("listed", func(t *testing.T) {
+		t.Parallel()
+
+		tweets := &fakeTweets{}
+		client := tweetClient(t, tweets, &fakeLogger{})
+
+		resp, err := client.ListTweets(context.Background(), &tweetspb.ListTweetsRequest{Symbol: "tsla", Limit: 20})
+		require.NoError(t, err)
+		require.Empty(t, resp.GetTweets())
+		require.Empty(t, resp.GetNextCursor())
+
+		// one more than the page tells whether there is a next one
+		require.Equal(t, repo.TweetFilter{Symbols: []string{"TSLA"}, Limit: 21}, tweets.lastFilter())
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		l := &fakeLogger{}
+		client := tweetClient(t, &panickingTweets{}, l)
+
+		// the call fails, the server stays up
+		for range 2 {
+			_, err := client.ListTweets(context.Background(), &tweetspb.ListTweetsRequest{Limit: 20})
+			require.Equal(t, codes.Internal, status.Code(err))
+			require.Equal(t, "internal error", status.Convert(err).Message())
+		}
+		require.Len(t, l.logged(), 2)
+		require.Contains(t, l.logged()[0], "nil pointer dereference")
+	})
+}
@@ -15,6 +15,16 @@ func toProtoAdminTweet(t *entity.Tweet) *adminpb.Tweet {
 		return nil
 	}
 
+	var quarantinedAt int64
+	if t.QuarantinedAt != nil {
+		quarantinedAt = t.QuarantinedAt.Unix()
+	}
+
+	reasons := make([]string, len(t.QuarantineReasons))
+	for i, r := range t.QuarantineReasons {
+		reasons[i] = string(r)
+	}
+
 	return &adminpb.Tweet{
 		Id:        t.ID.String(),
 		Text:      t.Text,
@@ -32,6 +42,8 @@ func toProtoAdminTweet(t *entity.Tweet) *adminpb.Tweet {
 			FavoriteCount: int32(t.Likes),
 			ReplyCount:    int32(t.Replies),
 		},
+		QuarantineReasons: reasons,
+		QuarantinedAt:     quarantinedAt,
 	}
 }
 
@@ -56,6 +68,51 @@ func toEntityAdminTweet(t *adminpb.Tweet) *entity.Tweet {
 	}
 }
 
+func toProtoAuthorScore(s *entity.AuthorScore) *adminpb.AuthorScore {
+	if s == nil {
+		return nil
+	}
+
+	return &adminpb.AuthorScore{
+		AuthorId:     s.AuthorID,
+		Reach:        s.Reach,
+		Consistency:  s.Consistency,
+		FinanceFocus: s.FinanceFocus,
+		HitRate:      s.HitRate,
+		Score:        s.Score,
+		Tweets:       int32(s.Tweets),
+		ComputedAt:   s.ComputedAt.Unix(),
+	}
+}
+
+func toProtoBulkOperation(op *entity.BulkOperation) *adminpb.BulkOperation {
+	if op == nil {
+		return nil
+	}
+
+	out := &adminpb.BulkOperation{
+		Id:        op.ID.String(),
+		Kind:      string(op.Kind),
+		Status:    string(op.Status),
+		Total:     int32(op.Total),
+		Processed: int32(op.Processed),
+		Succeeded: int32(op.Succeeded),
+		Failed:    int32(op.Failed),
+		Conflicts: int32(op.Conflicts),
+		ResultUri: op.ResultURI,
+		Error:     op.Error,
+		CreatedAt: op.CreatedAt.Unix(),
+	}
+	if op.StartedAt != nil {
+		out.StartedAt = op.StartedAt.Unix()
+	}
+	if op.FinishedAt != nil {
+		out.FinishedAt = op.FinishedAt.Unix()
+	}
+
+	return out
+}
+
 func toProtoTweet(t *entity.Tweet) *tweetspb.Tweet {
 	if t == nil {
 		return nil
@@ -76,6 +133,11 @@ func toProtoTweet(t *entity.Tweet) *tweetspb.Tweet {
 		Urls:      t.URLs,
 		Photos:    t.Photos,
 		Videos:    t.Videos,
+
+		IsFinancial:    t.IsFinancial,
+		Symbols:        t.Symbols,
+		SentimentScore: t.SentimentScore,
+		SentimentLabel: t.SentimentLabel,
 	}
 }
 
@@ -99,5 +161,10 @@ func toEntityTweet(t *tweetspb.Tweet) *entity.Tweet {
 		URLs:      t.Urls,
 		Photos:    t.Photos,
 		Videos:    t.Videos,
+
+		IsFinancial:    t.IsFinancial,
+		Symbols:        t.Symbols,
+		SentimentScore: t.SentimentScore,
+		SentimentLabel: t.SentimentLabel,
 	}
 }
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+// weights of the composite author score, they sum up to 1
+const (
+	authorReachWeight        = 0.35
+	authorConsistencyWeight  = 0.20
+	authorFinanceFocusWeight = 0.30
+	authorHitRateWeight      = 0.15
+
+	// authorVerifiedBonus is added on top of the composite score for verified authors
+	authorVerifiedBonus = 0.05
+)
+
+// AuthorStats is a raw per-author activity summary over a scoring window
+type AuthorStats struct {
+	AuthorID string
+	Verified bool
+
+	Tweets          int
+	FinancialTweets int
+
+	// engagement = likes + replies + retweets
+	MedianEngagement float64
+	MeanEngagement   float64
+	StddevEngagement float64
+
+	// Calls is the number of (symbol, day) sentiment calls that could be checked
+	// against the next sentiment aggregate, Hits is how many of them were right
+	Calls int
+	Hits  int
+}
+
+// AuthorScore represents influence and credibility of a tweet author
+type AuthorScore struct {
+	AuthorID string `db:"author_id" json:"author_id"`
+
+	Reach        float64  `db:"reach" json:"reach"`                 // median engagement
+	Consistency  float64  `db:"consistency" json:"consistency"`     // range 0 .. 1
+	FinanceFocus float64  `db:"finance_focus" json:"finance_focus"` // share of is_financial tweets
+	HitRate      *float64 `db:"hit_rate" json:"hit_rate"`           // nil when not tracked
+	Score        float64  `db:"score" json:"score"`                 // range 0 .. 1, sentiment weight
+
+	Tweets     int       `db:"tweets_cnt" json:"tweets_cnt"`
+	ComputedAt time.Time `db:"computed_at" json:"computed_at"`
+}
+
+// NewAuthorScore scores an author from its stats, maxReach is the highest
+// median engagement among all scored authors and is used to normalise reach
+func NewAuthorScore(s *AuthorStats, maxReach float64, withHitRate bool, now time.Time) *AuthorScore {
+	a := &AuthorScore{
+		AuthorID:   s.AuthorID,
+		Reach:      s.MedianEngagement,
+		Tweets:     s.Tweets,
+		ComputedAt: now.UTC(),
+	}
+
+	// consistency is the inverse of the engagement coefficient of variation
+	if s.MeanEngagement > 0 {
+		a.Consistency = 1 / (1 + s.StddevEngagement/s.MeanEngagement)
+	}
+	if s.Tweets > 0 {
+		a.FinanceFocus = float64(s.FinancialTweets) / float64(s.Tweets)
+	}
+	if withHitRate && s.Calls > 0 {
+		hr := float64(s.Hits) / float64(s.Calls)
+		a.HitRate = &hr
+	}
+
+	// reach is log-scaled, a few viral authors must not flatten everyone else
+	var reach float64
+	if maxReach > 0 {
+		reach = math.Log1p(s.MedianEngagement) / math.Log1p(maxReach)
+	}
+
+	score := authorReachWeight*reach +
+		authorConsistencyWeight*a.Consistency +
+		authorFinanceFocusWeight*a.FinanceFocus
+	if a.HitRate != nil {
+		score += authorHitRateWeight * *a.HitRate
+	} else {
+		score /= 1 - authorHitRateWeight
+	}
+	if s.Verified {
+		score += authorVerifiedBonus
+	}
+	a.Score = math.Min(score, 1)
+
+	return a
+}
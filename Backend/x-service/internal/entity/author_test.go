@@ -0,0 +1,116 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+)
+
+func TestNewAuthorScore(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 6, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	// mid reach half of the top author's on the log scale, engagement as
+	// spread as it is high, a quarter of the tweets financial, 3 of 4 calls right
+	mid := entity.AuthorStats{
+		AuthorID:         "mid",
+		Tweets:           4,
+		FinancialTweets:  1,
+		MedianEngagement: 9,
+		MeanEngagement:   10,
+		StddevEngagement: 10,
+		Calls:            4,
+		Hits:             3,
+	}
+
+	tests := []struct {
+		name        string
+		stats       func(s *entity.AuthorStats)
+		withHitRate bool
+		hitRate     *float64
+		score       float64
+	}{
+		{
+			name:        "with hit rate",
+			withHitRate: true,
+			hitRate:     ptr(0.75),
+			score:       0.35*0.5 + 0.20*0.5 + 0.30*0.25 + 0.15*0.75,
+		},
+		{
+			name:  "hit rate not tracked",
+			score: (0.35*0.5 + 0.20*0.5 + 0.30*0.25) / 0.85,
+		},
+		{
+			name:        "no calls to check",
+			stats:       func(s *entity.AuthorStats) { s.Calls, s.Hits = 0, 0 },
+			withHitRate: true,
+			score:       (0.35*0.5 + 0.20*0.5 + 0.30*0.25) / 0.85,
+		},
+		{
+			name:        "verified",
+			stats:       func(s *entity.AuthorStats) { s.Verified = true },
+			withHitRate: true,
+			hitRate:     ptr(0.75),
+			score:       0.35*0.5 + 0.20*0.5 + 0.30*0.25 + 0.15*0.75 + 0.05,
+		},
+		{
+			name: "capped",
+			stats: func(s *entity.AuthorStats) {
+				s.Verified = true
+				s.MedianEngagement, s.MeanEngagement, s.StddevEngagement = 99, 99, 0
+				s.FinancialTweets = s.Tweets
+			},
+			score: 1,
+		},
+		{
+			name: "no activity",
+			stats: func(s *entity.AuthorStats) {
+				*s = entity.AuthorStats{AuthorID: "mid"}
+			},
+			score: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := mid
+			if tc.stats != nil {
+				tc.stats(&s)
+			}
+
+			a := entity.NewAuthorScore(&s, 99, tc.withHitRate, now)
+			require.Equal(t, "mid", a.AuthorID)
+			require.Equal(t, s.MedianEngagement, a.Reach)
+			require.Equal(t, s.Tweets, a.Tweets)
+			require.Equal(t, time.UTC, a.ComputedAt.Location())
+			require.True(t, a.ComputedAt.Equal(now))
+			require.InDelta(t, tc.score, a.Score, 1e-9)
+			if tc.hitRate == nil {
+				require.Nil(t, a.HitRate)
+			} else {
+				require.NotNil(t, a.HitRate)
+				require.InDelta(t, *tc.hitRate, *a.HitRate, 1e-9)
+			}
+		})
+	}
+}
+
+func TestNewAuthorScoreWithoutReach(t *testing.T) {
+	t.Parallel()
+
+	// nobody has engagement yet, reach must not divide by zero
+	a := entity.NewAuthorScore(&entity.AuthorStats{AuthorID: "a", Tweets: 2, FinancialTweets: 2}, 0, false, time.Now())
+	require.Zero(t, a.Consistency)
+	require.InDelta(t, 1, a.FinanceFocus, 1e-9)
+	require.InDelta(t, 0.30/0.85, a.Score, 1e-9)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
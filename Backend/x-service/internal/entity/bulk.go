@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BulkKind represents the kind of a bulk admin operation
+type BulkKind string
+
+const (
+	BulkKindRetagSymbols     BulkKind = "retag_symbols"
+	BulkKindRescoreSentiment BulkKind = "rescore_sentiment"
+	BulkKindDelete           BulkKind = "delete"
+	BulkKindExport           BulkKind = "export"
+)
+
+// BulkStatus represents the status of a bulk admin operation
+type BulkStatus string
+
+const (
+	BulkStatusPending   BulkStatus = "pending"
+	BulkStatusRunning   BulkStatus = "running"
+	BulkStatusSucceeded BulkStatus = "succeeded"
+	BulkStatusFailed    BulkStatus = "failed"
+	BulkStatusCanceled  BulkStatus = "canceled"
+)
+
+// BulkOperation is an asynchronous admin operation over a set of tweets
+type BulkOperation struct {
+	ID     uuid.UUID  `db:"id" json:"id"`
+	Kind   BulkKind   `db:"kind" json:"kind"`
+	Status BulkStatus `db:"status" json:"status"`
+
+	// progress counters, Conflicts are tweets skipped because they kept
+	// being modified concurrently
+	Total     int `db:"total" json:"total"`
+	Processed int `db:"processed" json:"processed"`
+	Succeeded int `db:"succeeded" json:"succeeded"`
+	Failed    int `db:"failed" json:"failed"`
+	Conflicts int `db:"conflicts" json:"conflicts"`
+
+	ResultURI string `db:"result_uri" json:"result_uri"` // set by export operations
+	Error     string `db:"error_text" json:"error_text"`
+
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+	StartedAt  *time.Time `db:"started_at" json:"started_at"`
+	FinishedAt *time.Time `db:"finished_at" json:"finished_at"`
+}
+
+// NewBulkOperation creates a pending bulk operation
+func NewBulkOperation(kind BulkKind, now time.Time) *BulkOperation {
+	return &BulkOperation{
+		ID:        uuid.New(),
+		Kind:      kind,
+		Status:    BulkStatusPending,
+		CreatedAt: now.UTC(),
+	}
+}
+
+// Start marks the operation as running over total tweets
+func (o *BulkOperation) Start(total int, now time.Time) {
+	at := now.UTC()
+	o.Status = BulkStatusRunning
+	o.Total = total
+	o.StartedAt = &at
+}
+
+// Finish marks the operation as finished, a nil err means success
+func (o *BulkOperation) Finish(err error, now time.Time) {
+	at := now.UTC()
+	o.FinishedAt = &at
+	o.Status = BulkStatusSucceeded
+	if err != nil {
+		o.Status = BulkStatusFailed
+		o.Error = err.Error()
+	}
+}
+
+// Cancel marks the operation as canceled
+func (o *BulkOperation) Cancel(now time.Time) {
+	at := now.UTC()
+	o.FinishedAt = &at
+	o.Status = BulkStatusCanceled
+}
+
+// IsDone reports whether the operation reached a final status
+func (o *BulkOperation) IsDone() bool {
+	switch o.Status {
+	case BulkStatusSucceeded, BulkStatusFailed, BulkStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
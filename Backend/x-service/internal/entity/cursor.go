@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TweetCursor is the position of a tweet in feeds ordered by created_at desc
+// and id desc, a page starts right after the tweet
+type TweetCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// CursorOf returns the cursor of the tweet
+func CursorOf(t *Tweet) *TweetCursor {
+	return &TweetCursor{CreatedAt: t.CreatedAt, ID: t.ID}
+}
+
+// Encode returns the opaque form of the cursor handed to clients
+func (c *TweetCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeTweetCursor parses a cursor made by Encode
+func DecodeTweetCursor(s string) (*TweetCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &TweetCursor{CreatedAt: time.Unix(0, n).UTC(), ID: uid}, nil
+}
@@ -1,8 +1,100 @@
 package entity
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
+// Error kinds, every domain error matches exactly one of them with errors.Is,
+// transports map kinds to their status codes, anything else is internal
 var (
-	ErrEmptyText   = errors.New("tweet text must not be empty")
-	ErrTooLongText = errors.New("tweet text exceeds 4 096 characters")
+	ErrNotFound      = errors.New("not found")
+	ErrInvalid       = errors.New("invalid argument")
+	ErrAlreadyExists = errors.New("already exists")
+	ErrConflict      = errors.New("conflict")
+	ErrPrecondition  = errors.New("failed precondition")
+	ErrUnavailable   = errors.New("unavailable")
+)
+
+var (
+	ErrEmptyText   = &FieldError{Field: "text", Description: "tweet text must not be empty"}
+	ErrTooLongText = &FieldError{Field: "text", Description: "tweet text exceeds 4 096 characters"}
+
+	ErrInvalidCursor = &FieldError{Field: "cursor", Description: "invalid cursor"}
 )
+
+// Error is a domain error of a kind, Msg is safe to show to clients
+// while the optional cause Err is for logs only
+type Error struct {
+	Kind error
+	Msg  string
+	Err  error
+}
+
+// NewError creates a domain error of the kind
+func NewError(kind error, msg string) *Error {
+	return &Error{Kind: kind, Msg: msg}
+}
+
+// Wrap returns a copy of the error with the cause attached
+func (e *Error) Wrap(cause error) *Error {
+	return &Error{Kind: e.Kind, Msg: e.Msg, Err: cause}
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Msg + ": " + e.Err.Error()
+	}
+	return e.Msg
+}
+
+// Is matches the kind and, for wrapped copies, the error they were made from
+func (e *Error) Is(target error) bool {
+	if target == e.Kind {
+		return true
+	}
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind && t.Msg == e.Msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// FieldError is a validation error of a single input field, it is ErrInvalid
+type FieldError struct {
+	Field       string
+	Description string
+}
+
+func (e *FieldError) Error() string {
+	return e.Description
+}
+
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+// ThrottledError is returned when an upstream provider rate limits requests, it is ErrUnavailable
+type ThrottledError struct {
+	Provider   string
+	RetryAfter time.Duration // zero when the provider did not say
+	Err        error
+}
+
+func (e *ThrottledError) Error() string {
+	msg := fmt.Sprintf("%s is rate limited", e.Provider)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *ThrottledError) Is(target error) bool {
+	return target == ErrUnavailable
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return e.Err
+}
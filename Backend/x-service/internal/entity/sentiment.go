@@ -0,0 +1,52 @@
+package entity
+
+import "time"
+
+// sentiment labels stored in tweets.sentiment_label
+const (
+	SentimentPositive = "POS"
+	SentimentNegative = "NEG"
+	SentimentNeutral  = "NEU"
+)
+
+// Sentiment is a scored sentiment of a text
+type Sentiment struct {
+	Score float64 // range –1 .. 1
+	Label string  // POS, NEG or NEU
+}
+
+// NewSentiment builds a sentiment from class probabilities, the score is
+// positive minus negative and the label is the most probable class
+func NewSentiment(positive, neutral, negative float64) Sentiment {
+	s := Sentiment{
+		Score: positive - negative,
+		Label: SentimentNeutral,
+	}
+
+	switch {
+	case positive > neutral && positive > negative:
+		s.Label = SentimentPositive
+	case negative > neutral && negative > positive:
+		s.Label = SentimentNegative
+	}
+
+	return s
+}
+
+// SentimentDaily is a daily sentiment aggregate of a symbol
+type SentimentDaily struct {
+	Symbol           string    `db:"symbol" json:"symbol"`
+	Day              time.Time `db:"day" json:"day"`
+	AvgScore         float64   `db:"avg_score" json:"avg_score"`
+	WeightedAvgScore *float64  `db:"weighted_avg_score" json:"weighted_avg_score"` // nil until author scores are computed
+	Positive         int       `db:"pos_cnt" json:"pos_cnt"`
+	Negative         int       `db:"neg_cnt" json:"neg_cnt"`
+	Neutral          int       `db:"neu_cnt" json:"neu_cnt"`
+}
+
+// SymbolTrend is how much a symbol is talked about in a time window
+type SymbolTrend struct {
+	Symbol       string  `db:"symbol" json:"symbol"`
+	Mentions     int     `db:"mentions" json:"mentions"`
+	AvgSentiment float64 `db:"avg_sentiment" json:"avg_sentiment"`
+}
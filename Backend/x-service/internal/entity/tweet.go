@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -9,6 +13,9 @@ import (
 
 type TweetOption func(*Tweet)
 
+// urlPattern matches links, they are stripped before hashing tweet text
+var urlPattern = regexp.MustCompile(`https?://\S+`)
+
 // Tweet represents a Twitter post with its metadata and content
 type Tweet struct {
 	ID   uuid.UUID `db:"id" json:"id"`
@@ -38,6 +45,14 @@ type Tweet struct {
 	Symbols        []string `db:"symbols" json:"symbols"`
 	SentimentScore float64  `db:"sentiment_score" json:"sentiment_score"` // range –1 .. 1
 	SentimentLabel string   `db:"sentiment_label" json:"sentiment_label"`
+
+	// spam quarantine, quarantined tweets are kept out of listings and aggregates
+	QuarantineReasons []SpamReason `db:"quarantine_reasons" json:"quarantine_reasons"`
+	QuarantinedAt     *time.Time   `db:"quarantined_at" json:"quarantined_at"`
+
+	// author snapshot, filled only by providers that expose it
+	AuthorFollowers int       `db:"-" json:"author_followers"`
+	AuthorCreatedAt time.Time `db:"-" json:"author_created_at"`
 }
 
 // NewTweet creates a new Tweet instance
@@ -73,6 +88,34 @@ func (t *Tweet) Validate() error {
 	return nil
 }
 
+// TextHash returns a hash of the normalised text, retweeted or copy-pasted
+// posts that differ only in links, case or spacing share the same hash
+func (t *Tweet) TextHash() string {
+	text := urlPattern.ReplaceAllString(strings.ToLower(t.Text), "")
+	sum := sha256.Sum256([]byte(strings.Join(strings.Fields(text), " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Quarantine marks the tweet as spam with the given reason codes
+func (t *Tweet) Quarantine(reasons []SpamReason, now time.Time) {
+	at := now.UTC()
+	t.QuarantineReasons = reasons
+	t.QuarantinedAt = &at
+	t.UpdatedAt = at
+}
+
+// Release clears the quarantine after a manual review
+func (t *Tweet) Release(now time.Time) {
+	t.QuarantineReasons = nil
+	t.QuarantinedAt = nil
+	t.UpdatedAt = now.UTC()
+}
+
+// IsQuarantined reports whether the tweet is quarantined
+func (t *Tweet) IsQuarantined() bool {
+	return t.QuarantinedAt != nil
+}
+
 // Touch updates the updated_at field to the current time
 func (t *Tweet) Touch(now time.Time) {
 	t.UpdatedAt = now.UTC()
@@ -94,13 +137,25 @@ func (t *Tweet) UpdateEngagement(likes, replies, retweets, views int, now time.T
 	t.UpdatedAt = now.UTC()
 }
 
-// AddSymbol adds a financial symbol to the tweet
+// AddSymbol adds a financial symbol to the tweet, it is a no-op for linked symbols
 func (t *Tweet) AddSymbol(symbol string, now time.Time) {
-	t.Symbols = append(t.Symbols, symbol)
+	symbol = strings.ToUpper(symbol)
+	if !slices.Contains(t.Symbols, symbol) {
+		t.Symbols = append(t.Symbols, symbol)
+	}
 	t.IsFinancial = true
 	t.UpdatedAt = now.UTC()
 }
 
+// RemoveSymbol unlinks a financial symbol from the tweet
+func (t *Tweet) RemoveSymbol(symbol string, now time.Time) {
+	symbol = strings.ToUpper(symbol)
+	t.Symbols = slices.DeleteFunc(t.Symbols, func(s string) bool {
+		return s == symbol
+	})
+	t.UpdatedAt = now.UTC()
+}
+
 // AddURL adds a URL to the tweet
 func (t *Tweet) AddURL(url string, now time.Time) {
 	t.URLs = append(t.URLs, url)
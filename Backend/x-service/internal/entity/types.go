@@ -29,3 +29,13 @@ const (
 	CrawlStatusSuccess CrawlStatus = "success"
 	CrawlStatusFailed  CrawlStatus = "failed"
 )
+
+// SpamReason is a reason code of a quarantined tweet
+type SpamReason string
+
+const (
+	SpamReasonDuplicateCluster SpamReason = "duplicate_cluster"
+	SpamReasonCashtagStuffing  SpamReason = "cashtag_stuffing"
+	SpamReasonLinkFarm         SpamReason = "link_farm"
+	SpamReasonNewLowFollower   SpamReason = "new_low_follower"
+)
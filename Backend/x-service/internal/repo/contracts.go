@@ -26,6 +26,51 @@ type (
 		ListBySymbol(context.Context, string, int32, int32) ([]*entity.Tweet, error)
 		// ListBySentiment returns a list of tweets by sentiment
 		ListBySentiment(context.Context, string, int32, int32) ([]*entity.Tweet, error)
+		// CountTextAuthors counts other authors that posted the same text hash since the given time
+		CountTextAuthors(ctx context.Context, textHash, authorID string, since time.Time) (int, error)
+		// Release clears the quarantine of a tweet
+		Release(context.Context, uuid.UUID) error
+		// UpdateIfUnmodified updates a tweet only if its updated_at still equals the given version
+		UpdateIfUnmodified(ctx context.Context, t *entity.Tweet, version time.Time) error
+		// ListIDs returns ids of all tweets matching the filter
+		ListIDs(context.Context, TweetFilter) ([]uuid.UUID, error)
+		// DeleteMany removes tweets by ids
+		DeleteMany(context.Context, []uuid.UUID) (int64, error)
+		// Iterate streams all tweets matching the filter to fn
+		Iterate(ctx context.Context, f TweetFilter, fn func(*entity.Tweet) error) error
+		// TrendingSymbols returns symbols ordered by mentions since the given time
+		TrendingSymbols(ctx context.Context, since time.Time, limit int32) ([]*entity.SymbolTrend, error)
+	}
+
+	SentimentAggRepository interface {
+		// IterateDaily streams daily sentiment aggregates of the range to fn, all symbols if none given
+		IterateDaily(ctx context.Context, f SentimentAggFilter, fn func(*entity.SentimentDaily) error) error
+	}
+
+	BulkOperationRepository interface {
+		// Create stores a new bulk operation
+		Create(context.Context, *entity.BulkOperation) error
+		// Get fetches bulk operation by ID
+		Get(context.Context, uuid.UUID) (*entity.BulkOperation, error)
+		// List returns bulk operations, newest first
+		List(context.Context, int32, int32) ([]*entity.BulkOperation, error)
+		// Save stores status, progress counters and result of an operation
+		Save(context.Context, *entity.BulkOperation) error
+	}
+
+	AuthorScoreRepository interface {
+		// Stats aggregates activity of authors with at least minTweets tweets since the given time
+		Stats(ctx context.Context, since time.Time, minTweets int) ([]*entity.AuthorStats, error)
+		// HitRates fills Calls and Hits of the given stats from sentiment_daily_agg moves
+		HitRates(ctx context.Context, since time.Time, stats []*entity.AuthorStats) error
+		// Upsert stores computed author scores
+		Upsert(context.Context, []*entity.AuthorScore) error
+		// Get fetches score of a single author
+		Get(context.Context, string) (*entity.AuthorScore, error)
+		// List returns author scores ordered by score desc
+		List(context.Context, int32, int32) ([]*entity.AuthorScore, error)
+		// RefreshSentimentAgg rebuilds sentiment_daily_agg weighting tweets by author score
+		RefreshSentimentAgg(context.Context) error
 	}
 
 	TweetProvider interface {
@@ -42,6 +87,27 @@ type (
 		StartTime      *time.Time
 		EndTime        *time.Time
 		Limit, Offset  int32
+
+		// After is a keyset position, tweets at or before it are skipped
+		After *entity.TweetCursor
+
+		// Quarantined switches the query to quarantined tweets only,
+		// by default they are excluded
+		Quarantined      bool
+		QuarantineReason entity.SpamReason
+	}
+
+	// SentimentAggFilter selects a range of sentiment_daily_agg rows, zero times are open bounds
+	SentimentAggFilter struct {
+		Symbols  []string
+		From, To time.Time
+	}
+)
+
+type (
+	SentimentAnalyzer interface {
+		// Analyze scores the sentiment of each text, results keep the input order
+		Analyze(ctx context.Context, texts []string) ([]entity.Sentiment, error)
 	}
 )
 
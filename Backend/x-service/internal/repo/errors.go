@@ -1,13 +1,17 @@
 package repo
 
 import (
-	"errors"
-
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
 var (
-	ErrDuplicateTweet = errors.New("duplicate tweet")
+	ErrTweetNotFound         = entity.NewError(entity.ErrNotFound, "tweet not found")
+	ErrAuthorScoreNotFound   = entity.NewError(entity.ErrNotFound, "author score not found")
+	ErrBulkOperationNotFound = entity.NewError(entity.ErrNotFound, "bulk operation not found")
+	ErrDuplicateTweet        = entity.NewError(entity.ErrAlreadyExists, "duplicate tweet")
+	ErrTweetNotQuarantined   = entity.NewError(entity.ErrPrecondition, "tweet is not quarantined")
+	ErrStaleTweet            = entity.NewError(entity.ErrConflict, "tweet was modified concurrently")
 )
 
 var (
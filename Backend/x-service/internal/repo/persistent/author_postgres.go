@@ -0,0 +1,236 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// AuthorScoreRepository implements repo.AuthorScoreRepository backed by Postgres
+type AuthorScoreRepository struct {
+	*postgres.Postgres
+}
+
+// NewAuthorScorePostgres returns AuthorScoreRepository
+func NewAuthorScorePostgres(pg *postgres.Postgres) *AuthorScoreRepository {
+	return &AuthorScoreRepository{pg}
+}
+
+// Stats aggregates per-author engagement and finance focus over the window
+func (r *AuthorScoreRepository) Stats(ctx context.Context, since time.Time, minTweets int) ([]*entity.AuthorStats, error) {
+	const query = ` -- Stats(ctx context.Context, since time.Time, minTweets int) ([]*entity.AuthorStats, error)
+		WITH per_tweet AS (
+			SELECT
+				author_id,
+				is_financial,
+				(likes + replies + retweets)::float8 AS engagement
+			FROM tweets
+			WHERE created_at >= $1 AND quarantined_at IS NULL
+		)
+		SELECT
+			p.author_id,
+			a.verified,
+			count(*)                                                   AS tweets_cnt,
+			count(*) FILTER (WHERE p.is_financial)                     AS financial_cnt,
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY p.engagement)  AS median_engagement,
+			avg(p.engagement)                                          AS mean_engagement,
+			coalesce(stddev_pop(p.engagement), 0)                      AS stddev_engagement
+		FROM per_tweet p
+		JOIN authors a ON a.id = p.author_id
+		GROUP BY p.author_id, a.verified
+		HAVING count(*) >= $2`
+
+	rows, err := r.Pool.Query(ctx, query, since, minTweets)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query(SELECT FROM tweets): %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.AuthorStats
+	for rows.Next() {
+		var s entity.AuthorStats
+		if err := rows.Scan(
+			&s.AuthorID,
+			&s.Verified,
+			&s.Tweets,
+			&s.FinancialTweets,
+			&s.MedianEngagement,
+			&s.MeanEngagement,
+			&s.StddevEngagement,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, &s)
+	}
+	return out, rows.Err()
+}
+
+// HitRates checks every (author, symbol, day) sentiment call against the move of
+// the symbol's daily sentiment aggregate to the next aggregated day
+func (r *AuthorScoreRepository) HitRates(ctx context.Context, since time.Time, stats []*entity.AuthorStats) error {
+	const query = ` -- HitRates(ctx context.Context, since time.Time, stats []*entity.AuthorStats) error
+		WITH calls AS (
+			SELECT
+				t.author_id,
+				ts.symbol,
+				date_trunc('day', t.created_at)::date AS day,
+				avg(t.sentiment_score)                AS score
+			FROM tweets t
+			JOIN tweet_symbols ts ON ts.tweet_id = t.id
+			WHERE t.created_at >= $1
+			  AND t.quarantined_at IS NULL
+			  AND t.sentiment_score IS NOT NULL
+			  AND t.sentiment_score <> 0
+			GROUP BY t.author_id, ts.symbol, day
+		), moves AS (
+			SELECT
+				symbol,
+				day,
+				lead(avg_score) OVER (PARTITION BY symbol ORDER BY day) - avg_score AS delta
+			FROM sentiment_daily_agg
+		)
+		SELECT
+			c.author_id,
+			count(*)                                            AS calls,
+			count(*) FILTER (WHERE sign(c.score) = sign(m.delta)) AS hits
+		FROM calls c
+		JOIN moves m ON m.symbol = c.symbol AND m.day = c.day
+		WHERE m.delta IS NOT NULL AND m.delta <> 0
+		GROUP BY c.author_id`
+
+	byAuthor := make(map[string]*entity.AuthorStats, len(stats))
+	for _, s := range stats {
+		byAuthor[s.AuthorID] = s
+	}
+
+	rows, err := r.Pool.Query(ctx, query, since)
+	if err != nil {
+		return fmt.Errorf("r.Pool.Query(SELECT FROM tweets): %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			authorID    string
+			calls, hits int
+		)
+		if err := rows.Scan(&authorID, &calls, &hits); err != nil {
+			return err
+		}
+		if s, ok := byAuthor[authorID]; ok {
+			s.Calls, s.Hits = calls, hits
+		}
+	}
+	return rows.Err()
+}
+
+// Upsert stores author scores in one batch
+func (r *AuthorScoreRepository) Upsert(ctx context.Context, scores []*entity.AuthorScore) error {
+	const query = ` -- Upsert(ctx context.Context, scores []*entity.AuthorScore) error
+		INSERT INTO author_scores (
+			author_id, reach, consistency, finance_focus, hit_rate, score, tweets_cnt, computed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (author_id) DO UPDATE SET
+			reach         = EXCLUDED.reach,
+			consistency   = EXCLUDED.consistency,
+			finance_focus = EXCLUDED.finance_focus,
+			hit_rate      = EXCLUDED.hit_rate,
+			score         = EXCLUDED.score,
+			tweets_cnt    = EXCLUDED.tweets_cnt,
+			computed_at   = EXCLUDED.computed_at`
+
+	batch := &pgx.Batch{}
+	for _, s := range scores {
+		batch.Queue(query,
+			s.AuthorID, s.Reach, s.Consistency, s.FinanceFocus, s.HitRate, s.Score, s.Tweets, s.ComputedAt,
+		)
+	}
+
+	if err := r.Pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("r.Pool.SendBatch(INSERT INTO author_scores): %w", err)
+	}
+
+	return nil
+}
+
+// Get fetches score of a single author
+func (r *AuthorScoreRepository) Get(ctx context.Context, authorID string) (*entity.AuthorScore, error) {
+	const query = ` -- Get(ctx context.Context, authorID string) (*entity.AuthorScore, error)
+		SELECT
+			author_id, reach, consistency, finance_focus, hit_rate, score, tweets_cnt, computed_at
+		FROM author_scores
+		WHERE author_id = $1`
+
+	s, err := scanAuthorScore(r.Pool.QueryRow(ctx, query, authorID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repo.ErrAuthorScoreNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.QueryRow(SELECT FROM author_scores): %w", err)
+	}
+
+	return s, nil
+}
+
+// List returns author scores ordered by score desc
+func (r *AuthorScoreRepository) List(ctx context.Context, limit, offset int32) ([]*entity.AuthorScore, error) {
+	const query = ` -- List(ctx context.Context, limit, offset int32) ([]*entity.AuthorScore, error)
+		SELECT
+			author_id, reach, consistency, finance_focus, hit_rate, score, tweets_cnt, computed_at
+		FROM author_scores
+		ORDER BY score DESC, author_id
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.Pool.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query(SELECT FROM author_scores): %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.AuthorScore
+	for rows.Next() {
+		s, err := scanAuthorScore(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+// RefreshSentimentAgg rebuilds sentiment_daily_agg with the current author weights
+func (r *AuthorScoreRepository) RefreshSentimentAgg(ctx context.Context) error {
+	const query = ` -- RefreshSentimentAgg(ctx context.Context) error
+		SELECT refresh_sentiment_daily_agg()`
+
+	if _, err := r.Pool.Exec(ctx, query); err != nil {
+		return fmt.Errorf("r.Pool.Exec(refresh_sentiment_daily_agg): %w", err)
+	}
+
+	return nil
+}
+
+// scanAuthorScore scans an author score from a database row
+func scanAuthorScore(row pgx.Row) (*entity.AuthorScore, error) {
+	var s entity.AuthorScore
+	err := row.Scan(
+		&s.AuthorID,
+		&s.Reach,
+		&s.Consistency,
+		&s.FinanceFocus,
+		&s.HitRate,
+		&s.Score,
+		&s.Tweets,
+		&s.ComputedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
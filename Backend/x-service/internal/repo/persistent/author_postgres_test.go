@@ -0,0 +1,178 @@
+package persistent
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	// migrate tools
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/postgres"
+)
+
+// testPostgres creates a database on the server at PG_TEST_URL, migrates it
+// up and drops it after the test. Without PG_TEST_URL the test is skipped
+func testPostgres(t *testing.T) *postgres.Postgres {
+	t.Helper()
+
+	serverURL, ok := os.LookupEnv("PG_TEST_URL")
+	if !ok || len(serverURL) == 0 {
+		t.Skip("PG_TEST_URL is not set")
+	}
+
+	ctx := context.Background()
+	admin, err := pgx.Connect(ctx, serverURL)
+	require.NoError(t, err)
+
+	name := "x_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	_, err = admin.Exec(ctx, "CREATE DATABASE "+name)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = admin.Exec(ctx, "DROP DATABASE IF EXISTS "+name)
+		_ = admin.Close(ctx)
+	})
+
+	u, err := url.Parse(serverURL)
+	require.NoError(t, err)
+	u.Path = "/" + name
+	databaseURL := u.String()
+
+	m, err := migrate.New("file://../../../migrations", databaseURL)
+	require.NoError(t, err)
+	require.NoError(t, m.Up())
+	srcErr, dbErr := m.Close()
+	require.NoError(t, srcErr)
+	require.NoError(t, dbErr)
+
+	pg, err := postgres.New(databaseURL, postgres.ConnAttempts(1))
+	require.NoError(t, err)
+	t.Cleanup(pg.Close)
+
+	return pg
+}
+
+// insertTweet stores a scored tweet of the author about the symbol
+func insertTweet(t *testing.T, pg *postgres.Postgres, authorID, symbol string, createdAt time.Time, score float64, label string) uuid.UUID {
+	t.Helper()
+
+	ctx := context.Background()
+	_, err := pg.Pool.Exec(ctx, `
+		INSERT INTO authors (id, username, provider) VALUES ($1, $1, 'twitter')
+		ON CONFLICT (id) DO NOTHING`, authorID)
+	require.NoError(t, err)
+
+	var id uuid.UUID
+	require.NoError(t, pg.Pool.QueryRow(ctx, `
+		INSERT INTO tweets (author_id, username, text, created_at, updated_at, sentiment_score, sentiment_label)
+		VALUES ($1, $1, '$' || $2::text, $3, $3, $4, $5)
+		RETURNING id`, authorID, symbol, createdAt, score, label).Scan(&id))
+
+	_, err = pg.Pool.Exec(ctx, `INSERT INTO tweet_symbols (tweet_id, symbol) VALUES ($1, $2)`, id, symbol)
+	require.NoError(t, err)
+
+	return id
+}
+
+// dailyAggregates reads the whole sentiment_daily_agg
+func dailyAggregates(t *testing.T, pg *postgres.Postgres) []*entity.SentimentDaily {
+	t.Helper()
+
+	var out []*entity.SentimentDaily
+	require.NoError(t, NewSentimentAggPostgres(pg).IterateDaily(context.Background(), repo.SentimentAggFilter{},
+		func(d *entity.SentimentDaily) error {
+			out = append(out, d)
+			return nil
+		}))
+
+	return out
+}
+
+func TestRefreshSentimentAgg(t *testing.T) {
+	t.Parallel()
+
+	pg := testPostgres(t)
+	r := NewAuthorScorePostgres(pg)
+	ctx := context.Background()
+	day := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	insertTweet(t, pg, "a1", "TSLA", day.Add(9*time.Hour), 0.8, entity.SentimentPositive)
+	insertTweet(t, pg, "a2", "TSLA", day.Add(15*time.Hour), -0.4, entity.SentimentNegative)
+	insertTweet(t, pg, "a2", "AAPL", day.Add(24*time.Hour), 0.2, entity.SentimentNeutral)
+
+	// a2 has no score yet and is weighted 0.5
+	require.NoError(t, r.Upsert(ctx, []*entity.AuthorScore{{AuthorID: "a1", Score: 0.9, Tweets: 1, ComputedAt: day}}))
+	require.NoError(t, r.RefreshSentimentAgg(ctx))
+
+	aggs := dailyAggregates(t, pg)
+	require.Len(t, aggs, 2)
+
+	require.Equal(t, "AAPL", aggs[0].Symbol)
+	require.True(t, day.AddDate(0, 0, 1).Equal(aggs[0].Day))
+	require.InDelta(t, 0.2, aggs[0].AvgScore, 1e-6)
+	require.NotNil(t, aggs[0].WeightedAvgScore)
+	require.InDelta(t, 0.2, *aggs[0].WeightedAvgScore, 1e-6)
+	require.Equal(t, 1, aggs[0].Neutral)
+
+	require.Equal(t, "TSLA", aggs[1].Symbol)
+	require.True(t, day.Equal(aggs[1].Day))
+	require.InDelta(t, 0.2, aggs[1].AvgScore, 1e-6)
+	require.NotNil(t, aggs[1].WeightedAvgScore)
+	require.InDelta(t, (0.8*0.9-0.4*0.5)/(0.9+0.5), *aggs[1].WeightedAvgScore, 1e-6)
+	require.Equal(t, 1, aggs[1].Positive)
+	require.Equal(t, 1, aggs[1].Negative)
+	require.Zero(t, aggs[1].Neutral)
+
+	// a refresh replaces the aggregate
+	require.NoError(t, r.Upsert(ctx, []*entity.AuthorScore{{AuthorID: "a2", Score: 0.1, Tweets: 2, ComputedAt: day}}))
+	require.NoError(t, r.RefreshSentimentAgg(ctx))
+
+	aggs = dailyAggregates(t, pg)
+	require.Len(t, aggs, 2)
+	require.InDelta(t, (0.8*0.9-0.4*0.1)/(0.9+0.1), *aggs[1].WeightedAvgScore, 1e-6)
+}
+
+func TestRefreshSentimentAggSkipsQuarantined(t *testing.T) {
+	t.Parallel()
+
+	pg := testPostgres(t)
+	r := NewAuthorScorePostgres(pg)
+	ctx := context.Background()
+	day := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	insertTweet(t, pg, "a1", "TSLA", day.Add(9*time.Hour), -0.4, entity.SentimentNegative)
+	pump := insertTweet(t, pg, "a2", "TSLA", day.Add(10*time.Hour), 1, entity.SentimentPositive)
+	_, err := pg.Pool.Exec(ctx, `
+		UPDATE tweets SET quarantine_reasons = $2, quarantined_at = now() WHERE id = $1`,
+		pump, fromSpamReasons([]entity.SpamReason{entity.SpamReasonDuplicateCluster, entity.SpamReasonCashtagStuffing}))
+	require.NoError(t, err)
+
+	require.NoError(t, r.RefreshSentimentAgg(ctx))
+
+	aggs := dailyAggregates(t, pg)
+	require.Len(t, aggs, 1)
+	require.InDelta(t, -0.4, aggs[0].AvgScore, 1e-6)
+	require.InDelta(t, -0.4, *aggs[0].WeightedAvgScore, 1e-6)
+	require.Zero(t, aggs[0].Positive)
+	require.Equal(t, 1, aggs[0].Negative)
+
+	// a released tweet counts again from the next refresh
+	require.NoError(t, NewTweetPostgres(pg).Release(ctx, pump))
+	require.NoError(t, r.RefreshSentimentAgg(ctx))
+
+	aggs = dailyAggregates(t, pg)
+	require.Len(t, aggs, 1)
+	require.InDelta(t, 0.3, aggs[0].AvgScore, 1e-6)
+	require.Equal(t, 1, aggs[0].Positive)
+	require.Equal(t, 1, aggs[0].Negative)
+}
@@ -0,0 +1,137 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// BulkOperationRepository implements repo.BulkOperationRepository backed by Postgres
+type BulkOperationRepository struct {
+	*postgres.Postgres
+}
+
+// NewBulkOperationPostgres returns BulkOperationRepository
+func NewBulkOperationPostgres(pg *postgres.Postgres) *BulkOperationRepository {
+	return &BulkOperationRepository{pg}
+}
+
+// Create stores a new bulk operation
+func (r *BulkOperationRepository) Create(ctx context.Context, o *entity.BulkOperation) error {
+	const query = ` -- Create(ctx context.Context, o *entity.BulkOperation) error
+		INSERT INTO bulk_operations (id, kind, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $4)`
+
+	if _, err := r.Pool.Exec(ctx, query, o.ID, o.Kind, o.Status, o.CreatedAt); err != nil {
+		return fmt.Errorf("r.Pool.Exec(INSERT INTO bulk_operations): %w", err)
+	}
+
+	return nil
+}
+
+// Get fetches bulk operation by ID
+func (r *BulkOperationRepository) Get(ctx context.Context, id uuid.UUID) (*entity.BulkOperation, error) {
+	const query = ` -- Get(ctx context.Context, id uuid.UUID) (*entity.BulkOperation, error)
+		SELECT
+			id, kind, status,
+			total, processed, succeeded, failed, conflicts,
+			coalesce(result_uri, ''), coalesce(error_text, ''),
+			created_at, started_at, finished_at
+		FROM bulk_operations
+		WHERE id = $1`
+
+	o, err := scanBulkOperation(r.Pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repo.ErrBulkOperationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.QueryRow(SELECT FROM bulk_operations): %w", err)
+	}
+
+	return o, nil
+}
+
+// List returns bulk operations, newest first
+func (r *BulkOperationRepository) List(ctx context.Context, limit, offset int32) ([]*entity.BulkOperation, error) {
+	const query = ` -- List(ctx context.Context, limit, offset int32) ([]*entity.BulkOperation, error)
+		SELECT
+			id, kind, status,
+			total, processed, succeeded, failed, conflicts,
+			coalesce(result_uri, ''), coalesce(error_text, ''),
+			created_at, started_at, finished_at
+		FROM bulk_operations
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.Pool.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query(SELECT FROM bulk_operations): %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.BulkOperation
+	for rows.Next() {
+		o, err := scanBulkOperation(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, o)
+	}
+	return out, rows.Err()
+}
+
+// Save stores status, progress counters and result of an operation
+func (r *BulkOperationRepository) Save(ctx context.Context, o *entity.BulkOperation) error {
+	const query = ` -- Save(ctx context.Context, o *entity.BulkOperation) error
+		UPDATE bulk_operations
+		SET
+			status = $1,
+			total = $2, processed = $3, succeeded = $4, failed = $5, conflicts = $6,
+			result_uri = nullif($7, ''), error_text = nullif($8, ''),
+			started_at = $9, finished_at = $10,
+			updated_at = now()
+		WHERE id = $11`
+
+	_, err := r.Pool.Exec(ctx, query,
+		o.Status,
+		o.Total, o.Processed, o.Succeeded, o.Failed, o.Conflicts,
+		o.ResultURI, o.Error,
+		o.StartedAt, o.FinishedAt,
+		o.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("r.Pool.Exec(UPDATE bulk_operations): %w", err)
+	}
+
+	return nil
+}
+
+// scanBulkOperation scans a bulk operation from a database row
+func scanBulkOperation(row pgx.Row) (*entity.BulkOperation, error) {
+	var o entity.BulkOperation
+	err := row.Scan(
+		&o.ID,
+		&o.Kind,
+		&o.Status,
+		&o.Total,
+		&o.Processed,
+		&o.Succeeded,
+		&o.Failed,
+		&o.Conflicts,
+		&o.ResultURI,
+		&o.Error,
+		&o.CreatedAt,
+		&o.StartedAt,
+		&o.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
@@ -12,7 +12,10 @@ import (
 
 // scanTweet scans a tweet from a database row
 func scanTweet(row pgx.Row) (*entity.Tweet, error) {
-	var t entity.Tweet
+	var (
+		t       entity.Tweet
+		reasons []string
+	)
 	err := row.Scan(
 		&t.ID,
 		&t.Text,
@@ -32,13 +35,38 @@ func scanTweet(row pgx.Row) (*entity.Tweet, error) {
 		&t.IsFinancial,
 		&t.SentimentScore,
 		&t.SentimentLabel,
+		&reasons,
+		&t.QuarantinedAt,
+		&t.Symbols,
 	)
 	if err != nil {
 		return nil, err
 	}
+	t.QuarantineReasons = toSpamReasons(reasons)
 	return &t, nil
 }
 
+// toSpamReasons converts text[] reason codes into entity values
+func toSpamReasons(in []string) []entity.SpamReason {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]entity.SpamReason, len(in))
+	for i, r := range in {
+		out[i] = entity.SpamReason(r)
+	}
+	return out
+}
+
+// fromSpamReasons converts entity reason codes into text[] values
+func fromSpamReasons(in []entity.SpamReason) []string {
+	out := make([]string, len(in))
+	for i, r := range in {
+		out[i] = string(r)
+	}
+	return out
+}
+
 // buildFilter builds WHERE … LIMIT/OFFSET for List queries
 func buildFilter(f repo.TweetFilter) (string, []any) {
 	var (
@@ -59,14 +87,27 @@ func buildFilter(f repo.TweetFilter) (string, []any) {
 		args = append(args, f.SentimentLabel)
 		where = append(where, fmt.Sprintf("sentiment_label=$%d", len(args)))
 	}
-	if !f.StartTime.IsZero() {
-		args = append(args, f.StartTime)
+	if f.StartTime != nil && !f.StartTime.IsZero() {
+		args = append(args, *f.StartTime)
 		where = append(where, fmt.Sprintf("created_at>=$%d", len(args)))
 	}
-	if !f.EndTime.IsZero() {
-		args = append(args, f.EndTime)
+	if f.EndTime != nil && !f.EndTime.IsZero() {
+		args = append(args, *f.EndTime)
 		where = append(where, fmt.Sprintf("created_at<=$%d", len(args)))
 	}
+	if f.Quarantined {
+		where = append(where, "quarantined_at IS NOT NULL")
+		if f.QuarantineReason != "" {
+			args = append(args, string(f.QuarantineReason))
+			where = append(where, fmt.Sprintf("$%d = ANY(quarantine_reasons)", len(args)))
+		}
+	} else {
+		where = append(where, "quarantined_at IS NULL")
+	}
+	if f.After != nil {
+		args = append(args, f.After.CreatedAt, f.After.ID)
+		where = append(where, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
 	if len(f.Symbols) > 0 {
 		args = append(args, f.Symbols)
 		where = append(where,
@@ -78,7 +119,7 @@ func buildFilter(f repo.TweetFilter) (string, []any) {
 		buf.WriteString(" WHERE ")
 		buf.WriteString(strings.Join(where, " AND "))
 	}
-	buf.WriteString(" ORDER BY created_at DESC")
+	buf.WriteString(" ORDER BY created_at DESC, id DESC")
 
 	if f.Limit > 0 {
 		args = append(args, f.Limit)
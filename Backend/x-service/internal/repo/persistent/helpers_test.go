@@ -0,0 +1,86 @@
+package persistent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+)
+
+func TestBuildFilter(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	id := uuid.MustParse("0b5f3e4c-8f1e-4d8e-9b1a-3c2d1e0f9a8b")
+
+	tests := []struct {
+		name   string
+		filter repo.TweetFilter
+		sql    string
+		args   []any
+	}{
+		{
+			name:   "no time range",
+			filter: repo.TweetFilter{Limit: 10},
+			sql:    " WHERE quarantined_at IS NULL ORDER BY created_at DESC, id DESC LIMIT $1",
+			args:   []any{int32(10)},
+		},
+		{
+			name:   "zero time range",
+			filter: repo.TweetFilter{StartTime: &time.Time{}, EndTime: &time.Time{}},
+			sql:    " WHERE quarantined_at IS NULL ORDER BY created_at DESC, id DESC",
+		},
+		{
+			name:   "time range",
+			filter: repo.TweetFilter{StartTime: &start, EndTime: &end, Limit: 10, Offset: 20},
+			sql: " WHERE created_at>=$1 AND created_at<=$2 AND quarantined_at IS NULL" +
+				" ORDER BY created_at DESC, id DESC LIMIT $3 OFFSET $4",
+			args: []any{start, end, int32(10), int32(20)},
+		},
+		{
+			name:   "open end",
+			filter: repo.TweetFilter{StartTime: &start},
+			sql:    " WHERE created_at>=$1 AND quarantined_at IS NULL ORDER BY created_at DESC, id DESC",
+			args:   []any{start},
+		},
+		{
+			name: "quarantined",
+			filter: repo.TweetFilter{
+				Quarantined:      true,
+				QuarantineReason: entity.SpamReasonLinkFarm,
+				Limit:            50,
+			},
+			sql: " WHERE quarantined_at IS NOT NULL AND $1 = ANY(quarantine_reasons)" +
+				" ORDER BY created_at DESC, id DESC LIMIT $2",
+			args: []any{"link_farm", int32(50)},
+		},
+		{
+			name: "feed page",
+			filter: repo.TweetFilter{
+				SentimentLabel: entity.SentimentPositive,
+				Symbols:        []string{"TSLA"},
+				After:          &entity.TweetCursor{CreatedAt: end, ID: id},
+				Limit:          21,
+			},
+			sql: " WHERE sentiment_label=$1 AND quarantined_at IS NULL AND (created_at, id) < ($2, $3)" +
+				" AND EXISTS (SELECT 1 FROM tweet_symbols ts WHERE ts.tweet_id=tweets.id AND ts.symbol = ANY($4))" +
+				" ORDER BY created_at DESC, id DESC LIMIT $5",
+			args: []any{entity.SentimentPositive, end, id, []string{"TSLA"}, int32(21)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sql, args := buildFilter(tc.filter)
+			require.Equal(t, tc.sql, sql)
+			require.Equal(t, tc.args, args)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/postgres"
+)
+
+// SentimentAggRepository implements repo.SentimentAggRepository backed by Postgres
+type SentimentAggRepository struct {
+	*postgres.Postgres
+}
+
+// NewSentimentAggPostgres returns SentimentAggRepository
+func NewSentimentAggPostgres(pg *postgres.Postgres) *SentimentAggRepository {
+	return &SentimentAggRepository{pg}
+}
+
+// IterateDaily streams daily aggregates ordered by symbol and day to fn
+func (r *SentimentAggRepository) IterateDaily(ctx context.Context, f repo.SentimentAggFilter, fn func(*entity.SentimentDaily) error) error {
+	const query = ` -- IterateDaily(ctx context.Context, f repo.SentimentAggFilter, fn func(*entity.SentimentDaily) error) error
+		SELECT
+			symbol, day::timestamptz, avg_score, weighted_avg_score, pos_cnt, neg_cnt, neu_cnt
+		FROM sentiment_daily_agg
+	`
+
+	var (
+		where []string
+		args  []any
+	)
+	if len(f.Symbols) > 0 {
+		symbols := make([]string, len(f.Symbols))
+		for i, s := range f.Symbols {
+			symbols[i] = strings.ToUpper(s)
+		}
+		args = append(args, symbols)
+		where = append(where, fmt.Sprintf("symbol = ANY($%d)", len(args)))
+	}
+	if !f.From.IsZero() {
+		args = append(args, f.From)
+		where = append(where, fmt.Sprintf("day >= $%d::date", len(args)))
+	}
+	if !f.To.IsZero() {
+		args = append(args, f.To)
+		where = append(where, fmt.Sprintf("day <= $%d::date", len(args)))
+	}
+
+	sql := query
+	if len(where) > 0 {
+		sql += " WHERE " + strings.Join(where, " AND ")
+	}
+	sql += " ORDER BY symbol, day"
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("r.Pool.Query(SELECT FROM sentiment_daily_agg): %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d entity.SentimentDaily
+		if err := rows.Scan(
+			&d.Symbol,
+			&d.Day,
+			&d.AvgScore,
+			&d.WeightedAvgScore,
+			&d.Positive,
+			&d.Negative,
+			&d.Neutral,
+		); err != nil {
+			return err
+		}
+		if err := fn(&d); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/postgres"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
@@ -49,8 +50,9 @@ func (r *TweetRepository) Create(ctx context.Context, t *entity.Tweet) error {
 			likes, replies, retweets, views,
 			urls, photos, videos,
 			is_financial, sentiment_score, sentiment_label,
+			text_hash, quarantine_reasons, quarantined_at,
 			raw_payload
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, '{}')`
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, '{}')`
 
 	_, err = tx.Exec(ctx, queryTweets,
 		t.ID, t.Text, t.Lang, t.AuthorID, t.UserName,
@@ -58,6 +60,7 @@ func (r *TweetRepository) Create(ctx context.Context, t *entity.Tweet) error {
 		t.Likes, t.Replies, t.Retweets, t.Views,
 		t.URLs, t.Photos, t.Videos,
 		t.IsFinancial, t.SentimentScore, t.SentimentLabel,
+		t.TextHash(), fromSpamReasons(t.QuarantineReasons), t.QuarantinedAt,
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -67,28 +70,8 @@ func (r *TweetRepository) Create(ctx context.Context, t *entity.Tweet) error {
 		return fmt.Errorf("tx.Exec(INSERT INTO tweets): %w", err)
 	}
 
-	if len(t.Symbols) > 0 {
-		const querySymbols = `-- Create(ctx context.Context, t *entity.Tweet) error 
-			INSERT INTO symbols (ticker, type, display_name) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING
-		`
-
-		const queryTweetSymbols = `-- Create(ctx context.Context, t *entity.Tweet) error 
-			INSERT INTO tweet_symbols (tweet_id, symbol) VALUES ($1, $2)
-		`
-
-		for _, symbol := range t.Symbols {
-			s := strings.ToUpper(symbol)
-
-			_, err = tx.Exec(ctx, querySymbols, s, entity.SymbolTypeEquity, s)
-			if err != nil {
-				return fmt.Errorf("tx.Exec(INSERT INTO symbols): %w", err)
-			}
-
-			_, err = tx.Exec(ctx, queryTweetSymbols, t.ID, s)
-			if err != nil {
-				return fmt.Errorf("tx.Exec(INSERT INTO tweet_symbols): %w", err)
-			}
-		}
+	if err := insertTweetSymbols(ctx, tx, t.ID, t.Symbols); err != nil {
+		return err
 	}
 
 	return tx.Commit(ctx)
@@ -102,12 +85,21 @@ func (r *TweetRepository) Get(ctx context.Context, id uuid.UUID) (*entity.Tweet,
 			created_at, fetched_at, updated_at,
 			likes, replies, retweets, views,
 			urls, photos, videos,
-			is_financial, sentiment_score, sentiment_label
+			is_financial, sentiment_score, sentiment_label,
+			quarantine_reasons, quarantined_at,
+			ARRAY(SELECT ts.symbol FROM tweet_symbols ts WHERE ts.tweet_id = tweets.id ORDER BY ts.symbol)
 		FROM tweets 
 		WHERE id = $1`
 
-	row := r.Pool.QueryRow(ctx, query, id)
-	return scanTweet(row)
+	t, err := scanTweet(r.Pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repo.ErrTweetNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.QueryRow(SELECT FROM tweets): %w", err)
+	}
+
+	return t, nil
 }
 
 // Delete removes tweet (cascade cleans tweet_symbols via FK)
@@ -115,10 +107,13 @@ func (r *TweetRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	const query = ` -- Delete(ctx context.Context, id uuid.UUID) error 
 		DELETE FROM tweets WHERE id = $1
 	`
-	_, err := r.Pool.Exec(ctx, query, id)
+	tag, err := r.Pool.Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("r.Pool.Exec(DELETE FROM tweets): %w", err)
 	}
+	if tag.RowsAffected() == 0 {
+		return repo.ErrTweetNotFound
+	}
 
 	return nil
 }
@@ -133,7 +128,7 @@ func (r *TweetRepository) Update(ctx context.Context, t *entity.Tweet) error {
 			updated_at = $8
 		WHERE id = $9`
 
-	_, err := r.Pool.Exec(ctx, query,
+	tag, err := r.Pool.Exec(ctx, query,
 		t.Text, t.Likes, t.Replies, t.Retweets, t.Views,
 		t.SentimentScore, t.SentimentLabel,
 		time.Now().UTC(), t.ID,
@@ -141,6 +136,9 @@ func (r *TweetRepository) Update(ctx context.Context, t *entity.Tweet) error {
 	if err != nil {
 		return fmt.Errorf("r.Pool.Exec(UPDATE tweets): %w", err)
 	}
+	if tag.RowsAffected() == 0 {
+		return repo.ErrTweetNotFound
+	}
 
 	return nil
 }
@@ -153,7 +151,9 @@ func (r *TweetRepository) List(ctx context.Context, f repo.TweetFilter) ([]*enti
 			created_at, fetched_at, updated_at,
 			likes, replies, retweets, views,
 			urls, photos, videos,
-			is_financial, sentiment_score, sentiment_label
+			is_financial, sentiment_score, sentiment_label,
+			quarantine_reasons, quarantined_at,
+			ARRAY(SELECT ts.symbol FROM tweet_symbols ts WHERE ts.tweet_id = tweets.id ORDER BY ts.symbol)
 		FROM tweets
 	`
 
@@ -175,6 +175,40 @@ func (r *TweetRepository) List(ctx context.Context, f repo.TweetFilter) ([]*enti
 	return out, rows.Err()
 }
 
+// Iterate streams all tweets matching the filter to fn without buffering them,
+// iteration stops at the first error returned by fn
+func (r *TweetRepository) Iterate(ctx context.Context, f repo.TweetFilter, fn func(*entity.Tweet) error) error {
+	const query = ` -- Iterate(ctx context.Context, f repo.TweetFilter, fn func(*entity.Tweet) error) error
+		SELECT
+			id, text, lang, author_id, username,
+			created_at, fetched_at, updated_at,
+			likes, replies, retweets, views,
+			urls, photos, videos,
+			is_financial, sentiment_score, sentiment_label,
+			quarantine_reasons, quarantined_at,
+			ARRAY(SELECT ts.symbol FROM tweet_symbols ts WHERE ts.tweet_id = tweets.id ORDER BY ts.symbol)
+		FROM tweets
+	`
+
+	sqlSuffix, args := buildFilter(f)
+	rows, err := r.Pool.Query(ctx, query+sqlSuffix, args...)
+	if err != nil {
+		return fmt.Errorf("r.Pool.Query(SELECT FROM tweets): %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		t, err := scanTweet(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 // ListBySymbol quickly fetches tweets linked with given ticker
 func (r *TweetRepository) ListBySymbol(ctx context.Context, symbol string, limit, offset int32) ([]*entity.Tweet, error) {
 	query := `
@@ -183,10 +217,12 @@ func (r *TweetRepository) ListBySymbol(ctx context.Context, symbol string, limit
 			t.created_at, t.fetched_at, t.updated_at,
 			t.likes, t.replies, t.retweets, t.views,
 			t.urls, t.photos, t.videos,
-			t.is_financial, t.sentiment_score, t.sentiment_label
+			t.is_financial, t.sentiment_score, t.sentiment_label,
+			t.quarantine_reasons, t.quarantined_at,
+			ARRAY(SELECT s.symbol FROM tweet_symbols s WHERE s.tweet_id = t.id ORDER BY s.symbol)
 		FROM tweets t
 		JOIN tweet_symbols ts ON ts.tweet_id = t.id
-		WHERE ts.symbol = $1
+		WHERE ts.symbol = $1 AND t.quarantined_at IS NULL
 		ORDER BY t.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
@@ -216,9 +252,11 @@ func (r *TweetRepository) ListBySentiment(ctx context.Context, label string, lim
 			created_at, fetched_at, updated_at,
 			likes, replies, retweets, views,
 			urls, photos, videos,
-			is_financial, sentiment_score, sentiment_label
+			is_financial, sentiment_score, sentiment_label,
+			quarantine_reasons, quarantined_at,
+			ARRAY(SELECT ts.symbol FROM tweet_symbols ts WHERE ts.tweet_id = tweets.id ORDER BY ts.symbol)
 		FROM tweets
-		WHERE sentiment_label = $1
+		WHERE sentiment_label = $1 AND quarantined_at IS NULL
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
@@ -239,3 +277,165 @@ func (r *TweetRepository) ListBySentiment(ctx context.Context, label string, lim
 	}
 	return res, rows.Err()
 }
+
+// CountTextAuthors counts distinct other authors that posted the same normalised text
+func (r *TweetRepository) CountTextAuthors(ctx context.Context, textHash, authorID string, since time.Time) (int, error) {
+	const query = ` -- CountTextAuthors(ctx context.Context, textHash, authorID string, since time.Time) (int, error)
+		SELECT count(DISTINCT author_id)
+		FROM tweets
+		WHERE text_hash = $1 AND author_id <> $2 AND created_at >= $3`
+
+	var n int
+	if err := r.Pool.QueryRow(ctx, query, textHash, authorID, since).Scan(&n); err != nil {
+		return 0, fmt.Errorf("r.Pool.QueryRow(SELECT FROM tweets): %w", err)
+	}
+
+	return n, nil
+}
+
+// Release clears the quarantine of a tweet
+func (r *TweetRepository) Release(ctx context.Context, id uuid.UUID) error {
+	const query = ` -- Release(ctx context.Context, id uuid.UUID) error
+		UPDATE tweets
+		SET quarantine_reasons = '{}', quarantined_at = NULL
+		WHERE id = $1 AND quarantined_at IS NOT NULL`
+
+	tag, err := r.Pool.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("r.Pool.Exec(UPDATE tweets): %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repo.ErrTweetNotQuarantined
+	}
+
+	return nil
+}
+
+// UpdateIfUnmodified updates editable fields and symbol links only if the row's
+// updated_at still equals version, otherwise it returns repo.ErrStaleTweet
+func (r *TweetRepository) UpdateIfUnmodified(ctx context.Context, t *entity.Tweet, version time.Time) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("r.Pool.Begin(): %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	const query = ` -- UpdateIfUnmodified(ctx context.Context, t *entity.Tweet, version time.Time) error
+		UPDATE tweets
+		SET
+			text = $1, likes = $2, replies = $3, retweets = $4, views = $5,
+			sentiment_score = $6, sentiment_label = $7, is_financial = $8,
+			updated_at = $9
+		WHERE id = $10 AND updated_at = $11`
+
+	tag, err := tx.Exec(ctx, query,
+		t.Text, t.Likes, t.Replies, t.Retweets, t.Views,
+		t.SentimentScore, t.SentimentLabel, t.IsFinancial,
+		time.Now().UTC(), t.ID, version,
+	)
+	if err != nil {
+		return fmt.Errorf("tx.Exec(UPDATE tweets): %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repo.ErrStaleTweet
+	}
+
+	const queryUnlink = ` -- UpdateIfUnmodified(ctx context.Context, t *entity.Tweet, version time.Time) error
+		DELETE FROM tweet_symbols WHERE tweet_id = $1`
+
+	if _, err := tx.Exec(ctx, queryUnlink, t.ID); err != nil {
+		return fmt.Errorf("tx.Exec(DELETE FROM tweet_symbols): %w", err)
+	}
+
+	if err := insertTweetSymbols(ctx, tx, t.ID, t.Symbols); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListIDs returns ids of all tweets matching the filter, newest first
+func (r *TweetRepository) ListIDs(ctx context.Context, f repo.TweetFilter) ([]uuid.UUID, error) {
+	const query = ` -- ListIDs(ctx context.Context, f repo.TweetFilter) ([]uuid.UUID, error)
+		SELECT id FROM tweets
+	`
+
+	sqlSuffix, args := buildFilter(f)
+	rows, err := r.Pool.Query(ctx, query+sqlSuffix, args...)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query(SELECT FROM tweets): %w", err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows(): %w", err)
+	}
+
+	return ids, nil
+}
+
+// DeleteMany removes tweets by ids and returns how many were deleted
+func (r *TweetRepository) DeleteMany(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	const query = ` -- DeleteMany(ctx context.Context, ids []uuid.UUID) (int64, error)
+		DELETE FROM tweets WHERE id = ANY($1)`
+
+	tag, err := r.Pool.Exec(ctx, query, ids)
+	if err != nil {
+		return 0, fmt.Errorf("r.Pool.Exec(DELETE FROM tweets): %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// TrendingSymbols counts mentions of symbols in tweets posted since the given time
+func (r *TweetRepository) TrendingSymbols(ctx context.Context, since time.Time, limit int32) ([]*entity.SymbolTrend, error) {
+	const query = ` -- TrendingSymbols(ctx context.Context, since time.Time, limit int32) ([]*entity.SymbolTrend, error)
+		SELECT ts.symbol, count(*) AS mentions, COALESCE(avg(t.sentiment_score), 0) AS avg_sentiment
+		FROM tweet_symbols ts
+		JOIN tweets t ON t.id = ts.tweet_id
+		WHERE t.created_at >= $1 AND t.quarantined_at IS NULL
+		GROUP BY ts.symbol
+		ORDER BY mentions DESC, ts.symbol
+		LIMIT $2`
+
+	rows, err := r.Pool.Query(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query(SELECT FROM tweet_symbols): %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.SymbolTrend
+	for rows.Next() {
+		var st entity.SymbolTrend
+		if err := rows.Scan(&st.Symbol, &st.Mentions, &st.AvgSentiment); err != nil {
+			return nil, err
+		}
+		out = append(out, &st)
+	}
+	return out, rows.Err()
+}
+
+// insertTweetSymbols links a tweet with its symbols, unknown symbols are created as equities
+func insertTweetSymbols(ctx context.Context, tx pgx.Tx, id uuid.UUID, symbols []string) error {
+	const querySymbols = `-- insertTweetSymbols(ctx context.Context, tx pgx.Tx, id uuid.UUID, symbols []string) error
+		INSERT INTO symbols (ticker, type, display_name) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING
+	`
+
+	const queryTweetSymbols = `-- insertTweetSymbols(ctx context.Context, tx pgx.Tx, id uuid.UUID, symbols []string) error
+		INSERT INTO tweet_symbols (tweet_id, symbol) VALUES ($1, $2) ON CONFLICT DO NOTHING
+	`
+
+	for _, symbol := range symbols {
+		s := strings.ToUpper(symbol)
+
+		if _, err := tx.Exec(ctx, querySymbols, s, entity.SymbolTypeEquity, s); err != nil {
+			return fmt.Errorf("tx.Exec(INSERT INTO symbols): %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, queryTweetSymbols, id, s); err != nil {
+			return fmt.Errorf("tx.Exec(INSERT INTO tweet_symbols): %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package webapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/config"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	mlpb "github.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/ml/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var _errMLUnavailable = entity.NewError(entity.ErrUnavailable, "ml-service is unavailable")
+
+// MLSentiment implements repo.SentimentAnalyzer over ml-service gRPC
+type MLSentiment struct {
+	conn    *grpc.ClientConn
+	client  mlpb.MLServiceClient
+	timeout time.Duration
+}
+
+// NewMLSentiment dials ml-service, the connection is established lazily
+func NewMLSentiment(cfg config.ML) (*MLSentiment, error) {
+	conn, err := grpc.NewClient(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("grpc.NewClient(%s): %w", cfg.Addr, err)
+	}
+
+	return &MLSentiment{
+		conn:    conn,
+		client:  mlpb.NewMLServiceClient(conn),
+		timeout: cfg.Timeout,
+	}, nil
+}
+
+// Analyze scores texts in one BatchAnalyzeSentiment call
+func (m *MLSentiment) Analyze(ctx context.Context, texts []string) ([]entity.Sentiment, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	resp, err := m.client.BatchAnalyzeSentiment(ctx, &mlpb.BatchSentimentRequest{Texts: texts})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.ResourceExhausted:
+			return nil, &entity.ThrottledError{Provider: "ml-service", Err: err}
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return nil, _errMLUnavailable.Wrap(err)
+		}
+		return nil, fmt.Errorf("m.client.BatchAnalyzeSentiment(): %w", err)
+	}
+	if len(resp.GetResults()) != len(texts) {
+		return nil, fmt.Errorf("ml-service returned %d results for %d texts", len(resp.GetResults()), len(texts))
+	}
+
+	out := make([]entity.Sentiment, len(texts))
+	for i, r := range resp.GetResults() {
+		out[i] = entity.NewSentiment(float64(r.GetPositive()), float64(r.GetNeutral()), float64(r.GetNegative()))
+	}
+
+	return out, nil
+}
+
+// Close closes the underlying connection
+func (m *MLSentiment) Close() error {
+	return m.conn.Close()
+}
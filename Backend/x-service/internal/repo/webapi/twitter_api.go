@@ -2,6 +2,7 @@ package webapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -67,20 +68,30 @@ func (api *TwitterAPI) SearchTweets(ctx context.Context, query string, max int)
 		},
 		UserFields: []twitter.UserField{
 			twitter.UserFieldUserName,
+			twitter.UserFieldCreatedAt,
+			twitter.UserFieldPublicMetrics,
 		},
 	}
 
 	resp, err := api.client.TweetRecentSearch(ctx, query, opts)
 	if err != nil {
+		var er *twitter.ErrorResponse
+		if errors.As(err, &er) && er.StatusCode == http.StatusTooManyRequests {
+			throttled := &entity.ThrottledError{Provider: "x api", Err: err}
+			if er.RateLimit != nil && er.RateLimit.Reset.Time().After(time.Now()) {
+				throttled.RetryAfter = time.Until(er.RateLimit.Reset.Time())
+			}
+			return nil, throttled
+		}
 		return nil, fmt.Errorf("TweetRecentSearch error: %w", err)
 	}
 	if resp.RateLimit != nil {
 		fmt.Printf("Rate limit: %d remaining, resets at %v\n", resp.RateLimit.Remaining, resp.RateLimit.Reset)
 	}
 
-	userMap := make(map[string]string)
+	userMap := make(map[string]*twitter.UserObj)
 	for _, user := range resp.Raw.Includes.Users {
-		userMap[user.ID] = user.UserName
+		userMap[user.ID] = user
 	}
 
 	now := time.Now().UTC()
@@ -101,9 +112,20 @@ func (api *TwitterAPI) SearchTweets(ctx context.Context, query string, max int)
 			return nil, fmt.Errorf("time.Parse(): %w", err)
 		}
 
-		userName := userMap[t.AuthorID]
-		if userName == "" {
-			userName = "unknown"
+		userName := "unknown"
+		var (
+			followers       int
+			authorCreatedAt time.Time
+		)
+		if user, ok := userMap[t.AuthorID]; ok {
+			if user.UserName != "" {
+				userName = user.UserName
+			}
+			if user.PublicMetrics != nil {
+				followers = user.PublicMetrics.Followers
+			}
+			// account age is optional, the spam filter skips unknown ages
+			authorCreatedAt, _ = time.Parse(time.RFC3339, user.CreatedAt)
 		}
 
 		symbols := extractSymbols(t.Text, nil)
@@ -127,6 +149,9 @@ func (api *TwitterAPI) SearchTweets(ctx context.Context, query string, max int)
 			Videos:      nil,
 			IsFinancial: isFinancial,
 			Symbols:     nil,
+
+			AuthorFollowers: followers,
+			AuthorCreatedAt: authorCreatedAt,
 		})
 	}
 
@@ -3,6 +3,7 @@ package webapi
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/config"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
@@ -61,6 +62,9 @@ func (ts *TwitterScraper) SearchTweets(ctx context.Context, query string, maxTwe
 				if tr != nil && tr.Tweet.ID != "" {
 					id = tr.Tweet.ID
 				}
+				if tr != nil && strings.Contains(tr.Error.Error(), "response status 429") {
+					return nil, &entity.ThrottledError{Provider: "x scraper", Err: tr.Error}
+				}
 				return nil, fmt.Errorf("tweet scrape failed (id=%s): %w", id, tr.Error)
 			}
 			result = append(result, mapScrapedTweet(&tr.Tweet))
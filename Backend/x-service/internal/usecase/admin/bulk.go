@@ -0,0 +1,391 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/export"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSentimentUnavailable = entity.NewError(entity.ErrUnavailable, "sentiment analyzer is not configured")
+	ErrBulkOperationDone    = entity.NewError(entity.ErrPrecondition, "bulk operation is already finished")
+	ErrEmptySelector        = entity.NewError(entity.ErrInvalid, "selector matches no tweets")
+	errTextChanged          = entity.NewError(entity.ErrConflict, "tweet text changed while scoring")
+)
+
+// Selector picks the tweets of a bulk operation, explicit IDs take precedence over Filter,
+// Limit and Offset of the filter are ignored so the whole matching set is processed
+type Selector struct {
+	IDs    []uuid.UUID
+	Filter repo.TweetFilter
+}
+
+// chunkFunc processes one chunk of tweet ids and updates the operation counters
+type chunkFunc func(ctx context.Context, op *entity.BulkOperation, ids []uuid.UUID) error
+
+// Bulk runs asynchronous admin operations over sets of tweets and tracks their progress
+type Bulk struct {
+	tweets    repo.TweetRepository
+	ops       repo.BulkOperationRepository
+	analyzer  repo.SentimentAnalyzer // nil when ml-service is not configured
+	batchSize int
+	exportDir string
+
+	// operations run on baseCtx so they outlive the RPC that started them
+	baseCtx context.Context
+	stop    context.CancelFunc
+	wg      sync.WaitGroup
+
+	mu      sync.Mutex
+	cancels map[uuid.UUID]context.CancelFunc
+}
+
+// NewBulk creates a new Bulk use case
+func NewBulk(
+	tweets repo.TweetRepository,
+	ops repo.BulkOperationRepository,
+	analyzer repo.SentimentAnalyzer,
+	batchSize int,
+	exportDir string,
+) *Bulk {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+
+	return &Bulk{
+		tweets:    tweets,
+		ops:       ops,
+		analyzer:  analyzer,
+		batchSize: batchSize,
+		exportDir: exportDir,
+		baseCtx:   ctx,
+		stop:      stop,
+		cancels:   make(map[uuid.UUID]context.CancelFunc),
+	}
+}
+
+// RetagSymbols adds and removes symbols on every selected tweet
+func (b *Bulk) RetagSymbols(ctx context.Context, sel Selector, add, remove []string) (*entity.BulkOperation, error) {
+	return b.start(ctx, entity.BulkKindRetagSymbols, sel, func(ctx context.Context, op *entity.BulkOperation, ids []uuid.UUID) error {
+		for _, id := range ids {
+			err := modifyTweet(ctx, b.tweets, id, func(t *entity.Tweet) error {
+				now := time.Now().UTC()
+				for _, s := range remove {
+					t.RemoveSymbol(s, now)
+				}
+				for _, s := range add {
+					t.AddSymbol(s, now)
+				}
+				return nil
+			})
+			b.count(op, err)
+		}
+		return ctx.Err()
+	}, nil)
+}
+
+// RescoreSentiment re-scores the sentiment of every selected tweet with ml-service
+func (b *Bulk) RescoreSentiment(ctx context.Context, sel Selector) (*entity.BulkOperation, error) {
+	if b.analyzer == nil {
+		return nil, ErrSentimentUnavailable
+	}
+
+	return b.start(ctx, entity.BulkKindRescoreSentiment, sel, func(ctx context.Context, op *entity.BulkOperation, ids []uuid.UUID) error {
+		tweets := make([]*entity.Tweet, 0, len(ids))
+		for _, id := range ids {
+			t, err := b.tweets.Get(ctx, id)
+			if err != nil {
+				b.count(op, err)
+				continue
+			}
+			tweets = append(tweets, t)
+		}
+		if len(tweets) == 0 {
+			return ctx.Err()
+		}
+
+		texts := make([]string, len(tweets))
+		for i, t := range tweets {
+			texts[i] = t.Text
+		}
+
+		scores, err := b.analyzer.Analyze(ctx, texts)
+		if err != nil {
+			return fmt.Errorf("b.analyzer.Analyze(): %w", err)
+		}
+
+		for i, t := range tweets {
+			text, s := t.Text, scores[i]
+			err := modifyTweet(ctx, b.tweets, t.ID, func(t *entity.Tweet) error {
+				// the score belongs to the text it was computed for
+				if t.Text != text {
+					return errTextChanged
+				}
+				t.UpdateSentiment(s.Score, s.Label, time.Now().UTC())
+				return nil
+			})
+			b.count(op, err)
+		}
+		return ctx.Err()
+	}, nil)
+}
+
+// Delete removes every selected tweet
+func (b *Bulk) Delete(ctx context.Context, sel Selector) (*entity.BulkOperation, error) {
+	return b.start(ctx, entity.BulkKindDelete, sel, func(ctx context.Context, op *entity.BulkOperation, ids []uuid.UUID) error {
+		n, err := b.tweets.DeleteMany(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("b.tweets.DeleteMany(): %w", err)
+		}
+
+		// ids that were already gone are processed but not deleted
+		op.Processed += len(ids)
+		op.Succeeded += int(n)
+		op.Failed += len(ids) - int(n)
+		return ctx.Err()
+	}, nil)
+}
+
+// Export writes every selected tweet into a JSONL file of the export tweets schema
+// under the export dir, the file path is reported as the operation result
+func (b *Bulk) Export(ctx context.Context, sel Selector) (*entity.BulkOperation, error) {
+	var (
+		f  *os.File
+		tw *export.TweetWriter
+	)
+
+	return b.start(ctx, entity.BulkKindExport, sel, func(ctx context.Context, op *entity.BulkOperation, ids []uuid.UUID) error {
+		if f == nil {
+			if err := os.MkdirAll(b.exportDir, 0o755); err != nil {
+				return fmt.Errorf("os.MkdirAll(): %w", err)
+			}
+
+			path := filepath.Join(b.exportDir, op.ID.String()+".jsonl")
+			file, err := os.Create(path)
+			if err != nil {
+				return fmt.Errorf("os.Create(): %w", err)
+			}
+			f = file
+			op.ResultURI = "file://" + path
+
+			if tw, err = export.NewTweetWriter(export.FormatJSONL, f); err != nil {
+				return fmt.Errorf("export.NewTweetWriter(): %w", err)
+			}
+		}
+
+		for _, id := range ids {
+			t, err := b.tweets.Get(ctx, id)
+			if err == nil {
+				err = tw.Write(t)
+			}
+			b.count(op, err)
+		}
+
+		if op.Processed == op.Total {
+			if err := tw.Close(); err != nil {
+				return fmt.Errorf("tw.Close(): %w", err)
+			}
+
+			err := f.Close()
+			f = nil
+			if err != nil {
+				return fmt.Errorf("f.Close(): %w", err)
+			}
+		}
+		return ctx.Err()
+	}, func() {
+		// the operation was interrupted before the last chunk
+		if f != nil {
+			_ = f.Close()
+		}
+	})
+}
+
+// Get retrieves a bulk operation with its progress
+func (b *Bulk) Get(ctx context.Context, id uuid.UUID) (*entity.BulkOperation, error) {
+	op, err := b.ops.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("b.ops.Get(): %w", err)
+	}
+
+	return op, nil
+}
+
+// List retrieves bulk operations, newest first
+func (b *Bulk) List(ctx context.Context, limit, offset int32) ([]*entity.BulkOperation, error) {
+	list, err := b.ops.List(ctx, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("b.ops.List(): %w", err)
+	}
+
+	return list, nil
+}
+
+// Cancel stops a running operation, work done before cancellation is kept
+func (b *Bulk) Cancel(ctx context.Context, id uuid.UUID) error {
+	b.mu.Lock()
+	cancel, ok := b.cancels[id]
+	b.mu.Unlock()
+
+	if ok {
+		cancel()
+		return nil
+	}
+
+	op, err := b.ops.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("b.ops.Get(): %w", err)
+	}
+	if op.IsDone() {
+		return ErrBulkOperationDone
+	}
+
+	// not running in this process, e.g. interrupted by a restart
+	op.Cancel(time.Now())
+	if err := b.ops.Save(ctx, op); err != nil {
+		return fmt.Errorf("b.ops.Save(): %w", err)
+	}
+
+	return nil
+}
+
+// Shutdown cancels running operations and waits for them to record their state
+func (b *Bulk) Shutdown() {
+	b.stop()
+	b.wg.Wait()
+}
+
+// start stores a pending operation and runs it in the background,
+// cleanup, if set, is called once the operation is over
+func (b *Bulk) start(ctx context.Context, kind entity.BulkKind, sel Selector, fn chunkFunc, cleanup func()) (*entity.BulkOperation, error) {
+	op := entity.NewBulkOperation(kind, time.Now())
+	if err := b.ops.Create(ctx, op); err != nil {
+		return nil, fmt.Errorf("b.ops.Create(): %w", err)
+	}
+
+	runCtx, cancel := context.WithCancel(b.baseCtx)
+
+	b.mu.Lock()
+	b.cancels[op.ID] = cancel
+	b.mu.Unlock()
+
+	// the caller gets a snapshot, the goroutine keeps mutating its own copy
+	snapshot := *op
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		defer func() {
+			b.mu.Lock()
+			delete(b.cancels, op.ID)
+			b.mu.Unlock()
+			cancel()
+			if cleanup != nil {
+				cleanup()
+			}
+		}()
+		// nothing recovers panics of this goroutine but here, they would
+		// take the whole service down
+		defer func() {
+			if r := recover(); r != nil {
+				b.finish(context.WithoutCancel(runCtx), runCtx, op, fmt.Errorf("bulk operation panicked: %v", r))
+			}
+		}()
+
+		b.run(runCtx, op, sel, fn)
+	}()
+
+	return &snapshot, nil
+}
+
+// run resolves the selector and feeds the ids to fn chunk by chunk,
+// saving progress after each chunk
+func (b *Bulk) run(ctx context.Context, op *entity.BulkOperation, sel Selector, fn chunkFunc) {
+	// the final state must be saved even when ctx is canceled
+	saveCtx := context.WithoutCancel(ctx)
+
+	ids, err := b.resolve(ctx, sel)
+	if err == nil && len(ids) == 0 {
+		err = ErrEmptySelector
+	}
+	if err != nil {
+		b.finish(saveCtx, ctx, op, err)
+		return
+	}
+
+	op.Start(len(ids), time.Now())
+	if err := b.ops.Save(saveCtx, op); err != nil {
+		b.finish(saveCtx, ctx, op, fmt.Errorf("b.ops.Save(): %w", err))
+		return
+	}
+
+	for start := 0; start < len(ids); start += b.batchSize {
+		end := min(start+b.batchSize, len(ids))
+
+		if err := fn(ctx, op, ids[start:end]); err != nil {
+			b.finish(saveCtx, ctx, op, err)
+			return
+		}
+
+		if err := b.ops.Save(saveCtx, op); err != nil {
+			b.finish(saveCtx, ctx, op, fmt.Errorf("b.ops.Save(): %w", err))
+			return
+		}
+	}
+
+	b.finish(saveCtx, ctx, op, nil)
+}
+
+// finish records the final state of an operation
+func (b *Bulk) finish(saveCtx, ctx context.Context, op *entity.BulkOperation, err error) {
+	if ctx.Err() != nil {
+		op.Cancel(time.Now())
+	} else {
+		op.Finish(err, time.Now())
+	}
+
+	// the state stays running in the table if this fails, Cancel can settle it later
+	_ = b.ops.Save(saveCtx, op)
+}
+
+// resolve expands the selector into tweet ids
+func (b *Bulk) resolve(ctx context.Context, sel Selector) ([]uuid.UUID, error) {
+	if len(sel.IDs) > 0 {
+		return sel.IDs, nil
+	}
+
+	f := sel.Filter
+	f.Limit, f.Offset = 0, 0
+
+	ids, err := b.tweets.ListIDs(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("b.tweets.ListIDs(): %w", err)
+	}
+
+	return ids, nil
+}
+
+// count updates the counters with the outcome of a single tweet
+func (b *Bulk) count(op *entity.BulkOperation, err error) {
+	op.Processed++
+
+	switch {
+	case err == nil:
+		op.Succeeded++
+	case errors.Is(err, repo.ErrStaleTweet), errors.Is(err, errTextChanged):
+		op.Conflicts++
+	default:
+		op.Failed++
+	}
+}
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -94,33 +95,77 @@ func (uc *UseCase) Delete(ctx context.Context, id uuid.UUID) error {
 
 // AddSymbol adds a symbol to a tweet
 func (uc *UseCase) AddSymbol(ctx context.Context, id uuid.UUID, symbol string) error {
-	t, err := uc.repo.Get(ctx, id)
-	if err != nil {
-		return fmt.Errorf("uc.repo.Get(): %w", err)
-	}
-
-	t.AddSymbol(symbol, time.Now().UTC())
-	return uc.repo.Update(ctx, t)
+	return modifyTweet(ctx, uc.repo, id, func(t *entity.Tweet) error {
+		t.AddSymbol(symbol, time.Now().UTC())
+		return nil
+	})
 }
 
 // UpdateSentiment updates the sentiment of a tweet
 func (uc *UseCase) UpdateSentiment(ctx context.Context, id uuid.UUID, score float64, label string) error {
-	t, err := uc.repo.Get(ctx, id)
-	if err != nil {
-		return fmt.Errorf("uc.repo.Get(): %w", err)
-	}
-
-	t.UpdateSentiment(score, label, time.Now().UTC())
-	return uc.repo.Update(ctx, t)
+	return modifyTweet(ctx, uc.repo, id, func(t *entity.Tweet) error {
+		t.UpdateSentiment(score, label, time.Now().UTC())
+		return nil
+	})
 }
 
 // UpdateEngagement updates the engagement of a tweet
 func (uc *UseCase) UpdateEngagement(ctx context.Context, id uuid.UUID, likes, replies, retweets, views int) error {
-	t, err := uc.repo.Get(ctx, id)
+	return modifyTweet(ctx, uc.repo, id, func(t *entity.Tweet) error {
+		t.UpdateEngagement(likes, replies, retweets, views, time.Now().UTC())
+		return nil
+	})
+}
+
+// ListQuarantined returns quarantined tweets, optionally by reason code
+func (uc *UseCase) ListQuarantined(ctx context.Context, reason entity.SpamReason, limit, offset int32) ([]*entity.Tweet, error) {
+	list, err := uc.repo.List(ctx, repo.TweetFilter{
+		Quarantined:      true,
+		QuarantineReason: reason,
+		Limit:            limit,
+		Offset:           offset,
+	})
 	if err != nil {
-		return fmt.Errorf("uc.repo.Get(): %w", err)
+		return nil, fmt.Errorf("uc.repo.List(): %w", err)
+	}
+
+	return list, nil
+}
+
+// Release releases a quarantined tweet, it is counted in sentiment
+// aggregates from their next refresh on
+func (uc *UseCase) Release(ctx context.Context, id uuid.UUID) error {
+	if err := uc.repo.Release(ctx, id); err != nil {
+		return fmt.Errorf("uc.repo.Release(): %w", err)
 	}
 
-	t.UpdateEngagement(likes, replies, retweets, views, time.Now().UTC())
-	return uc.repo.Update(ctx, t)
+	return nil
+}
+
+// _modifyAttempts is how many times a read-modify-write is retried when the
+// tweet is changed concurrently between the read and the write
+const _modifyAttempts = 3
+
+// modifyTweet reads a tweet, applies mutate and writes it back only if nobody
+// updated it in between, on conflict the whole cycle is retried on a fresh copy
+func modifyTweet(ctx context.Context, tweets repo.TweetRepository, id uuid.UUID, mutate func(*entity.Tweet) error) error {
+	for attempt := 0; ; attempt++ {
+		t, err := tweets.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("tweets.Get(): %w", err)
+		}
+
+		version := t.UpdatedAt
+		if err := mutate(t); err != nil {
+			return err
+		}
+
+		err = tweets.UpdateIfUnmodified(ctx, t, version)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, repo.ErrStaleTweet) || attempt+1 >= _modifyAttempts {
+			return fmt.Errorf("tweets.UpdateIfUnmodified(): %w", err)
+		}
+	}
 }
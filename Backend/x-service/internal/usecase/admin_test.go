@@ -0,0 +1,41 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/admin"
+)
+
+func TestListQuarantined(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	tweets := NewMockTweetRepository(mockCtl)
+	useCase := admin.New(tweets)
+
+	want := []*entity.Tweet{{Text: "pump"}}
+
+	// the review queue has no time range, persistent.buildFilter must take
+	// the filter without one
+	tweets.EXPECT().List(gomock.Any(), repo.TweetFilter{
+		Quarantined:      true,
+		QuarantineReason: entity.SpamReasonDuplicateCluster,
+		Limit:            50,
+		Offset:           100,
+	}).Return(want, nil)
+
+	got, err := useCase.ListQuarantined(context.Background(), entity.SpamReasonDuplicateCluster, 50, 100)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	tweets.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errDB)
+
+	_, err = useCase.ListQuarantined(context.Background(), "", 50, 0)
+	require.ErrorIs(t, err, errDB)
+}
@@ -0,0 +1,91 @@
+package author
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+)
+
+// UseCase implements author scoring
+type UseCase struct {
+	repo repo.AuthorScoreRepository
+
+	window       time.Duration
+	minTweets    int
+	trackHitRate bool
+}
+
+// New creates a new author scoring use case, only tweets newer than window
+// are taken into account and authors with fewer than minTweets are skipped
+func New(repo repo.AuthorScoreRepository, window time.Duration, minTweets int, trackHitRate bool) *UseCase {
+	return &UseCase{
+		repo:         repo,
+		window:       window,
+		minTweets:    minTweets,
+		trackHitRate: trackHitRate,
+	}
+}
+
+// Recompute scores every active author, stores the scores and re-weights
+// sentiment aggregates, it returns the number of scored authors
+func (uc *UseCase) Recompute(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	since := now.Add(-uc.window)
+
+	stats, err := uc.repo.Stats(ctx, since, uc.minTweets)
+	if err != nil {
+		return 0, fmt.Errorf("uc.repo.Stats(): %w", err)
+	}
+	if len(stats) == 0 {
+		return 0, nil
+	}
+
+	if uc.trackHitRate {
+		if err := uc.repo.HitRates(ctx, since, stats); err != nil {
+			return 0, fmt.Errorf("uc.repo.HitRates(): %w", err)
+		}
+	}
+
+	var maxReach float64
+	for _, s := range stats {
+		maxReach = max(maxReach, s.MedianEngagement)
+	}
+
+	scores := make([]*entity.AuthorScore, 0, len(stats))
+	for _, s := range stats {
+		scores = append(scores, entity.NewAuthorScore(s, maxReach, uc.trackHitRate, now))
+	}
+
+	if err := uc.repo.Upsert(ctx, scores); err != nil {
+		return 0, fmt.Errorf("uc.repo.Upsert(): %w", err)
+	}
+
+	if err := uc.repo.RefreshSentimentAgg(ctx); err != nil {
+		return 0, fmt.Errorf("uc.repo.RefreshSentimentAgg(): %w", err)
+	}
+
+	return len(scores), nil
+}
+
+// Get returns score of a single author
+func (uc *UseCase) Get(ctx context.Context, authorID string) (*entity.AuthorScore, error) {
+	s, err := uc.repo.Get(ctx, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("uc.repo.Get(): %w", err)
+	}
+
+	return s, nil
+}
+
+// List returns authors ranked by score
+func (uc *UseCase) List(ctx context.Context, limit, offset int32) ([]*entity.AuthorScore, error) {
+	list, err := uc.repo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("uc.repo.List(): %w", err)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,89 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/author"
+)
+
+var errDB = errors.New("db is down")
+
+func TestAuthorRecompute(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := NewMockAuthorScoreRepository(mockCtl)
+	useCase := author.New(repo, 30*24*time.Hour, 5, true)
+
+	stats := []*entity.AuthorStats{
+		{AuthorID: "top", Tweets: 10, FinancialTweets: 10, MedianEngagement: 99, MeanEngagement: 99},
+		{AuthorID: "low", Tweets: 10, MedianEngagement: 9, MeanEngagement: 10, StddevEngagement: 10},
+	}
+
+	var scores []*entity.AuthorScore
+	gomock.InOrder(
+		repo.EXPECT().Stats(gomock.Any(), gomock.Any(), 5).DoAndReturn(
+			func(_ context.Context, since time.Time, _ int) ([]*entity.AuthorStats, error) {
+				require.WithinDuration(t, time.Now().Add(-30*24*time.Hour), since, time.Minute)
+				return stats, nil
+			}),
+		repo.EXPECT().HitRates(gomock.Any(), gomock.Any(), stats).DoAndReturn(
+			func(_ context.Context, _ time.Time, stats []*entity.AuthorStats) error {
+				stats[0].Calls, stats[0].Hits = 2, 2
+				return nil
+			}),
+		repo.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, s []*entity.AuthorScore) error {
+				scores = s
+				return nil
+			}),
+		repo.EXPECT().RefreshSentimentAgg(gomock.Any()).Return(nil),
+	)
+
+	n, err := useCase.Recompute(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Len(t, scores, 2)
+
+	// reach is normalised by the top author, who also got every call right
+	require.InDelta(t, 1, scores[0].Score, 1e-9)
+	require.NotNil(t, scores[0].HitRate)
+	require.Nil(t, scores[1].HitRate)
+	require.InDelta(t, (0.35*0.5+0.20*0.5)/0.85, scores[1].Score, 1e-9)
+}
+
+func TestAuthorRecomputeNothingToScore(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := NewMockAuthorScoreRepository(mockCtl)
+	useCase := author.New(repo, time.Hour, 1, true)
+
+	repo.EXPECT().Stats(gomock.Any(), gomock.Any(), 1).Return(nil, nil)
+
+	n, err := useCase.Recompute(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestAuthorRecomputeStoreFails(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	repo := NewMockAuthorScoreRepository(mockCtl)
+	useCase := author.New(repo, time.Hour, 1, false)
+
+	repo.EXPECT().Stats(gomock.Any(), gomock.Any(), 1).Return([]*entity.AuthorStats{{AuthorID: "a", Tweets: 1}}, nil)
+	repo.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(errDB)
+
+	// the aggregates are not re-weighted with scores that were not stored
+	_, err := useCase.Recompute(context.Background())
+	require.ErrorIs(t, err, errDB)
+}
@@ -2,18 +2,27 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
 	"github.com/google/uuid"
 )
 
-//go:generate mockgen -source=interfaces.go -destination=./mocks_usecase_test.go -package=usecase_test
+//go:generate mockgen -source=contracts.go -destination=./mocks_usecase_test.go -package=usecase_test
+
+type (
+	SpamFilter interface {
+		// Check - returns spam reason codes of the tweet, nil if it is clean
+		Check(context.Context, *entity.Tweet) ([]entity.SpamReason, error)
+	}
+)
 
 type (
 	TweetUseCase interface {
 		// Ingest - fetches fresh Tweets that match the query, stores them,
-		// and returns the slice that were persisted this round
+		// and returns the slice that were persisted this round,
+		// tweets flagged by the spam filter are persisted quarantined
 		Ingest(context.Context, string, int) ([]*entity.Tweet, error)
 
 		// GetListLatest - returns newest stored tweets
@@ -21,6 +30,16 @@ type (
 
 		// GetByID - returns a single stored tweet by ID
 		GetByID(ctx context.Context, id uuid.UUID) (*entity.Tweet, error)
+
+		// ListFeed - returns a page of tweets matching the filter, newest first,
+		// and the cursor of the next page, nil on the last one
+		ListFeed(ctx context.Context, f repo.TweetFilter) ([]*entity.Tweet, *entity.TweetCursor, error)
+
+		// SentimentSeries - returns daily sentiment of a symbol in the range
+		SentimentSeries(ctx context.Context, symbol string, from, to time.Time) ([]*entity.SentimentDaily, error)
+
+		// Trending - returns symbols mentioned most since the given time
+		Trending(ctx context.Context, since time.Time, limit int32) ([]*entity.SymbolTrend, error)
 	}
 )
 
@@ -55,5 +74,24 @@ type (
 
 		// UpdateEngagement - updates the engagement of a tweet
 		UpdateEngagement(ctx context.Context, id uuid.UUID, likes, replies, retweets, views int) error
+
+		// ListQuarantined - retrieves quarantined tweets, optionally by reason code
+		ListQuarantined(ctx context.Context, reason entity.SpamReason, limit, offset int32) ([]*entity.Tweet, error)
+
+		// Release - releases a quarantined tweet after review
+		Release(ctx context.Context, id uuid.UUID) error
+	}
+)
+
+type (
+	AuthorScoreUseCase interface {
+		// Recompute - scores all active authors and re-weights sentiment aggregates
+		Recompute(ctx context.Context) (int, error)
+
+		// Get - retrieves the score of a single author
+		Get(ctx context.Context, authorID string) (*entity.AuthorScore, error)
+
+		// List - retrieves authors ranked by score
+		List(ctx context.Context, limit, offset int32) ([]*entity.AuthorScore, error)
 	}
 )
@@ -0,0 +1,203 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// _parquetRowGroupSize keeps parquet memory bounded, rows are buffered per row group
+const _parquetRowGroupSize = 16 * 1024 * 1024
+
+// encoder writes rows of a schema in one of the export formats
+type encoder interface {
+	// Write writes a single row, values follow the schema columns
+	Write(row []any) error
+	// Close writes pending data and the trailer if the format has one
+	Close() error
+}
+
+// newEncoder creates an encoder and writes the versioned header:
+// a "#schema=<dataset>/v<version>" line followed by the column names for CSV,
+// a {"schema": ..., "columns": [...]} line for JSONL and "schema" key-value
+// metadata for Parquet
+func newEncoder(f Format, s Schema, w io.Writer) (encoder, error) {
+	switch f {
+	case FormatCSV:
+		return newCSVEncoder(s, w)
+	case FormatJSONL:
+		return newJSONLEncoder(s, w)
+	case FormatParquet:
+		return newParquetEncoder(s, w)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, f)
+	}
+}
+
+type csvEncoder struct {
+	schema Schema
+	w      *csv.Writer
+	rec    []string
+}
+
+func newCSVEncoder(s Schema, w io.Writer) (*csvEncoder, error) {
+	if _, err := fmt.Fprintf(w, "#schema=%s\n", s.ID()); err != nil {
+		return nil, err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(s.ColumnNames()); err != nil {
+		return nil, err
+	}
+
+	return &csvEncoder{schema: s, w: cw, rec: make([]string, len(s.Columns))}, nil
+}
+
+func (e *csvEncoder) Write(row []any) error {
+	for i, c := range e.schema.Columns {
+		e.rec[i] = formatValue(c, row[i])
+	}
+	return e.w.Write(e.rec)
+}
+
+func (e *csvEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type jsonlEncoder struct {
+	schema Schema
+	w      io.Writer
+	buf    bytes.Buffer
+}
+
+func newJSONLEncoder(s Schema, w io.Writer) (*jsonlEncoder, error) {
+	header, err := json.Marshal(struct {
+		Schema  string   `json:"schema"`
+		Columns []string `json:"columns"`
+	}{
+		Schema:  s.ID(),
+		Columns: s.ColumnNames(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(append(header, '\n')); err != nil {
+		return nil, err
+	}
+
+	return &jsonlEncoder{schema: s, w: w}, nil
+}
+
+// Write renders the row as an object with keys in column order
+func (e *jsonlEncoder) Write(row []any) error {
+	e.buf.Reset()
+	e.buf.WriteByte('{')
+
+	for i, c := range e.schema.Columns {
+		if i > 0 {
+			e.buf.WriteByte(',')
+		}
+
+		key, _ := json.Marshal(c.Name)
+		e.buf.Write(key)
+		e.buf.WriteByte(':')
+
+		v := row[i]
+		if t, ok := v.(time.Time); ok {
+			v = formatValue(c, t)
+		}
+
+		val, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("json.Marshal(%s): %w", c.Name, err)
+		}
+		e.buf.Write(val)
+	}
+
+	e.buf.WriteString("}\n")
+	_, err := e.w.Write(e.buf.Bytes())
+	return err
+}
+
+func (e *jsonlEncoder) Close() error {
+	return nil
+}
+
+type parquetEncoder struct {
+	schema Schema
+	pw     *writer.CSVWriter
+}
+
+func newParquetEncoder(s Schema, w io.Writer) (*parquetEncoder, error) {
+	md := make([]string, len(s.Columns))
+	for i, c := range s.Columns {
+		md[i] = parquetColumn(c)
+	}
+
+	pw, err := writer.NewCSVWriterFromWriter(md, w, 1)
+	if err != nil {
+		return nil, fmt.Errorf("writer.NewCSVWriterFromWriter(): %w", err)
+	}
+	pw.RowGroupSize = _parquetRowGroupSize
+
+	version := s.ID()
+	kv := parquet.NewKeyValue()
+	kv.Key, kv.Value = "schema", &version
+	pw.Footer.KeyValueMetadata = []*parquet.KeyValue{kv}
+
+	return &parquetEncoder{schema: s, pw: pw}, nil
+}
+
+func (e *parquetEncoder) Write(row []any) error {
+	rec := make([]any, len(row))
+	for i, v := range row {
+		t, ok := v.(time.Time)
+		switch {
+		case !ok:
+			rec[i] = v
+		case e.schema.Columns[i].Type == TypeDate:
+			rec[i] = int32(t.UTC().Unix() / 86400)
+		default:
+			rec[i] = t.UTC().UnixMilli()
+		}
+	}
+	return e.pw.Write(rec)
+}
+
+func (e *parquetEncoder) Close() error {
+	return e.pw.WriteStop()
+}
+
+// parquetColumn renders parquet-go column metadata of a column
+func parquetColumn(c Column) string {
+	repetition := "REQUIRED"
+	if c.Nullable {
+		repetition = "OPTIONAL"
+	}
+
+	var typ string
+	switch c.Type {
+	case TypeInt:
+		typ = "type=INT64"
+	case TypeFloat:
+		typ = "type=DOUBLE"
+	case TypeBool:
+		typ = "type=BOOLEAN"
+	case TypeTime:
+		typ = "type=INT64, convertedtype=TIMESTAMP_MILLIS"
+	case TypeDate:
+		typ = "type=INT32, convertedtype=DATE"
+	default:
+		typ = "type=BYTE_ARRAY, convertedtype=UTF8"
+	}
+
+	return fmt.Sprintf("name=%s, %s, repetitiontype=%s", c.Name, typ, repetition)
+}
@@ -0,0 +1,205 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+)
+
+var (
+	ErrUnknownFormat  = &entity.FieldError{Field: "format", Description: "unknown export format"}
+	ErrUnknownDataset = &entity.FieldError{Field: "dataset", Description: "unknown export dataset"}
+)
+
+// Format is an export file format
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatJSONL   Format = "jsonl"
+	FormatParquet Format = "parquet"
+)
+
+// Dataset is an exportable data set
+type Dataset string
+
+const (
+	DatasetTweets         Dataset = "tweets"
+	DatasetSentimentDaily Dataset = "sentiment_daily"
+)
+
+// SchemaOf returns the current schema of a dataset
+func SchemaOf(d Dataset) (Schema, error) {
+	switch d {
+	case DatasetTweets:
+		return TweetsSchema, nil
+	case DatasetSentimentDaily:
+		return SentimentDailySchema, nil
+	default:
+		return Schema{}, fmt.Errorf("%w: %q", ErrUnknownDataset, d)
+	}
+}
+
+// Request describes what to export, only the filter of the requested dataset is used
+type Request struct {
+	Dataset   Dataset
+	Format    Format
+	Tweets    repo.TweetFilter
+	Sentiment repo.SentimentAggFilter
+}
+
+// TweetWriter encodes tweets one by one using TweetsSchema
+type TweetWriter struct {
+	enc encoder
+}
+
+// NewTweetWriter writes the header of the format and returns a TweetWriter
+func NewTweetWriter(f Format, w io.Writer) (*TweetWriter, error) {
+	enc, err := newEncoder(f, TweetsSchema, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TweetWriter{enc: enc}, nil
+}
+
+// Write encodes a single tweet
+func (tw *TweetWriter) Write(t *entity.Tweet) error {
+	return tw.enc.Write(tweetRow(t))
+}
+
+// Close finishes the output, it does not close the underlying writer
+func (tw *TweetWriter) Close() error {
+	return tw.enc.Close()
+}
+
+// UseCase streams datasets into CSV, JSONL or Parquet
+type UseCase struct {
+	tweets repo.TweetRepository
+	agg    repo.SentimentAggRepository
+	dir    string
+}
+
+// New creates a new Export use case, files are written under dir
+func New(tweets repo.TweetRepository, agg repo.SentimentAggRepository, dir string) *UseCase {
+	return &UseCase{
+		tweets: tweets,
+		agg:    agg,
+		dir:    dir,
+	}
+}
+
+// Write streams the requested dataset to w and returns the number of rows written,
+// limit and offset of the tweets filter are honoured
+func (uc *UseCase) Write(ctx context.Context, req Request, w io.Writer) (int, error) {
+	s, err := SchemaOf(req.Dataset)
+	if err != nil {
+		return 0, err
+	}
+
+	enc, err := newEncoder(req.Format, s, w)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	switch req.Dataset {
+	case DatasetTweets:
+		err = uc.tweets.Iterate(ctx, req.Tweets, func(t *entity.Tweet) error {
+			n++
+			return enc.Write(tweetRow(t))
+		})
+		if err != nil {
+			return n, fmt.Errorf("uc.tweets.Iterate(): %w", err)
+		}
+
+	case DatasetSentimentDaily:
+		err = uc.agg.IterateDaily(ctx, req.Sentiment, func(d *entity.SentimentDaily) error {
+			n++
+			return enc.Write(sentimentDailyRow(d))
+		})
+		if err != nil {
+			return n, fmt.Errorf("uc.agg.IterateDaily(): %w", err)
+		}
+	}
+
+	if err := enc.Close(); err != nil {
+		return n, fmt.Errorf("enc.Close(): %w", err)
+	}
+
+	return n, nil
+}
+
+// WriteFile exports the requested dataset into a new file under the export dir,
+// named <dataset>_v<version>_<UTC time>.<format>. The file is written under a
+// temporary name and renamed when complete, so readers never see partial dumps
+func (uc *UseCase) WriteFile(ctx context.Context, req Request) (string, int, error) {
+	s, err := SchemaOf(req.Dataset)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if err := os.MkdirAll(uc.dir, 0o755); err != nil {
+		return "", 0, fmt.Errorf("os.MkdirAll(): %w", err)
+	}
+
+	name := fmt.Sprintf("%s_v%d_%s.%s", s.Dataset, s.Version, time.Now().UTC().Format("20060102T150405Z"), req.Format)
+	path := filepath.Join(uc.dir, name)
+
+	f, err := os.Create(path + ".part")
+	if err != nil {
+		return "", 0, fmt.Errorf("os.Create(): %w", err)
+	}
+	defer os.Remove(f.Name()) // no-op after the rename
+
+	n, err := uc.Write(ctx, req, f)
+	if err != nil {
+		f.Close()
+		return "", n, err
+	}
+
+	if err := f.Close(); err != nil {
+		return "", n, fmt.Errorf("f.Close(): %w", err)
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", n, fmt.Errorf("os.Rename(): %w", err)
+	}
+
+	return path, n, nil
+}
+
+// Dump writes scheduled files of both datasets covering the window before now
+func (uc *UseCase) Dump(ctx context.Context, format Format, window time.Duration) ([]string, error) {
+	since := time.Now().UTC().Add(-window)
+
+	reqs := []Request{
+		{
+			Dataset: DatasetTweets,
+			Format:  format,
+			Tweets:  repo.TweetFilter{StartTime: &since},
+		},
+		{
+			Dataset:   DatasetSentimentDaily,
+			Format:    format,
+			Sentiment: repo.SentimentAggFilter{From: since},
+		},
+	}
+
+	paths := make([]string, 0, len(reqs))
+	for _, req := range reqs {
+		path, _, err := uc.WriteFile(ctx, req)
+		if err != nil {
+			return paths, fmt.Errorf("uc.WriteFile(%s): %w", req.Dataset, err)
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
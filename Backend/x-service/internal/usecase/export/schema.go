@@ -0,0 +1,149 @@
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+)
+
+// ColumnType is the logical type of an export column
+type ColumnType int
+
+const (
+	TypeString ColumnType = iota
+	TypeInt
+	TypeFloat
+	TypeBool
+	TypeTime // UTC instant, RFC 3339 in text formats
+	TypeDate // calendar day, YYYY-MM-DD in text formats
+)
+
+// Column is a named and typed export column, nullable columns may hold nil
+type Column struct {
+	Name     string
+	Type     ColumnType
+	Nullable bool
+}
+
+// Schema is the stable column layout of a dataset. Columns are only ever
+// appended within a version, renames, removals and type changes bump Version
+type Schema struct {
+	Dataset Dataset
+	Version int
+	Columns []Column
+}
+
+// ID returns the versioned schema identifier written into export headers, e.g. "tweets/v1"
+func (s Schema) ID() string {
+	return fmt.Sprintf("%s/v%d", s.Dataset, s.Version)
+}
+
+// ColumnNames returns names of the schema columns in order
+func (s Schema) ColumnNames() []string {
+	names := make([]string, len(s.Columns))
+	for i, c := range s.Columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// TweetsSchema is the column layout of the tweets dataset
+var TweetsSchema = Schema{
+	Dataset: DatasetTweets,
+	Version: 1,
+	Columns: []Column{
+		{Name: "id", Type: TypeString},
+		{Name: "created_at", Type: TypeTime},
+		{Name: "author_id", Type: TypeString},
+		{Name: "username", Type: TypeString},
+		{Name: "lang", Type: TypeString},
+		{Name: "text", Type: TypeString},
+		{Name: "likes", Type: TypeInt},
+		{Name: "replies", Type: TypeInt},
+		{Name: "retweets", Type: TypeInt},
+		{Name: "views", Type: TypeInt},
+		{Name: "is_financial", Type: TypeBool},
+		{Name: "symbols", Type: TypeString}, // space separated tickers
+		{Name: "sentiment_score", Type: TypeFloat},
+		{Name: "sentiment_label", Type: TypeString},
+	},
+}
+
+// SentimentDailySchema is the column layout of the sentiment_daily dataset
+var SentimentDailySchema = Schema{
+	Dataset: DatasetSentimentDaily,
+	Version: 1,
+	Columns: []Column{
+		{Name: "symbol", Type: TypeString},
+		{Name: "day", Type: TypeDate},
+		{Name: "avg_score", Type: TypeFloat},
+		{Name: "weighted_avg_score", Type: TypeFloat, Nullable: true},
+		{Name: "pos_cnt", Type: TypeInt},
+		{Name: "neg_cnt", Type: TypeInt},
+		{Name: "neu_cnt", Type: TypeInt},
+	},
+}
+
+// tweetRow converts a tweet into a TweetsSchema row
+func tweetRow(t *entity.Tweet) []any {
+	return []any{
+		t.ID.String(),
+		t.CreatedAt,
+		t.AuthorID,
+		t.UserName,
+		t.Lang,
+		t.Text,
+		int64(t.Likes),
+		int64(t.Replies),
+		int64(t.Retweets),
+		int64(t.Views),
+		t.IsFinancial,
+		strings.Join(t.Symbols, " "),
+		t.SentimentScore,
+		t.SentimentLabel,
+	}
+}
+
+// sentimentDailyRow converts a daily aggregate into a SentimentDailySchema row
+func sentimentDailyRow(d *entity.SentimentDaily) []any {
+	var weighted any
+	if d.WeightedAvgScore != nil {
+		weighted = *d.WeightedAvgScore
+	}
+
+	return []any{
+		d.Symbol,
+		d.Day,
+		d.AvgScore,
+		weighted,
+		int64(d.Positive),
+		int64(d.Negative),
+		int64(d.Neutral),
+	}
+}
+
+// formatValue renders a row value for text formats, nil becomes an empty string
+func formatValue(c Column, v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		if c.Type == TypeDate {
+			return x.UTC().Format(time.DateOnly)
+		}
+		return x.UTC().Format(time.RFC3339)
+	case string:
+		return x
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	default:
+		return fmt.Sprint(x)
+	}
+}
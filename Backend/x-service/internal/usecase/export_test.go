@@ -0,0 +1,195 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/export"
+)
+
+var _exportTweet = &entity.Tweet{
+	ID:             uuid.MustParse("0b5f3e4c-8f1e-4d8e-9b1a-3c2d1e0f9a8b"),
+	CreatedAt:      time.Date(2025, 6, 6, 14, 30, 0, 0, time.FixedZone("CEST", 2*60*60)),
+	AuthorID:       "42",
+	UserName:       "jane",
+	Lang:           "en",
+	Text:           `$TSLA "beats", again`,
+	Likes:          10,
+	Replies:        2,
+	Retweets:       3,
+	Views:          400,
+	IsFinancial:    true,
+	Symbols:        []string{"TSLA", "AAPL"},
+	SentimentScore: 0.75,
+	SentimentLabel: entity.SentimentPositive,
+}
+
+// exportUseCase streams _exportTweet for any tweet filter and one day of
+// TSLA sentiment without a weighted score, filters are sent to filters
+func exportUseCase(t *testing.T, dir string) (*export.UseCase, chan any) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+	tweets := NewMockTweetRepository(mockCtl)
+	agg := NewMockSentimentAggRepository(mockCtl)
+	filters := make(chan any, 10)
+
+	tweets.EXPECT().Iterate(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f repo.TweetFilter, fn func(*entity.Tweet) error) error {
+			filters <- f
+			return fn(_exportTweet)
+		}).AnyTimes()
+	agg.EXPECT().IterateDaily(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f repo.SentimentAggFilter, fn func(*entity.SentimentDaily) error) error {
+			filters <- f
+			return fn(&entity.SentimentDaily{
+				Symbol:   "TSLA",
+				Day:      time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC),
+				AvgScore: 0.5,
+				Positive: 3,
+				Negative: 1,
+			})
+		}).AnyTimes()
+
+	return export.New(tweets, agg, dir), filters
+}
+
+func TestExportCSV(t *testing.T) {
+	t.Parallel()
+
+	useCase, filters := exportUseCase(t, t.TempDir())
+
+	// exports without a time range read the whole dataset
+	var buf bytes.Buffer
+	n, err := useCase.Write(context.Background(), export.Request{Dataset: export.DatasetTweets, Format: export.FormatCSV}, &buf)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, repo.TweetFilter{}, <-filters)
+
+	require.Equal(t, "#schema=tweets/v1\n"+
+		"id,created_at,author_id,username,lang,text,likes,replies,retweets,views,is_financial,symbols,sentiment_score,sentiment_label\n"+
+		`0b5f3e4c-8f1e-4d8e-9b1a-3c2d1e0f9a8b,2025-06-06T12:30:00Z,42,jane,en,"$TSLA ""beats"", again",10,2,3,400,true,TSLA AAPL,0.75,POS`+"\n",
+		buf.String())
+
+	buf.Reset()
+	_, err = useCase.Write(context.Background(), export.Request{Dataset: export.DatasetSentimentDaily, Format: export.FormatCSV}, &buf)
+	require.NoError(t, err)
+	require.Equal(t, "#schema=sentiment_daily/v1\n"+
+		"symbol,day,avg_score,weighted_avg_score,pos_cnt,neg_cnt,neu_cnt\n"+
+		"TSLA,2025-06-06,0.5,,3,1,0\n",
+		buf.String())
+}
+
+func TestExportJSONL(t *testing.T) {
+	t.Parallel()
+
+	useCase, _ := exportUseCase(t, t.TempDir())
+
+	var buf bytes.Buffer
+	_, err := useCase.Write(context.Background(), export.Request{Dataset: export.DatasetSentimentDaily, Format: export.FormatJSONL}, &buf)
+	require.NoError(t, err)
+
+	// keys keep the column order, missing values are null
+	require.Equal(t, `{"schema":"sentiment_daily/v1","columns":["symbol","day","avg_score","weighted_avg_score","pos_cnt","neg_cnt","neu_cnt"]}`+"\n"+
+		`{"symbol":"TSLA","day":"2025-06-06","avg_score":0.5,"weighted_avg_score":null,"pos_cnt":3,"neg_cnt":1,"neu_cnt":0}`+"\n",
+		buf.String())
+
+	buf.Reset()
+	_, err = useCase.Write(context.Background(), export.Request{Dataset: export.DatasetTweets, Format: export.FormatJSONL}, &buf)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	require.JSONEq(t, `{
+		"id": "0b5f3e4c-8f1e-4d8e-9b1a-3c2d1e0f9a8b", "created_at": "2025-06-06T12:30:00Z",
+		"author_id": "42", "username": "jane", "lang": "en", "text": "$TSLA \"beats\", again",
+		"likes": 10, "replies": 2, "retweets": 3, "views": 400, "is_financial": true,
+		"symbols": "TSLA AAPL", "sentiment_score": 0.75, "sentiment_label": "POS"
+	}`, lines[1])
+}
+
+func TestExportParquet(t *testing.T) {
+	t.Parallel()
+
+	useCase, _ := exportUseCase(t, t.TempDir())
+
+	var buf bytes.Buffer
+	_, err := useCase.Write(context.Background(), export.Request{Dataset: export.DatasetTweets, Format: export.FormatParquet}, &buf)
+	require.NoError(t, err)
+
+	pf, err := buffer.NewBufferFile(buf.Bytes())
+	require.NoError(t, err)
+	pr, err := reader.NewParquetColumnReader(pf, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	require.EqualValues(t, 1, pr.GetNumRows())
+	require.Len(t, pr.Footer.KeyValueMetadata, 1)
+	require.Equal(t, "schema", pr.Footer.KeyValueMetadata[0].Key)
+	require.Equal(t, "tweets/v1", *pr.Footer.KeyValueMetadata[0].Value)
+
+	// times are UTC milliseconds
+	createdAt, _, _, err := pr.ReadColumnByIndex(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []any{_exportTweet.CreatedAt.UnixMilli()}, createdAt)
+
+	text, _, _, err := pr.ReadColumnByIndex(5, 1)
+	require.NoError(t, err)
+	require.Equal(t, []any{_exportTweet.Text}, text)
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	useCase, _ := exportUseCase(t, t.TempDir())
+
+	_, err := useCase.Write(context.Background(), export.Request{Dataset: export.DatasetTweets, Format: "xml"}, &bytes.Buffer{})
+	require.ErrorIs(t, err, entity.ErrInvalid)
+	require.ErrorIs(t, err, export.ErrUnknownFormat)
+
+	_, err = useCase.Write(context.Background(), export.Request{Dataset: "users", Format: export.FormatCSV}, &bytes.Buffer{})
+	require.ErrorIs(t, err, export.ErrUnknownDataset)
+}
+
+func TestExportDump(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	useCase, filters := exportUseCase(t, filepath.Join(dir, "dumps"))
+
+	paths, err := useCase.Dump(context.Background(), export.FormatJSONL, 24*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+	require.Regexp(t, `/tweets_v1_\d{8}T\d{6}Z\.jsonl$`, paths[0])
+	require.Regexp(t, `/sentiment_daily_v1_\d{8}T\d{6}Z\.jsonl$`, paths[1])
+
+	// the dump covers the window, open ended
+	tf := (<-filters).(repo.TweetFilter)
+	require.NotNil(t, tf.StartTime)
+	require.Nil(t, tf.EndTime)
+	require.WithinDuration(t, time.Now().Add(-24*time.Hour), *tf.StartTime, time.Minute)
+	sf := (<-filters).(repo.SentimentAggFilter)
+	require.True(t, sf.To.IsZero())
+	require.True(t, sf.From.Equal(*tf.StartTime))
+
+	// only complete files are left behind
+	entries, err := os.ReadDir(filepath.Join(dir, "dumps"))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, e := range entries {
+		require.NotContains(t, e.Name(), ".part")
+	}
+}
@@ -0,0 +1,597 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repo/contracts.go
+//
+// Generated by this command:
+//
+//	mockgen -source ./internal/repo/contracts.go -package usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	repo "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTweetRepository is a mock of TweetRepository interface.
+type MockTweetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTweetRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTweetRepositoryMockRecorder is the mock recorder for MockTweetRepository.
+type MockTweetRepositoryMockRecorder struct {
+	mock *MockTweetRepository
+}
+
+// NewMockTweetRepository creates a new mock instance.
+func NewMockTweetRepository(ctrl *gomock.Controller) *MockTweetRepository {
+	mock := &MockTweetRepository{ctrl: ctrl}
+	mock.recorder = &MockTweetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTweetRepository) EXPECT() *MockTweetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountTextAuthors mocks base method.
+func (m *MockTweetRepository) CountTextAuthors(ctx context.Context, textHash, authorID string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTextAuthors", ctx, textHash, authorID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTextAuthors indicates an expected call of CountTextAuthors.
+func (mr *MockTweetRepositoryMockRecorder) CountTextAuthors(ctx, textHash, authorID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTextAuthors", reflect.TypeOf((*MockTweetRepository)(nil).CountTextAuthors), ctx, textHash, authorID, since)
+}
+
+// Create mocks base method.
+func (m *MockTweetRepository) Create(arg0 context.Context, arg1 *entity.Tweet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTweetRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTweetRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockTweetRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTweetRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTweetRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteMany mocks base method.
+func (m *MockTweetRepository) DeleteMany(arg0 context.Context, arg1 []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockTweetRepositoryMockRecorder) DeleteMany(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockTweetRepository)(nil).DeleteMany), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockTweetRepository) Get(arg0 context.Context, arg1 uuid.UUID) (*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTweetRepositoryMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTweetRepository)(nil).Get), arg0, arg1)
+}
+
+// Iterate mocks base method.
+func (m *MockTweetRepository) Iterate(ctx context.Context, f repo.TweetFilter, fn func(*entity.Tweet) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, f, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockTweetRepositoryMockRecorder) Iterate(ctx, f, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockTweetRepository)(nil).Iterate), ctx, f, fn)
+}
+
+// List mocks base method.
+func (m *MockTweetRepository) List(arg0 context.Context, arg1 repo.TweetFilter) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTweetRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTweetRepository)(nil).List), arg0, arg1)
+}
+
+// ListBySentiment mocks base method.
+func (m *MockTweetRepository) ListBySentiment(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySentiment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySentiment indicates an expected call of ListBySentiment.
+func (mr *MockTweetRepositoryMockRecorder) ListBySentiment(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySentiment", reflect.TypeOf((*MockTweetRepository)(nil).ListBySentiment), arg0, arg1, arg2, arg3)
+}
+
+// ListBySymbol mocks base method.
+func (m *MockTweetRepository) ListBySymbol(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySymbol", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySymbol indicates an expected call of ListBySymbol.
+func (mr *MockTweetRepositoryMockRecorder) ListBySymbol(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySymbol", reflect.TypeOf((*MockTweetRepository)(nil).ListBySymbol), arg0, arg1, arg2, arg3)
+}
+
+// ListIDs mocks base method.
+func (m *MockTweetRepository) ListIDs(arg0 context.Context, arg1 repo.TweetFilter) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIDs", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIDs indicates an expected call of ListIDs.
+func (mr *MockTweetRepositoryMockRecorder) ListIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIDs", reflect.TypeOf((*MockTweetRepository)(nil).ListIDs), arg0, arg1)
+}
+
+// Release mocks base method.
+func (m *MockTweetRepository) Release(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockTweetRepositoryMockRecorder) Release(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockTweetRepository)(nil).Release), arg0, arg1)
+}
+
+// TrendingSymbols mocks base method.
+func (m *MockTweetRepository) TrendingSymbols(ctx context.Context, since time.Time, limit int32) ([]*entity.SymbolTrend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrendingSymbols", ctx, since, limit)
+	ret0, _ := ret[0].([]*entity.SymbolTrend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrendingSymbols indicates an expected call of TrendingSymbols.
+func (mr *MockTweetRepositoryMockRecorder) TrendingSymbols(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrendingSymbols", reflect.TypeOf((*MockTweetRepository)(nil).TrendingSymbols), ctx, since, limit)
+}
+
+// Update mocks base method.
+func (m *MockTweetRepository) Update(arg0 context.Context, arg1 *entity.Tweet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTweetRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTweetRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateIfUnmodified mocks base method.
+func (m *MockTweetRepository) UpdateIfUnmodified(ctx context.Context, t *entity.Tweet, version time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIfUnmodified", ctx, t, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIfUnmodified indicates an expected call of UpdateIfUnmodified.
+func (mr *MockTweetRepositoryMockRecorder) UpdateIfUnmodified(ctx, t, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIfUnmodified", reflect.TypeOf((*MockTweetRepository)(nil).UpdateIfUnmodified), ctx, t, version)
+}
+
+// MockSentimentAggRepository is a mock of SentimentAggRepository interface.
+type MockSentimentAggRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSentimentAggRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSentimentAggRepositoryMockRecorder is the mock recorder for MockSentimentAggRepository.
+type MockSentimentAggRepositoryMockRecorder struct {
+	mock *MockSentimentAggRepository
+}
+
+// NewMockSentimentAggRepository creates a new mock instance.
+func NewMockSentimentAggRepository(ctrl *gomock.Controller) *MockSentimentAggRepository {
+	mock := &MockSentimentAggRepository{ctrl: ctrl}
+	mock.recorder = &MockSentimentAggRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSentimentAggRepository) EXPECT() *MockSentimentAggRepositoryMockRecorder {
+	return m.recorder
+}
+
+// IterateDaily mocks base method.
+func (m *MockSentimentAggRepository) IterateDaily(ctx context.Context, f repo.SentimentAggFilter, fn func(*entity.SentimentDaily) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateDaily", ctx, f, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateDaily indicates an expected call of IterateDaily.
+func (mr *MockSentimentAggRepositoryMockRecorder) IterateDaily(ctx, f, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDaily", reflect.TypeOf((*MockSentimentAggRepository)(nil).IterateDaily), ctx, f, fn)
+}
+
+// MockBulkOperationRepository is a mock of BulkOperationRepository interface.
+type MockBulkOperationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkOperationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBulkOperationRepositoryMockRecorder is the mock recorder for MockBulkOperationRepository.
+type MockBulkOperationRepositoryMockRecorder struct {
+	mock *MockBulkOperationRepository
+}
+
+// NewMockBulkOperationRepository creates a new mock instance.
+func NewMockBulkOperationRepository(ctrl *gomock.Controller) *MockBulkOperationRepository {
+	mock := &MockBulkOperationRepository{ctrl: ctrl}
+	mock.recorder = &MockBulkOperationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkOperationRepository) EXPECT() *MockBulkOperationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBulkOperationRepository) Create(arg0 context.Context, arg1 *entity.BulkOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBulkOperationRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBulkOperationRepository)(nil).Create), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockBulkOperationRepository) Get(arg0 context.Context, arg1 uuid.UUID) (*entity.BulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*entity.BulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBulkOperationRepositoryMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBulkOperationRepository)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockBulkOperationRepository) List(arg0 context.Context, arg1, arg2 int32) ([]*entity.BulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.BulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockBulkOperationRepositoryMockRecorder) List(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBulkOperationRepository)(nil).List), arg0, arg1, arg2)
+}
+
+// Save mocks base method.
+func (m *MockBulkOperationRepository) Save(arg0 context.Context, arg1 *entity.BulkOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockBulkOperationRepositoryMockRecorder) Save(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBulkOperationRepository)(nil).Save), arg0, arg1)
+}
+
+// MockAuthorScoreRepository is a mock of AuthorScoreRepository interface.
+type MockAuthorScoreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorScoreRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorScoreRepositoryMockRecorder is the mock recorder for MockAuthorScoreRepository.
+type MockAuthorScoreRepositoryMockRecorder struct {
+	mock *MockAuthorScoreRepository
+}
+
+// NewMockAuthorScoreRepository creates a new mock instance.
+func NewMockAuthorScoreRepository(ctrl *gomock.Controller) *MockAuthorScoreRepository {
+	mock := &MockAuthorScoreRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthorScoreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorScoreRepository) EXPECT() *MockAuthorScoreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAuthorScoreRepository) Get(arg0 context.Context, arg1 string) (*entity.AuthorScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*entity.AuthorScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAuthorScoreRepositoryMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAuthorScoreRepository)(nil).Get), arg0, arg1)
+}
+
+// HitRates mocks base method.
+func (m *MockAuthorScoreRepository) HitRates(ctx context.Context, since time.Time, stats []*entity.AuthorStats) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HitRates", ctx, since, stats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HitRates indicates an expected call of HitRates.
+func (mr *MockAuthorScoreRepositoryMockRecorder) HitRates(ctx, since, stats any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HitRates", reflect.TypeOf((*MockAuthorScoreRepository)(nil).HitRates), ctx, since, stats)
+}
+
+// List mocks base method.
+func (m *MockAuthorScoreRepository) List(arg0 context.Context, arg1, arg2 int32) ([]*entity.AuthorScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.AuthorScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuthorScoreRepositoryMockRecorder) List(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuthorScoreRepository)(nil).List), arg0, arg1, arg2)
+}
+
+// RefreshSentimentAgg mocks base method.
+func (m *MockAuthorScoreRepository) RefreshSentimentAgg(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSentimentAgg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshSentimentAgg indicates an expected call of RefreshSentimentAgg.
+func (mr *MockAuthorScoreRepositoryMockRecorder) RefreshSentimentAgg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSentimentAgg", reflect.TypeOf((*MockAuthorScoreRepository)(nil).RefreshSentimentAgg), arg0)
+}
+
+// Stats mocks base method.
+func (m *MockAuthorScoreRepository) Stats(ctx context.Context, since time.Time, minTweets int) ([]*entity.AuthorStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, since, minTweets)
+	ret0, _ := ret[0].([]*entity.AuthorStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockAuthorScoreRepositoryMockRecorder) Stats(ctx, since, minTweets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockAuthorScoreRepository)(nil).Stats), ctx, since, minTweets)
+}
+
+// Upsert mocks base method.
+func (m *MockAuthorScoreRepository) Upsert(arg0 context.Context, arg1 []*entity.AuthorScore) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockAuthorScoreRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAuthorScoreRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockTweetProvider is a mock of TweetProvider interface.
+type MockTweetProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTweetProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockTweetProviderMockRecorder is the mock recorder for MockTweetProvider.
+type MockTweetProviderMockRecorder struct {
+	mock *MockTweetProvider
+}
+
+// NewMockTweetProvider creates a new mock instance.
+func NewMockTweetProvider(ctrl *gomock.Controller) *MockTweetProvider {
+	mock := &MockTweetProvider{ctrl: ctrl}
+	mock.recorder = &MockTweetProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTweetProvider) EXPECT() *MockTweetProviderMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockTweetProvider) Search(arg0 context.Context, arg1 string, arg2 int) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTweetProviderMockRecorder) Search(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTweetProvider)(nil).Search), arg0, arg1, arg2)
+}
+
+// MockSentimentAnalyzer is a mock of SentimentAnalyzer interface.
+type MockSentimentAnalyzer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSentimentAnalyzerMockRecorder
+	isgomock struct{}
+}
+
+// MockSentimentAnalyzerMockRecorder is the mock recorder for MockSentimentAnalyzer.
+type MockSentimentAnalyzerMockRecorder struct {
+	mock *MockSentimentAnalyzer
+}
+
+// NewMockSentimentAnalyzer creates a new mock instance.
+func NewMockSentimentAnalyzer(ctrl *gomock.Controller) *MockSentimentAnalyzer {
+	mock := &MockSentimentAnalyzer{ctrl: ctrl}
+	mock.recorder = &MockSentimentAnalyzerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSentimentAnalyzer) EXPECT() *MockSentimentAnalyzerMockRecorder {
+	return m.recorder
+}
+
+// Analyze mocks base method.
+func (m *MockSentimentAnalyzer) Analyze(ctx context.Context, texts []string) ([]entity.Sentiment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Analyze", ctx, texts)
+	ret0, _ := ret[0].([]entity.Sentiment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Analyze indicates an expected call of Analyze.
+func (mr *MockSentimentAnalyzerMockRecorder) Analyze(ctx, texts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Analyze", reflect.TypeOf((*MockSentimentAnalyzer)(nil).Analyze), ctx, texts)
+}
+
+// MockSocialFetcher is a mock of SocialFetcher interface.
+type MockSocialFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSocialFetcherMockRecorder
+	isgomock struct{}
+}
+
+// MockSocialFetcherMockRecorder is the mock recorder for MockSocialFetcher.
+type MockSocialFetcherMockRecorder struct {
+	mock *MockSocialFetcher
+}
+
+// NewMockSocialFetcher creates a new mock instance.
+func NewMockSocialFetcher(ctrl *gomock.Controller) *MockSocialFetcher {
+	mock := &MockSocialFetcher{ctrl: ctrl}
+	mock.recorder = &MockSocialFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSocialFetcher) EXPECT() *MockSocialFetcherMockRecorder {
+	return m.recorder
+}
+
+// SearchTweets mocks base method.
+func (m *MockSocialFetcher) SearchTweets(ctx context.Context, query string, maxResults int) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTweets", ctx, query, maxResults)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTweets indicates an expected call of SearchTweets.
+func (mr *MockSocialFetcherMockRecorder) SearchTweets(ctx, query, maxResults any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTweets", reflect.TypeOf((*MockSocialFetcher)(nil).SearchTweets), ctx, query, maxResults)
+}
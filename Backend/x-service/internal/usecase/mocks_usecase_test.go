@@ -0,0 +1,442 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/contracts.go
+//
+// Generated by this command:
+//
+//	mockgen -source ./internal/usecase/contracts.go -package usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	repo "github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSpamFilter is a mock of SpamFilter interface.
+type MockSpamFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpamFilterMockRecorder
+	isgomock struct{}
+}
+
+// MockSpamFilterMockRecorder is the mock recorder for MockSpamFilter.
+type MockSpamFilterMockRecorder struct {
+	mock *MockSpamFilter
+}
+
+// NewMockSpamFilter creates a new mock instance.
+func NewMockSpamFilter(ctrl *gomock.Controller) *MockSpamFilter {
+	mock := &MockSpamFilter{ctrl: ctrl}
+	mock.recorder = &MockSpamFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpamFilter) EXPECT() *MockSpamFilterMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockSpamFilter) Check(arg0 context.Context, arg1 *entity.Tweet) ([]entity.SpamReason, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", arg0, arg1)
+	ret0, _ := ret[0].([]entity.SpamReason)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockSpamFilterMockRecorder) Check(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockSpamFilter)(nil).Check), arg0, arg1)
+}
+
+// MockTweetUseCase is a mock of TweetUseCase interface.
+type MockTweetUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTweetUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockTweetUseCaseMockRecorder is the mock recorder for MockTweetUseCase.
+type MockTweetUseCaseMockRecorder struct {
+	mock *MockTweetUseCase
+}
+
+// NewMockTweetUseCase creates a new mock instance.
+func NewMockTweetUseCase(ctrl *gomock.Controller) *MockTweetUseCase {
+	mock := &MockTweetUseCase{ctrl: ctrl}
+	mock.recorder = &MockTweetUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTweetUseCase) EXPECT() *MockTweetUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockTweetUseCase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTweetUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTweetUseCase)(nil).GetByID), ctx, id)
+}
+
+// GetListLatest mocks base method.
+func (m *MockTweetUseCase) GetListLatest(arg0 context.Context, arg1 int32) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListLatest", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListLatest indicates an expected call of GetListLatest.
+func (mr *MockTweetUseCaseMockRecorder) GetListLatest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListLatest", reflect.TypeOf((*MockTweetUseCase)(nil).GetListLatest), arg0, arg1)
+}
+
+// Ingest mocks base method.
+func (m *MockTweetUseCase) Ingest(arg0 context.Context, arg1 string, arg2 int) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ingest", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ingest indicates an expected call of Ingest.
+func (mr *MockTweetUseCaseMockRecorder) Ingest(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ingest", reflect.TypeOf((*MockTweetUseCase)(nil).Ingest), arg0, arg1, arg2)
+}
+
+// ListFeed mocks base method.
+func (m *MockTweetUseCase) ListFeed(ctx context.Context, f repo.TweetFilter) ([]*entity.Tweet, *entity.TweetCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeed", ctx, f)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(*entity.TweetCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFeed indicates an expected call of ListFeed.
+func (mr *MockTweetUseCaseMockRecorder) ListFeed(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeed", reflect.TypeOf((*MockTweetUseCase)(nil).ListFeed), ctx, f)
+}
+
+// SentimentSeries mocks base method.
+func (m *MockTweetUseCase) SentimentSeries(ctx context.Context, symbol string, from, to time.Time) ([]*entity.SentimentDaily, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SentimentSeries", ctx, symbol, from, to)
+	ret0, _ := ret[0].([]*entity.SentimentDaily)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SentimentSeries indicates an expected call of SentimentSeries.
+func (mr *MockTweetUseCaseMockRecorder) SentimentSeries(ctx, symbol, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SentimentSeries", reflect.TypeOf((*MockTweetUseCase)(nil).SentimentSeries), ctx, symbol, from, to)
+}
+
+// Trending mocks base method.
+func (m *MockTweetUseCase) Trending(ctx context.Context, since time.Time, limit int32) ([]*entity.SymbolTrend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trending", ctx, since, limit)
+	ret0, _ := ret[0].([]*entity.SymbolTrend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trending indicates an expected call of Trending.
+func (mr *MockTweetUseCaseMockRecorder) Trending(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trending", reflect.TypeOf((*MockTweetUseCase)(nil).Trending), ctx, since, limit)
+}
+
+// MockAdminTweetUseCase is a mock of AdminTweetUseCase interface.
+type MockAdminTweetUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminTweetUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminTweetUseCaseMockRecorder is the mock recorder for MockAdminTweetUseCase.
+type MockAdminTweetUseCaseMockRecorder struct {
+	mock *MockAdminTweetUseCase
+}
+
+// NewMockAdminTweetUseCase creates a new mock instance.
+func NewMockAdminTweetUseCase(ctrl *gomock.Controller) *MockAdminTweetUseCase {
+	mock := &MockAdminTweetUseCase{ctrl: ctrl}
+	mock.recorder = &MockAdminTweetUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminTweetUseCase) EXPECT() *MockAdminTweetUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AddSymbol mocks base method.
+func (m *MockAdminTweetUseCase) AddSymbol(ctx context.Context, id uuid.UUID, symbol string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSymbol", ctx, id, symbol)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSymbol indicates an expected call of AddSymbol.
+func (mr *MockAdminTweetUseCaseMockRecorder) AddSymbol(ctx, id, symbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSymbol", reflect.TypeOf((*MockAdminTweetUseCase)(nil).AddSymbol), ctx, id, symbol)
+}
+
+// Create mocks base method.
+func (m *MockAdminTweetUseCase) Create(ctx context.Context, text, authorID string) (*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, text, authorID)
+	ret0, _ := ret[0].(*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAdminTweetUseCaseMockRecorder) Create(ctx, text, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAdminTweetUseCase)(nil).Create), ctx, text, authorID)
+}
+
+// Delete mocks base method.
+func (m *MockAdminTweetUseCase) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAdminTweetUseCaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAdminTweetUseCase)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockAdminTweetUseCase) Get(ctx context.Context, id uuid.UUID) (*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAdminTweetUseCaseMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAdminTweetUseCase)(nil).Get), ctx, id)
+}
+
+// GetTweetsBySentiment mocks base method.
+func (m *MockAdminTweetUseCase) GetTweetsBySentiment(ctx context.Context, sentiment string, limit, offset int32) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTweetsBySentiment", ctx, sentiment, limit, offset)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTweetsBySentiment indicates an expected call of GetTweetsBySentiment.
+func (mr *MockAdminTweetUseCaseMockRecorder) GetTweetsBySentiment(ctx, sentiment, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTweetsBySentiment", reflect.TypeOf((*MockAdminTweetUseCase)(nil).GetTweetsBySentiment), ctx, sentiment, limit, offset)
+}
+
+// GetTweetsBySymbol mocks base method.
+func (m *MockAdminTweetUseCase) GetTweetsBySymbol(ctx context.Context, symbol string, limit, offset int32) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTweetsBySymbol", ctx, symbol, limit, offset)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTweetsBySymbol indicates an expected call of GetTweetsBySymbol.
+func (mr *MockAdminTweetUseCaseMockRecorder) GetTweetsBySymbol(ctx, symbol, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTweetsBySymbol", reflect.TypeOf((*MockAdminTweetUseCase)(nil).GetTweetsBySymbol), ctx, symbol, limit, offset)
+}
+
+// List mocks base method.
+func (m *MockAdminTweetUseCase) List(ctx context.Context, f repo.TweetFilter) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, f)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAdminTweetUseCaseMockRecorder) List(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAdminTweetUseCase)(nil).List), ctx, f)
+}
+
+// ListQuarantined mocks base method.
+func (m *MockAdminTweetUseCase) ListQuarantined(ctx context.Context, reason entity.SpamReason, limit, offset int32) ([]*entity.Tweet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuarantined", ctx, reason, limit, offset)
+	ret0, _ := ret[0].([]*entity.Tweet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuarantined indicates an expected call of ListQuarantined.
+func (mr *MockAdminTweetUseCaseMockRecorder) ListQuarantined(ctx, reason, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantined", reflect.TypeOf((*MockAdminTweetUseCase)(nil).ListQuarantined), ctx, reason, limit, offset)
+}
+
+// Release mocks base method.
+func (m *MockAdminTweetUseCase) Release(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockAdminTweetUseCaseMockRecorder) Release(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockAdminTweetUseCase)(nil).Release), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockAdminTweetUseCase) Update(ctx context.Context, t *entity.Tweet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAdminTweetUseCaseMockRecorder) Update(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAdminTweetUseCase)(nil).Update), ctx, t)
+}
+
+// UpdateEngagement mocks base method.
+func (m *MockAdminTweetUseCase) UpdateEngagement(ctx context.Context, id uuid.UUID, likes, replies, retweets, views int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEngagement", ctx, id, likes, replies, retweets, views)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEngagement indicates an expected call of UpdateEngagement.
+func (mr *MockAdminTweetUseCaseMockRecorder) UpdateEngagement(ctx, id, likes, replies, retweets, views any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEngagement", reflect.TypeOf((*MockAdminTweetUseCase)(nil).UpdateEngagement), ctx, id, likes, replies, retweets, views)
+}
+
+// UpdateSentiment mocks base method.
+func (m *MockAdminTweetUseCase) UpdateSentiment(ctx context.Context, id uuid.UUID, score float64, label string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSentiment", ctx, id, score, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSentiment indicates an expected call of UpdateSentiment.
+func (mr *MockAdminTweetUseCaseMockRecorder) UpdateSentiment(ctx, id, score, label any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSentiment", reflect.TypeOf((*MockAdminTweetUseCase)(nil).UpdateSentiment), ctx, id, score, label)
+}
+
+// MockAuthorScoreUseCase is a mock of AuthorScoreUseCase interface.
+type MockAuthorScoreUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorScoreUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorScoreUseCaseMockRecorder is the mock recorder for MockAuthorScoreUseCase.
+type MockAuthorScoreUseCaseMockRecorder struct {
+	mock *MockAuthorScoreUseCase
+}
+
+// NewMockAuthorScoreUseCase creates a new mock instance.
+func NewMockAuthorScoreUseCase(ctrl *gomock.Controller) *MockAuthorScoreUseCase {
+	mock := &MockAuthorScoreUseCase{ctrl: ctrl}
+	mock.recorder = &MockAuthorScoreUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorScoreUseCase) EXPECT() *MockAuthorScoreUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAuthorScoreUseCase) Get(ctx context.Context, authorID string) (*entity.AuthorScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, authorID)
+	ret0, _ := ret[0].(*entity.AuthorScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAuthorScoreUseCaseMockRecorder) Get(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAuthorScoreUseCase)(nil).Get), ctx, authorID)
+}
+
+// List mocks base method.
+func (m *MockAuthorScoreUseCase) List(ctx context.Context, limit, offset int32) ([]*entity.AuthorScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]*entity.AuthorScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuthorScoreUseCaseMockRecorder) List(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuthorScoreUseCase)(nil).List), ctx, limit, offset)
+}
+
+// Recompute mocks base method.
+func (m *MockAuthorScoreUseCase) Recompute(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recompute", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recompute indicates an expected call of Recompute.
+func (mr *MockAuthorScoreUseCaseMockRecorder) Recompute(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recompute", reflect.TypeOf((*MockAuthorScoreUseCase)(nil).Recompute), ctx)
+}
@@ -0,0 +1,135 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+)
+
+// cashtagPattern matches $AAPL, $btc, etc
+var cashtagPattern = regexp.MustCompile(`\$([A-Za-z]{1,6})\b`)
+
+// DuplicateCluster flags a tweet whose normalised text was already posted by
+// at least minAuthors other authors within the window
+type DuplicateCluster struct {
+	repo       repo.TweetRepository
+	window     time.Duration
+	minAuthors int
+}
+
+// NewDuplicateCluster creates a DuplicateCluster rule
+func NewDuplicateCluster(repo repo.TweetRepository, window time.Duration, minAuthors int) *DuplicateCluster {
+	return &DuplicateCluster{repo: repo, window: window, minAuthors: minAuthors}
+}
+
+// Name -.
+func (r *DuplicateCluster) Name() string { return "duplicate_cluster" }
+
+// Check -.
+func (r *DuplicateCluster) Check(ctx context.Context, t *entity.Tweet) (entity.SpamReason, bool, error) {
+	since := time.Now().UTC().Add(-r.window)
+
+	n, err := r.repo.CountTextAuthors(ctx, t.TextHash(), t.AuthorID, since)
+	if err != nil {
+		return "", false, fmt.Errorf("r.repo.CountTextAuthors(): %w", err)
+	}
+
+	return entity.SpamReasonDuplicateCluster, n >= r.minAuthors, nil
+}
+
+// CashtagStuffing flags a tweet that mentions more than maxTickers tickers
+type CashtagStuffing struct {
+	maxTickers int
+}
+
+// NewCashtagStuffing creates a CashtagStuffing rule
+func NewCashtagStuffing(maxTickers int) *CashtagStuffing {
+	return &CashtagStuffing{maxTickers: maxTickers}
+}
+
+// Name -.
+func (r *CashtagStuffing) Name() string { return "cashtag_stuffing" }
+
+// Check -.
+func (r *CashtagStuffing) Check(_ context.Context, t *entity.Tweet) (entity.SpamReason, bool, error) {
+	tickers := make(map[string]struct{}, len(t.Symbols))
+	for _, s := range t.Symbols {
+		tickers[strings.ToUpper(s)] = struct{}{}
+	}
+	for _, m := range cashtagPattern.FindAllStringSubmatch(t.Text, -1) {
+		tickers[strings.ToUpper(m[1])] = struct{}{}
+	}
+
+	return entity.SpamReasonCashtagStuffing, len(tickers) > r.maxTickers, nil
+}
+
+// LinkFarm flags a tweet linking to one of the known link-farm domains or their subdomains
+type LinkFarm struct {
+	domains map[string]struct{}
+}
+
+// NewLinkFarm creates a LinkFarm rule
+func NewLinkFarm(domains []string) *LinkFarm {
+	r := &LinkFarm{domains: make(map[string]struct{}, len(domains))}
+	for _, d := range domains {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			r.domains[d] = struct{}{}
+		}
+	}
+	return r
+}
+
+// Name -.
+func (r *LinkFarm) Name() string { return "link_farm" }
+
+// Check -.
+func (r *LinkFarm) Check(_ context.Context, t *entity.Tweet) (entity.SpamReason, bool, error) {
+	for _, raw := range t.URLs {
+		u, err := url.Parse(raw)
+		if err != nil {
+			continue
+		}
+
+		// walk up the host: a.b.example.com -> b.example.com -> example.com
+		host := strings.ToLower(u.Hostname())
+		for host != "" {
+			if _, ok := r.domains[host]; ok {
+				return entity.SpamReasonLinkFarm, true, nil
+			}
+			_, host, _ = strings.Cut(host, ".")
+		}
+	}
+
+	return entity.SpamReasonLinkFarm, false, nil
+}
+
+// NewLowFollower flags a tweet of an account younger than maxAge with fewer
+// than minFollowers followers, tweets without author data are let through
+type NewLowFollower struct {
+	maxAge       time.Duration
+	minFollowers int
+}
+
+// NewNewLowFollower creates a NewLowFollower rule
+func NewNewLowFollower(maxAge time.Duration, minFollowers int) *NewLowFollower {
+	return &NewLowFollower{maxAge: maxAge, minFollowers: minFollowers}
+}
+
+// Name -.
+func (r *NewLowFollower) Name() string { return "new_low_follower" }
+
+// Check -.
+func (r *NewLowFollower) Check(_ context.Context, t *entity.Tweet) (entity.SpamReason, bool, error) {
+	if t.AuthorCreatedAt.IsZero() {
+		return entity.SpamReasonNewLowFollower, false, nil
+	}
+
+	isNew := time.Since(t.AuthorCreatedAt) < r.maxAge
+	return entity.SpamReasonNewLowFollower, isNew && t.AuthorFollowers < r.minFollowers, nil
+}
@@ -0,0 +1,43 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+)
+
+// Rule inspects a single tweet and reports whether it looks like spam
+type Rule interface {
+	// Name returns the rule name, used in error messages
+	Name() string
+	// Check returns a reason code and true when the tweet must be quarantined
+	Check(context.Context, *entity.Tweet) (entity.SpamReason, bool, error)
+}
+
+// Chain runs every rule against a tweet and collects all reasons,
+// an empty chain lets everything through
+type Chain struct {
+	rules []Rule
+}
+
+// NewChain creates a filter chain from the given rules
+func NewChain(rules ...Rule) *Chain {
+	return &Chain{rules: rules}
+}
+
+// Check returns reason codes of all rules that flagged the tweet, nil if it is clean
+func (c *Chain) Check(ctx context.Context, t *entity.Tweet) ([]entity.SpamReason, error) {
+	var reasons []entity.SpamReason
+	for _, r := range c.rules {
+		reason, flagged, err := r.Check(ctx, t)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", r.Name(), err)
+		}
+		if flagged {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return reasons, nil
+}
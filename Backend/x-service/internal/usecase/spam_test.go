@@ -0,0 +1,148 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/spam"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase/tweet"
+)
+
+// spamChain is the ingest filter: text posted by 3 other authors within a
+// day, more than 3 tickers, links to pump.example, or accounts younger than a
+// week with fewer than 100 followers
+func spamChain(tweets repo.TweetRepository) *spam.Chain {
+	return spam.NewChain(
+		spam.NewDuplicateCluster(tweets, 24*time.Hour, 3),
+		spam.NewCashtagStuffing(3),
+		spam.NewLinkFarm([]string{" Pump.Example ", ""}),
+		spam.NewNewLowFollower(7*24*time.Hour, 100),
+	)
+}
+
+func TestSpamChainPumpAndDump(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	tweets := NewMockTweetRepository(mockCtl)
+
+	// a coordinated pump: fresh accounts posting the same ticker list and link
+	post := &entity.Tweet{
+		Text:            "$GME $AMC $BB to the moon, next is $nok https://t.co/x",
+		AuthorID:        "bot-4",
+		URLs:            []string{"https://go.pump.example/buy"},
+		AuthorCreatedAt: time.Now().Add(-time.Hour),
+		AuthorFollowers: 3,
+	}
+	tweets.EXPECT().CountTextAuthors(gomock.Any(), post.TextHash(), "bot-4", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ string, since time.Time) (int, error) {
+			require.WithinDuration(t, time.Now().Add(-24*time.Hour), since, time.Minute)
+			return 3, nil
+		})
+
+	reasons, err := spamChain(tweets).Check(context.Background(), post)
+	require.NoError(t, err)
+	require.Equal(t, []entity.SpamReason{
+		entity.SpamReasonDuplicateCluster,
+		entity.SpamReasonCashtagStuffing,
+		entity.SpamReasonLinkFarm,
+		entity.SpamReasonNewLowFollower,
+	}, reasons)
+}
+
+func TestSpamChainClean(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	tweets := NewMockTweetRepository(mockCtl)
+	tweets.EXPECT().CountTextAuthors(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(2, nil)
+
+	// tickers count once whatever their case, and only the listed domain and
+	// its subdomains are link farms
+	post := &entity.Tweet{
+		Text:            "$TSLA $tsla $AAPL earnings beat",
+		Symbols:         []string{"TSLA", "MSFT"},
+		URLs:            []string{"https://notpump.example/a", "https://pump.example.com/b", "::bad"},
+		AuthorCreatedAt: time.Now().Add(-30 * 24 * time.Hour),
+	}
+
+	reasons, err := spamChain(tweets).Check(context.Background(), post)
+	require.NoError(t, err)
+	require.Nil(t, reasons)
+}
+
+func TestSpamChainRuleFails(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	tweets := NewMockTweetRepository(mockCtl)
+	tweets.EXPECT().CountTextAuthors(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(0, errDB)
+
+	_, err := spamChain(tweets).Check(context.Background(), &entity.Tweet{Text: "hi"})
+	require.ErrorIs(t, err, errDB)
+	require.ErrorContains(t, err, "rule duplicate_cluster")
+}
+
+func TestNewLowFollower(t *testing.T) {
+	t.Parallel()
+
+	rule := spam.NewNewLowFollower(7*24*time.Hour, 100)
+
+	tests := []struct {
+		name    string
+		tweet   entity.Tweet
+		flagged bool
+	}{
+		{name: "no author data", tweet: entity.Tweet{AuthorFollowers: 0}},
+		{name: "new with few followers", tweet: entity.Tweet{AuthorCreatedAt: time.Now().Add(-time.Hour), AuthorFollowers: 99}, flagged: true},
+		{name: "new with followers", tweet: entity.Tweet{AuthorCreatedAt: time.Now().Add(-time.Hour), AuthorFollowers: 100}},
+		{name: "old with few followers", tweet: entity.Tweet{AuthorCreatedAt: time.Now().Add(-8 * 24 * time.Hour)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason, flagged, err := rule.Check(context.Background(), &tc.tweet)
+			require.NoError(t, err)
+			require.Equal(t, entity.SpamReasonNewLowFollower, reason)
+			require.Equal(t, tc.flagged, flagged)
+		})
+	}
+}
+
+func TestIngestQuarantinesSpam(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	tweets := NewMockTweetRepository(mockCtl)
+	fetcher := NewMockSocialFetcher(mockCtl)
+	filter := NewMockSpamFilter(mockCtl)
+	useCase := tweet.New(tweets, NewMockSentimentAggRepository(mockCtl), fetcher, filter)
+
+	clean := &entity.Tweet{Text: "clean"}
+	pump := &entity.Tweet{Text: "pump"}
+	dup := &entity.Tweet{Text: "already stored"}
+
+	fetcher.EXPECT().SearchTweets(gomock.Any(), "$TSLA", 10).Return([]*entity.Tweet{clean, pump, dup}, nil)
+	filter.EXPECT().Check(gomock.Any(), clean).Return(nil, nil)
+	filter.EXPECT().Check(gomock.Any(), pump).Return([]entity.SpamReason{entity.SpamReasonLinkFarm}, nil)
+	filter.EXPECT().Check(gomock.Any(), dup).Return(nil, nil)
+	tweets.EXPECT().Create(gomock.Any(), clean).Return(nil)
+	tweets.EXPECT().Create(gomock.Any(), pump).Return(nil)
+	tweets.EXPECT().Create(gomock.Any(), dup).Return(repo.ErrDuplicateTweet)
+
+	// spam is stored quarantined, not dropped, duplicates are skipped
+	saved, err := useCase.Ingest(context.Background(), "$TSLA", 10)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.Tweet{clean, pump}, saved)
+	require.False(t, clean.IsQuarantined())
+	require.True(t, pump.IsQuarantined())
+	require.Equal(t, []entity.SpamReason{entity.SpamReasonLinkFarm}, pump.QuarantineReasons)
+}
@@ -4,32 +4,41 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/entity"
 	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/repo"
+	"github.com/Denterry/FinancialAdviser/Backend/x-service/internal/usecase"
 	"github.com/google/uuid"
 )
 
 // UseCase represents the Tweet use case
 type UseCase struct {
-	tweetRepo repo.TweetRepository
-	fetcher   repo.SocialFetcher
+	tweetRepo     repo.TweetRepository
+	sentimentRepo repo.SentimentAggRepository
+	fetcher       repo.SocialFetcher
+	filter        usecase.SpamFilter
 }
 
 // New creates a new Tweet use case
 func New(
 	tweetRepo repo.TweetRepository,
+	sentimentRepo repo.SentimentAggRepository,
 	fetcher repo.SocialFetcher,
+	filter usecase.SpamFilter,
 ) *UseCase {
 	return &UseCase{
-		tweetRepo: tweetRepo,
-		fetcher:   fetcher,
+		tweetRepo:     tweetRepo,
+		sentimentRepo: sentimentRepo,
+		fetcher:       fetcher,
+		filter:        filter,
 	}
 }
 
 // Ingest searches via the configured fetcher, persists each new tweet,
-// and returns the slice of tweets that were successfully inserted
+// and returns the slice of tweets that were successfully inserted.
+// Tweets flagged by the spam filter are persisted quarantined, not dropped
 func (uc *UseCase) Ingest(ctx context.Context, query string, maxResults int) ([]*entity.Tweet, error) {
 	// 1) fetch from scraper or API
 	fresh, err := uc.fetcher.SearchTweets(ctx, query, maxResults)
@@ -40,11 +49,19 @@ func (uc *UseCase) Ingest(ctx context.Context, query string, maxResults int) ([]
 	saved := make([]*entity.Tweet, 0, len(fresh))
 	now := time.Now().UTC()
 
-	// 2) persist each one
+	// 2) persist each one, quarantining spam
 	for _, t := range fresh {
 		t.FetchedAt = now
 		t.UpdatedAt = now
 
+		reasons, err := uc.filter.Check(ctx, t)
+		if err != nil {
+			return nil, fmt.Errorf("uc.filter.Check(): %w", err)
+		}
+		if len(reasons) > 0 {
+			t.Quarantine(reasons, now)
+		}
+
 		if err := uc.tweetRepo.Create(ctx, t); err != nil {
 			if errors.Is(err, repo.ErrDuplicateTweet) {
 				continue
@@ -81,3 +98,51 @@ func (uc *UseCase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Tweet, er
 
 	return t, nil
 }
+
+// ListFeed returns a page of f.Limit tweets after f.After, one extra tweet
+// is read to tell whether another page follows
+func (uc *UseCase) ListFeed(ctx context.Context, f repo.TweetFilter) ([]*entity.Tweet, *entity.TweetCursor, error) {
+	limit := f.Limit
+	f.Limit++
+	f.Offset = 0
+
+	out, err := uc.tweetRepo.List(ctx, f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("uc.tweetRepo.List(): %w", err)
+	}
+
+	if int32(len(out)) <= limit {
+		return out, nil, nil
+	}
+
+	out = out[:limit]
+	return out, entity.CursorOf(out[len(out)-1]), nil
+}
+
+// SentimentSeries returns daily sentiment aggregates of a symbol ordered by day
+func (uc *UseCase) SentimentSeries(ctx context.Context, symbol string, from, to time.Time) ([]*entity.SentimentDaily, error) {
+	var days []*entity.SentimentDaily
+	err := uc.sentimentRepo.IterateDaily(ctx, repo.SentimentAggFilter{
+		Symbols: []string{strings.ToUpper(symbol)},
+		From:    from,
+		To:      to,
+	}, func(d *entity.SentimentDaily) error {
+		days = append(days, d)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("uc.sentimentRepo.IterateDaily(): %w", err)
+	}
+
+	return days, nil
+}
+
+// Trending returns symbols ordered by mentions since the given time
+func (uc *UseCase) Trending(ctx context.Context, since time.Time, limit int32) ([]*entity.SymbolTrend, error) {
+	out, err := uc.tweetRepo.TrendingSymbols(ctx, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("uc.tweetRepo.TrendingSymbols(): %w", err)
+	}
+
+	return out, nil
+}
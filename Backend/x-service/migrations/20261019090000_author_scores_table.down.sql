@@ -0,0 +1,24 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION refresh_sentiment_daily_agg() RETURNS void LANGUAGE plpgsql AS $$
+BEGIN
+    TRUNCATE sentiment_daily_agg;
+    INSERT INTO sentiment_daily_agg
+    SELECT
+        s.ticker,
+        date_trunc('day', t.created_at)::date AS day,
+        avg(t.sentiment_score)                AS avg_score,
+        count(*) FILTER (WHERE t.sentiment_label='POS') AS pos_cnt,
+        count(*) FILTER (WHERE t.sentiment_label='NEG') AS neg_cnt,
+        count(*) FILTER (WHERE t.sentiment_label='NEU') AS neu_cnt
+    FROM symbols s
+    JOIN tweet_symbols ts ON ts.symbol = s.ticker
+    JOIN tweets t         ON t.id = ts.tweet_id
+    WHERE t.sentiment_score IS NOT NULL
+    GROUP BY s.ticker, day;
+END $$;
+
+ALTER TABLE sentiment_daily_agg DROP COLUMN IF EXISTS weighted_avg_score;
+DROP TABLE IF EXISTS author_scores;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,54 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TABLE author_scores (
+    author_id      VARCHAR(64) PRIMARY KEY REFERENCES authors(id) ON DELETE CASCADE,
+    reach          REAL        NOT NULL DEFAULT 0,
+    consistency    REAL        NOT NULL DEFAULT 0,
+    finance_focus  REAL        NOT NULL DEFAULT 0,
+    hit_rate       REAL,
+    score          REAL        NOT NULL DEFAULT 0 CHECK (score >= 0 AND score <= 1),
+    tweets_cnt     INT         NOT NULL DEFAULT 0,
+    computed_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- COMMENTS
+COMMENT ON TABLE author_scores IS 'Author influence and credibility scores';
+COMMENT ON COLUMN author_scores.author_id IS 'ID of the scored author';
+COMMENT ON COLUMN author_scores.reach IS 'Median engagement (likes + replies + retweets) over the scoring window';
+COMMENT ON COLUMN author_scores.consistency IS 'Inverse coefficient of variation of engagement, 0 .. 1';
+COMMENT ON COLUMN author_scores.finance_focus IS 'Share of is_financial tweets, 0 .. 1';
+COMMENT ON COLUMN author_scores.hit_rate IS 'Share of sentiment calls that preceded the aggregate move, NULL if not tracked';
+COMMENT ON COLUMN author_scores.score IS 'Composite score, used to weight sentiment aggregates';
+COMMENT ON COLUMN author_scores.tweets_cnt IS 'Number of tweets in the scoring window';
+COMMENT ON COLUMN author_scores.computed_at IS 'Timestamp when the score was computed';
+
+-- INDEXES
+CREATE INDEX author_scores_score_idx ON author_scores(score DESC);
+
+-- weighted aggregate
+ALTER TABLE sentiment_daily_agg ADD COLUMN weighted_avg_score REAL;
+
+COMMENT ON COLUMN sentiment_daily_agg.weighted_avg_score IS 'Average score weighted by author_scores.score';
+
+-- refresh helper, authors without a score get a neutral 0.5 weight
+CREATE OR REPLACE FUNCTION refresh_sentiment_daily_agg() RETURNS void LANGUAGE plpgsql AS $$
+BEGIN
+    TRUNCATE sentiment_daily_agg;
+    INSERT INTO sentiment_daily_agg (symbol, day, avg_score, pos_cnt, neg_cnt, neu_cnt, weighted_avg_score)
+    SELECT
+        s.ticker,
+        date_trunc('day', t.created_at)::date AS day,
+        avg(t.sentiment_score)                AS avg_score,
+        count(*) FILTER (WHERE t.sentiment_label='POS') AS pos_cnt,
+        count(*) FILTER (WHERE t.sentiment_label='NEG') AS neg_cnt,
+        count(*) FILTER (WHERE t.sentiment_label='NEU') AS neu_cnt,
+        sum(t.sentiment_score * coalesce(a.score, 0.5)) / nullif(sum(coalesce(a.score, 0.5)), 0) AS weighted_avg_score
+    FROM symbols s
+    JOIN tweet_symbols ts ON ts.symbol = s.ticker
+    JOIN tweets t         ON t.id = ts.tweet_id
+    LEFT JOIN author_scores a ON a.author_id = t.author_id
+    WHERE t.sentiment_score IS NOT NULL
+    GROUP BY s.ticker, day;
+END $$;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,31 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION refresh_sentiment_daily_agg() RETURNS void LANGUAGE plpgsql AS $$
+BEGIN
+    TRUNCATE sentiment_daily_agg;
+    INSERT INTO sentiment_daily_agg (symbol, day, avg_score, pos_cnt, neg_cnt, neu_cnt, weighted_avg_score)
+    SELECT
+        s.ticker,
+        date_trunc('day', t.created_at)::date AS day,
+        avg(t.sentiment_score)                AS avg_score,
+        count(*) FILTER (WHERE t.sentiment_label='POS') AS pos_cnt,
+        count(*) FILTER (WHERE t.sentiment_label='NEG') AS neg_cnt,
+        count(*) FILTER (WHERE t.sentiment_label='NEU') AS neu_cnt,
+        sum(t.sentiment_score * coalesce(a.score, 0.5)) / nullif(sum(coalesce(a.score, 0.5)), 0) AS weighted_avg_score
+    FROM symbols s
+    JOIN tweet_symbols ts ON ts.symbol = s.ticker
+    JOIN tweets t         ON t.id = ts.tweet_id
+    LEFT JOIN author_scores a ON a.author_id = t.author_id
+    WHERE t.sentiment_score IS NOT NULL
+    GROUP BY s.ticker, day;
+END $$;
+
+DROP INDEX IF EXISTS idx_tweets_quarantined_at;
+DROP INDEX IF EXISTS idx_tweets_text_hash_created_at;
+
+ALTER TABLE tweets
+    DROP COLUMN IF EXISTS quarantined_at,
+    DROP COLUMN IF EXISTS quarantine_reasons,
+    DROP COLUMN IF EXISTS text_hash;
+-- +goose StatementEnd
\ No newline at end of file
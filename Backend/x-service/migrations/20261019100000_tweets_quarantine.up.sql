@@ -0,0 +1,39 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+ALTER TABLE tweets
+    ADD COLUMN text_hash          TEXT,
+    ADD COLUMN quarantine_reasons TEXT[]      NOT NULL DEFAULT '{}',
+    ADD COLUMN quarantined_at     TIMESTAMPTZ;
+
+-- COMMENTS
+COMMENT ON COLUMN tweets.text_hash          IS 'SHA-256 of normalised text, used to find duplicate-text clusters';
+COMMENT ON COLUMN tweets.quarantine_reasons IS 'Spam reason codes (duplicate_cluster, cashtag_stuffing, link_farm, new_low_follower)';
+COMMENT ON COLUMN tweets.quarantined_at     IS 'Timestamp when the tweet was quarantined, NULL for clean tweets';
+
+-- INDEXES
+CREATE INDEX idx_tweets_text_hash_created_at ON tweets(text_hash, created_at DESC);
+CREATE INDEX idx_tweets_quarantined_at ON tweets(quarantined_at DESC) WHERE quarantined_at IS NOT NULL;
+
+-- refresh helper, quarantined tweets are kept out of the aggregate
+CREATE OR REPLACE FUNCTION refresh_sentiment_daily_agg() RETURNS void LANGUAGE plpgsql AS $$
+BEGIN
+    TRUNCATE sentiment_daily_agg;
+    INSERT INTO sentiment_daily_agg (symbol, day, avg_score, pos_cnt, neg_cnt, neu_cnt, weighted_avg_score)
+    SELECT
+        s.ticker,
+        date_trunc('day', t.created_at)::date AS day,
+        avg(t.sentiment_score)                AS avg_score,
+        count(*) FILTER (WHERE t.sentiment_label='POS') AS pos_cnt,
+        count(*) FILTER (WHERE t.sentiment_label='NEG') AS neg_cnt,
+        count(*) FILTER (WHERE t.sentiment_label='NEU') AS neu_cnt,
+        sum(t.sentiment_score * coalesce(a.score, 0.5)) / nullif(sum(coalesce(a.score, 0.5)), 0) AS weighted_avg_score
+    FROM symbols s
+    JOIN tweet_symbols ts ON ts.symbol = s.ticker
+    JOIN tweets t         ON t.id = ts.tweet_id
+    LEFT JOIN author_scores a ON a.author_id = t.author_id
+    WHERE t.sentiment_score IS NOT NULL
+      AND t.quarantined_at IS NULL
+    GROUP BY s.ticker, day;
+END $$;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- +goose Down
+-- +migrate Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS bulk_operations;
+DROP TYPE IF EXISTS bulk_status_enum;
+DROP TYPE IF EXISTS bulk_kind_enum;
+-- +goose StatementEnd
\ No newline at end of file
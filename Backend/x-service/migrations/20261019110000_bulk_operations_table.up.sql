@@ -0,0 +1,43 @@
+-- +goose Up
+-- +migrate Up
+-- +goose StatementBegin
+CREATE TYPE bulk_kind_enum AS ENUM ('retag_symbols', 'rescore_sentiment', 'delete', 'export');
+CREATE TYPE bulk_status_enum AS ENUM ('pending', 'running', 'succeeded', 'failed', 'canceled');
+
+CREATE TABLE bulk_operations (
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind         bulk_kind_enum   NOT NULL,
+    status       bulk_status_enum NOT NULL DEFAULT 'pending',
+    total        INT              NOT NULL DEFAULT 0,
+    processed    INT              NOT NULL DEFAULT 0,
+    succeeded    INT              NOT NULL DEFAULT 0,
+    failed       INT              NOT NULL DEFAULT 0,
+    conflicts    INT              NOT NULL DEFAULT 0,
+    result_uri   TEXT,
+    error_text   TEXT,
+    created_at   TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    started_at   TIMESTAMPTZ,
+    finished_at  TIMESTAMPTZ,
+    updated_at   TIMESTAMPTZ      NOT NULL DEFAULT now()
+);
+
+-- COMMENTS
+COMMENT ON TABLE bulk_operations IS 'Asynchronous bulk admin operations over tweets';
+COMMENT ON COLUMN bulk_operations.id IS 'Unique identifier for the operation';
+COMMENT ON COLUMN bulk_operations.kind IS 'Kind of the operation';
+COMMENT ON COLUMN bulk_operations.status IS 'Status of the operation';
+COMMENT ON COLUMN bulk_operations.total IS 'Number of selected tweets';
+COMMENT ON COLUMN bulk_operations.processed IS 'Number of processed tweets';
+COMMENT ON COLUMN bulk_operations.succeeded IS 'Number of successfully processed tweets';
+COMMENT ON COLUMN bulk_operations.failed IS 'Number of tweets that failed to process';
+COMMENT ON COLUMN bulk_operations.conflicts IS 'Number of tweets skipped because of concurrent modifications';
+COMMENT ON COLUMN bulk_operations.result_uri IS 'Location of the operation result, e.g. export file';
+COMMENT ON COLUMN bulk_operations.error_text IS 'Error text of a failed operation';
+COMMENT ON COLUMN bulk_operations.created_at IS 'Created at timestamp';
+COMMENT ON COLUMN bulk_operations.started_at IS 'Started at timestamp';
+COMMENT ON COLUMN bulk_operations.finished_at IS 'Finished at timestamp';
+COMMENT ON COLUMN bulk_operations.updated_at IS 'Updated at timestamp';
+
+-- INDEXES
+CREATE INDEX bulk_operations_created_at_idx ON bulk_operations(created_at DESC);
+-- +goose StatementEnd
\ No newline at end of file
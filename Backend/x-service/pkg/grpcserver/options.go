@@ -26,3 +26,17 @@ func MaxStreams(n uint32) Option {
 		s.opts = append(s.opts, grpc.MaxConcurrentStreams(n))
 	}
 }
+
+// UnaryInterceptors -.
+func UnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(s *Server) {
+		s.opts = append(s.opts, grpc.ChainUnaryInterceptor(interceptors...))
+	}
+}
+
+// StreamInterceptors -.
+func StreamInterceptors(interceptors ...grpc.StreamServerInterceptor) Option {
+	return func(s *Server) {
+		s.opts = append(s.opts, grpc.ChainStreamInterceptor(interceptors...))
+	}
+}
@@ -69,7 +69,7 @@ func (s *Server) Serve(register func(*grpc.Server)) {
 	// listen
 	lis, err := net.Listen("tcp", s.address)
 	if err != nil {
-		log.Printf("net.Listen(%s): %w", s.address, err)
+		log.Printf("net.Listen(%s): %v", s.address, err)
 		s.notify <- fmt.Errorf("net.Listen(%s): %w", s.address, err)
 		close(s.notify)
 		return
@@ -78,7 +78,7 @@ func (s *Server) Serve(register func(*grpc.Server)) {
 	go func() {
 		err := s.grpcServer.Serve(lis)
 		if err != nil {
-			log.Printf("Serve: %w", err)
+			log.Printf("Serve: %v", err)
 			s.notify <- fmt.Errorf("Serve: %w", err)
 		}
 		close(s.notify)
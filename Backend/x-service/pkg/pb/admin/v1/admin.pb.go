@@ -209,7 +209,7 @@ func (x *GetTweetResponse) GetTweet() *Tweet {
 type ListTweetsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	AuthorId       string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	IsFinancial    bool                   `protobuf:"varint,2,opt,name=is_financial,json=isFinancial,proto3" json:"is_financial,omitempty"`
+	IsFinancial    *bool                  `protobuf:"varint,2,opt,name=is_financial,json=isFinancial,proto3,oneof" json:"is_financial,omitempty"` // unset matches both
 	SentimentLabel string                 `protobuf:"bytes,3,opt,name=sentiment_label,json=sentimentLabel,proto3" json:"sentiment_label,omitempty"`
 	Symbols        []string               `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"`
 	StartTime      int64                  `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
@@ -258,8 +258,8 @@ func (x *ListTweetsRequest) GetAuthorId() string {
 }
 
 func (x *ListTweetsRequest) GetIsFinancial() bool {
-	if x != nil {
-		return x.IsFinancial
+	if x != nil && x.IsFinancial != nil {
+		return *x.IsFinancial
 	}
 	return false
 }
@@ -750,36 +750,27 @@ func (x *GetTweetsBySentimentResponse) GetTweets() []*Tweet {
 	return nil
 }
 
-// --- ADVANCED MESSAGES ---
-type Tweet struct {
+type GetAuthorScoreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Sentiment     *Sentiment             `protobuf:"bytes,6,opt,name=sentiment,proto3" json:"sentiment,omitempty"`
-	IsFinancial   bool                   `protobuf:"varint,7,opt,name=is_financial,json=isFinancial,proto3" json:"is_financial,omitempty"`
-	Symbols       []string               `protobuf:"bytes,8,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	Engagement    *Engagement            `protobuf:"bytes,9,opt,name=engagement,proto3" json:"engagement,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tweet) Reset() {
-	*x = Tweet{}
+func (x *GetAuthorScoreRequest) Reset() {
+	*x = GetAuthorScoreRequest{}
 	mi := &file_admin_v1_admin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tweet) String() string {
+func (x *GetAuthorScoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tweet) ProtoMessage() {}
+func (*GetAuthorScoreRequest) ProtoMessage() {}
 
-func (x *Tweet) ProtoReflect() protoreflect.Message {
+func (x *GetAuthorScoreRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_admin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -791,97 +782,216 @@ func (x *Tweet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tweet.ProtoReflect.Descriptor instead.
-func (*Tweet) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuthorScoreRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorScoreRequest) Descriptor() ([]byte, []int) {
 	return file_admin_v1_admin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Tweet) GetId() string {
+func (x *GetAuthorScoreRequest) GetAuthorId() string {
 	if x != nil {
-		return x.Id
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (x *Tweet) GetText() string {
+type GetAuthorScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         *AuthorScore           `protobuf:"bytes,1,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorScoreResponse) Reset() {
+	*x = GetAuthorScoreResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorScoreResponse) ProtoMessage() {}
+
+func (x *GetAuthorScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
 	if x != nil {
-		return x.Text
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Tweet) GetAuthorId() string {
+// Deprecated: Use GetAuthorScoreResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorScoreResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAuthorScoreResponse) GetScore() *AuthorScore {
 	if x != nil {
-		return x.AuthorId
+		return x.Score
 	}
-	return ""
+	return nil
 }
 
-func (x *Tweet) GetCreatedAt() int64 {
+type ListAuthorScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorScoresRequest) Reset() {
+	*x = ListAuthorScoresRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorScoresRequest) ProtoMessage() {}
+
+func (x *ListAuthorScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Tweet) GetUpdatedAt() int64 {
+// Deprecated: Use ListAuthorScoresRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorScoresRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAuthorScoresRequest) GetLimit() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *Tweet) GetSentiment() *Sentiment {
+func (x *ListAuthorScoresRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Sentiment
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-func (x *Tweet) GetIsFinancial() bool {
+type ListAuthorScoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        []*AuthorScore         `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorScoresResponse) Reset() {
+	*x = ListAuthorScoresResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorScoresResponse) ProtoMessage() {}
+
+func (x *ListAuthorScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
 	if x != nil {
-		return x.IsFinancial
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Tweet) GetSymbols() []string {
+// Deprecated: Use ListAuthorScoresResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorScoresResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAuthorScoresResponse) GetScores() []*AuthorScore {
 	if x != nil {
-		return x.Symbols
+		return x.Scores
 	}
 	return nil
 }
 
-func (x *Tweet) GetEngagement() *Engagement {
+type RecomputeAuthorScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeAuthorScoresRequest) Reset() {
+	*x = RecomputeAuthorScoresRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeAuthorScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeAuthorScoresRequest) ProtoMessage() {}
+
+func (x *RecomputeAuthorScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
 	if x != nil {
-		return x.Engagement
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type Sentiment struct {
+// Deprecated: Use RecomputeAuthorScoresRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeAuthorScoresRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+type RecomputeAuthorScoresResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Scored        int32                  `protobuf:"varint,1,opt,name=scored,proto3" json:"scored,omitempty"` // number of scored authors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Sentiment) Reset() {
-	*x = Sentiment{}
-	mi := &file_admin_v1_admin_proto_msgTypes[15]
+func (x *RecomputeAuthorScoresResponse) Reset() {
+	*x = RecomputeAuthorScoresResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Sentiment) String() string {
+func (x *RecomputeAuthorScoresResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sentiment) ProtoMessage() {}
+func (*RecomputeAuthorScoresResponse) ProtoMessage() {}
 
-func (x *Sentiment) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[15]
+func (x *RecomputeAuthorScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,49 +1002,100 @@ func (x *Sentiment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sentiment.ProtoReflect.Descriptor instead.
-func (*Sentiment) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RecomputeAuthorScoresResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeAuthorScoresResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Sentiment) GetScore() float64 {
+func (x *RecomputeAuthorScoresResponse) GetScored() int32 {
 	if x != nil {
-		return x.Score
+		return x.Scored
 	}
 	return 0
 }
 
-func (x *Sentiment) GetLabel() string {
+type ListQuarantinedTweetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"` // optional reason code filter
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedTweetsRequest) Reset() {
+	*x = ListQuarantinedTweetsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedTweetsRequest) ProtoMessage() {}
+
+func (x *ListQuarantinedTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
 	if x != nil {
-		return x.Label
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedTweetsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListQuarantinedTweetsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-type Engagement struct {
+func (x *ListQuarantinedTweetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListQuarantinedTweetsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListQuarantinedTweetsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RetweetCount  int32                  `protobuf:"varint,1,opt,name=retweet_count,json=retweetCount,proto3" json:"retweet_count,omitempty"`
-	FavoriteCount int32                  `protobuf:"varint,2,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
-	ReplyCount    int32                  `protobuf:"varint,3,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	Tweets        []*Tweet               `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Engagement) Reset() {
-	*x = Engagement{}
-	mi := &file_admin_v1_admin_proto_msgTypes[16]
+func (x *ListQuarantinedTweetsResponse) Reset() {
+	*x = ListQuarantinedTweetsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Engagement) String() string {
+func (x *ListQuarantinedTweetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Engagement) ProtoMessage() {}
+func (*ListQuarantinedTweetsResponse) ProtoMessage() {}
 
-func (x *Engagement) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[16]
+func (x *ListQuarantinedTweetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,56 +1106,1182 @@ func (x *Engagement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Engagement.ProtoReflect.Descriptor instead.
-func (*Engagement) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListQuarantinedTweetsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedTweetsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Engagement) GetRetweetCount() int32 {
+func (x *ListQuarantinedTweetsResponse) GetTweets() []*Tweet {
 	if x != nil {
-		return x.RetweetCount
+		return x.Tweets
 	}
-	return 0
+	return nil
 }
 
-func (x *Engagement) GetFavoriteCount() int32 {
+type ReleaseTweetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseTweetRequest) Reset() {
+	*x = ReleaseTweetRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseTweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseTweetRequest) ProtoMessage() {}
+
+func (x *ReleaseTweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
 	if x != nil {
-		return x.FavoriteCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Engagement) GetReplyCount() int32 {
+// Deprecated: Use ReleaseTweetRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseTweetRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReleaseTweetRequest) GetId() string {
 	if x != nil {
-		return x.ReplyCount
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-var File_admin_v1_admin_proto protoreflect.FileDescriptor
+type ReleaseTweetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_admin_v1_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x14admin/v1/admin.proto\x12\badmin.v1\"E\n" +
-	"\x12CreateTweetRequest\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1b\n" +
-	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\"<\n" +
-	"\x13CreateTweetResponse\x12%\n" +
-	"\x05tweet\x18\x01 \x01(\v2\x0f.admin.v1.TweetR\x05tweet\"!\n" +
-	"\x0fGetTweetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
-	"\x10GetTweetResponse\x12%\n" +
-	"\x05tweet\x18\x01 \x01(\v2\x0f.admin.v1.TweetR\x05tweet\"\xfe\x01\n" +
-	"\x11ListTweetsRequest\x12\x1b\n" +
-	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12!\n" +
-	"\fis_financial\x18\x02 \x01(\bR\visFinancial\x12'\n" +
+func (x *ReleaseTweetResponse) Reset() {
+	*x = ReleaseTweetResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseTweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseTweetResponse) ProtoMessage() {}
+
+func (x *ReleaseTweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseTweetResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseTweetResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+type BulkRetagSymbolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      *BulkSelector          `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Add           []string               `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkRetagSymbolsRequest) Reset() {
+	*x = BulkRetagSymbolsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRetagSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRetagSymbolsRequest) ProtoMessage() {}
+
+func (x *BulkRetagSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRetagSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*BulkRetagSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BulkRetagSymbolsRequest) GetSelector() *BulkSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *BulkRetagSymbolsRequest) GetAdd() []string {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *BulkRetagSymbolsRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type BulkRescoreSentimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      *BulkSelector          `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkRescoreSentimentRequest) Reset() {
+	*x = BulkRescoreSentimentRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRescoreSentimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRescoreSentimentRequest) ProtoMessage() {}
+
+func (x *BulkRescoreSentimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRescoreSentimentRequest.ProtoReflect.Descriptor instead.
+func (*BulkRescoreSentimentRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BulkRescoreSentimentRequest) GetSelector() *BulkSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+type BulkDeleteTweetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      *BulkSelector          `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteTweetsRequest) Reset() {
+	*x = BulkDeleteTweetsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteTweetsRequest) ProtoMessage() {}
+
+func (x *BulkDeleteTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteTweetsRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BulkDeleteTweetsRequest) GetSelector() *BulkSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+type BulkExportTweetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      *BulkSelector          `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkExportTweetsRequest) Reset() {
+	*x = BulkExportTweetsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkExportTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkExportTweetsRequest) ProtoMessage() {}
+
+func (x *BulkExportTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkExportTweetsRequest.ProtoReflect.Descriptor instead.
+func (*BulkExportTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BulkExportTweetsRequest) GetSelector() *BulkSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+type BulkOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *BulkOperation         `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkOperationResponse) Reset() {
+	*x = BulkOperationResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOperationResponse) ProtoMessage() {}
+
+func (x *BulkOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkOperationResponse.ProtoReflect.Descriptor instead.
+func (*BulkOperationResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkOperationResponse) GetOperation() *BulkOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type GetBulkOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBulkOperationRequest) Reset() {
+	*x = GetBulkOperationRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBulkOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBulkOperationRequest) ProtoMessage() {}
+
+func (x *GetBulkOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBulkOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetBulkOperationRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBulkOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBulkOperationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBulkOperationsRequest) Reset() {
+	*x = ListBulkOperationsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBulkOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBulkOperationsRequest) ProtoMessage() {}
+
+func (x *ListBulkOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBulkOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListBulkOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListBulkOperationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBulkOperationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListBulkOperationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operations    []*BulkOperation       `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBulkOperationsResponse) Reset() {
+	*x = ListBulkOperationsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBulkOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBulkOperationsResponse) ProtoMessage() {}
+
+func (x *ListBulkOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBulkOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListBulkOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBulkOperationsResponse) GetOperations() []*BulkOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type CancelBulkOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBulkOperationRequest) Reset() {
+	*x = CancelBulkOperationRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBulkOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBulkOperationRequest) ProtoMessage() {}
+
+func (x *CancelBulkOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBulkOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelBulkOperationRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelBulkOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelBulkOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBulkOperationResponse) Reset() {
+	*x = CancelBulkOperationResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBulkOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBulkOperationResponse) ProtoMessage() {}
+
+func (x *CancelBulkOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBulkOperationResponse.ProtoReflect.Descriptor instead.
+func (*CancelBulkOperationResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+// --- ADVANCED MESSAGES ---
+type Tweet struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text              string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAt         int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Sentiment         *Sentiment             `protobuf:"bytes,6,opt,name=sentiment,proto3" json:"sentiment,omitempty"`
+	IsFinancial       bool                   `protobuf:"varint,7,opt,name=is_financial,json=isFinancial,proto3" json:"is_financial,omitempty"`
+	Symbols           []string               `protobuf:"bytes,8,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	Engagement        *Engagement            `protobuf:"bytes,9,opt,name=engagement,proto3" json:"engagement,omitempty"`
+	QuarantineReasons []string               `protobuf:"bytes,10,rep,name=quarantine_reasons,json=quarantineReasons,proto3" json:"quarantine_reasons,omitempty"` // spam reason codes, empty for clean tweets
+	QuarantinedAt     int64                  `protobuf:"varint,11,opt,name=quarantined_at,json=quarantinedAt,proto3" json:"quarantined_at,omitempty"`            // 0 for clean tweets
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Tweet) Reset() {
+	*x = Tweet{}
+	mi := &file_admin_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tweet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tweet) ProtoMessage() {}
+
+func (x *Tweet) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tweet.ProtoReflect.Descriptor instead.
+func (*Tweet) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Tweet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tweet) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Tweet) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Tweet) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Tweet) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Tweet) GetSentiment() *Sentiment {
+	if x != nil {
+		return x.Sentiment
+	}
+	return nil
+}
+
+func (x *Tweet) GetIsFinancial() bool {
+	if x != nil {
+		return x.IsFinancial
+	}
+	return false
+}
+
+func (x *Tweet) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *Tweet) GetEngagement() *Engagement {
+	if x != nil {
+		return x.Engagement
+	}
+	return nil
+}
+
+func (x *Tweet) GetQuarantineReasons() []string {
+	if x != nil {
+		return x.QuarantineReasons
+	}
+	return nil
+}
+
+func (x *Tweet) GetQuarantinedAt() int64 {
+	if x != nil {
+		return x.QuarantinedAt
+	}
+	return 0
+}
+
+type Sentiment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sentiment) Reset() {
+	*x = Sentiment{}
+	mi := &file_admin_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sentiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sentiment) ProtoMessage() {}
+
+func (x *Sentiment) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sentiment.ProtoReflect.Descriptor instead.
+func (*Sentiment) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Sentiment) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Sentiment) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type Engagement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetweetCount  int32                  `protobuf:"varint,1,opt,name=retweet_count,json=retweetCount,proto3" json:"retweet_count,omitempty"`
+	FavoriteCount int32                  `protobuf:"varint,2,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	ReplyCount    int32                  `protobuf:"varint,3,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Engagement) Reset() {
+	*x = Engagement{}
+	mi := &file_admin_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Engagement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Engagement) ProtoMessage() {}
+
+func (x *Engagement) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Engagement.ProtoReflect.Descriptor instead.
+func (*Engagement) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Engagement) GetRetweetCount() int32 {
+	if x != nil {
+		return x.RetweetCount
+	}
+	return 0
+}
+
+func (x *Engagement) GetFavoriteCount() int32 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *Engagement) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+type AuthorScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Reach         float64                `protobuf:"fixed64,2,opt,name=reach,proto3" json:"reach,omitempty"`                                   // median engagement
+	Consistency   float64                `protobuf:"fixed64,3,opt,name=consistency,proto3" json:"consistency,omitempty"`                       // 0 .. 1
+	FinanceFocus  float64                `protobuf:"fixed64,4,opt,name=finance_focus,json=financeFocus,proto3" json:"finance_focus,omitempty"` // share of financial tweets
+	HitRate       *float64               `protobuf:"fixed64,5,opt,name=hit_rate,json=hitRate,proto3,oneof" json:"hit_rate,omitempty"`          // unset when hit rate is not tracked
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`                                   // 0 .. 1, weight in sentiment aggregates
+	Tweets        int32                  `protobuf:"varint,7,opt,name=tweets,proto3" json:"tweets,omitempty"`
+	ComputedAt    int64                  `protobuf:"varint,8,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorScore) Reset() {
+	*x = AuthorScore{}
+	mi := &file_admin_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorScore) ProtoMessage() {}
+
+func (x *AuthorScore) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorScore.ProtoReflect.Descriptor instead.
+func (*AuthorScore) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AuthorScore) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AuthorScore) GetReach() float64 {
+	if x != nil {
+		return x.Reach
+	}
+	return 0
+}
+
+func (x *AuthorScore) GetConsistency() float64 {
+	if x != nil {
+		return x.Consistency
+	}
+	return 0
+}
+
+func (x *AuthorScore) GetFinanceFocus() float64 {
+	if x != nil {
+		return x.FinanceFocus
+	}
+	return 0
+}
+
+func (x *AuthorScore) GetHitRate() float64 {
+	if x != nil && x.HitRate != nil {
+		return *x.HitRate
+	}
+	return 0
+}
+
+func (x *AuthorScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AuthorScore) GetTweets() int32 {
+	if x != nil {
+		return x.Tweets
+	}
+	return 0
+}
+
+func (x *AuthorScore) GetComputedAt() int64 {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return 0
+}
+
+type BulkSelector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*BulkSelector_Filter
+	//	*BulkSelector_Ids
+	Target        isBulkSelector_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkSelector) Reset() {
+	*x = BulkSelector{}
+	mi := &file_admin_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkSelector) ProtoMessage() {}
+
+func (x *BulkSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkSelector.ProtoReflect.Descriptor instead.
+func (*BulkSelector) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BulkSelector) GetTarget() isBulkSelector_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *BulkSelector) GetFilter() *ListTweetsRequest {
+	if x != nil {
+		if x, ok := x.Target.(*BulkSelector_Filter); ok {
+			return x.Filter
+		}
+	}
+	return nil
+}
+
+func (x *BulkSelector) GetIds() *TweetIDs {
+	if x != nil {
+		if x, ok := x.Target.(*BulkSelector_Ids); ok {
+			return x.Ids
+		}
+	}
+	return nil
+}
+
+type isBulkSelector_Target interface {
+	isBulkSelector_Target()
+}
+
+type BulkSelector_Filter struct {
+	Filter *ListTweetsRequest `protobuf:"bytes,1,opt,name=filter,proto3,oneof"` // limit and offset are ignored
+}
+
+type BulkSelector_Ids struct {
+	Ids *TweetIDs `protobuf:"bytes,2,opt,name=ids,proto3,oneof"`
+}
+
+func (*BulkSelector_Filter) isBulkSelector_Target() {}
+
+func (*BulkSelector_Ids) isBulkSelector_Target() {}
+
+type TweetIDs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TweetIDs) Reset() {
+	*x = TweetIDs{}
+	mi := &file_admin_v1_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TweetIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TweetIDs) ProtoMessage() {}
+
+func (x *TweetIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TweetIDs.ProtoReflect.Descriptor instead.
+func (*TweetIDs) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TweetIDs) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BulkOperation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`     // retag_symbols, rescore_sentiment, delete, export
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending, running, succeeded, failed, canceled
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int32                  `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,6,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Conflicts     int32                  `protobuf:"varint,8,opt,name=conflicts,proto3" json:"conflicts,omitempty"` // skipped because of concurrent modifications
+	ResultUri     string                 `protobuf:"bytes,9,opt,name=result_uri,json=resultUri,proto3" json:"result_uri,omitempty"`
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,12,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`    // 0 until started
+	FinishedAt    int64                  `protobuf:"varint,13,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // 0 until finished
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkOperation) Reset() {
+	*x = BulkOperation{}
+	mi := &file_admin_v1_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOperation) ProtoMessage() {}
+
+func (x *BulkOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkOperation.ProtoReflect.Descriptor instead.
+func (*BulkOperation) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BulkOperation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BulkOperation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BulkOperation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkOperation) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BulkOperation) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *BulkOperation) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkOperation) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkOperation) GetConflicts() int32 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+func (x *BulkOperation) GetResultUri() string {
+	if x != nil {
+		return x.ResultUri
+	}
+	return ""
+}
+
+func (x *BulkOperation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkOperation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *BulkOperation) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *BulkOperation) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+var File_admin_v1_admin_proto protoreflect.FileDescriptor
+
+const file_admin_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14admin/v1/admin.proto\x12\badmin.v1\"E\n" +
+	"\x12CreateTweetRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\"<\n" +
+	"\x13CreateTweetResponse\x12%\n" +
+	"\x05tweet\x18\x01 \x01(\v2\x0f.admin.v1.TweetR\x05tweet\"!\n" +
+	"\x0fGetTweetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x10GetTweetResponse\x12%\n" +
+	"\x05tweet\x18\x01 \x01(\v2\x0f.admin.v1.TweetR\x05tweet\"\x94\x02\n" +
+	"\x11ListTweetsRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12&\n" +
+	"\fis_financial\x18\x02 \x01(\bH\x00R\visFinancial\x88\x01\x01\x12'\n" +
 	"\x0fsentiment_label\x18\x03 \x01(\tR\x0esentimentLabel\x12\x18\n" +
 	"\asymbols\x18\x04 \x03(\tR\asymbols\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x05 \x01(\x03R\tstartTime\x12\x19\n" +
 	"\bend_time\x18\x06 \x01(\x03R\aendTime\x12\x14\n" +
 	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\b \x01(\x05R\x06offset\"=\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offsetB\x0f\n" +
+	"\r_is_financial\"=\n" +
 	"\x12ListTweetsResponse\x12'\n" +
 	"\x06tweets\x18\x01 \x03(\v2\x0f.admin.v1.TweetR\x06tweets\"\xa1\x01\n" +
 	"\x12UpdateTweetRequest\x12\x0e\n" +
@@ -1020,7 +2307,52 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\"G\n" +
 	"\x1cGetTweetsBySentimentResponse\x12'\n" +
-	"\x06tweets\x18\x01 \x03(\v2\x0f.admin.v1.TweetR\x06tweets\"\xac\x02\n" +
+	"\x06tweets\x18\x01 \x03(\v2\x0f.admin.v1.TweetR\x06tweets\"4\n" +
+	"\x15GetAuthorScoreRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\"E\n" +
+	"\x16GetAuthorScoreResponse\x12+\n" +
+	"\x05score\x18\x01 \x01(\v2\x15.admin.v1.AuthorScoreR\x05score\"G\n" +
+	"\x17ListAuthorScoresRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"I\n" +
+	"\x18ListAuthorScoresResponse\x12-\n" +
+	"\x06scores\x18\x01 \x03(\v2\x15.admin.v1.AuthorScoreR\x06scores\"\x1e\n" +
+	"\x1cRecomputeAuthorScoresRequest\"7\n" +
+	"\x1dRecomputeAuthorScoresResponse\x12\x16\n" +
+	"\x06scored\x18\x01 \x01(\x05R\x06scored\"d\n" +
+	"\x1cListQuarantinedTweetsRequest\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"H\n" +
+	"\x1dListQuarantinedTweetsResponse\x12'\n" +
+	"\x06tweets\x18\x01 \x03(\v2\x0f.admin.v1.TweetR\x06tweets\"%\n" +
+	"\x13ReleaseTweetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14ReleaseTweetResponse\"w\n" +
+	"\x17BulkRetagSymbolsRequest\x122\n" +
+	"\bselector\x18\x01 \x01(\v2\x16.admin.v1.BulkSelectorR\bselector\x12\x10\n" +
+	"\x03add\x18\x02 \x03(\tR\x03add\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\"Q\n" +
+	"\x1bBulkRescoreSentimentRequest\x122\n" +
+	"\bselector\x18\x01 \x01(\v2\x16.admin.v1.BulkSelectorR\bselector\"M\n" +
+	"\x17BulkDeleteTweetsRequest\x122\n" +
+	"\bselector\x18\x01 \x01(\v2\x16.admin.v1.BulkSelectorR\bselector\"M\n" +
+	"\x17BulkExportTweetsRequest\x122\n" +
+	"\bselector\x18\x01 \x01(\v2\x16.admin.v1.BulkSelectorR\bselector\"N\n" +
+	"\x15BulkOperationResponse\x125\n" +
+	"\toperation\x18\x01 \x01(\v2\x17.admin.v1.BulkOperationR\toperation\")\n" +
+	"\x17GetBulkOperationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x19ListBulkOperationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"U\n" +
+	"\x1aListBulkOperationsResponse\x127\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2\x17.admin.v1.BulkOperationR\n" +
+	"operations\",\n" +
+	"\x1aCancelBulkOperationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bCancelBulkOperationResponse\"\x82\x03\n" +
 	"\x05Tweet\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1b\n" +
@@ -1034,7 +2366,10 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\asymbols\x18\b \x03(\tR\asymbols\x124\n" +
 	"\n" +
 	"engagement\x18\t \x01(\v2\x14.admin.v1.EngagementR\n" +
-	"engagement\"7\n" +
+	"engagement\x12-\n" +
+	"\x12quarantine_reasons\x18\n" +
+	" \x03(\tR\x11quarantineReasons\x12%\n" +
+	"\x0equarantined_at\x18\v \x01(\x03R\rquarantinedAt\"7\n" +
 	"\tSentiment\x12\x14\n" +
 	"\x05score\x18\x01 \x01(\x01R\x05score\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\"y\n" +
@@ -1043,7 +2378,43 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\rretweet_count\x18\x01 \x01(\x05R\fretweetCount\x12%\n" +
 	"\x0efavorite_count\x18\x02 \x01(\x05R\rfavoriteCount\x12\x1f\n" +
 	"\vreply_count\x18\x03 \x01(\x05R\n" +
-	"replyCount2\xd6\x04\n" +
+	"replyCount\"\x83\x02\n" +
+	"\vAuthorScore\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x14\n" +
+	"\x05reach\x18\x02 \x01(\x01R\x05reach\x12 \n" +
+	"\vconsistency\x18\x03 \x01(\x01R\vconsistency\x12#\n" +
+	"\rfinance_focus\x18\x04 \x01(\x01R\ffinanceFocus\x12\x1e\n" +
+	"\bhit_rate\x18\x05 \x01(\x01H\x00R\ahitRate\x88\x01\x01\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06tweets\x18\a \x01(\x05R\x06tweets\x12\x1f\n" +
+	"\vcomputed_at\x18\b \x01(\x03R\n" +
+	"computedAtB\v\n" +
+	"\t_hit_rate\"w\n" +
+	"\fBulkSelector\x125\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1b.admin.v1.ListTweetsRequestH\x00R\x06filter\x12&\n" +
+	"\x03ids\x18\x02 \x01(\v2\x12.admin.v1.TweetIDsH\x00R\x03idsB\b\n" +
+	"\x06target\"\x1c\n" +
+	"\bTweetIDs\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xe7\x02\n" +
+	"\rBulkOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x05 \x01(\x05R\tprocessed\x12\x1c\n" +
+	"\tsucceeded\x18\x06 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\a \x01(\x05R\x06failed\x12\x1c\n" +
+	"\tconflicts\x18\b \x01(\x05R\tconflicts\x12\x1d\n" +
+	"\n" +
+	"result_uri\x18\t \x01(\tR\tresultUri\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\f \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\r \x01(\x03R\n" +
+	"finishedAt2\xc6\r\n" +
 	"\x11AdminTweetService\x12L\n" +
 	"\vCreateTweet\x12\x1c.admin.v1.CreateTweetRequest\x1a\x1d.admin.v1.CreateTweetResponse\"\x00\x12C\n" +
 	"\bGetTweet\x12\x19.admin.v1.GetTweetRequest\x1a\x1a.admin.v1.GetTweetResponse\"\x00\x12I\n" +
@@ -1052,7 +2423,19 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\vUpdateTweet\x12\x1c.admin.v1.UpdateTweetRequest\x1a\x1d.admin.v1.UpdateTweetResponse\"\x00\x12L\n" +
 	"\vDeleteTweet\x12\x1c.admin.v1.DeleteTweetRequest\x1a\x1d.admin.v1.DeleteTweetResponse\"\x00\x12^\n" +
 	"\x11GetTweetsBySymbol\x12\".admin.v1.GetTweetsBySymbolRequest\x1a#.admin.v1.GetTweetsBySymbolResponse\"\x00\x12g\n" +
-	"\x14GetTweetsBySentiment\x12%.admin.v1.GetTweetsBySentimentRequest\x1a&.admin.v1.GetTweetsBySentimentResponse\"\x00BPZNgithub.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1;adminpbb\x06proto3"
+	"\x14GetTweetsBySentiment\x12%.admin.v1.GetTweetsBySentimentRequest\x1a&.admin.v1.GetTweetsBySentimentResponse\"\x00\x12U\n" +
+	"\x0eGetAuthorScore\x12\x1f.admin.v1.GetAuthorScoreRequest\x1a .admin.v1.GetAuthorScoreResponse\"\x00\x12[\n" +
+	"\x10ListAuthorScores\x12!.admin.v1.ListAuthorScoresRequest\x1a\".admin.v1.ListAuthorScoresResponse\"\x00\x12j\n" +
+	"\x15RecomputeAuthorScores\x12&.admin.v1.RecomputeAuthorScoresRequest\x1a'.admin.v1.RecomputeAuthorScoresResponse\"\x00\x12j\n" +
+	"\x15ListQuarantinedTweets\x12&.admin.v1.ListQuarantinedTweetsRequest\x1a'.admin.v1.ListQuarantinedTweetsResponse\"\x00\x12O\n" +
+	"\fReleaseTweet\x12\x1d.admin.v1.ReleaseTweetRequest\x1a\x1e.admin.v1.ReleaseTweetResponse\"\x00\x12X\n" +
+	"\x10BulkRetagSymbols\x12!.admin.v1.BulkRetagSymbolsRequest\x1a\x1f.admin.v1.BulkOperationResponse\"\x00\x12`\n" +
+	"\x14BulkRescoreSentiment\x12%.admin.v1.BulkRescoreSentimentRequest\x1a\x1f.admin.v1.BulkOperationResponse\"\x00\x12X\n" +
+	"\x10BulkDeleteTweets\x12!.admin.v1.BulkDeleteTweetsRequest\x1a\x1f.admin.v1.BulkOperationResponse\"\x00\x12X\n" +
+	"\x10BulkExportTweets\x12!.admin.v1.BulkExportTweetsRequest\x1a\x1f.admin.v1.BulkOperationResponse\"\x00\x12X\n" +
+	"\x10GetBulkOperation\x12!.admin.v1.GetBulkOperationRequest\x1a\x1f.admin.v1.BulkOperationResponse\"\x00\x12a\n" +
+	"\x12ListBulkOperations\x12#.admin.v1.ListBulkOperationsRequest\x1a$.admin.v1.ListBulkOperationsResponse\"\x00\x12d\n" +
+	"\x13CancelBulkOperation\x12$.admin.v1.CancelBulkOperationRequest\x1a%.admin.v1.CancelBulkOperationResponse\"\x00BPZNgithub.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/admin/v1;adminpbb\x06proto3"
 
 var (
 	file_admin_v1_admin_proto_rawDescOnce sync.Once
@@ -1066,56 +2449,115 @@ func file_admin_v1_admin_proto_rawDescGZIP() []byte {
 	return file_admin_v1_admin_proto_rawDescData
 }
 
-var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_admin_v1_admin_proto_goTypes = []any{
-	(*CreateTweetRequest)(nil),           // 0: admin.v1.CreateTweetRequest
-	(*CreateTweetResponse)(nil),          // 1: admin.v1.CreateTweetResponse
-	(*GetTweetRequest)(nil),              // 2: admin.v1.GetTweetRequest
-	(*GetTweetResponse)(nil),             // 3: admin.v1.GetTweetResponse
-	(*ListTweetsRequest)(nil),            // 4: admin.v1.ListTweetsRequest
-	(*ListTweetsResponse)(nil),           // 5: admin.v1.ListTweetsResponse
-	(*UpdateTweetRequest)(nil),           // 6: admin.v1.UpdateTweetRequest
-	(*UpdateTweetResponse)(nil),          // 7: admin.v1.UpdateTweetResponse
-	(*DeleteTweetRequest)(nil),           // 8: admin.v1.DeleteTweetRequest
-	(*DeleteTweetResponse)(nil),          // 9: admin.v1.DeleteTweetResponse
-	(*GetTweetsBySymbolRequest)(nil),     // 10: admin.v1.GetTweetsBySymbolRequest
-	(*GetTweetsBySymbolResponse)(nil),    // 11: admin.v1.GetTweetsBySymbolResponse
-	(*GetTweetsBySentimentRequest)(nil),  // 12: admin.v1.GetTweetsBySentimentRequest
-	(*GetTweetsBySentimentResponse)(nil), // 13: admin.v1.GetTweetsBySentimentResponse
-	(*Tweet)(nil),                        // 14: admin.v1.Tweet
-	(*Sentiment)(nil),                    // 15: admin.v1.Sentiment
-	(*Engagement)(nil),                   // 16: admin.v1.Engagement
+	(*CreateTweetRequest)(nil),            // 0: admin.v1.CreateTweetRequest
+	(*CreateTweetResponse)(nil),           // 1: admin.v1.CreateTweetResponse
+	(*GetTweetRequest)(nil),               // 2: admin.v1.GetTweetRequest
+	(*GetTweetResponse)(nil),              // 3: admin.v1.GetTweetResponse
+	(*ListTweetsRequest)(nil),             // 4: admin.v1.ListTweetsRequest
+	(*ListTweetsResponse)(nil),            // 5: admin.v1.ListTweetsResponse
+	(*UpdateTweetRequest)(nil),            // 6: admin.v1.UpdateTweetRequest
+	(*UpdateTweetResponse)(nil),           // 7: admin.v1.UpdateTweetResponse
+	(*DeleteTweetRequest)(nil),            // 8: admin.v1.DeleteTweetRequest
+	(*DeleteTweetResponse)(nil),           // 9: admin.v1.DeleteTweetResponse
+	(*GetTweetsBySymbolRequest)(nil),      // 10: admin.v1.GetTweetsBySymbolRequest
+	(*GetTweetsBySymbolResponse)(nil),     // 11: admin.v1.GetTweetsBySymbolResponse
+	(*GetTweetsBySentimentRequest)(nil),   // 12: admin.v1.GetTweetsBySentimentRequest
+	(*GetTweetsBySentimentResponse)(nil),  // 13: admin.v1.GetTweetsBySentimentResponse
+	(*GetAuthorScoreRequest)(nil),         // 14: admin.v1.GetAuthorScoreRequest
+	(*GetAuthorScoreResponse)(nil),        // 15: admin.v1.GetAuthorScoreResponse
+	(*ListAuthorScoresRequest)(nil),       // 16: admin.v1.ListAuthorScoresRequest
+	(*ListAuthorScoresResponse)(nil),      // 17: admin.v1.ListAuthorScoresResponse
+	(*RecomputeAuthorScoresRequest)(nil),  // 18: admin.v1.RecomputeAuthorScoresRequest
+	(*RecomputeAuthorScoresResponse)(nil), // 19: admin.v1.RecomputeAuthorScoresResponse
+	(*ListQuarantinedTweetsRequest)(nil),  // 20: admin.v1.ListQuarantinedTweetsRequest
+	(*ListQuarantinedTweetsResponse)(nil), // 21: admin.v1.ListQuarantinedTweetsResponse
+	(*ReleaseTweetRequest)(nil),           // 22: admin.v1.ReleaseTweetRequest
+	(*ReleaseTweetResponse)(nil),          // 23: admin.v1.ReleaseTweetResponse
+	(*BulkRetagSymbolsRequest)(nil),       // 24: admin.v1.BulkRetagSymbolsRequest
+	(*BulkRescoreSentimentRequest)(nil),   // 25: admin.v1.BulkRescoreSentimentRequest
+	(*BulkDeleteTweetsRequest)(nil),       // 26: admin.v1.BulkDeleteTweetsRequest
+	(*BulkExportTweetsRequest)(nil),       // 27: admin.v1.BulkExportTweetsRequest
+	(*BulkOperationResponse)(nil),         // 28: admin.v1.BulkOperationResponse
+	(*GetBulkOperationRequest)(nil),       // 29: admin.v1.GetBulkOperationRequest
+	(*ListBulkOperationsRequest)(nil),     // 30: admin.v1.ListBulkOperationsRequest
+	(*ListBulkOperationsResponse)(nil),    // 31: admin.v1.ListBulkOperationsResponse
+	(*CancelBulkOperationRequest)(nil),    // 32: admin.v1.CancelBulkOperationRequest
+	(*CancelBulkOperationResponse)(nil),   // 33: admin.v1.CancelBulkOperationResponse
+	(*Tweet)(nil),                         // 34: admin.v1.Tweet
+	(*Sentiment)(nil),                     // 35: admin.v1.Sentiment
+	(*Engagement)(nil),                    // 36: admin.v1.Engagement
+	(*AuthorScore)(nil),                   // 37: admin.v1.AuthorScore
+	(*BulkSelector)(nil),                  // 38: admin.v1.BulkSelector
+	(*TweetIDs)(nil),                      // 39: admin.v1.TweetIDs
+	(*BulkOperation)(nil),                 // 40: admin.v1.BulkOperation
 }
 var file_admin_v1_admin_proto_depIdxs = []int32{
-	14, // 0: admin.v1.CreateTweetResponse.tweet:type_name -> admin.v1.Tweet
-	14, // 1: admin.v1.GetTweetResponse.tweet:type_name -> admin.v1.Tweet
-	14, // 2: admin.v1.ListTweetsResponse.tweets:type_name -> admin.v1.Tweet
-	15, // 3: admin.v1.UpdateTweetRequest.sentiment:type_name -> admin.v1.Sentiment
-	16, // 4: admin.v1.UpdateTweetRequest.engagement:type_name -> admin.v1.Engagement
-	14, // 5: admin.v1.UpdateTweetResponse.tweet:type_name -> admin.v1.Tweet
-	14, // 6: admin.v1.GetTweetsBySymbolResponse.tweets:type_name -> admin.v1.Tweet
-	14, // 7: admin.v1.GetTweetsBySentimentResponse.tweets:type_name -> admin.v1.Tweet
-	15, // 8: admin.v1.Tweet.sentiment:type_name -> admin.v1.Sentiment
-	16, // 9: admin.v1.Tweet.engagement:type_name -> admin.v1.Engagement
-	0,  // 10: admin.v1.AdminTweetService.CreateTweet:input_type -> admin.v1.CreateTweetRequest
-	2,  // 11: admin.v1.AdminTweetService.GetTweet:input_type -> admin.v1.GetTweetRequest
-	4,  // 12: admin.v1.AdminTweetService.ListTweets:input_type -> admin.v1.ListTweetsRequest
-	6,  // 13: admin.v1.AdminTweetService.UpdateTweet:input_type -> admin.v1.UpdateTweetRequest
-	8,  // 14: admin.v1.AdminTweetService.DeleteTweet:input_type -> admin.v1.DeleteTweetRequest
-	10, // 15: admin.v1.AdminTweetService.GetTweetsBySymbol:input_type -> admin.v1.GetTweetsBySymbolRequest
-	12, // 16: admin.v1.AdminTweetService.GetTweetsBySentiment:input_type -> admin.v1.GetTweetsBySentimentRequest
-	1,  // 17: admin.v1.AdminTweetService.CreateTweet:output_type -> admin.v1.CreateTweetResponse
-	3,  // 18: admin.v1.AdminTweetService.GetTweet:output_type -> admin.v1.GetTweetResponse
-	5,  // 19: admin.v1.AdminTweetService.ListTweets:output_type -> admin.v1.ListTweetsResponse
-	7,  // 20: admin.v1.AdminTweetService.UpdateTweet:output_type -> admin.v1.UpdateTweetResponse
-	9,  // 21: admin.v1.AdminTweetService.DeleteTweet:output_type -> admin.v1.DeleteTweetResponse
-	11, // 22: admin.v1.AdminTweetService.GetTweetsBySymbol:output_type -> admin.v1.GetTweetsBySymbolResponse
-	13, // 23: admin.v1.AdminTweetService.GetTweetsBySentiment:output_type -> admin.v1.GetTweetsBySentimentResponse
-	17, // [17:24] is the sub-list for method output_type
-	10, // [10:17] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	34, // 0: admin.v1.CreateTweetResponse.tweet:type_name -> admin.v1.Tweet
+	34, // 1: admin.v1.GetTweetResponse.tweet:type_name -> admin.v1.Tweet
+	34, // 2: admin.v1.ListTweetsResponse.tweets:type_name -> admin.v1.Tweet
+	35, // 3: admin.v1.UpdateTweetRequest.sentiment:type_name -> admin.v1.Sentiment
+	36, // 4: admin.v1.UpdateTweetRequest.engagement:type_name -> admin.v1.Engagement
+	34, // 5: admin.v1.UpdateTweetResponse.tweet:type_name -> admin.v1.Tweet
+	34, // 6: admin.v1.GetTweetsBySymbolResponse.tweets:type_name -> admin.v1.Tweet
+	34, // 7: admin.v1.GetTweetsBySentimentResponse.tweets:type_name -> admin.v1.Tweet
+	37, // 8: admin.v1.GetAuthorScoreResponse.score:type_name -> admin.v1.AuthorScore
+	37, // 9: admin.v1.ListAuthorScoresResponse.scores:type_name -> admin.v1.AuthorScore
+	34, // 10: admin.v1.ListQuarantinedTweetsResponse.tweets:type_name -> admin.v1.Tweet
+	38, // 11: admin.v1.BulkRetagSymbolsRequest.selector:type_name -> admin.v1.BulkSelector
+	38, // 12: admin.v1.BulkRescoreSentimentRequest.selector:type_name -> admin.v1.BulkSelector
+	38, // 13: admin.v1.BulkDeleteTweetsRequest.selector:type_name -> admin.v1.BulkSelector
+	38, // 14: admin.v1.BulkExportTweetsRequest.selector:type_name -> admin.v1.BulkSelector
+	40, // 15: admin.v1.BulkOperationResponse.operation:type_name -> admin.v1.BulkOperation
+	40, // 16: admin.v1.ListBulkOperationsResponse.operations:type_name -> admin.v1.BulkOperation
+	35, // 17: admin.v1.Tweet.sentiment:type_name -> admin.v1.Sentiment
+	36, // 18: admin.v1.Tweet.engagement:type_name -> admin.v1.Engagement
+	4,  // 19: admin.v1.BulkSelector.filter:type_name -> admin.v1.ListTweetsRequest
+	39, // 20: admin.v1.BulkSelector.ids:type_name -> admin.v1.TweetIDs
+	0,  // 21: admin.v1.AdminTweetService.CreateTweet:input_type -> admin.v1.CreateTweetRequest
+	2,  // 22: admin.v1.AdminTweetService.GetTweet:input_type -> admin.v1.GetTweetRequest
+	4,  // 23: admin.v1.AdminTweetService.ListTweets:input_type -> admin.v1.ListTweetsRequest
+	6,  // 24: admin.v1.AdminTweetService.UpdateTweet:input_type -> admin.v1.UpdateTweetRequest
+	8,  // 25: admin.v1.AdminTweetService.DeleteTweet:input_type -> admin.v1.DeleteTweetRequest
+	10, // 26: admin.v1.AdminTweetService.GetTweetsBySymbol:input_type -> admin.v1.GetTweetsBySymbolRequest
+	12, // 27: admin.v1.AdminTweetService.GetTweetsBySentiment:input_type -> admin.v1.GetTweetsBySentimentRequest
+	14, // 28: admin.v1.AdminTweetService.GetAuthorScore:input_type -> admin.v1.GetAuthorScoreRequest
+	16, // 29: admin.v1.AdminTweetService.ListAuthorScores:input_type -> admin.v1.ListAuthorScoresRequest
+	18, // 30: admin.v1.AdminTweetService.RecomputeAuthorScores:input_type -> admin.v1.RecomputeAuthorScoresRequest
+	20, // 31: admin.v1.AdminTweetService.ListQuarantinedTweets:input_type -> admin.v1.ListQuarantinedTweetsRequest
+	22, // 32: admin.v1.AdminTweetService.ReleaseTweet:input_type -> admin.v1.ReleaseTweetRequest
+	24, // 33: admin.v1.AdminTweetService.BulkRetagSymbols:input_type -> admin.v1.BulkRetagSymbolsRequest
+	25, // 34: admin.v1.AdminTweetService.BulkRescoreSentiment:input_type -> admin.v1.BulkRescoreSentimentRequest
+	26, // 35: admin.v1.AdminTweetService.BulkDeleteTweets:input_type -> admin.v1.BulkDeleteTweetsRequest
+	27, // 36: admin.v1.AdminTweetService.BulkExportTweets:input_type -> admin.v1.BulkExportTweetsRequest
+	29, // 37: admin.v1.AdminTweetService.GetBulkOperation:input_type -> admin.v1.GetBulkOperationRequest
+	30, // 38: admin.v1.AdminTweetService.ListBulkOperations:input_type -> admin.v1.ListBulkOperationsRequest
+	32, // 39: admin.v1.AdminTweetService.CancelBulkOperation:input_type -> admin.v1.CancelBulkOperationRequest
+	1,  // 40: admin.v1.AdminTweetService.CreateTweet:output_type -> admin.v1.CreateTweetResponse
+	3,  // 41: admin.v1.AdminTweetService.GetTweet:output_type -> admin.v1.GetTweetResponse
+	5,  // 42: admin.v1.AdminTweetService.ListTweets:output_type -> admin.v1.ListTweetsResponse
+	7,  // 43: admin.v1.AdminTweetService.UpdateTweet:output_type -> admin.v1.UpdateTweetResponse
+	9,  // 44: admin.v1.AdminTweetService.DeleteTweet:output_type -> admin.v1.DeleteTweetResponse
+	11, // 45: admin.v1.AdminTweetService.GetTweetsBySymbol:output_type -> admin.v1.GetTweetsBySymbolResponse
+	13, // 46: admin.v1.AdminTweetService.GetTweetsBySentiment:output_type -> admin.v1.GetTweetsBySentimentResponse
+	15, // 47: admin.v1.AdminTweetService.GetAuthorScore:output_type -> admin.v1.GetAuthorScoreResponse
+	17, // 48: admin.v1.AdminTweetService.ListAuthorScores:output_type -> admin.v1.ListAuthorScoresResponse
+	19, // 49: admin.v1.AdminTweetService.RecomputeAuthorScores:output_type -> admin.v1.RecomputeAuthorScoresResponse
+	21, // 50: admin.v1.AdminTweetService.ListQuarantinedTweets:output_type -> admin.v1.ListQuarantinedTweetsResponse
+	23, // 51: admin.v1.AdminTweetService.ReleaseTweet:output_type -> admin.v1.ReleaseTweetResponse
+	28, // 52: admin.v1.AdminTweetService.BulkRetagSymbols:output_type -> admin.v1.BulkOperationResponse
+	28, // 53: admin.v1.AdminTweetService.BulkRescoreSentiment:output_type -> admin.v1.BulkOperationResponse
+	28, // 54: admin.v1.AdminTweetService.BulkDeleteTweets:output_type -> admin.v1.BulkOperationResponse
+	28, // 55: admin.v1.AdminTweetService.BulkExportTweets:output_type -> admin.v1.BulkOperationResponse
+	28, // 56: admin.v1.AdminTweetService.GetBulkOperation:output_type -> admin.v1.BulkOperationResponse
+	31, // 57: admin.v1.AdminTweetService.ListBulkOperations:output_type -> admin.v1.ListBulkOperationsResponse
+	33, // 58: admin.v1.AdminTweetService.CancelBulkOperation:output_type -> admin.v1.CancelBulkOperationResponse
+	40, // [40:59] is the sub-list for method output_type
+	21, // [21:40] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_admin_v1_admin_proto_init() }
@@ -1123,13 +2565,19 @@ func file_admin_v1_admin_proto_init() {
 	if File_admin_v1_admin_proto != nil {
 		return
 	}
+	file_admin_v1_admin_proto_msgTypes[4].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[37].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[38].OneofWrappers = []any{
+		(*BulkSelector_Filter)(nil),
+		(*BulkSelector_Ids)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminTweetService_CreateTweet_FullMethodName          = "/admin.v1.AdminTweetService/CreateTweet"
-	AdminTweetService_GetTweet_FullMethodName             = "/admin.v1.AdminTweetService/GetTweet"
-	AdminTweetService_ListTweets_FullMethodName           = "/admin.v1.AdminTweetService/ListTweets"
-	AdminTweetService_UpdateTweet_FullMethodName          = "/admin.v1.AdminTweetService/UpdateTweet"
-	AdminTweetService_DeleteTweet_FullMethodName          = "/admin.v1.AdminTweetService/DeleteTweet"
-	AdminTweetService_GetTweetsBySymbol_FullMethodName    = "/admin.v1.AdminTweetService/GetTweetsBySymbol"
-	AdminTweetService_GetTweetsBySentiment_FullMethodName = "/admin.v1.AdminTweetService/GetTweetsBySentiment"
+	AdminTweetService_CreateTweet_FullMethodName           = "/admin.v1.AdminTweetService/CreateTweet"
+	AdminTweetService_GetTweet_FullMethodName              = "/admin.v1.AdminTweetService/GetTweet"
+	AdminTweetService_ListTweets_FullMethodName            = "/admin.v1.AdminTweetService/ListTweets"
+	AdminTweetService_UpdateTweet_FullMethodName           = "/admin.v1.AdminTweetService/UpdateTweet"
+	AdminTweetService_DeleteTweet_FullMethodName           = "/admin.v1.AdminTweetService/DeleteTweet"
+	AdminTweetService_GetTweetsBySymbol_FullMethodName     = "/admin.v1.AdminTweetService/GetTweetsBySymbol"
+	AdminTweetService_GetTweetsBySentiment_FullMethodName  = "/admin.v1.AdminTweetService/GetTweetsBySentiment"
+	AdminTweetService_GetAuthorScore_FullMethodName        = "/admin.v1.AdminTweetService/GetAuthorScore"
+	AdminTweetService_ListAuthorScores_FullMethodName      = "/admin.v1.AdminTweetService/ListAuthorScores"
+	AdminTweetService_RecomputeAuthorScores_FullMethodName = "/admin.v1.AdminTweetService/RecomputeAuthorScores"
+	AdminTweetService_ListQuarantinedTweets_FullMethodName = "/admin.v1.AdminTweetService/ListQuarantinedTweets"
+	AdminTweetService_ReleaseTweet_FullMethodName          = "/admin.v1.AdminTweetService/ReleaseTweet"
+	AdminTweetService_BulkRetagSymbols_FullMethodName      = "/admin.v1.AdminTweetService/BulkRetagSymbols"
+	AdminTweetService_BulkRescoreSentiment_FullMethodName  = "/admin.v1.AdminTweetService/BulkRescoreSentiment"
+	AdminTweetService_BulkDeleteTweets_FullMethodName      = "/admin.v1.AdminTweetService/BulkDeleteTweets"
+	AdminTweetService_BulkExportTweets_FullMethodName      = "/admin.v1.AdminTweetService/BulkExportTweets"
+	AdminTweetService_GetBulkOperation_FullMethodName      = "/admin.v1.AdminTweetService/GetBulkOperation"
+	AdminTweetService_ListBulkOperations_FullMethodName    = "/admin.v1.AdminTweetService/ListBulkOperations"
+	AdminTweetService_CancelBulkOperation_FullMethodName   = "/admin.v1.AdminTweetService/CancelBulkOperation"
 )
 
 // AdminTweetServiceClient is the client API for AdminTweetService service.
@@ -48,6 +60,30 @@ type AdminTweetServiceClient interface {
 	GetTweetsBySymbol(ctx context.Context, in *GetTweetsBySymbolRequest, opts ...grpc.CallOption) (*GetTweetsBySymbolResponse, error)
 	// GetTweetsBySentiment retrieves tweets with specific sentiment
 	GetTweetsBySentiment(ctx context.Context, in *GetTweetsBySentimentRequest, opts ...grpc.CallOption) (*GetTweetsBySentimentResponse, error)
+	// GetAuthorScore retrieves the influence and credibility score of an author
+	GetAuthorScore(ctx context.Context, in *GetAuthorScoreRequest, opts ...grpc.CallOption) (*GetAuthorScoreResponse, error)
+	// ListAuthorScores retrieves authors ranked by score
+	ListAuthorScores(ctx context.Context, in *ListAuthorScoresRequest, opts ...grpc.CallOption) (*ListAuthorScoresResponse, error)
+	// RecomputeAuthorScores runs the author scoring job immediately
+	RecomputeAuthorScores(ctx context.Context, in *RecomputeAuthorScoresRequest, opts ...grpc.CallOption) (*RecomputeAuthorScoresResponse, error)
+	// ListQuarantinedTweets retrieves tweets quarantined by the spam filter
+	ListQuarantinedTweets(ctx context.Context, in *ListQuarantinedTweetsRequest, opts ...grpc.CallOption) (*ListQuarantinedTweetsResponse, error)
+	// ReleaseTweet releases a quarantined tweet after review
+	ReleaseTweet(ctx context.Context, in *ReleaseTweetRequest, opts ...grpc.CallOption) (*ReleaseTweetResponse, error)
+	// BulkRetagSymbols starts adding and removing symbols on selected tweets
+	BulkRetagSymbols(ctx context.Context, in *BulkRetagSymbolsRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// BulkRescoreSentiment starts re-scoring the sentiment of selected tweets with ml-service
+	BulkRescoreSentiment(ctx context.Context, in *BulkRescoreSentimentRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// BulkDeleteTweets starts deleting selected tweets
+	BulkDeleteTweets(ctx context.Context, in *BulkDeleteTweetsRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// BulkExportTweets starts exporting selected tweets into a JSONL file
+	BulkExportTweets(ctx context.Context, in *BulkExportTweetsRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// GetBulkOperation retrieves a bulk operation with its progress
+	GetBulkOperation(ctx context.Context, in *GetBulkOperationRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// ListBulkOperations retrieves bulk operations, newest first
+	ListBulkOperations(ctx context.Context, in *ListBulkOperationsRequest, opts ...grpc.CallOption) (*ListBulkOperationsResponse, error)
+	// CancelBulkOperation stops a running bulk operation
+	CancelBulkOperation(ctx context.Context, in *CancelBulkOperationRequest, opts ...grpc.CallOption) (*CancelBulkOperationResponse, error)
 }
 
 type adminTweetServiceClient struct {
@@ -128,6 +164,126 @@ func (c *adminTweetServiceClient) GetTweetsBySentiment(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *adminTweetServiceClient) GetAuthorScore(ctx context.Context, in *GetAuthorScoreRequest, opts ...grpc.CallOption) (*GetAuthorScoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuthorScoreResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_GetAuthorScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) ListAuthorScores(ctx context.Context, in *ListAuthorScoresRequest, opts ...grpc.CallOption) (*ListAuthorScoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthorScoresResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_ListAuthorScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) RecomputeAuthorScores(ctx context.Context, in *RecomputeAuthorScoresRequest, opts ...grpc.CallOption) (*RecomputeAuthorScoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeAuthorScoresResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_RecomputeAuthorScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) ListQuarantinedTweets(ctx context.Context, in *ListQuarantinedTweetsRequest, opts ...grpc.CallOption) (*ListQuarantinedTweetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuarantinedTweetsResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_ListQuarantinedTweets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) ReleaseTweet(ctx context.Context, in *ReleaseTweetRequest, opts ...grpc.CallOption) (*ReleaseTweetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseTweetResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_ReleaseTweet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) BulkRetagSymbols(ctx context.Context, in *BulkRetagSymbolsRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_BulkRetagSymbols_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) BulkRescoreSentiment(ctx context.Context, in *BulkRescoreSentimentRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_BulkRescoreSentiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) BulkDeleteTweets(ctx context.Context, in *BulkDeleteTweetsRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_BulkDeleteTweets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) BulkExportTweets(ctx context.Context, in *BulkExportTweetsRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_BulkExportTweets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) GetBulkOperation(ctx context.Context, in *GetBulkOperationRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_GetBulkOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) ListBulkOperations(ctx context.Context, in *ListBulkOperationsRequest, opts ...grpc.CallOption) (*ListBulkOperationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBulkOperationsResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_ListBulkOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminTweetServiceClient) CancelBulkOperation(ctx context.Context, in *CancelBulkOperationRequest, opts ...grpc.CallOption) (*CancelBulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBulkOperationResponse)
+	err := c.cc.Invoke(ctx, AdminTweetService_CancelBulkOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminTweetServiceServer is the server API for AdminTweetService service.
 // All implementations must embed UnimplementedAdminTweetServiceServer
 // for forward compatibility.
@@ -148,6 +304,30 @@ type AdminTweetServiceServer interface {
 	GetTweetsBySymbol(context.Context, *GetTweetsBySymbolRequest) (*GetTweetsBySymbolResponse, error)
 	// GetTweetsBySentiment retrieves tweets with specific sentiment
 	GetTweetsBySentiment(context.Context, *GetTweetsBySentimentRequest) (*GetTweetsBySentimentResponse, error)
+	// GetAuthorScore retrieves the influence and credibility score of an author
+	GetAuthorScore(context.Context, *GetAuthorScoreRequest) (*GetAuthorScoreResponse, error)
+	// ListAuthorScores retrieves authors ranked by score
+	ListAuthorScores(context.Context, *ListAuthorScoresRequest) (*ListAuthorScoresResponse, error)
+	// RecomputeAuthorScores runs the author scoring job immediately
+	RecomputeAuthorScores(context.Context, *RecomputeAuthorScoresRequest) (*RecomputeAuthorScoresResponse, error)
+	// ListQuarantinedTweets retrieves tweets quarantined by the spam filter
+	ListQuarantinedTweets(context.Context, *ListQuarantinedTweetsRequest) (*ListQuarantinedTweetsResponse, error)
+	// ReleaseTweet releases a quarantined tweet after review
+	ReleaseTweet(context.Context, *ReleaseTweetRequest) (*ReleaseTweetResponse, error)
+	// BulkRetagSymbols starts adding and removing symbols on selected tweets
+	BulkRetagSymbols(context.Context, *BulkRetagSymbolsRequest) (*BulkOperationResponse, error)
+	// BulkRescoreSentiment starts re-scoring the sentiment of selected tweets with ml-service
+	BulkRescoreSentiment(context.Context, *BulkRescoreSentimentRequest) (*BulkOperationResponse, error)
+	// BulkDeleteTweets starts deleting selected tweets
+	BulkDeleteTweets(context.Context, *BulkDeleteTweetsRequest) (*BulkOperationResponse, error)
+	// BulkExportTweets starts exporting selected tweets into a JSONL file
+	BulkExportTweets(context.Context, *BulkExportTweetsRequest) (*BulkOperationResponse, error)
+	// GetBulkOperation retrieves a bulk operation with its progress
+	GetBulkOperation(context.Context, *GetBulkOperationRequest) (*BulkOperationResponse, error)
+	// ListBulkOperations retrieves bulk operations, newest first
+	ListBulkOperations(context.Context, *ListBulkOperationsRequest) (*ListBulkOperationsResponse, error)
+	// CancelBulkOperation stops a running bulk operation
+	CancelBulkOperation(context.Context, *CancelBulkOperationRequest) (*CancelBulkOperationResponse, error)
 	mustEmbedUnimplementedAdminTweetServiceServer()
 }
 
@@ -179,6 +359,42 @@ func (UnimplementedAdminTweetServiceServer) GetTweetsBySymbol(context.Context, *
 func (UnimplementedAdminTweetServiceServer) GetTweetsBySentiment(context.Context, *GetTweetsBySentimentRequest) (*GetTweetsBySentimentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTweetsBySentiment not implemented")
 }
+func (UnimplementedAdminTweetServiceServer) GetAuthorScore(context.Context, *GetAuthorScoreRequest) (*GetAuthorScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorScore not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) ListAuthorScores(context.Context, *ListAuthorScoresRequest) (*ListAuthorScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorScores not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) RecomputeAuthorScores(context.Context, *RecomputeAuthorScoresRequest) (*RecomputeAuthorScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeAuthorScores not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) ListQuarantinedTweets(context.Context, *ListQuarantinedTweetsRequest) (*ListQuarantinedTweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedTweets not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) ReleaseTweet(context.Context, *ReleaseTweetRequest) (*ReleaseTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseTweet not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) BulkRetagSymbols(context.Context, *BulkRetagSymbolsRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkRetagSymbols not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) BulkRescoreSentiment(context.Context, *BulkRescoreSentimentRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkRescoreSentiment not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) BulkDeleteTweets(context.Context, *BulkDeleteTweetsRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteTweets not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) BulkExportTweets(context.Context, *BulkExportTweetsRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkExportTweets not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) GetBulkOperation(context.Context, *GetBulkOperationRequest) (*BulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkOperation not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) ListBulkOperations(context.Context, *ListBulkOperationsRequest) (*ListBulkOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBulkOperations not implemented")
+}
+func (UnimplementedAdminTweetServiceServer) CancelBulkOperation(context.Context, *CancelBulkOperationRequest) (*CancelBulkOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBulkOperation not implemented")
+}
 func (UnimplementedAdminTweetServiceServer) mustEmbedUnimplementedAdminTweetServiceServer() {}
 func (UnimplementedAdminTweetServiceServer) testEmbeddedByValue()                           {}
 
@@ -326,6 +542,222 @@ func _AdminTweetService_GetTweetsBySentiment_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminTweetService_GetAuthorScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).GetAuthorScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_GetAuthorScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).GetAuthorScore(ctx, req.(*GetAuthorScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_ListAuthorScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).ListAuthorScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_ListAuthorScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).ListAuthorScores(ctx, req.(*ListAuthorScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_RecomputeAuthorScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeAuthorScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).RecomputeAuthorScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_RecomputeAuthorScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).RecomputeAuthorScores(ctx, req.(*RecomputeAuthorScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_ListQuarantinedTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedTweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).ListQuarantinedTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_ListQuarantinedTweets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).ListQuarantinedTweets(ctx, req.(*ListQuarantinedTweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_ReleaseTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).ReleaseTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_ReleaseTweet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).ReleaseTweet(ctx, req.(*ReleaseTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_BulkRetagSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRetagSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).BulkRetagSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_BulkRetagSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).BulkRetagSymbols(ctx, req.(*BulkRetagSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_BulkRescoreSentiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRescoreSentimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).BulkRescoreSentiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_BulkRescoreSentiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).BulkRescoreSentiment(ctx, req.(*BulkRescoreSentimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_BulkDeleteTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteTweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).BulkDeleteTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_BulkDeleteTweets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).BulkDeleteTweets(ctx, req.(*BulkDeleteTweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_BulkExportTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkExportTweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).BulkExportTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_BulkExportTweets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).BulkExportTweets(ctx, req.(*BulkExportTweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_GetBulkOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).GetBulkOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_GetBulkOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).GetBulkOperation(ctx, req.(*GetBulkOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_ListBulkOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBulkOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).ListBulkOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_ListBulkOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).ListBulkOperations(ctx, req.(*ListBulkOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminTweetService_CancelBulkOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBulkOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminTweetServiceServer).CancelBulkOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminTweetService_CancelBulkOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminTweetServiceServer).CancelBulkOperation(ctx, req.(*CancelBulkOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminTweetService_ServiceDesc is the grpc.ServiceDesc for AdminTweetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,6 +793,54 @@ var AdminTweetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTweetsBySentiment",
 			Handler:    _AdminTweetService_GetTweetsBySentiment_Handler,
 		},
+		{
+			MethodName: "GetAuthorScore",
+			Handler:    _AdminTweetService_GetAuthorScore_Handler,
+		},
+		{
+			MethodName: "ListAuthorScores",
+			Handler:    _AdminTweetService_ListAuthorScores_Handler,
+		},
+		{
+			MethodName: "RecomputeAuthorScores",
+			Handler:    _AdminTweetService_RecomputeAuthorScores_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedTweets",
+			Handler:    _AdminTweetService_ListQuarantinedTweets_Handler,
+		},
+		{
+			MethodName: "ReleaseTweet",
+			Handler:    _AdminTweetService_ReleaseTweet_Handler,
+		},
+		{
+			MethodName: "BulkRetagSymbols",
+			Handler:    _AdminTweetService_BulkRetagSymbols_Handler,
+		},
+		{
+			MethodName: "BulkRescoreSentiment",
+			Handler:    _AdminTweetService_BulkRescoreSentiment_Handler,
+		},
+		{
+			MethodName: "BulkDeleteTweets",
+			Handler:    _AdminTweetService_BulkDeleteTweets_Handler,
+		},
+		{
+			MethodName: "BulkExportTweets",
+			Handler:    _AdminTweetService_BulkExportTweets_Handler,
+		},
+		{
+			MethodName: "GetBulkOperation",
+			Handler:    _AdminTweetService_GetBulkOperation_Handler,
+		},
+		{
+			MethodName: "ListBulkOperations",
+			Handler:    _AdminTweetService_ListBulkOperations_Handler,
+		},
+		{
+			MethodName: "CancelBulkOperation",
+			Handler:    _AdminTweetService_CancelBulkOperation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/admin.proto",
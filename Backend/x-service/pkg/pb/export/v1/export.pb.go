@@ -0,0 +1,762 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: export/v1/export.proto
+
+package exportpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// --- ADVANCED MESSAGES ---
+type Format int32
+
+const (
+	Format_FORMAT_UNSPECIFIED Format = 0 // CSV
+	Format_FORMAT_CSV         Format = 1
+	Format_FORMAT_JSONL       Format = 2
+	Format_FORMAT_PARQUET     Format = 3
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_CSV",
+		2: "FORMAT_JSONL",
+		3: "FORMAT_PARQUET",
+	}
+	Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_CSV":         1,
+		"FORMAT_JSONL":       2,
+		"FORMAT_PARQUET":     3,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_export_v1_export_proto_enumTypes[0].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_export_v1_export_proto_enumTypes[0]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{0}
+}
+
+// --- REQUESTS & RESPONSES ---
+type ExportTweetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        Format                 `protobuf:"varint,1,opt,name=format,proto3,enum=export.v1.Format" json:"format,omitempty"`
+	Filter        *TweetFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTweetsRequest) Reset() {
+	*x = ExportTweetsRequest{}
+	mi := &file_export_v1_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTweetsRequest) ProtoMessage() {}
+
+func (x *ExportTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_export_v1_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTweetsRequest.ProtoReflect.Descriptor instead.
+func (*ExportTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportTweetsRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportTweetsRequest) GetFilter() *TweetFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ExportSentimentDailyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        Format                 `protobuf:"varint,1,opt,name=format,proto3,enum=export.v1.Format" json:"format,omitempty"`
+	Filter        *SentimentDailyFilter  `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSentimentDailyRequest) Reset() {
+	*x = ExportSentimentDailyRequest{}
+	mi := &file_export_v1_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSentimentDailyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSentimentDailyRequest) ProtoMessage() {}
+
+func (x *ExportSentimentDailyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_export_v1_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSentimentDailyRequest.ProtoReflect.Descriptor instead.
+func (*ExportSentimentDailyRequest) Descriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportSentimentDailyRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportSentimentDailyRequest) GetFilter() *SentimentDailyFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ExportChunk is either the header, sent once as the first message, or a piece of
+// the file. Concatenated data of all chunks is a complete file in the requested format
+type ExportChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExportChunk_Header
+	//	*ExportChunk_Data
+	Payload       isExportChunk_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_export_v1_export_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_export_v1_export_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportChunk) GetPayload() isExportChunk_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetHeader() *ExportHeader {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportChunk_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportChunk_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isExportChunk_Payload interface {
+	isExportChunk_Payload()
+}
+
+type ExportChunk_Header struct {
+	Header *ExportHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type ExportChunk_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*ExportChunk_Header) isExportChunk_Payload() {}
+
+func (*ExportChunk_Data) isExportChunk_Payload() {}
+
+type ExportToFileRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format Format                 `protobuf:"varint,1,opt,name=format,proto3,enum=export.v1.Format" json:"format,omitempty"`
+	// Types that are valid to be assigned to Dataset:
+	//
+	//	*ExportToFileRequest_Tweets
+	//	*ExportToFileRequest_SentimentDaily
+	Dataset       isExportToFileRequest_Dataset `protobuf_oneof:"dataset"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportToFileRequest) Reset() {
+	*x = ExportToFileRequest{}
+	mi := &file_export_v1_export_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportToFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToFileRequest) ProtoMessage() {}
+
+func (x *ExportToFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_export_v1_export_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToFileRequest.ProtoReflect.Descriptor instead.
+func (*ExportToFileRequest) Descriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportToFileRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportToFileRequest) GetDataset() isExportToFileRequest_Dataset {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *ExportToFileRequest) GetTweets() *TweetFilter {
+	if x != nil {
+		if x, ok := x.Dataset.(*ExportToFileRequest_Tweets); ok {
+			return x.Tweets
+		}
+	}
+	return nil
+}
+
+func (x *ExportToFileRequest) GetSentimentDaily() *SentimentDailyFilter {
+	if x != nil {
+		if x, ok := x.Dataset.(*ExportToFileRequest_SentimentDaily); ok {
+			return x.SentimentDaily
+		}
+	}
+	return nil
+}
+
+type isExportToFileRequest_Dataset interface {
+	isExportToFileRequest_Dataset()
+}
+
+type ExportToFileRequest_Tweets struct {
+	Tweets *TweetFilter `protobuf:"bytes,2,opt,name=tweets,proto3,oneof"`
+}
+
+type ExportToFileRequest_SentimentDaily struct {
+	SentimentDaily *SentimentDailyFilter `protobuf:"bytes,3,opt,name=sentiment_daily,json=sentimentDaily,proto3,oneof"`
+}
+
+func (*ExportToFileRequest_Tweets) isExportToFileRequest_Dataset() {}
+
+func (*ExportToFileRequest_SentimentDaily) isExportToFileRequest_Dataset() {}
+
+type ExportToFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Rows          int64                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Header        *ExportHeader          `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportToFileResponse) Reset() {
+	*x = ExportToFileResponse{}
+	mi := &file_export_v1_export_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportToFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToFileResponse) ProtoMessage() {}
+
+func (x *ExportToFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_export_v1_export_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToFileResponse.ProtoReflect.Descriptor instead.
+func (*ExportToFileResponse) Descriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExportToFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ExportToFileResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ExportToFileResponse) GetHeader() *ExportHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type ExportHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        string                 `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`   // versioned schema id, e.g. "tweets/v1"
+	Columns       []string               `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"` // column names in file order
+	Format        Format                 `protobuf:"varint,3,opt,name=format,proto3,enum=export.v1.Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportHeader) Reset() {
+	*x = ExportHeader{}
+	mi := &file_export_v1_export_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportHeader) ProtoMessage() {}
+
+func (x *ExportHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_export_v1_export_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportHeader.ProtoReflect.Descriptor instead.
+func (*ExportHeader) Descriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportHeader) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ExportHeader) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportHeader) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+type TweetFilter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId       string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	IsFinancial    *bool                  `protobuf:"varint,2,opt,name=is_financial,json=isFinancial,proto3,oneof" json:"is_financial,omitempty"`
+	SentimentLabel string                 `protobuf:"bytes,3,opt,name=sentiment_label,json=sentimentLabel,proto3" json:"sentiment_label,omitempty"`
+	Symbols        []string               `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	StartTime      int64                  `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        int64                  `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Limit          int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"` // 0 exports all matching tweets
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TweetFilter) Reset() {
+	*x = TweetFilter{}
+	mi := &file_export_v1_export_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TweetFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TweetFilter) ProtoMessage() {}
+
+func (x *TweetFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_export_v1_export_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TweetFilter.ProtoReflect.Descriptor instead.
+func (*TweetFilter) Descriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TweetFilter) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *TweetFilter) GetIsFinancial() bool {
+	if x != nil && x.IsFinancial != nil {
+		return *x.IsFinancial
+	}
+	return false
+}
+
+func (x *TweetFilter) GetSentimentLabel() string {
+	if x != nil {
+		return x.SentimentLabel
+	}
+	return ""
+}
+
+func (x *TweetFilter) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *TweetFilter) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *TweetFilter) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *TweetFilter) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SentimentDailyFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"` // all symbols if empty
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`      // unix seconds, inclusive day
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`          // unix seconds, inclusive day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentimentDailyFilter) Reset() {
+	*x = SentimentDailyFilter{}
+	mi := &file_export_v1_export_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentimentDailyFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentimentDailyFilter) ProtoMessage() {}
+
+func (x *SentimentDailyFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_export_v1_export_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentDailyFilter.ProtoReflect.Descriptor instead.
+func (*SentimentDailyFilter) Descriptor() ([]byte, []int) {
+	return file_export_v1_export_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SentimentDailyFilter) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *SentimentDailyFilter) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SentimentDailyFilter) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+var File_export_v1_export_proto protoreflect.FileDescriptor
+
+const file_export_v1_export_proto_rawDesc = "" +
+	"\n" +
+	"\x16export/v1/export.proto\x12\texport.v1\"p\n" +
+	"\x13ExportTweetsRequest\x12)\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x11.export.v1.FormatR\x06format\x12.\n" +
+	"\x06filter\x18\x02 \x01(\v2\x16.export.v1.TweetFilterR\x06filter\"\x81\x01\n" +
+	"\x1bExportSentimentDailyRequest\x12)\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x11.export.v1.FormatR\x06format\x127\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1f.export.v1.SentimentDailyFilterR\x06filter\"a\n" +
+	"\vExportChunk\x121\n" +
+	"\x06header\x18\x01 \x01(\v2\x17.export.v1.ExportHeaderH\x00R\x06header\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\t\n" +
+	"\apayload\"\xc9\x01\n" +
+	"\x13ExportToFileRequest\x12)\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x11.export.v1.FormatR\x06format\x120\n" +
+	"\x06tweets\x18\x02 \x01(\v2\x16.export.v1.TweetFilterH\x00R\x06tweets\x12J\n" +
+	"\x0fsentiment_daily\x18\x03 \x01(\v2\x1f.export.v1.SentimentDailyFilterH\x00R\x0esentimentDailyB\t\n" +
+	"\adataset\"o\n" +
+	"\x14ExportToFileResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\x03R\x04rows\x12/\n" +
+	"\x06header\x18\x03 \x01(\v2\x17.export.v1.ExportHeaderR\x06header\"k\n" +
+	"\fExportHeader\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12)\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x11.export.v1.FormatR\x06format\"\xf6\x01\n" +
+	"\vTweetFilter\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12&\n" +
+	"\fis_financial\x18\x02 \x01(\bH\x00R\visFinancial\x88\x01\x01\x12'\n" +
+	"\x0fsentiment_label\x18\x03 \x01(\tR\x0esentimentLabel\x12\x18\n" +
+	"\asymbols\x18\x04 \x03(\tR\asymbols\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\x03R\aendTime\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limitB\x0f\n" +
+	"\r_is_financial\"T\n" +
+	"\x14SentimentDailyFilter\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to*V\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"FORMAT_CSV\x10\x01\x12\x10\n" +
+	"\fFORMAT_JSONL\x10\x02\x12\x12\n" +
+	"\x0eFORMAT_PARQUET\x10\x032\x8a\x02\n" +
+	"\rExportService\x12J\n" +
+	"\fExportTweets\x12\x1e.export.v1.ExportTweetsRequest\x1a\x16.export.v1.ExportChunk\"\x000\x01\x12Z\n" +
+	"\x14ExportSentimentDaily\x12&.export.v1.ExportSentimentDailyRequest\x1a\x16.export.v1.ExportChunk\"\x000\x01\x12Q\n" +
+	"\fExportToFile\x12\x1e.export.v1.ExportToFileRequest\x1a\x1f.export.v1.ExportToFileResponse\"\x00BRZPgithub.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/export/v1;exportpbb\x06proto3"
+
+var (
+	file_export_v1_export_proto_rawDescOnce sync.Once
+	file_export_v1_export_proto_rawDescData []byte
+)
+
+func file_export_v1_export_proto_rawDescGZIP() []byte {
+	file_export_v1_export_proto_rawDescOnce.Do(func() {
+		file_export_v1_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_export_v1_export_proto_rawDesc), len(file_export_v1_export_proto_rawDesc)))
+	})
+	return file_export_v1_export_proto_rawDescData
+}
+
+var file_export_v1_export_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_export_v1_export_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_export_v1_export_proto_goTypes = []any{
+	(Format)(0),                         // 0: export.v1.Format
+	(*ExportTweetsRequest)(nil),         // 1: export.v1.ExportTweetsRequest
+	(*ExportSentimentDailyRequest)(nil), // 2: export.v1.ExportSentimentDailyRequest
+	(*ExportChunk)(nil),                 // 3: export.v1.ExportChunk
+	(*ExportToFileRequest)(nil),         // 4: export.v1.ExportToFileRequest
+	(*ExportToFileResponse)(nil),        // 5: export.v1.ExportToFileResponse
+	(*ExportHeader)(nil),                // 6: export.v1.ExportHeader
+	(*TweetFilter)(nil),                 // 7: export.v1.TweetFilter
+	(*SentimentDailyFilter)(nil),        // 8: export.v1.SentimentDailyFilter
+}
+var file_export_v1_export_proto_depIdxs = []int32{
+	0,  // 0: export.v1.ExportTweetsRequest.format:type_name -> export.v1.Format
+	7,  // 1: export.v1.ExportTweetsRequest.filter:type_name -> export.v1.TweetFilter
+	0,  // 2: export.v1.ExportSentimentDailyRequest.format:type_name -> export.v1.Format
+	8,  // 3: export.v1.ExportSentimentDailyRequest.filter:type_name -> export.v1.SentimentDailyFilter
+	6,  // 4: export.v1.ExportChunk.header:type_name -> export.v1.ExportHeader
+	0,  // 5: export.v1.ExportToFileRequest.format:type_name -> export.v1.Format
+	7,  // 6: export.v1.ExportToFileRequest.tweets:type_name -> export.v1.TweetFilter
+	8,  // 7: export.v1.ExportToFileRequest.sentiment_daily:type_name -> export.v1.SentimentDailyFilter
+	6,  // 8: export.v1.ExportToFileResponse.header:type_name -> export.v1.ExportHeader
+	0,  // 9: export.v1.ExportHeader.format:type_name -> export.v1.Format
+	1,  // 10: export.v1.ExportService.ExportTweets:input_type -> export.v1.ExportTweetsRequest
+	2,  // 11: export.v1.ExportService.ExportSentimentDaily:input_type -> export.v1.ExportSentimentDailyRequest
+	4,  // 12: export.v1.ExportService.ExportToFile:input_type -> export.v1.ExportToFileRequest
+	3,  // 13: export.v1.ExportService.ExportTweets:output_type -> export.v1.ExportChunk
+	3,  // 14: export.v1.ExportService.ExportSentimentDaily:output_type -> export.v1.ExportChunk
+	5,  // 15: export.v1.ExportService.ExportToFile:output_type -> export.v1.ExportToFileResponse
+	13, // [13:16] is the sub-list for method output_type
+	10, // [10:13] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_export_v1_export_proto_init() }
+func file_export_v1_export_proto_init() {
+	if File_export_v1_export_proto != nil {
+		return
+	}
+	file_export_v1_export_proto_msgTypes[2].OneofWrappers = []any{
+		(*ExportChunk_Header)(nil),
+		(*ExportChunk_Data)(nil),
+	}
+	file_export_v1_export_proto_msgTypes[3].OneofWrappers = []any{
+		(*ExportToFileRequest_Tweets)(nil),
+		(*ExportToFileRequest_SentimentDaily)(nil),
+	}
+	file_export_v1_export_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_export_v1_export_proto_rawDesc), len(file_export_v1_export_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_export_v1_export_proto_goTypes,
+		DependencyIndexes: file_export_v1_export_proto_depIdxs,
+		EnumInfos:         file_export_v1_export_proto_enumTypes,
+		MessageInfos:      file_export_v1_export_proto_msgTypes,
+	}.Build()
+	File_export_v1_export_proto = out.File
+	file_export_v1_export_proto_goTypes = nil
+	file_export_v1_export_proto_depIdxs = nil
+}
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: export/v1/export.proto
+
+package exportpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ExportService_ExportTweets_FullMethodName         = "/export.v1.ExportService/ExportTweets"
+	ExportService_ExportSentimentDaily_FullMethodName = "/export.v1.ExportService/ExportSentimentDaily"
+	ExportService_ExportToFile_FullMethodName         = "/export.v1.ExportService/ExportToFile"
+)
+
+// ExportServiceClient is the client API for ExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// --- SERVICE ---
+type ExportServiceClient interface {
+	// ExportTweets streams tweets matching the filter as a file in the requested format
+	ExportTweets(ctx context.Context, in *ExportTweetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	// ExportSentimentDaily streams daily sentiment aggregates as a file in the requested format
+	ExportSentimentDaily(ctx context.Context, in *ExportSentimentDailyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	// ExportToFile writes a dataset into a file on the server export volume
+	ExportToFile(ctx context.Context, in *ExportToFileRequest, opts ...grpc.CallOption) (*ExportToFileResponse, error)
+}
+
+type exportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExportServiceClient(cc grpc.ClientConnInterface) ExportServiceClient {
+	return &exportServiceClient{cc}
+}
+
+func (c *exportServiceClient) ExportTweets(ctx context.Context, in *ExportTweetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[0], ExportService_ExportTweets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTweetsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExportService_ExportTweetsClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *exportServiceClient) ExportSentimentDaily(ctx context.Context, in *ExportSentimentDailyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[1], ExportService_ExportSentimentDaily_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportSentimentDailyRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExportService_ExportSentimentDailyClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *exportServiceClient) ExportToFile(ctx context.Context, in *ExportToFileRequest, opts ...grpc.CallOption) (*ExportToFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportToFileResponse)
+	err := c.cc.Invoke(ctx, ExportService_ExportToFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExportServiceServer is the server API for ExportService service.
+// All implementations must embed UnimplementedExportServiceServer
+// for forward compatibility.
+//
+// --- SERVICE ---
+type ExportServiceServer interface {
+	// ExportTweets streams tweets matching the filter as a file in the requested format
+	ExportTweets(*ExportTweetsRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	// ExportSentimentDaily streams daily sentiment aggregates as a file in the requested format
+	ExportSentimentDaily(*ExportSentimentDailyRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	// ExportToFile writes a dataset into a file on the server export volume
+	ExportToFile(context.Context, *ExportToFileRequest) (*ExportToFileResponse, error)
+	mustEmbedUnimplementedExportServiceServer()
+}
+
+// UnimplementedExportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExportServiceServer struct{}
+
+func (UnimplementedExportServiceServer) ExportTweets(*ExportTweetsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTweets not implemented")
+}
+func (UnimplementedExportServiceServer) ExportSentimentDaily(*ExportSentimentDailyRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSentimentDaily not implemented")
+}
+func (UnimplementedExportServiceServer) ExportToFile(context.Context, *ExportToFileRequest) (*ExportToFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportToFile not implemented")
+}
+func (UnimplementedExportServiceServer) mustEmbedUnimplementedExportServiceServer() {}
+func (UnimplementedExportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExportServiceServer will
+// result in compilation errors.
+type UnsafeExportServiceServer interface {
+	mustEmbedUnimplementedExportServiceServer()
+}
+
+func RegisterExportServiceServer(s grpc.ServiceRegistrar, srv ExportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExportService_ServiceDesc, srv)
+}
+
+func _ExportService_ExportTweets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTweetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).ExportTweets(m, &grpc.GenericServerStream[ExportTweetsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExportService_ExportTweetsServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _ExportService_ExportSentimentDaily_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSentimentDailyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).ExportSentimentDaily(m, &grpc.GenericServerStream[ExportSentimentDailyRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExportService_ExportSentimentDailyServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _ExportService_ExportToFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportToFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).ExportToFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_ExportToFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).ExportToFile(ctx, req.(*ExportToFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExportService_ServiceDesc is the grpc.ServiceDesc for ExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "export.v1.ExportService",
+	HandlerType: (*ExportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportToFile",
+			Handler:    _ExportService_ExportToFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTweets",
+			Handler:       _ExportService_ExportTweets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportSentimentDaily",
+			Handler:       _ExportService_ExportSentimentDaily_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "export/v1/export.proto",
+}
@@ -0,0 +1,914 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: ml/v1/ml_service.proto
+
+package mlpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// --- REQUESTS & RESPONSES ---
+// Sentiment Analysis
+type SentimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentimentRequest) Reset() {
+	*x = SentimentRequest{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentimentRequest) ProtoMessage() {}
+
+func (x *SentimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentRequest.ProtoReflect.Descriptor instead.
+func (*SentimentRequest) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SentimentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SentimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positive      float32                `protobuf:"fixed32,1,opt,name=positive,proto3" json:"positive,omitempty"`
+	Neutral       float32                `protobuf:"fixed32,2,opt,name=neutral,proto3" json:"neutral,omitempty"`
+	Negative      float32                `protobuf:"fixed32,3,opt,name=negative,proto3" json:"negative,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentimentResponse) Reset() {
+	*x = SentimentResponse{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentimentResponse) ProtoMessage() {}
+
+func (x *SentimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentResponse.ProtoReflect.Descriptor instead.
+func (*SentimentResponse) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SentimentResponse) GetPositive() float32 {
+	if x != nil {
+		return x.Positive
+	}
+	return 0
+}
+
+func (x *SentimentResponse) GetNeutral() float32 {
+	if x != nil {
+		return x.Neutral
+	}
+	return 0
+}
+
+func (x *SentimentResponse) GetNegative() float32 {
+	if x != nil {
+		return x.Negative
+	}
+	return 0
+}
+
+type BatchSentimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Texts         []string               `protobuf:"bytes,1,rep,name=texts,proto3" json:"texts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSentimentRequest) Reset() {
+	*x = BatchSentimentRequest{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSentimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSentimentRequest) ProtoMessage() {}
+
+func (x *BatchSentimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSentimentRequest.ProtoReflect.Descriptor instead.
+func (*BatchSentimentRequest) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchSentimentRequest) GetTexts() []string {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+type BatchSentimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SentimentResponse   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSentimentResponse) Reset() {
+	*x = BatchSentimentResponse{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSentimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSentimentResponse) ProtoMessage() {}
+
+func (x *BatchSentimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSentimentResponse.ProtoReflect.Descriptor instead.
+func (*BatchSentimentResponse) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchSentimentResponse) GetResults() []*SentimentResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Trend Prediction
+type PriceData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceData) Reset() {
+	*x = PriceData{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceData) ProtoMessage() {}
+
+func (x *PriceData) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceData.ProtoReflect.Descriptor instead.
+func (*PriceData) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PriceData) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PriceData) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type TrendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Data          []*PriceData           `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Periods       int32                  `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendRequest) Reset() {
+	*x = TrendRequest{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendRequest) ProtoMessage() {}
+
+func (x *TrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendRequest.ProtoReflect.Descriptor instead.
+func (*TrendRequest) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TrendRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TrendRequest) GetData() []*PriceData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TrendRequest) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+type TrendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dates         []string               `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
+	Predictions   []float64              `protobuf:"fixed64,2,rep,packed,name=predictions,proto3" json:"predictions,omitempty"`
+	LowerBound    []float64              `protobuf:"fixed64,3,rep,packed,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound    []float64              `protobuf:"fixed64,4,rep,packed,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendResponse) Reset() {
+	*x = TrendResponse{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendResponse) ProtoMessage() {}
+
+func (x *TrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendResponse.ProtoReflect.Descriptor instead.
+func (*TrendResponse) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TrendResponse) GetDates() []string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *TrendResponse) GetPredictions() []float64 {
+	if x != nil {
+		return x.Predictions
+	}
+	return nil
+}
+
+func (x *TrendResponse) GetLowerBound() []float64 {
+	if x != nil {
+		return x.LowerBound
+	}
+	return nil
+}
+
+func (x *TrendResponse) GetUpperBound() []float64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return nil
+}
+
+type GetSymbolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSymbolsRequest) Reset() {
+	*x = GetSymbolsRequest{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSymbolsRequest) ProtoMessage() {}
+
+func (x *GetSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*GetSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{7}
+}
+
+type GetSymbolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSymbolsResponse) Reset() {
+	*x = GetSymbolsResponse{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSymbolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSymbolsResponse) ProtoMessage() {}
+
+func (x *GetSymbolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*GetSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSymbolsResponse) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+// Trading
+type MarketData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Volume        float64                `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Indicators    map[string]float64     `protobuf:"bytes,5,rep,name=indicators,proto3" json:"indicators,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketData) Reset() {
+	*x = MarketData{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketData) ProtoMessage() {}
+
+func (x *MarketData) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketData.ProtoReflect.Descriptor instead.
+func (*MarketData) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarketData) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MarketData) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MarketData) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *MarketData) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *MarketData) GetIndicators() map[string]float64 {
+	if x != nil {
+		return x.Indicators
+	}
+	return nil
+}
+
+type TradingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketData    *MarketData            `protobuf:"bytes,1,opt,name=market_data,json=marketData,proto3" json:"market_data,omitempty"`
+	SentimentData map[string]float64     `protobuf:"bytes,2,rep,name=sentiment_data,json=sentimentData,proto3" json:"sentiment_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	TrendData     map[string]float64     `protobuf:"bytes,3,rep,name=trend_data,json=trendData,proto3" json:"trend_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradingRequest) Reset() {
+	*x = TradingRequest{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingRequest) ProtoMessage() {}
+
+func (x *TradingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingRequest.ProtoReflect.Descriptor instead.
+func (*TradingRequest) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TradingRequest) GetMarketData() *MarketData {
+	if x != nil {
+		return x.MarketData
+	}
+	return nil
+}
+
+func (x *TradingRequest) GetSentimentData() map[string]float64 {
+	if x != nil {
+		return x.SentimentData
+	}
+	return nil
+}
+
+func (x *TradingRequest) GetTrendData() map[string]float64 {
+	if x != nil {
+		return x.TrendData
+	}
+	return nil
+}
+
+type TradingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Parameters    map[string]string      `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Explanation   string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradingResponse) Reset() {
+	*x = TradingResponse{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingResponse) ProtoMessage() {}
+
+func (x *TradingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingResponse.ProtoReflect.Descriptor instead.
+func (*TradingResponse) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TradingResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TradingResponse) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *TradingResponse) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *TradingResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type TradeExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Trade         map[string]string      `protobuf:"bytes,2,rep,name=trade,proto3" json:"trade,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Analysis      *TradingResponse       `protobuf:"bytes,3,opt,name=analysis,proto3" json:"analysis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeExecutionResponse) Reset() {
+	*x = TradeExecutionResponse{}
+	mi := &file_ml_v1_ml_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeExecutionResponse) ProtoMessage() {}
+
+func (x *TradeExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_ml_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeExecutionResponse.ProtoReflect.Descriptor instead.
+func (*TradeExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_ml_v1_ml_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TradeExecutionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TradeExecutionResponse) GetTrade() map[string]string {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *TradeExecutionResponse) GetAnalysis() *TradingResponse {
+	if x != nil {
+		return x.Analysis
+	}
+	return nil
+}
+
+var File_ml_v1_ml_service_proto protoreflect.FileDescriptor
+
+const file_ml_v1_ml_service_proto_rawDesc = "" +
+	"\n" +
+	"\x16ml/v1/ml_service.proto\x12\n" +
+	"ml_service\"&\n" +
+	"\x10SentimentRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"e\n" +
+	"\x11SentimentResponse\x12\x1a\n" +
+	"\bpositive\x18\x01 \x01(\x02R\bpositive\x12\x18\n" +
+	"\aneutral\x18\x02 \x01(\x02R\aneutral\x12\x1a\n" +
+	"\bnegative\x18\x03 \x01(\x02R\bnegative\"-\n" +
+	"\x15BatchSentimentRequest\x12\x14\n" +
+	"\x05texts\x18\x01 \x03(\tR\x05texts\"Q\n" +
+	"\x16BatchSentimentResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.ml_service.SentimentResponseR\aresults\"5\n" +
+	"\tPriceData\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\"k\n" +
+	"\fTrendRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12)\n" +
+	"\x04data\x18\x02 \x03(\v2\x15.ml_service.PriceDataR\x04data\x12\x18\n" +
+	"\aperiods\x18\x03 \x01(\x05R\aperiods\"\x89\x01\n" +
+	"\rTrendResponse\x12\x14\n" +
+	"\x05dates\x18\x01 \x03(\tR\x05dates\x12 \n" +
+	"\vpredictions\x18\x02 \x03(\x01R\vpredictions\x12\x1f\n" +
+	"\vlower_bound\x18\x03 \x03(\x01R\n" +
+	"lowerBound\x12\x1f\n" +
+	"\vupper_bound\x18\x04 \x03(\x01R\n" +
+	"upperBound\"\x13\n" +
+	"\x11GetSymbolsRequest\".\n" +
+	"\x12GetSymbolsResponse\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\"\xf7\x01\n" +
+	"\n" +
+	"MarketData\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06volume\x18\x03 \x01(\x01R\x06volume\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\tR\ttimestamp\x12F\n" +
+	"\n" +
+	"indicators\x18\x05 \x03(\v2&.ml_service.MarketData.IndicatorsEntryR\n" +
+	"indicators\x1a=\n" +
+	"\x0fIndicatorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xe9\x02\n" +
+	"\x0eTradingRequest\x127\n" +
+	"\vmarket_data\x18\x01 \x01(\v2\x16.ml_service.MarketDataR\n" +
+	"marketData\x12T\n" +
+	"\x0esentiment_data\x18\x02 \x03(\v2-.ml_service.TradingRequest.SentimentDataEntryR\rsentimentData\x12H\n" +
+	"\n" +
+	"trend_data\x18\x03 \x03(\v2).ml_service.TradingRequest.TrendDataEntryR\ttrendData\x1a@\n" +
+	"\x12SentimentDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a<\n" +
+	"\x0eTrendDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xf7\x01\n" +
+	"\x0fTradingResponse\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01R\n" +
+	"confidence\x12K\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\v2+.ml_service.TradingResponse.ParametersEntryR\n" +
+	"parameters\x12 \n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe8\x01\n" +
+	"\x16TradeExecutionResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12C\n" +
+	"\x05trade\x18\x02 \x03(\v2-.ml_service.TradeExecutionResponse.TradeEntryR\x05trade\x127\n" +
+	"\banalysis\x18\x03 \x01(\v2\x1b.ml_service.TradingResponseR\banalysis\x1a8\n" +
+	"\n" +
+	"TradeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xf5\x03\n" +
+	"\tMLService\x12Q\n" +
+	"\x10AnalyzeSentiment\x12\x1c.ml_service.SentimentRequest\x1a\x1d.ml_service.SentimentResponse\"\x00\x12`\n" +
+	"\x15BatchAnalyzeSentiment\x12!.ml_service.BatchSentimentRequest\x1a\".ml_service.BatchSentimentResponse\"\x00\x12E\n" +
+	"\fPredictTrend\x12\x18.ml_service.TrendRequest\x1a\x19.ml_service.TrendResponse\"\x00\x12M\n" +
+	"\n" +
+	"GetSymbols\x12\x1d.ml_service.GetSymbolsRequest\x1a\x1e.ml_service.GetSymbolsResponse\"\x00\x12K\n" +
+	"\x0eAnalyzeTrading\x12\x1a.ml_service.TradingRequest\x1a\x1b.ml_service.TradingResponse\"\x00\x12P\n" +
+	"\fExecuteTrade\x12\x1a.ml_service.TradingRequest\x1a\".ml_service.TradeExecutionResponse\"\x00BJZHgithub.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/ml/v1;mlpbb\x06proto3"
+
+var (
+	file_ml_v1_ml_service_proto_rawDescOnce sync.Once
+	file_ml_v1_ml_service_proto_rawDescData []byte
+)
+
+func file_ml_v1_ml_service_proto_rawDescGZIP() []byte {
+	file_ml_v1_ml_service_proto_rawDescOnce.Do(func() {
+		file_ml_v1_ml_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ml_v1_ml_service_proto_rawDesc), len(file_ml_v1_ml_service_proto_rawDesc)))
+	})
+	return file_ml_v1_ml_service_proto_rawDescData
+}
+
+var file_ml_v1_ml_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_ml_v1_ml_service_proto_goTypes = []any{
+	(*SentimentRequest)(nil),       // 0: ml_service.SentimentRequest
+	(*SentimentResponse)(nil),      // 1: ml_service.SentimentResponse
+	(*BatchSentimentRequest)(nil),  // 2: ml_service.BatchSentimentRequest
+	(*BatchSentimentResponse)(nil), // 3: ml_service.BatchSentimentResponse
+	(*PriceData)(nil),              // 4: ml_service.PriceData
+	(*TrendRequest)(nil),           // 5: ml_service.TrendRequest
+	(*TrendResponse)(nil),          // 6: ml_service.TrendResponse
+	(*GetSymbolsRequest)(nil),      // 7: ml_service.GetSymbolsRequest
+	(*GetSymbolsResponse)(nil),     // 8: ml_service.GetSymbolsResponse
+	(*MarketData)(nil),             // 9: ml_service.MarketData
+	(*TradingRequest)(nil),         // 10: ml_service.TradingRequest
+	(*TradingResponse)(nil),        // 11: ml_service.TradingResponse
+	(*TradeExecutionResponse)(nil), // 12: ml_service.TradeExecutionResponse
+	nil,                            // 13: ml_service.MarketData.IndicatorsEntry
+	nil,                            // 14: ml_service.TradingRequest.SentimentDataEntry
+	nil,                            // 15: ml_service.TradingRequest.TrendDataEntry
+	nil,                            // 16: ml_service.TradingResponse.ParametersEntry
+	nil,                            // 17: ml_service.TradeExecutionResponse.TradeEntry
+}
+var file_ml_v1_ml_service_proto_depIdxs = []int32{
+	1,  // 0: ml_service.BatchSentimentResponse.results:type_name -> ml_service.SentimentResponse
+	4,  // 1: ml_service.TrendRequest.data:type_name -> ml_service.PriceData
+	13, // 2: ml_service.MarketData.indicators:type_name -> ml_service.MarketData.IndicatorsEntry
+	9,  // 3: ml_service.TradingRequest.market_data:type_name -> ml_service.MarketData
+	14, // 4: ml_service.TradingRequest.sentiment_data:type_name -> ml_service.TradingRequest.SentimentDataEntry
+	15, // 5: ml_service.TradingRequest.trend_data:type_name -> ml_service.TradingRequest.TrendDataEntry
+	16, // 6: ml_service.TradingResponse.parameters:type_name -> ml_service.TradingResponse.ParametersEntry
+	17, // 7: ml_service.TradeExecutionResponse.trade:type_name -> ml_service.TradeExecutionResponse.TradeEntry
+	11, // 8: ml_service.TradeExecutionResponse.analysis:type_name -> ml_service.TradingResponse
+	0,  // 9: ml_service.MLService.AnalyzeSentiment:input_type -> ml_service.SentimentRequest
+	2,  // 10: ml_service.MLService.BatchAnalyzeSentiment:input_type -> ml_service.BatchSentimentRequest
+	5,  // 11: ml_service.MLService.PredictTrend:input_type -> ml_service.TrendRequest
+	7,  // 12: ml_service.MLService.GetSymbols:input_type -> ml_service.GetSymbolsRequest
+	10, // 13: ml_service.MLService.AnalyzeTrading:input_type -> ml_service.TradingRequest
+	10, // 14: ml_service.MLService.ExecuteTrade:input_type -> ml_service.TradingRequest
+	1,  // 15: ml_service.MLService.AnalyzeSentiment:output_type -> ml_service.SentimentResponse
+	3,  // 16: ml_service.MLService.BatchAnalyzeSentiment:output_type -> ml_service.BatchSentimentResponse
+	6,  // 17: ml_service.MLService.PredictTrend:output_type -> ml_service.TrendResponse
+	8,  // 18: ml_service.MLService.GetSymbols:output_type -> ml_service.GetSymbolsResponse
+	11, // 19: ml_service.MLService.AnalyzeTrading:output_type -> ml_service.TradingResponse
+	12, // 20: ml_service.MLService.ExecuteTrade:output_type -> ml_service.TradeExecutionResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_ml_v1_ml_service_proto_init() }
+func file_ml_v1_ml_service_proto_init() {
+	if File_ml_v1_ml_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ml_v1_ml_service_proto_rawDesc), len(file_ml_v1_ml_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ml_v1_ml_service_proto_goTypes,
+		DependencyIndexes: file_ml_v1_ml_service_proto_depIdxs,
+		MessageInfos:      file_ml_v1_ml_service_proto_msgTypes,
+	}.Build()
+	File_ml_v1_ml_service_proto = out.File
+	file_ml_v1_ml_service_proto_goTypes = nil
+	file_ml_v1_ml_service_proto_depIdxs = nil
+}
@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: ml/v1/ml_service.proto
+
+package mlpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MLService_AnalyzeSentiment_FullMethodName      = "/ml_service.MLService/AnalyzeSentiment"
+	MLService_BatchAnalyzeSentiment_FullMethodName = "/ml_service.MLService/BatchAnalyzeSentiment"
+	MLService_PredictTrend_FullMethodName          = "/ml_service.MLService/PredictTrend"
+	MLService_GetSymbols_FullMethodName            = "/ml_service.MLService/GetSymbols"
+	MLService_AnalyzeTrading_FullMethodName        = "/ml_service.MLService/AnalyzeTrading"
+	MLService_ExecuteTrade_FullMethodName          = "/ml_service.MLService/ExecuteTrade"
+)
+
+// MLServiceClient is the client API for MLService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// --- SERVICE ---
+// ML Service definition
+type MLServiceClient interface {
+	// Analyze sentiment of text
+	AnalyzeSentiment(ctx context.Context, in *SentimentRequest, opts ...grpc.CallOption) (*SentimentResponse, error)
+	// Batch analyze sentiment
+	BatchAnalyzeSentiment(ctx context.Context, in *BatchSentimentRequest, opts ...grpc.CallOption) (*BatchSentimentResponse, error)
+	// Predict price trends
+	PredictTrend(ctx context.Context, in *TrendRequest, opts ...grpc.CallOption) (*TrendResponse, error)
+	// Get available symbols
+	GetSymbols(ctx context.Context, in *GetSymbolsRequest, opts ...grpc.CallOption) (*GetSymbolsResponse, error)
+	// Analyze trading opportunity
+	AnalyzeTrading(ctx context.Context, in *TradingRequest, opts ...grpc.CallOption) (*TradingResponse, error)
+	// Execute trade
+	ExecuteTrade(ctx context.Context, in *TradingRequest, opts ...grpc.CallOption) (*TradeExecutionResponse, error)
+}
+
+type mLServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMLServiceClient(cc grpc.ClientConnInterface) MLServiceClient {
+	return &mLServiceClient{cc}
+}
+
+func (c *mLServiceClient) AnalyzeSentiment(ctx context.Context, in *SentimentRequest, opts ...grpc.CallOption) (*SentimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SentimentResponse)
+	err := c.cc.Invoke(ctx, MLService_AnalyzeSentiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mLServiceClient) BatchAnalyzeSentiment(ctx context.Context, in *BatchSentimentRequest, opts ...grpc.CallOption) (*BatchSentimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchSentimentResponse)
+	err := c.cc.Invoke(ctx, MLService_BatchAnalyzeSentiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mLServiceClient) PredictTrend(ctx context.Context, in *TrendRequest, opts ...grpc.CallOption) (*TrendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendResponse)
+	err := c.cc.Invoke(ctx, MLService_PredictTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mLServiceClient) GetSymbols(ctx context.Context, in *GetSymbolsRequest, opts ...grpc.CallOption) (*GetSymbolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSymbolsResponse)
+	err := c.cc.Invoke(ctx, MLService_GetSymbols_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mLServiceClient) AnalyzeTrading(ctx context.Context, in *TradingRequest, opts ...grpc.CallOption) (*TradingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradingResponse)
+	err := c.cc.Invoke(ctx, MLService_AnalyzeTrading_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mLServiceClient) ExecuteTrade(ctx context.Context, in *TradingRequest, opts ...grpc.CallOption) (*TradeExecutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeExecutionResponse)
+	err := c.cc.Invoke(ctx, MLService_ExecuteTrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MLServiceServer is the server API for MLService service.
+// All implementations must embed UnimplementedMLServiceServer
+// for forward compatibility.
+//
+// --- SERVICE ---
+// ML Service definition
+type MLServiceServer interface {
+	// Analyze sentiment of text
+	AnalyzeSentiment(context.Context, *SentimentRequest) (*SentimentResponse, error)
+	// Batch analyze sentiment
+	BatchAnalyzeSentiment(context.Context, *BatchSentimentRequest) (*BatchSentimentResponse, error)
+	// Predict price trends
+	PredictTrend(context.Context, *TrendRequest) (*TrendResponse, error)
+	// Get available symbols
+	GetSymbols(context.Context, *GetSymbolsRequest) (*GetSymbolsResponse, error)
+	// Analyze trading opportunity
+	AnalyzeTrading(context.Context, *TradingRequest) (*TradingResponse, error)
+	// Execute trade
+	ExecuteTrade(context.Context, *TradingRequest) (*TradeExecutionResponse, error)
+	mustEmbedUnimplementedMLServiceServer()
+}
+
+// UnimplementedMLServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMLServiceServer struct{}
+
+func (UnimplementedMLServiceServer) AnalyzeSentiment(context.Context, *SentimentRequest) (*SentimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeSentiment not implemented")
+}
+func (UnimplementedMLServiceServer) BatchAnalyzeSentiment(context.Context, *BatchSentimentRequest) (*BatchSentimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAnalyzeSentiment not implemented")
+}
+func (UnimplementedMLServiceServer) PredictTrend(context.Context, *TrendRequest) (*TrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PredictTrend not implemented")
+}
+func (UnimplementedMLServiceServer) GetSymbols(context.Context, *GetSymbolsRequest) (*GetSymbolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSymbols not implemented")
+}
+func (UnimplementedMLServiceServer) AnalyzeTrading(context.Context, *TradingRequest) (*TradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeTrading not implemented")
+}
+func (UnimplementedMLServiceServer) ExecuteTrade(context.Context, *TradingRequest) (*TradeExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteTrade not implemented")
+}
+func (UnimplementedMLServiceServer) mustEmbedUnimplementedMLServiceServer() {}
+func (UnimplementedMLServiceServer) testEmbeddedByValue()                   {}
+
+// UnsafeMLServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MLServiceServer will
+// result in compilation errors.
+type UnsafeMLServiceServer interface {
+	mustEmbedUnimplementedMLServiceServer()
+}
+
+func RegisterMLServiceServer(s grpc.ServiceRegistrar, srv MLServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMLServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MLService_ServiceDesc, srv)
+}
+
+func _MLService_AnalyzeSentiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SentimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MLServiceServer).AnalyzeSentiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MLService_AnalyzeSentiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MLServiceServer).AnalyzeSentiment(ctx, req.(*SentimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MLService_BatchAnalyzeSentiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSentimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MLServiceServer).BatchAnalyzeSentiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MLService_BatchAnalyzeSentiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MLServiceServer).BatchAnalyzeSentiment(ctx, req.(*BatchSentimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MLService_PredictTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MLServiceServer).PredictTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MLService_PredictTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MLServiceServer).PredictTrend(ctx, req.(*TrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MLService_GetSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MLServiceServer).GetSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MLService_GetSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MLServiceServer).GetSymbols(ctx, req.(*GetSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MLService_AnalyzeTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MLServiceServer).AnalyzeTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MLService_AnalyzeTrading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MLServiceServer).AnalyzeTrading(ctx, req.(*TradingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MLService_ExecuteTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MLServiceServer).ExecuteTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MLService_ExecuteTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MLServiceServer).ExecuteTrade(ctx, req.(*TradingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MLService_ServiceDesc is the grpc.ServiceDesc for MLService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MLService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ml_service.MLService",
+	HandlerType: (*MLServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AnalyzeSentiment",
+			Handler:    _MLService_AnalyzeSentiment_Handler,
+		},
+		{
+			MethodName: "BatchAnalyzeSentiment",
+			Handler:    _MLService_BatchAnalyzeSentiment_Handler,
+		},
+		{
+			MethodName: "PredictTrend",
+			Handler:    _MLService_PredictTrend_Handler,
+		},
+		{
+			MethodName: "GetSymbols",
+			Handler:    _MLService_GetSymbols_Handler,
+		},
+		{
+			MethodName: "AnalyzeTrading",
+			Handler:    _MLService_AnalyzeTrading_Handler,
+		},
+		{
+			MethodName: "ExecuteTrade",
+			Handler:    _MLService_ExecuteTrade_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ml/v1/ml_service.proto",
+}
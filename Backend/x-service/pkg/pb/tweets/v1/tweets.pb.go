@@ -76,7 +76,8 @@ func (x *IngestRequest) GetMax() int32 {
 
 type IngestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ingested      int32                  `protobuf:"varint,1,opt,name=ingested,proto3" json:"ingested,omitempty"` // number of tweets ingested
+	Ingested      int32                  `protobuf:"varint,1,opt,name=ingested,proto3" json:"ingested,omitempty"`       // number of tweets ingested
+	Quarantined   int32                  `protobuf:"varint,2,opt,name=quarantined,proto3" json:"quarantined,omitempty"` // number of tweets quarantined by the spam filter
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -118,6 +119,13 @@ func (x *IngestResponse) GetIngested() int32 {
 	return 0
 }
 
+func (x *IngestResponse) GetQuarantined() int32 {
+	if x != nil {
+		return x.Quarantined
+	}
+	return 0
+}
+
 type ListLatestTweetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // max number of tweets to return
@@ -294,30 +302,354 @@ func (x *GetTweetByIDResponse) GetTweet() *Tweet {
 	return nil
 }
 
-// --- ADVANCED MESSAGES ---
-type Tweet struct {
+type ListTweetsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`                                       // optional symbol filter
+	SentimentLabel string                 `protobuf:"bytes,2,opt,name=sentiment_label,json=sentimentLabel,proto3" json:"sentiment_label,omitempty"` // optional label filter, POS, NEG or NEU
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                        // page size, 1 .. 100
+	Cursor         string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                                       // next_cursor of the previous page, empty for the first one
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTweetsRequest) Reset() {
+	*x = ListTweetsRequest{}
+	mi := &file_tweets_v1_tweets_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTweetsRequest) ProtoMessage() {}
+
+func (x *ListTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweets_v1_tweets_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTweetsRequest.ProtoReflect.Descriptor instead.
+func (*ListTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTweetsRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ListTweetsRequest) GetSentimentLabel() string {
+	if x != nil {
+		return x.SentimentLabel
+	}
+	return ""
+}
+
+func (x *ListTweetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTweetsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListTweetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tweets        []*Tweet               `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTweetsResponse) Reset() {
+	*x = ListTweetsResponse{}
+	mi := &file_tweets_v1_tweets_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTweetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTweetsResponse) ProtoMessage() {}
+
+func (x *ListTweetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweets_v1_tweets_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTweetsResponse.ProtoReflect.Descriptor instead.
+func (*ListTweetsResponse) Descriptor() ([]byte, []int) {
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTweetsResponse) GetTweets() []*Tweet {
+	if x != nil {
+		return x.Tweets
+	}
+	return nil
+}
+
+func (x *ListTweetsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetSentimentSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"` // unix seconds, days from the day of it
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`     // unix seconds, days up to the day of it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSentimentSeriesRequest) Reset() {
+	*x = GetSentimentSeriesRequest{}
+	mi := &file_tweets_v1_tweets_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSentimentSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSentimentSeriesRequest) ProtoMessage() {}
+
+func (x *GetSentimentSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweets_v1_tweets_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSentimentSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetSentimentSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSentimentSeriesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetSentimentSeriesRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetSentimentSeriesRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type GetSentimentSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*SentimentDay        `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"` // ordered by day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSentimentSeriesResponse) Reset() {
+	*x = GetSentimentSeriesResponse{}
+	mi := &file_tweets_v1_tweets_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSentimentSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSentimentSeriesResponse) ProtoMessage() {}
+
+func (x *GetSentimentSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweets_v1_tweets_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSentimentSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetSentimentSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSentimentSeriesResponse) GetDays() []*SentimentDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type ListTrendingSymbolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         int64                  `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"` // unix seconds, start of the window
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 1 .. 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingSymbolsRequest) Reset() {
+	*x = ListTrendingSymbolsRequest{}
+	mi := &file_tweets_v1_tweets_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingSymbolsRequest) ProtoMessage() {}
+
+func (x *ListTrendingSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tweets_v1_tweets_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTrendingSymbolsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListTrendingSymbolsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTrendingSymbolsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID
-	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
-	Lang          string                 `protobuf:"bytes,5,opt,name=lang,proto3" json:"lang,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
-	FetchedAt     int64                  `protobuf:"varint,7,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"` // unix seconds (when we stored it)
-	Likes         int32                  `protobuf:"varint,8,opt,name=likes,proto3" json:"likes,omitempty"`
-	Replies       int32                  `protobuf:"varint,9,opt,name=replies,proto3" json:"replies,omitempty"`
-	Retweets      int32                  `protobuf:"varint,10,opt,name=retweets,proto3" json:"retweets,omitempty"`
-	Views         int32                  `protobuf:"varint,11,opt,name=views,proto3" json:"views,omitempty"`
-	Urls          []string               `protobuf:"bytes,12,rep,name=urls,proto3" json:"urls,omitempty"`     // list of urls
-	Photos        []string               `protobuf:"bytes,13,rep,name=photos,proto3" json:"photos,omitempty"` // list of photos
-	Videos        []string               `protobuf:"bytes,14,rep,name=videos,proto3" json:"videos,omitempty"` // list of videos
+	Symbols       []*SymbolTrend         `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"` // ordered by mentions desc
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ListTrendingSymbolsResponse) Reset() {
+	*x = ListTrendingSymbolsResponse{}
+	mi := &file_tweets_v1_tweets_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingSymbolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingSymbolsResponse) ProtoMessage() {}
+
+func (x *ListTrendingSymbolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tweets_v1_tweets_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListTrendingSymbolsResponse) GetSymbols() []*SymbolTrend {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+// --- ADVANCED MESSAGES ---
+type Tweet struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID
+	AuthorId       string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Username       string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Text           string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Lang           string                 `protobuf:"bytes,5,opt,name=lang,proto3" json:"lang,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
+	FetchedAt      int64                  `protobuf:"varint,7,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"` // unix seconds (when we stored it)
+	Likes          int32                  `protobuf:"varint,8,opt,name=likes,proto3" json:"likes,omitempty"`
+	Replies        int32                  `protobuf:"varint,9,opt,name=replies,proto3" json:"replies,omitempty"`
+	Retweets       int32                  `protobuf:"varint,10,opt,name=retweets,proto3" json:"retweets,omitempty"`
+	Views          int32                  `protobuf:"varint,11,opt,name=views,proto3" json:"views,omitempty"`
+	Urls           []string               `protobuf:"bytes,12,rep,name=urls,proto3" json:"urls,omitempty"`     // list of urls
+	Photos         []string               `protobuf:"bytes,13,rep,name=photos,proto3" json:"photos,omitempty"` // list of photos
+	Videos         []string               `protobuf:"bytes,14,rep,name=videos,proto3" json:"videos,omitempty"` // list of videos
+	IsFinancial    bool                   `protobuf:"varint,15,opt,name=is_financial,json=isFinancial,proto3" json:"is_financial,omitempty"`
+	Symbols        []string               `protobuf:"bytes,16,rep,name=symbols,proto3" json:"symbols,omitempty"`                                       // cashtags, upper case
+	SentimentScore float64                `protobuf:"fixed64,17,opt,name=sentiment_score,json=sentimentScore,proto3" json:"sentiment_score,omitempty"` // range -1 .. 1
+	SentimentLabel string                 `protobuf:"bytes,18,opt,name=sentiment_label,json=sentimentLabel,proto3" json:"sentiment_label,omitempty"`   // POS, NEG or NEU, empty until scored
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *Tweet) Reset() {
 	*x = Tweet{}
-	mi := &file_tweets_v1_tweets_proto_msgTypes[6]
+	mi := &file_tweets_v1_tweets_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -329,7 +661,7 @@ func (x *Tweet) String() string {
 func (*Tweet) ProtoMessage() {}
 
 func (x *Tweet) ProtoReflect() protoreflect.Message {
-	mi := &file_tweets_v1_tweets_proto_msgTypes[6]
+	mi := &file_tweets_v1_tweets_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +674,7 @@ func (x *Tweet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tweet.ProtoReflect.Descriptor instead.
 func (*Tweet) Descriptor() ([]byte, []int) {
-	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{6}
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Tweet) GetId() string {
@@ -443,6 +775,178 @@ func (x *Tweet) GetVideos() []string {
 	return nil
 }
 
+func (x *Tweet) GetIsFinancial() bool {
+	if x != nil {
+		return x.IsFinancial
+	}
+	return false
+}
+
+func (x *Tweet) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *Tweet) GetSentimentScore() float64 {
+	if x != nil {
+		return x.SentimentScore
+	}
+	return 0
+}
+
+func (x *Tweet) GetSentimentLabel() string {
+	if x != nil {
+		return x.SentimentLabel
+	}
+	return ""
+}
+
+type SentimentDay struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Day              string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"` // YYYY-MM-DD
+	AvgScore         float64                `protobuf:"fixed64,2,opt,name=avg_score,json=avgScore,proto3" json:"avg_score,omitempty"`
+	WeightedAvgScore *float64               `protobuf:"fixed64,3,opt,name=weighted_avg_score,json=weightedAvgScore,proto3,oneof" json:"weighted_avg_score,omitempty"` // unset until author scores are computed
+	Positive         int32                  `protobuf:"varint,4,opt,name=positive,proto3" json:"positive,omitempty"`
+	Negative         int32                  `protobuf:"varint,5,opt,name=negative,proto3" json:"negative,omitempty"`
+	Neutral          int32                  `protobuf:"varint,6,opt,name=neutral,proto3" json:"neutral,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SentimentDay) Reset() {
+	*x = SentimentDay{}
+	mi := &file_tweets_v1_tweets_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentimentDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentimentDay) ProtoMessage() {}
+
+func (x *SentimentDay) ProtoReflect() protoreflect.Message {
+	mi := &file_tweets_v1_tweets_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentDay.ProtoReflect.Descriptor instead.
+func (*SentimentDay) Descriptor() ([]byte, []int) {
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SentimentDay) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *SentimentDay) GetAvgScore() float64 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *SentimentDay) GetWeightedAvgScore() float64 {
+	if x != nil && x.WeightedAvgScore != nil {
+		return *x.WeightedAvgScore
+	}
+	return 0
+}
+
+func (x *SentimentDay) GetPositive() int32 {
+	if x != nil {
+		return x.Positive
+	}
+	return 0
+}
+
+func (x *SentimentDay) GetNegative() int32 {
+	if x != nil {
+		return x.Negative
+	}
+	return 0
+}
+
+func (x *SentimentDay) GetNeutral() int32 {
+	if x != nil {
+		return x.Neutral
+	}
+	return 0
+}
+
+type SymbolTrend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Mentions      int32                  `protobuf:"varint,2,opt,name=mentions,proto3" json:"mentions,omitempty"`
+	AvgSentiment  float64                `protobuf:"fixed64,3,opt,name=avg_sentiment,json=avgSentiment,proto3" json:"avg_sentiment,omitempty"` // range -1 .. 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolTrend) Reset() {
+	*x = SymbolTrend{}
+	mi := &file_tweets_v1_tweets_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolTrend) ProtoMessage() {}
+
+func (x *SymbolTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_tweets_v1_tweets_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolTrend.ProtoReflect.Descriptor instead.
+func (*SymbolTrend) Descriptor() ([]byte, []int) {
+	return file_tweets_v1_tweets_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SymbolTrend) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SymbolTrend) GetMentions() int32 {
+	if x != nil {
+		return x.Mentions
+	}
+	return 0
+}
+
+func (x *SymbolTrend) GetAvgSentiment() float64 {
+	if x != nil {
+		return x.AvgSentiment
+	}
+	return 0
+}
+
 var File_tweets_v1_tweets_proto protoreflect.FileDescriptor
 
 const file_tweets_v1_tweets_proto_rawDesc = "" +
@@ -450,9 +954,10 @@ const file_tweets_v1_tweets_proto_rawDesc = "" +
 	"\x16tweets/v1/tweets.proto\x12\ttweets.v1\"7\n" +
 	"\rIngestRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x10\n" +
-	"\x03max\x18\x02 \x01(\x05R\x03max\",\n" +
+	"\x03max\x18\x02 \x01(\x05R\x03max\"N\n" +
 	"\x0eIngestResponse\x12\x1a\n" +
-	"\bingested\x18\x01 \x01(\x05R\bingested\"/\n" +
+	"\bingested\x18\x01 \x01(\x05R\bingested\x12 \n" +
+	"\vquarantined\x18\x02 \x01(\x05R\vquarantined\"/\n" +
 	"\x17ListLatestTweetsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"D\n" +
 	"\x18ListLatestTweetsResponse\x12(\n" +
@@ -460,7 +965,27 @@ const file_tweets_v1_tweets_proto_rawDesc = "" +
 	"\x13GetTweetByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
 	"\x14GetTweetByIDResponse\x12&\n" +
-	"\x05tweet\x18\x01 \x01(\v2\x10.tweets.v1.TweetR\x05tweet\"\xdc\x02\n" +
+	"\x05tweet\x18\x01 \x01(\v2\x10.tweets.v1.TweetR\x05tweet\"\x82\x01\n" +
+	"\x11ListTweetsRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12'\n" +
+	"\x0fsentiment_label\x18\x02 \x01(\tR\x0esentimentLabel\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"_\n" +
+	"\x12ListTweetsResponse\x12(\n" +
+	"\x06tweets\x18\x01 \x03(\v2\x10.tweets.v1.TweetR\x06tweets\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"W\n" +
+	"\x19GetSentimentSeriesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\"I\n" +
+	"\x1aGetSentimentSeriesResponse\x12+\n" +
+	"\x04days\x18\x01 \x03(\v2\x17.tweets.v1.SentimentDayR\x04days\"H\n" +
+	"\x1aListTrendingSymbolsRequest\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"O\n" +
+	"\x1bListTrendingSymbolsResponse\x120\n" +
+	"\asymbols\x18\x01 \x03(\v2\x16.tweets.v1.SymbolTrendR\asymbols\"\xeb\x03\n" +
 	"\x05Tweet\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1a\n" +
@@ -478,11 +1003,31 @@ const file_tweets_v1_tweets_proto_rawDesc = "" +
 	"\x05views\x18\v \x01(\x05R\x05views\x12\x12\n" +
 	"\x04urls\x18\f \x03(\tR\x04urls\x12\x16\n" +
 	"\x06photos\x18\r \x03(\tR\x06photos\x12\x16\n" +
-	"\x06videos\x18\x0e \x03(\tR\x06videos2\xfb\x01\n" +
+	"\x06videos\x18\x0e \x03(\tR\x06videos\x12!\n" +
+	"\fis_financial\x18\x0f \x01(\bR\visFinancial\x12\x18\n" +
+	"\asymbols\x18\x10 \x03(\tR\asymbols\x12'\n" +
+	"\x0fsentiment_score\x18\x11 \x01(\x01R\x0esentimentScore\x12'\n" +
+	"\x0fsentiment_label\x18\x12 \x01(\tR\x0esentimentLabel\"\xd9\x01\n" +
+	"\fSentimentDay\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x1b\n" +
+	"\tavg_score\x18\x02 \x01(\x01R\bavgScore\x121\n" +
+	"\x12weighted_avg_score\x18\x03 \x01(\x01H\x00R\x10weightedAvgScore\x88\x01\x01\x12\x1a\n" +
+	"\bpositive\x18\x04 \x01(\x05R\bpositive\x12\x1a\n" +
+	"\bnegative\x18\x05 \x01(\x05R\bnegative\x12\x18\n" +
+	"\aneutral\x18\x06 \x01(\x05R\aneutralB\x15\n" +
+	"\x13_weighted_avg_score\"f\n" +
+	"\vSymbolTrend\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bmentions\x18\x02 \x01(\x05R\bmentions\x12#\n" +
+	"\ravg_sentiment\x18\x03 \x01(\x01R\favgSentiment2\x8f\x04\n" +
 	"\fTweetService\x12=\n" +
 	"\x06Ingest\x12\x18.tweets.v1.IngestRequest\x1a\x19.tweets.v1.IngestResponse\x12[\n" +
 	"\x10ListLatestTweets\x12\".tweets.v1.ListLatestTweetsRequest\x1a#.tweets.v1.ListLatestTweetsResponse\x12O\n" +
-	"\fGetTweetByID\x12\x1e.tweets.v1.GetTweetByIDRequest\x1a\x1f.tweets.v1.GetTweetByIDResponseBRZPgithub.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/tweets/v1;tweetspbb\x06proto3"
+	"\fGetTweetByID\x12\x1e.tweets.v1.GetTweetByIDRequest\x1a\x1f.tweets.v1.GetTweetByIDResponse\x12I\n" +
+	"\n" +
+	"ListTweets\x12\x1c.tweets.v1.ListTweetsRequest\x1a\x1d.tweets.v1.ListTweetsResponse\x12a\n" +
+	"\x12GetSentimentSeries\x12$.tweets.v1.GetSentimentSeriesRequest\x1a%.tweets.v1.GetSentimentSeriesResponse\x12d\n" +
+	"\x13ListTrendingSymbols\x12%.tweets.v1.ListTrendingSymbolsRequest\x1a&.tweets.v1.ListTrendingSymbolsResponseBRZPgithub.com/Denterry/FinancialAdviser/Backend/x-service/pkg/pb/tweets/v1;tweetspbb\x06proto3"
 
 var (
 	file_tweets_v1_tweets_proto_rawDescOnce sync.Once
@@ -496,30 +1041,47 @@ func file_tweets_v1_tweets_proto_rawDescGZIP() []byte {
 	return file_tweets_v1_tweets_proto_rawDescData
 }
 
-var file_tweets_v1_tweets_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_tweets_v1_tweets_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_tweets_v1_tweets_proto_goTypes = []any{
-	(*IngestRequest)(nil),            // 0: tweets.v1.IngestRequest
-	(*IngestResponse)(nil),           // 1: tweets.v1.IngestResponse
-	(*ListLatestTweetsRequest)(nil),  // 2: tweets.v1.ListLatestTweetsRequest
-	(*ListLatestTweetsResponse)(nil), // 3: tweets.v1.ListLatestTweetsResponse
-	(*GetTweetByIDRequest)(nil),      // 4: tweets.v1.GetTweetByIDRequest
-	(*GetTweetByIDResponse)(nil),     // 5: tweets.v1.GetTweetByIDResponse
-	(*Tweet)(nil),                    // 6: tweets.v1.Tweet
+	(*IngestRequest)(nil),               // 0: tweets.v1.IngestRequest
+	(*IngestResponse)(nil),              // 1: tweets.v1.IngestResponse
+	(*ListLatestTweetsRequest)(nil),     // 2: tweets.v1.ListLatestTweetsRequest
+	(*ListLatestTweetsResponse)(nil),    // 3: tweets.v1.ListLatestTweetsResponse
+	(*GetTweetByIDRequest)(nil),         // 4: tweets.v1.GetTweetByIDRequest
+	(*GetTweetByIDResponse)(nil),        // 5: tweets.v1.GetTweetByIDResponse
+	(*ListTweetsRequest)(nil),           // 6: tweets.v1.ListTweetsRequest
+	(*ListTweetsResponse)(nil),          // 7: tweets.v1.ListTweetsResponse
+	(*GetSentimentSeriesRequest)(nil),   // 8: tweets.v1.GetSentimentSeriesRequest
+	(*GetSentimentSeriesResponse)(nil),  // 9: tweets.v1.GetSentimentSeriesResponse
+	(*ListTrendingSymbolsRequest)(nil),  // 10: tweets.v1.ListTrendingSymbolsRequest
+	(*ListTrendingSymbolsResponse)(nil), // 11: tweets.v1.ListTrendingSymbolsResponse
+	(*Tweet)(nil),                       // 12: tweets.v1.Tweet
+	(*SentimentDay)(nil),                // 13: tweets.v1.SentimentDay
+	(*SymbolTrend)(nil),                 // 14: tweets.v1.SymbolTrend
 }
 var file_tweets_v1_tweets_proto_depIdxs = []int32{
-	6, // 0: tweets.v1.ListLatestTweetsResponse.tweets:type_name -> tweets.v1.Tweet
-	6, // 1: tweets.v1.GetTweetByIDResponse.tweet:type_name -> tweets.v1.Tweet
-	0, // 2: tweets.v1.TweetService.Ingest:input_type -> tweets.v1.IngestRequest
-	2, // 3: tweets.v1.TweetService.ListLatestTweets:input_type -> tweets.v1.ListLatestTweetsRequest
-	4, // 4: tweets.v1.TweetService.GetTweetByID:input_type -> tweets.v1.GetTweetByIDRequest
-	1, // 5: tweets.v1.TweetService.Ingest:output_type -> tweets.v1.IngestResponse
-	3, // 6: tweets.v1.TweetService.ListLatestTweets:output_type -> tweets.v1.ListLatestTweetsResponse
-	5, // 7: tweets.v1.TweetService.GetTweetByID:output_type -> tweets.v1.GetTweetByIDResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: tweets.v1.ListLatestTweetsResponse.tweets:type_name -> tweets.v1.Tweet
+	12, // 1: tweets.v1.GetTweetByIDResponse.tweet:type_name -> tweets.v1.Tweet
+	12, // 2: tweets.v1.ListTweetsResponse.tweets:type_name -> tweets.v1.Tweet
+	13, // 3: tweets.v1.GetSentimentSeriesResponse.days:type_name -> tweets.v1.SentimentDay
+	14, // 4: tweets.v1.ListTrendingSymbolsResponse.symbols:type_name -> tweets.v1.SymbolTrend
+	0,  // 5: tweets.v1.TweetService.Ingest:input_type -> tweets.v1.IngestRequest
+	2,  // 6: tweets.v1.TweetService.ListLatestTweets:input_type -> tweets.v1.ListLatestTweetsRequest
+	4,  // 7: tweets.v1.TweetService.GetTweetByID:input_type -> tweets.v1.GetTweetByIDRequest
+	6,  // 8: tweets.v1.TweetService.ListTweets:input_type -> tweets.v1.ListTweetsRequest
+	8,  // 9: tweets.v1.TweetService.GetSentimentSeries:input_type -> tweets.v1.GetSentimentSeriesRequest
+	10, // 10: tweets.v1.TweetService.ListTrendingSymbols:input_type -> tweets.v1.ListTrendingSymbolsRequest
+	1,  // 11: tweets.v1.TweetService.Ingest:output_type -> tweets.v1.IngestResponse
+	3,  // 12: tweets.v1.TweetService.ListLatestTweets:output_type -> tweets.v1.ListLatestTweetsResponse
+	5,  // 13: tweets.v1.TweetService.GetTweetByID:output_type -> tweets.v1.GetTweetByIDResponse
+	7,  // 14: tweets.v1.TweetService.ListTweets:output_type -> tweets.v1.ListTweetsResponse
+	9,  // 15: tweets.v1.TweetService.GetSentimentSeries:output_type -> tweets.v1.GetSentimentSeriesResponse
+	11, // 16: tweets.v1.TweetService.ListTrendingSymbols:output_type -> tweets.v1.ListTrendingSymbolsResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_tweets_v1_tweets_proto_init() }
@@ -527,13 +1089,14 @@ func file_tweets_v1_tweets_proto_init() {
 	if File_tweets_v1_tweets_proto != nil {
 		return
 	}
+	file_tweets_v1_tweets_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tweets_v1_tweets_proto_rawDesc), len(file_tweets_v1_tweets_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
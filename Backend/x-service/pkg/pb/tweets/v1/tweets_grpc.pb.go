@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TweetService_Ingest_FullMethodName           = "/tweets.v1.TweetService/Ingest"
-	TweetService_ListLatestTweets_FullMethodName = "/tweets.v1.TweetService/ListLatestTweets"
-	TweetService_GetTweetByID_FullMethodName     = "/tweets.v1.TweetService/GetTweetByID"
+	TweetService_Ingest_FullMethodName              = "/tweets.v1.TweetService/Ingest"
+	TweetService_ListLatestTweets_FullMethodName    = "/tweets.v1.TweetService/ListLatestTweets"
+	TweetService_GetTweetByID_FullMethodName        = "/tweets.v1.TweetService/GetTweetByID"
+	TweetService_ListTweets_FullMethodName          = "/tweets.v1.TweetService/ListTweets"
+	TweetService_GetSentimentSeries_FullMethodName  = "/tweets.v1.TweetService/GetSentimentSeries"
+	TweetService_ListTrendingSymbols_FullMethodName = "/tweets.v1.TweetService/ListTrendingSymbols"
 )
 
 // TweetServiceClient is the client API for TweetService service.
@@ -37,6 +40,13 @@ type TweetServiceClient interface {
 	ListLatestTweets(ctx context.Context, in *ListLatestTweetsRequest, opts ...grpc.CallOption) (*ListLatestTweetsResponse, error)
 	// Return one tweet by internal ID (UUID string).
 	GetTweetByID(ctx context.Context, in *GetTweetByIDRequest, opts ...grpc.CallOption) (*GetTweetByIDResponse, error)
+	// Return a page of tweets ordered by created_at desc, optionally of one
+	// symbol or sentiment label, pages are chained with cursors
+	ListTweets(ctx context.Context, in *ListTweetsRequest, opts ...grpc.CallOption) (*ListTweetsResponse, error)
+	// Return the daily sentiment aggregates of a symbol
+	GetSentimentSeries(ctx context.Context, in *GetSentimentSeriesRequest, opts ...grpc.CallOption) (*GetSentimentSeriesResponse, error)
+	// Return the symbols mentioned most in a recent time window
+	ListTrendingSymbols(ctx context.Context, in *ListTrendingSymbolsRequest, opts ...grpc.CallOption) (*ListTrendingSymbolsResponse, error)
 }
 
 type tweetServiceClient struct {
@@ -77,6 +87,36 @@ func (c *tweetServiceClient) GetTweetByID(ctx context.Context, in *GetTweetByIDR
 	return out, nil
 }
 
+func (c *tweetServiceClient) ListTweets(ctx context.Context, in *ListTweetsRequest, opts ...grpc.CallOption) (*ListTweetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTweetsResponse)
+	err := c.cc.Invoke(ctx, TweetService_ListTweets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) GetSentimentSeries(ctx context.Context, in *GetSentimentSeriesRequest, opts ...grpc.CallOption) (*GetSentimentSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSentimentSeriesResponse)
+	err := c.cc.Invoke(ctx, TweetService_GetSentimentSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tweetServiceClient) ListTrendingSymbols(ctx context.Context, in *ListTrendingSymbolsRequest, opts ...grpc.CallOption) (*ListTrendingSymbolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrendingSymbolsResponse)
+	err := c.cc.Invoke(ctx, TweetService_ListTrendingSymbols_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TweetServiceServer is the server API for TweetService service.
 // All implementations must embed UnimplementedTweetServiceServer
 // for forward compatibility.
@@ -90,6 +130,13 @@ type TweetServiceServer interface {
 	ListLatestTweets(context.Context, *ListLatestTweetsRequest) (*ListLatestTweetsResponse, error)
 	// Return one tweet by internal ID (UUID string).
 	GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error)
+	// Return a page of tweets ordered by created_at desc, optionally of one
+	// symbol or sentiment label, pages are chained with cursors
+	ListTweets(context.Context, *ListTweetsRequest) (*ListTweetsResponse, error)
+	// Return the daily sentiment aggregates of a symbol
+	GetSentimentSeries(context.Context, *GetSentimentSeriesRequest) (*GetSentimentSeriesResponse, error)
+	// Return the symbols mentioned most in a recent time window
+	ListTrendingSymbols(context.Context, *ListTrendingSymbolsRequest) (*ListTrendingSymbolsResponse, error)
 	mustEmbedUnimplementedTweetServiceServer()
 }
 
@@ -109,6 +156,15 @@ func (UnimplementedTweetServiceServer) ListLatestTweets(context.Context, *ListLa
 func (UnimplementedTweetServiceServer) GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTweetByID not implemented")
 }
+func (UnimplementedTweetServiceServer) ListTweets(context.Context, *ListTweetsRequest) (*ListTweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTweets not implemented")
+}
+func (UnimplementedTweetServiceServer) GetSentimentSeries(context.Context, *GetSentimentSeriesRequest) (*GetSentimentSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSentimentSeries not implemented")
+}
+func (UnimplementedTweetServiceServer) ListTrendingSymbols(context.Context, *ListTrendingSymbolsRequest) (*ListTrendingSymbolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingSymbols not implemented")
+}
 func (UnimplementedTweetServiceServer) mustEmbedUnimplementedTweetServiceServer() {}
 func (UnimplementedTweetServiceServer) testEmbeddedByValue()                      {}
 
@@ -184,6 +240,60 @@ func _TweetService_GetTweetByID_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TweetService_ListTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).ListTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TweetService_ListTweets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).ListTweets(ctx, req.(*ListTweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_GetSentimentSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSentimentSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).GetSentimentSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TweetService_GetSentimentSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).GetSentimentSeries(ctx, req.(*GetSentimentSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TweetService_ListTrendingSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).ListTrendingSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TweetService_ListTrendingSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).ListTrendingSymbols(ctx, req.(*ListTrendingSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TweetService_ServiceDesc is the grpc.ServiceDesc for TweetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +313,18 @@ var TweetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTweetByID",
 			Handler:    _TweetService_GetTweetByID_Handler,
 		},
+		{
+			MethodName: "ListTweets",
+			Handler:    _TweetService_ListTweets_Handler,
+		},
+		{
+			MethodName: "GetSentimentSeries",
+			Handler:    _TweetService_GetSentimentSeries_Handler,
+		},
+		{
+			MethodName: "ListTrendingSymbols",
+			Handler:    _TweetService_ListTrendingSymbols_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tweets/v1/tweets.proto",